@@ -0,0 +1,82 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/loads/fmts"
+	"github.com/go-swagger/go-swagger/generator"
+	"github.com/jessevdk/go-flags"
+	"gopkg.in/yaml.v2"
+)
+
+// ConvertSpec is a command that converts a spec document to another version of the specification
+type ConvertSpec struct {
+	ToSwagger2 bool           `long:"to-2.0" description:"downgrade an OpenAPI 3.0 document to swagger 2.0"`
+	Output     flags.Filename `long:"output" short:"o" description:"the file to write the converted document to, written as yaml for a .yml or .yaml extension, defaults to json on stdout"`
+}
+
+// Execute converts the spec
+func (c *ConvertSpec) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("The convert command requires the document url to be specified")
+	}
+	if !c.ToSwagger2 {
+		return errors.New("The convert command only supports --to-2.0 for now")
+	}
+
+	var raw json.RawMessage
+	var err error
+	if fmts.YAMLMatcher(args[0]) {
+		raw, err = fmts.YAMLDoc(args[0])
+	} else {
+		raw, err = loads.JSONDoc(args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	specDoc, err := generator.DowngradeSpec(raw)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(specDoc.Spec(), "", "  ")
+	if err != nil {
+		return err
+	}
+	output := string(c.Output)
+	if ext := filepath.Ext(output); ext == ".yml" || ext == ".yaml" {
+		var doc yaml.MapSlice
+		if err := yaml.Unmarshal(b, &doc); err != nil {
+			return err
+		}
+		if b, err = yaml.Marshal(doc); err != nil {
+			return err
+		}
+	}
+	if output == "" {
+		_, err = fmt.Fprintln(os.Stdout, string(b))
+		return err
+	}
+	return ioutil.WriteFile(output, b, 0644)
+}
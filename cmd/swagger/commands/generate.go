@@ -24,4 +24,8 @@ type Generate struct {
 	Server    *generate.Server    `command:"server"`
 	Spec      *generate.SpecFile  `command:"spec"`
 	Client    *generate.Client    `command:"client"`
+	Validator *generate.Validator `command:"validator"`
+
+	ConformanceTests *generate.ConformanceTests `command:"conformance-tests"`
+	Benchmarks       *generate.Benchmarks       `command:"benchmarks"`
 }
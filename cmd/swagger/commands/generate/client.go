@@ -26,8 +26,10 @@ type Client struct {
 	Models          []string `long:"model" short:"M" description:"specify a model to include, repeat for multiple"`
 	DefaultScheme   string   `long:"default-scheme" description:"the default scheme for this client" default:"http"`
 	DefaultProduces string   `long:"default-produces" description:"the default mime type that API operations produce" default:"application/json"`
+	DefaultError    string   `long:"default-error" description:"the model to decode undeclared responses with, their raw body is returned when it doesn't decode"`
 	SkipModels      bool     `long:"skip-models" description:"no models will be generated when this flag is specified"`
 	SkipOperations  bool     `long:"skip-operations" description:"no operations will be generated when this flag is specified"`
+	GoGenerate      bool     `long:"go-generate" description:"add a //go:generate directive with the flags of this command to the client facade file"`
 	DumpData        bool     `long:"dump-data" description:"when present dumps the json for the template generator instead of generating files"`
 }
 
@@ -50,6 +52,38 @@ func (c *Client) Execute(args []string) error {
 		IncludeResponses:  !c.SkipOperations,
 		IncludeSupport:    true,
 		TemplateDir:       string(c.TemplateDir),
+		TuplesAsSlices:    c.TuplesAsSlices,
+		OmitIgnored:       c.OmitIgnored,
+		Strict:            c.Strict,
+		ContextFormats:    c.ContextFormats,
+		ExtraSpecs:        c.ExtraSpecs,
+		NetIP:             c.NetIP,
+		PlainBytes:        c.PlainBytes,
+		SingleChar:        c.SingleChar,
+		DirtyTracking:     c.DirtyTracking,
+		WithBuilder:       c.WithBuilder,
+		FixedArrays:       c.FixedArrays,
+		WithScrub:         c.WithScrub,
+		WithFieldMask:     c.WithFieldMask,
+		WithGob:           c.WithGob,
+		ExtraFields:       c.ExtraFields,
+		WithDiff:          c.WithDiff,
+		WithNormalize:     c.WithNormalize,
+		TypedErrors:       c.TypedErrors,
+		SplitReadWrite:    c.SplitReadWrite,
+		SeekableStreams:   c.Seekable,
+		SchemaRegistry:    c.SchemaRegistry,
+		SkipFormats:       c.SkipFormats,
+		CompatMode:        c.CompatMode,
+		NullablePolicy:    generator.NullableBehavior(c.NullablePolicy),
+		TypeMappingFile:   c.TypeMapping,
+		EnumStatus:        c.EnumStatus,
+		GoVersion:         c.GoVersion,
+		SpecAuthHeader:    c.SpecAuthHeader,
+		SpecBearerToken:   c.SpecBearerToken,
+		SpecCACert:        string(c.SpecCACert),
+		DefaultError:      c.DefaultError,
+		GoGenerate:        c.GoGenerate,
 		DumpData:          c.DumpData,
 	}
 	if err := generator.GenerateClient(c.Name, c.Models, c.Operations, opts); err != nil {
@@ -0,0 +1,68 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generate
+
+import "github.com/go-swagger/go-swagger/generator"
+
+// ConformanceTests the command to generate the tests checking the models against the examples of the spec
+type ConformanceTests struct {
+	shared
+	Name []string `long:"name" short:"n" description:"the model to generate the conformance test of, defaults to all the models with an example"`
+}
+
+// Execute generates the conformance tests
+func (c *ConformanceTests) Execute(args []string) error {
+	return generator.GenerateConformanceTests(
+		c.Name,
+		generator.GenOpts{
+			Spec:            string(c.Spec),
+			Target:          string(c.Target),
+			APIPackage:      c.APIPackage,
+			ModelPackage:    c.ModelPackage,
+			ServerPackage:   c.ServerPackage,
+			ClientPackage:   c.ClientPackage,
+			TemplateDir:     string(c.TemplateDir),
+			TuplesAsSlices:  c.TuplesAsSlices,
+			OmitIgnored:     c.OmitIgnored,
+			Strict:          c.Strict,
+			ContextFormats:  c.ContextFormats,
+			ExtraSpecs:      c.ExtraSpecs,
+			NetIP:           c.NetIP,
+			PlainBytes:      c.PlainBytes,
+			SingleChar:      c.SingleChar,
+			DirtyTracking:   c.DirtyTracking,
+			WithBuilder:     c.WithBuilder,
+			FixedArrays:     c.FixedArrays,
+			WithScrub:       c.WithScrub,
+			WithFieldMask:   c.WithFieldMask,
+			WithGob:         c.WithGob,
+			ExtraFields:     c.ExtraFields,
+			WithDiff:        c.WithDiff,
+			WithNormalize:   c.WithNormalize,
+			TypedErrors:     c.TypedErrors,
+			SplitReadWrite:  c.SplitReadWrite,
+			SeekableStreams: c.Seekable,
+			SchemaRegistry:  c.SchemaRegistry,
+			SkipFormats:     c.SkipFormats,
+			CompatMode:      c.CompatMode,
+			NullablePolicy:  generator.NullableBehavior(c.NullablePolicy),
+			TypeMappingFile: c.TypeMapping,
+			EnumStatus:      c.EnumStatus,
+			GoVersion:       c.GoVersion,
+			SpecAuthHeader:  c.SpecAuthHeader,
+			SpecBearerToken: c.SpecBearerToken,
+			SpecCACert:      string(c.SpecCACert),
+		})
+}
@@ -39,13 +39,43 @@ func (m *Model) Execute(args []string) error {
 		!m.NoStruct,
 		!m.NoValidator,
 		generator.GenOpts{
-			Spec:          string(m.Spec),
-			Target:        string(m.Target),
-			APIPackage:    m.APIPackage,
-			ModelPackage:  m.ModelPackage,
-			ServerPackage: m.ServerPackage,
-			ClientPackage: m.ClientPackage,
-			DumpData:      m.DumpData,
-			TemplateDir:   string(m.TemplateDir),
+			Spec:            string(m.Spec),
+			Target:          string(m.Target),
+			APIPackage:      m.APIPackage,
+			ModelPackage:    m.ModelPackage,
+			ServerPackage:   m.ServerPackage,
+			ClientPackage:   m.ClientPackage,
+			DumpData:        m.DumpData,
+			TemplateDir:     string(m.TemplateDir),
+			TuplesAsSlices:  m.TuplesAsSlices,
+			OmitIgnored:     m.OmitIgnored,
+			Strict:          m.Strict,
+			ContextFormats:  m.ContextFormats,
+			ExtraSpecs:      m.ExtraSpecs,
+			NetIP:           m.NetIP,
+			PlainBytes:      m.PlainBytes,
+			SingleChar:      m.SingleChar,
+			DirtyTracking:   m.DirtyTracking,
+			WithBuilder:     m.WithBuilder,
+			FixedArrays:     m.FixedArrays,
+			WithScrub:       m.WithScrub,
+			WithFieldMask:   m.WithFieldMask,
+			WithGob:         m.WithGob,
+			ExtraFields:     m.ExtraFields,
+			WithDiff:        m.WithDiff,
+			WithNormalize:   m.WithNormalize,
+			TypedErrors:     m.TypedErrors,
+			SplitReadWrite:  m.SplitReadWrite,
+			SeekableStreams: m.Seekable,
+			SchemaRegistry:  m.SchemaRegistry,
+			SkipFormats:     m.SkipFormats,
+			CompatMode:      m.CompatMode,
+			NullablePolicy:  generator.NullableBehavior(m.NullablePolicy),
+			TypeMappingFile: m.TypeMapping,
+			EnumStatus:      m.EnumStatus,
+			GoVersion:       m.GoVersion,
+			SpecAuthHeader:  m.SpecAuthHeader,
+			SpecBearerToken: m.SpecBearerToken,
+			SpecCACert:      string(m.SpecCACert),
 		})
 }
@@ -45,15 +45,45 @@ func (o *Operation) Execute(args []string) error {
 		!o.NoStruct,
 		!o.NoResponses,
 		generator.GenOpts{
-			Spec:          string(o.Spec),
-			Target:        string(o.Target),
-			APIPackage:    o.APIPackage,
-			ModelPackage:  o.ModelPackage,
-			ServerPackage: o.ServerPackage,
-			ClientPackage: o.ClientPackage,
-			Principal:     o.Principal,
-			DumpData:      o.DumpData,
-			DefaultScheme: o.DefaultScheme,
-			TemplateDir:   string(o.TemplateDir),
+			Spec:            string(o.Spec),
+			Target:          string(o.Target),
+			APIPackage:      o.APIPackage,
+			ModelPackage:    o.ModelPackage,
+			ServerPackage:   o.ServerPackage,
+			ClientPackage:   o.ClientPackage,
+			Principal:       o.Principal,
+			DumpData:        o.DumpData,
+			DefaultScheme:   o.DefaultScheme,
+			TemplateDir:     string(o.TemplateDir),
+			TuplesAsSlices:  o.TuplesAsSlices,
+			OmitIgnored:     o.OmitIgnored,
+			Strict:          o.Strict,
+			ContextFormats:  o.ContextFormats,
+			ExtraSpecs:      o.ExtraSpecs,
+			NetIP:           o.NetIP,
+			PlainBytes:      o.PlainBytes,
+			SingleChar:      o.SingleChar,
+			DirtyTracking:   o.DirtyTracking,
+			WithBuilder:     o.WithBuilder,
+			FixedArrays:     o.FixedArrays,
+			WithScrub:       o.WithScrub,
+			WithFieldMask:   o.WithFieldMask,
+			WithGob:         o.WithGob,
+			ExtraFields:     o.ExtraFields,
+			WithDiff:        o.WithDiff,
+			WithNormalize:   o.WithNormalize,
+			TypedErrors:     o.TypedErrors,
+			SplitReadWrite:  o.SplitReadWrite,
+			SeekableStreams: o.Seekable,
+			SchemaRegistry:  o.SchemaRegistry,
+			SkipFormats:     o.SkipFormats,
+			CompatMode:      o.CompatMode,
+			NullablePolicy:  generator.NullableBehavior(o.NullablePolicy),
+			TypeMappingFile: o.TypeMapping,
+			EnumStatus:      o.EnumStatus,
+			GoVersion:       o.GoVersion,
+			SpecAuthHeader:  o.SpecAuthHeader,
+			SpecBearerToken: o.SpecBearerToken,
+			SpecCACert:      string(o.SpecCACert),
 		})
 }
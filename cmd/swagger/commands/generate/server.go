@@ -27,6 +27,38 @@ type shared struct {
 	ClientPackage string         `long:"client-package" short:"c" description:"the package to save the client specific code" default:"client"`
 	Target        flags.Filename `long:"target" short:"t" default:"./" description:"the base directory for generating the files"`
 	TemplateDir   flags.Filename `long:"template-dir"`
+
+	TuplesAsSlices bool     `long:"tuples-as-slices" description:"render positional items as a validated []interface{} instead of a tuple struct"`
+	OmitIgnored    bool     `long:"omit-ignored" description:"leave out properties marked with x-go-ignore instead of rendering them with a json:\"-\" tag"`
+	Strict         bool     `long:"strict" description:"fail on ambiguous schemas, like allOf members declaring a property with different types, instead of resolving them with a warning"`
+	ExtraSpecs     []string `long:"additional-spec" description:"another spec whose definitions are generated along with the ones of the spec, the definitions found in several specs must be identical, repeat for multiple"`
+	ContextFormats []string `long:"context-format" description:"a custom format validated with the request context, like a lookup in an external registry, repeat for multiple"`
+	NetIP          bool     `long:"net-ip" description:"render the ipv4 and ipv6 formats of schemas as net.IP instead of strfmt.IPv4 and strfmt.IPv6"`
+	PlainBytes     bool     `long:"plain-bytes" description:"render the byte format of schemas as a []byte, base64-encoded by encoding/json, instead of strfmt.Base64"`
+	SingleChar     string   `long:"single-char" description:"render the single character strings of schemas, of format char or with a minLength and maxLength of 1, as a Char type of the models package with this underlying type" choice:"rune" choice:"byte"`
+	DirtyTracking  bool     `long:"with-dirty-tracking" description:"generate setters for the properties of models, recording the ones set for ChangedFields()"`
+	WithBuilder    bool     `long:"with-builder" description:"generate a fluent builder for the models, with sub-builders for the nested models"`
+	FixedArrays    bool     `long:"fixed-arrays" description:"render the array definitions with as many minItems as maxItems as a fixed-size [N]T array instead of a slice"`
+	WithScrub      bool     `long:"with-scrub" description:"generate a Scrub method returning a copy of the models with their passwords and x-sensitive properties redacted"`
+	WithFieldMask  bool     `long:"with-field-mask" description:"generate an ApplyFieldMask method copying the fields named by a protobuf-style field mask from another model"`
+	WithGob        bool     `long:"with-gob" description:"register the concrete types of the polymorphic models with encoding/gob, for their interface fields to be gob-encoded"`
+	ExtraFields    bool     `long:"with-extra-fields" description:"capture the unknown fields of the JSON objects unmarshaled into the models in an Extra map, and marshal them back"`
+	WithDiff       bool     `long:"with-diff" description:"generate a DiffFrom method listing the fields of the models which differ from another version, down the nested models"`
+	WithNormalize  bool     `long:"with-normalize" description:"generate a Normalize method applying the defaults to the unset properties of the models, and trimming or lowercasing the strings marked with x-trim or x-lowercase"`
+	TypedErrors    bool     `long:"with-structured-validation" description:"generate a ValidateStructured method returning the violations found by Validate as ValidationError values, with the dotted path of the value and the constraint it violates"`
+	SplitReadWrite bool     `long:"split-read-write" description:"generate Request and Response variants of the models with read-only or write-only properties, without their read-only and their write-only properties respectively"`
+	Seekable       bool     `long:"seekable-streams" description:"let the handlers of the operations returning a binary body serve a seekable content instead, answering the byte ranges of the Range header with 206 Partial Content"`
+	SchemaRegistry bool     `long:"with-schema-registry" description:"generate a SchemaFor map of the JSON schemas of the definitions, and a ValidateSchema function validating a payload against one of them at runtime"`
+	SkipFormats    bool     `long:"skip-format-registry" description:"don't register the formats of the go types hinted as formatters by x-go-type with strfmt.Default, for a registry managed by hand"`
+	TypeMapping    string   `long:"type-mapping" description:"a yaml file mapping the names of definitions to the go types they are rendered with, written like x-go-type; the mapped definitions aren't generated"`
+	NullablePolicy string   `long:"nullable-policy" description:"which primitive properties of the models are pointers: the ones telling a zero value from a missing one with spec, all of them with always-pointer, none with never-pointer; x-nullable prevails" choice:"spec" choice:"always-pointer" choice:"never-pointer" default:"spec"`
+	CompatMode     string   `long:"compat-mode" description:"restore the behaviors of a prior go-swagger version, see docs/generate/compat.md for the differences restored" choice:"0.5"`
+	EnumStatus     int      `long:"enum-status" description:"the status code answering a value out of the enum of a path or query param bound server-side, like 422" default:"400"`
+	GoVersion      string   `long:"go-version" description:"the go version targeted by the generated code, like 1.18 to render interface{} as any"`
+
+	SpecAuthHeader  string         `long:"spec-auth-header" description:"a header sent when fetching a remote spec, like \"X-Api-Key: secret\""`
+	SpecBearerToken string         `long:"spec-bearer-token" description:"a bearer token sent when fetching a remote spec"`
+	SpecCACert      flags.Filename `long:"spec-ca-cert" description:"a PEM file with the certificate authorities to trust when fetching a remote spec over https"`
 }
 
 // Server the command to generate an entire server application
@@ -44,6 +76,10 @@ type Server struct {
 	ExcludeMain    bool     `long:"exclude-main" description:"exclude main function, so just generate the library"`
 	ExcludeSpec    bool     `long:"exclude-spec" description:"don't embed the swagger specification"`
 	WithContext    bool     `long:"with-context" description:"handlers get a context as first arg"`
+	WithLogging    bool     `long:"with-logging" description:"handlers log every request they serve to a structured (slog) logger"`
+	Standalone     bool     `long:"standalone-server" description:"generate a net/http router with param binders instead of a server built on the go-openapi runtime, JSON operations only, without --with-logging, --with-route-map and --seekable-streams"`
+	RouteMap       bool     `long:"with-route-map" description:"generate a map of the method and path of the operations to their param binders and body models, to plug them into another router like openapi3filter"`
+	GoGenerate     bool     `long:"go-generate" description:"add a //go:generate directive with the flags of this command to the server main file"`
 	DumpData       bool     `long:"dump-data" description:"when present dumps the json for the template generator instead of generating files"`
 }
 
@@ -67,7 +103,41 @@ func (s *Server) Execute(args []string) error {
 		IncludeSupport:    !s.SkipSupport,
 		ExcludeSpec:       s.ExcludeSpec,
 		TemplateDir:       string(s.TemplateDir),
+		TuplesAsSlices:    s.TuplesAsSlices,
+		OmitIgnored:       s.OmitIgnored,
+		Strict:            s.Strict,
+		ContextFormats:    s.ContextFormats,
+		ExtraSpecs:        s.ExtraSpecs,
+		NetIP:             s.NetIP,
+		PlainBytes:        s.PlainBytes,
+		SingleChar:        s.SingleChar,
+		DirtyTracking:     s.DirtyTracking,
+		WithBuilder:       s.WithBuilder,
+		FixedArrays:       s.FixedArrays,
+		WithScrub:         s.WithScrub,
+		WithFieldMask:     s.WithFieldMask,
+		WithGob:           s.WithGob,
+		ExtraFields:       s.ExtraFields,
+		WithDiff:          s.WithDiff,
+		WithNormalize:     s.WithNormalize,
+		TypedErrors:       s.TypedErrors,
+		SplitReadWrite:    s.SplitReadWrite,
+		SeekableStreams:   s.Seekable,
+		SchemaRegistry:    s.SchemaRegistry,
+		SkipFormats:       s.SkipFormats,
+		CompatMode:        s.CompatMode,
+		NullablePolicy:    generator.NullableBehavior(s.NullablePolicy),
+		TypeMappingFile:   s.TypeMapping,
+		EnumStatus:        s.EnumStatus,
+		GoVersion:         s.GoVersion,
+		SpecAuthHeader:    s.SpecAuthHeader,
+		SpecBearerToken:   s.SpecBearerToken,
+		SpecCACert:        string(s.SpecCACert),
 		WithContext:       s.WithContext,
+		WithLogging:       s.WithLogging,
+		StandaloneServer:  s.Standalone,
+		RouteMap:          s.RouteMap,
+		GoGenerate:        s.GoGenerate,
 		DumpData:          s.DumpData,
 	}
 
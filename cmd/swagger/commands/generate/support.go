@@ -34,15 +34,45 @@ func (s *Support) Execute(args []string) error {
 		nil,
 		nil,
 		generator.GenOpts{
-			Spec:          string(s.Spec),
-			Target:        string(s.Target),
-			APIPackage:    s.APIPackage,
-			ModelPackage:  s.ModelPackage,
-			ServerPackage: s.ServerPackage,
-			ClientPackage: s.ClientPackage,
-			Principal:     s.Principal,
-			DumpData:      s.DumpData,
-			DefaultScheme: s.DefaultScheme,
-			TemplateDir:   string(s.TemplateDir),
+			Spec:            string(s.Spec),
+			Target:          string(s.Target),
+			APIPackage:      s.APIPackage,
+			ModelPackage:    s.ModelPackage,
+			ServerPackage:   s.ServerPackage,
+			ClientPackage:   s.ClientPackage,
+			Principal:       s.Principal,
+			DumpData:        s.DumpData,
+			DefaultScheme:   s.DefaultScheme,
+			TemplateDir:     string(s.TemplateDir),
+			TuplesAsSlices:  s.TuplesAsSlices,
+			OmitIgnored:     s.OmitIgnored,
+			Strict:          s.Strict,
+			ContextFormats:  s.ContextFormats,
+			ExtraSpecs:      s.ExtraSpecs,
+			NetIP:           s.NetIP,
+			PlainBytes:      s.PlainBytes,
+			SingleChar:      s.SingleChar,
+			DirtyTracking:   s.DirtyTracking,
+			WithBuilder:     s.WithBuilder,
+			FixedArrays:     s.FixedArrays,
+			WithScrub:       s.WithScrub,
+			WithFieldMask:   s.WithFieldMask,
+			WithGob:         s.WithGob,
+			ExtraFields:     s.ExtraFields,
+			WithDiff:        s.WithDiff,
+			WithNormalize:   s.WithNormalize,
+			TypedErrors:     s.TypedErrors,
+			SplitReadWrite:  s.SplitReadWrite,
+			SeekableStreams: s.Seekable,
+			SchemaRegistry:  s.SchemaRegistry,
+			SkipFormats:     s.SkipFormats,
+			CompatMode:      s.CompatMode,
+			NullablePolicy:  generator.NullableBehavior(s.NullablePolicy),
+			TypeMappingFile: s.TypeMapping,
+			EnumStatus:      s.EnumStatus,
+			GoVersion:       s.GoVersion,
+			SpecAuthHeader:  s.SpecAuthHeader,
+			SpecBearerToken: s.SpecBearerToken,
+			SpecCACert:      string(s.SpecCACert),
 		})
 }
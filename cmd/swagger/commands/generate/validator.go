@@ -0,0 +1,76 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generate
+
+import "github.com/go-swagger/go-swagger/generator"
+
+// Validator the command to generate a middleware validating requests against the spec
+type Validator struct {
+	shared
+	Name       string   `long:"name" short:"A" description:"the name of the application, defaults to a mangled value of info.title"`
+	Operations []string `long:"operation" short:"O" description:"specify an operation to include, repeat for multiple"`
+	Tags       []string `long:"tags" description:"the tags to include, if not specified defaults to all"`
+	Models     []string `long:"model" short:"M" description:"specify a model to include, repeat for multiple"`
+	SkipModels bool     `long:"skip-models" description:"no models will be generated when this flag is specified"`
+	DumpData   bool     `long:"dump-data" description:"when present dumps the json for the template generator instead of generating files"`
+}
+
+// Execute runs this command
+func (v *Validator) Execute(args []string) error {
+	opts := generator.GenOpts{
+		Spec:             string(v.Spec),
+		Target:           string(v.Target),
+		APIPackage:       v.APIPackage,
+		ModelPackage:     v.ModelPackage,
+		ServerPackage:    v.ServerPackage,
+		ClientPackage:    v.ClientPackage,
+		IncludeModel:     !v.SkipModels,
+		IncludeValidator: !v.SkipModels,
+		TemplateDir:      string(v.TemplateDir),
+		TuplesAsSlices:   v.TuplesAsSlices,
+		OmitIgnored:      v.OmitIgnored,
+		Strict:           v.Strict,
+		ContextFormats:   v.ContextFormats,
+		ExtraSpecs:       v.ExtraSpecs,
+		NetIP:            v.NetIP,
+		PlainBytes:       v.PlainBytes,
+		SingleChar:       v.SingleChar,
+		DirtyTracking:    v.DirtyTracking,
+		WithBuilder:      v.WithBuilder,
+		FixedArrays:      v.FixedArrays,
+		WithScrub:        v.WithScrub,
+		WithFieldMask:    v.WithFieldMask,
+		WithGob:          v.WithGob,
+		ExtraFields:      v.ExtraFields,
+		WithDiff:         v.WithDiff,
+		WithNormalize:    v.WithNormalize,
+		TypedErrors:      v.TypedErrors,
+		SplitReadWrite:   v.SplitReadWrite,
+		SeekableStreams:  v.Seekable,
+		SchemaRegistry:   v.SchemaRegistry,
+		SkipFormats:      v.SkipFormats,
+		CompatMode:       v.CompatMode,
+		NullablePolicy:   generator.NullableBehavior(v.NullablePolicy),
+		TypeMappingFile:  v.TypeMapping,
+		EnumStatus:       v.EnumStatus,
+		GoVersion:        v.GoVersion,
+		SpecAuthHeader:   v.SpecAuthHeader,
+		SpecBearerToken:  v.SpecBearerToken,
+		SpecCACert:       string(v.SpecCACert),
+		DumpData:         v.DumpData,
+	}
+
+	return generator.GenerateValidator(v.Name, v.Models, v.Operations, opts)
+}
@@ -50,6 +50,11 @@ It aims to represent the contract of your API with a language agnostic descripti
 		log.Fatal(err)
 	}
 
+	_, err = parser.AddCommand("convert", "convert the swagger document", "convert the provided spec document to another version of the specification", &commands.ConvertSpec{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	_, err = parser.AddCommand("version", "print the version", "print the version of the swagger command", &commands.PrintVersion{})
 	if err != nil {
 		log.Fatal(err)
@@ -79,6 +84,15 @@ It aims to represent the contract of your API with a language agnostic descripti
 		case "operation":
 			cmd.ShortDescription = "generate one or more server operations from the swagger spec"
 			cmd.LongDescription = cmd.ShortDescription
+		case "validator":
+			cmd.ShortDescription = "generate a net/http middleware validating requests against the swagger spec"
+			cmd.LongDescription = cmd.ShortDescription
+		case "conformance-tests":
+			cmd.ShortDescription = "generate the tests checking the models against the examples of the swagger spec"
+			cmd.LongDescription = cmd.ShortDescription
+		case "benchmarks":
+			cmd.ShortDescription = "generate the benchmarks of the marshaling of the models, seeded with the examples of the swagger spec"
+			cmd.LongDescription = cmd.ShortDescription
 		}
 	}
 
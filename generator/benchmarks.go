@@ -0,0 +1,112 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+)
+
+// GenerateBenchmarks generates the go benchmarks of the marshaling and unmarshaling of the models,
+// in the package of the models. The benchmarks are seeded with the examples of the schemas,
+// so the definitions without an example are skipped, as are the primitive ones.
+func GenerateBenchmarks(modelNames []string, opts GenOpts) error {
+	if opts.TemplateDir != "" {
+		if err := templates.LoadDir(opts.TemplateDir); err != nil {
+			return err
+		}
+	}
+
+	compileTemplates()
+
+	specPath, specDoc, err := loadSpec(&opts)
+	if err != nil {
+		return err
+	}
+
+	if len(modelNames) == 0 {
+		for k := range specDoc.Spec().Definitions {
+			modelNames = append(modelNames, k)
+		}
+	}
+	sort.Strings(modelNames)
+
+	target := filepath.Join(opts.Target, opts.ModelPackage)
+	pkg := mangleName(filepath.Base(target), "definitions")
+	var generated int
+	for _, modelName := range modelNames {
+		model, ok := specDoc.Spec().Definitions[modelName]
+		if !ok {
+			return fmt.Errorf("model %q not found in definitions in %s", modelName, specPath)
+		}
+		if model.Example == nil {
+			continue
+		}
+
+		benchmark, err := makeGenBenchmark(modelName, target, model, specDoc, &opts)
+		if err != nil {
+			return err
+		}
+		if benchmark == nil {
+			continue
+		}
+		benchmark.Package = pkg
+
+		buf := bytes.NewBuffer(nil)
+		if err := benchmarkTemplate.Execute(buf, benchmark); err != nil {
+			return err
+		}
+		log.Println("rendered benchmark template:", modelName)
+		if err := writeToTestFile(target, modelName+"_benchmark", buf.Bytes()); err != nil {
+			return err
+		}
+		generated++
+	}
+
+	if generated == 0 {
+		log.Println("no model has an example, no benchmark was generated")
+	}
+	return nil
+}
+
+// makeGenBenchmark builds the benchmarks of a model out of the example of its schema, it returns nil
+// for the primitive models and for the ones which can't be unmarshaled into their go type,
+// like the polymorphic ones
+func makeGenBenchmark(name, pkg string, schema spec.Schema, specDoc *loads.Document, opts *GenOpts) (*GenBenchmark, error) {
+	genModel, err := makeGenDefinitionHierarchy(name, pkg, "", schema, specDoc, true, true, opts)
+	if err != nil {
+		return nil, err
+	}
+	if genModel.IsBaseType || genModel.IsPrimitive || genModel.IsInterface || genModel.IsStream {
+		return nil, nil
+	}
+
+	example, err := json.Marshal(resolveExample(genModel, &schema, specDoc))
+	if err != nil {
+		return nil, fmt.Errorf("%s: the example is not valid JSON: %v", name, err)
+	}
+	return &GenBenchmark{
+		Name:    name,
+		GoType:  pascalize(genModel.Name),
+		Example: string(example),
+	}, nil
+}
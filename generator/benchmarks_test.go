@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBenchmarks_Models(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.benchmarks.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	benchmark, err := makeGenBenchmark("Task", "models", definitions["Task"], specDoc, &GenOpts{})
+	if assert.NoError(t, err) && assert.NotNil(t, benchmark) {
+		assert.Equal(t, "Task", benchmark.GoType)
+		assert.JSONEq(t, `{"id":12,"title":"buy milk","due":"2017-05-01T10:00:00Z","tags":[{"name":"errands"}]}`, benchmark.Example)
+	}
+
+	// a primitive model has no marshaling to benchmark
+	benchmark, err = makeGenBenchmark("Label", "models", definitions["Label"], specDoc, &GenOpts{})
+	if assert.NoError(t, err) {
+		assert.Nil(t, benchmark)
+	}
+}
+
+func TestBenchmarks_Run(t *testing.T) {
+	w := newGoWorkspace(t)
+	opts := GenOpts{
+		Spec:         "../fixtures/codegen/todolist.benchmarks.yml",
+		Target:       w.Dir(""),
+		ModelPackage: "models",
+	}
+	if !assert.NoError(t, GenerateDefinition(nil, true, true, opts)) {
+		return
+	}
+	if !assert.NoError(t, GenerateBenchmarks(nil, opts)) {
+		return
+	}
+	for _, name := range []string{"task_benchmark_test.go", "tasks_benchmark_test.go", "board_benchmark_test.go"} {
+		_, err := os.Stat(filepath.Join(w.Dir("models"), name))
+		assert.NoError(t, err)
+	}
+	// Tag has no example and Label is a string
+	for _, name := range []string{"tag_benchmark_test.go", "label_benchmark_test.go"} {
+		_, err := os.Stat(filepath.Join(w.Dir("models"), name))
+		assert.True(t, os.IsNotExist(err), name)
+	}
+
+	if lines, ok := w.Go(t, "models", "test", "-run", "^$", "-bench", ".", "-benchtime", "10x", "."); ok {
+		out := strings.Join(lines, "\n")
+		for _, name := range []string{"Task", "Tasks", "Board"} {
+			assert.Contains(t, out, "Benchmark"+name+"Marshal")
+			assert.Contains(t, out, "Benchmark"+name+"Unmarshal")
+		}
+		assert.Contains(t, out, "allocs/op")
+	}
+}
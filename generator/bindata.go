@@ -1,33 +1,65 @@
 // Code generated by go-bindata.
 // sources:
 // templates/additionalpropertiesserializer.gotmpl
+// templates/benchmark.gotmpl
+// templates/builder.gotmpl
+// templates/char.gotmpl
+// templates/charserializer.gotmpl
 // templates/client/client.gotmpl
 // templates/client/facade.gotmpl
 // templates/client/parameter.gotmpl
 // templates/client/response.gotmpl
+// templates/conformance.gotmpl
+// templates/conformancehelpers.gotmpl
+// templates/contextvalidator.gotmpl
+// templates/diff.gotmpl
+// templates/dirtytracking.gotmpl
 // templates/docstring.gotmpl
+// templates/enumconsts.gotmpl
+// templates/extrafields.gotmpl
+// templates/fieldmask.gotmpl
+// templates/fixedarray.gotmpl
+// templates/formatregistry.gotmpl
+// templates/gob.gotmpl
+// templates/gobregistry.gotmpl
 // templates/header.gotmpl
 // templates/model.gotmpl
+// templates/modeldiff.gotmpl
 // templates/modelvalidator.gotmpl
+// templates/normalize.gotmpl
+// templates/patch.gotmpl
+// templates/polymorphicslice.gotmpl
+// templates/readwrite.gotmpl
 // templates/schema.gotmpl
 // templates/schemabody.gotmpl
+// templates/schemaregistry.gotmpl
 // templates/schematype.gotmpl
 // templates/schemavalidator.gotmpl
+// templates/scrub.gotmpl
 // templates/server/builder.gotmpl
 // templates/server/configureapi.gotmpl
 // templates/server/doc.gotmpl
+// templates/server/eventstream.gotmpl
 // templates/server/main.gotmpl
 // templates/server/operation.gotmpl
 // templates/server/parameter.gotmpl
 // templates/server/responses.gotmpl
+// templates/server/routemap.gotmpl
 // templates/server/server.gotmpl
+// templates/server/standalone.gotmpl
+// templates/server/validator.gotmpl
+// templates/server/websocket.gotmpl
 // templates/structfield.gotmpl
 // templates/swagger_json_embed.gotmpl
+// templates/timeofday.gotmpl
 // templates/tuplefield.gotmpl
 // templates/tupleserializer.gotmpl
+// templates/union.gotmpl
+// templates/unwrapserializer.gotmpl
 // templates/validation/customformat.gotmpl
 // templates/validation/primitive.gotmpl
 // templates/validation/structfield.gotmpl
+// templates/validationerrors.gotmpl
 // DO NOT EDIT!
 
 package generator
@@ -115,7 +147,87 @@ func templatesAdditionalpropertiesserializerGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClientClientGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x56\x4d\x6f\xe4\x36\x0c\xbd\xfb\x57\xb0\xd3\x6d\x30\x0e\x66\x3d\x77\x03\x39\x04\x9b\x16\xcd\x61\x37\x41\x12\x74\x8f\x85\x62\xd3\xb6\x90\xb1\xe4\x4a\x72\x82\xa9\xe1\xff\x5e\xea\xc3\xf2\x7c\x26\x39\xf5\x10\x44\x16\x1f\x69\xf2\xf1\x91\x9e\x8e\x15\x2f\xac\x46\x18\x06\xc8\x7e\xb0\x16\x61\x1c\x93\x64\xbd\x86\xa7\x86\x6b\xa8\xf8\x06\xe1\x8d\x69\xa8\x51\xa0\x62\x06\x4b\x78\xde\x82\x69\x10\xf4\x1b\xab\x6b\x54\x60\xa4\xdc\x64\x16\xff\x7b\xc9\x0d\x17\x35\x19\x27\xbf\x96\xd7\x8d\x81\x4e\xc9\x57\x84\xaa\x37\x2e\x54\x83\x02\xb6\xb2\x07\x85\x5f\x55\x2f\xf6\x22\x4d\xaf\x80\x42\xb6\x2d\x13\x65\x92\xf0\xb6\x93\xca\xc0\x32\x01\x58\x08\x34\xeb\xc6\x98\x6e\x61\x1f\x6a\x6e\x9a\xfe\x39\x23\xe0\xba\x96\x5f\x65\x87\x82\x75\x7c\x8d\x4a\x49\xa5\xdf\x01\xd8\x37\xbd\x63\xa6\x84\x0c\x6f\xf1\x1d\xc4\x2b\xdb\xf0\x92\x52\x5c\x24\x84\xd1\x46\x55\xad\x39\xfb\x2e\x67\x75\x40\x62\x56\x31\x41\x14\x67\x37\x58\xb1\x7e\x63\x6e\x5d\x5d\x9a\x98\x26\x53\xa7\xb8\x30\x15\x2c\x7e\xfb\x67\x01\x19\x71\xef\xf0\x28\x4a\x98\xce\xde\xf7\xcb\x0b\x6e\x57\xf0\x85\x32\xe8\x11\xf2\x2b\xc8\xf6\x82\x58\x2b\x9d\xe0\x20\x5e\x80\x1f\x44\x4d\x5d\x7f\x7f\xe0\x1b\x14\x0a\xa9\x1a\x0d\x0c\x04\x3d\x11\xa2\xe9\x89\x79\xfe\x2f\x46\x29\xc0\xf5\xfd\x2d\x14\x1b\x8e\xc2\x64\x49\xd5\x8b\xc2\xfa\x2d\x0d\xe5\xa4\x5d\x6f\x02\x67\xd9\x37\x07\x79\x9a\xee\x57\x50\x49\xd5\x32\x4a\xcf\xf3\x90\x3d\x60\xcd\xe9\xb8\x4d\xe1\xd2\x43\x61\xa0\x9c\x14\x9a\x5e\x09\xb8\xf0\x57\x43\x0c\x9b\x83\x39\x8a\x94\x4f\x87\x31\xb1\x02\xbd\x4c\xa6\x38\x03\xf0\x0a\xb2\xc7\x9e\x44\xa3\xb6\x9e\x8e\xfd\x27\x6b\xbe\x41\x5d\x28\xde\x19\x2e\x85\x13\xb8\x05\xed\xdf\x45\x7e\xec\x61\xa3\xf1\xd0\xcd\x07\x3e\xf6\xb1\xd0\x71\xa4\xdc\xce\xf2\x37\x33\x7f\xb9\x4e\xcc\xb6\x43\x08\xa9\x13\x21\x7d\xe1\x99\xf8\x90\x51\xc2\x9c\xa1\x34\xf1\xe5\x04\x89\xdd\x75\x76\x88\x28\x3d\xab\x0c\x62\xc9\x2a\x82\xe9\x82\x84\xbb\x9b\xd5\x29\xd2\xba\x4d\xaf\x1c\xec\x0f\xae\xb4\xf9\x29\x55\x09\xcb\xb9\x9e\x00\x4d\xff\x3f\x4a\x3f\x45\xa7\x93\xe4\x92\x4d\xaa\x4a\xe1\x64\xbd\xcb\x8e\x29\xd6\x6a\xb8\x3c\x69\xbd\x77\xc6\x50\xd5\x75\x6f\x1a\xa9\xc8\x6c\xdf\xb0\x02\x46\x8f\xb7\xa2\x92\x07\x6d\xb9\x0e\xd7\x3f\x15\x37\xa8\x86\x81\x12\x8a\xbc\xfc\xc9\xf4\xa3\xa1\xc1\x6a\x69\x1b\x3e\x20\x35\x4f\xb8\x72\x56\xf0\xe6\xc0\xc0\x65\x36\xb9\x85\x42\xd2\xb9\x1f\x45\x81\x5a\xef\x78\x2d\x0f\x52\x3e\x40\x4c\x25\xac\xe6\xf1\x76\x5b\xf0\x6c\xbc\x34\xe2\x9c\xec\xec\x9e\xbf\xbb\xb9\xcb\xe1\xaf\xb0\xd9\xdc\x46\x0e\x6c\x3d\x23\x29\x8e\xf6\x33\xe1\xa9\x14\x42\x53\xc8\x60\xba\xba\x02\xc1\x37\x2e\x04\xc4\x3b\xbb\x1a\xde\x21\x78\x99\x12\x7a\x0c\x0b\xf1\x74\x76\x0a\x35\xad\x47\xa2\x93\x14\x30\x8e\x7f\xc7\x5c\x57\x40\x55\xd9\xad\xc7\xb2\x38\x28\xe4\xfe\xdc\x72\xb3\xbc\xd8\xef\x4c\xd4\xbf\xcf\xed\xf6\x26\x3f\x5c\x8a\x91\x33\x07\xf8\x8e\xd4\xef\xf2\x18\xe4\xef\x23\xec\x9e\x99\x86\xfe\xa8\x6b\xe2\x18\x6b\x8d\x33\x52\xc9\xb2\xa7\xba\xbe\x63\xc9\xd9\x13\x4d\xbb\xde\x77\xf8\xf5\xd5\x7a\x1c\x81\xa2\xff\x37\xe2\xa2\x6f\x3f\xf0\x3f\x06\x45\xff\xc7\xa2\xc1\xf6\xa4\x53\xb0\xec\xd4\x64\xdb\x92\x87\xfe\xf9\xbb\x07\x64\x25\xaa\x1c\x2e\x4e\x36\xd2\x5b\x87\xb8\x91\x59\x16\x8e\x9f\x93\x7e\x1e\xfe\xc7\xbe\x8e\xab\x53\x53\xe7\x12\x99\x26\x2c\x8f\x23\xb8\xf2\x6e\xce\x3e\xa6\x5e\x8e\x56\x16\xbf\xec\x6a\x31\x7c\x51\xce\x2a\x8c\x90\xfb\xb3\xe2\x34\xf9\xb1\x9f\x57\x66\xf6\xc9\x71\x4c\x77\xde\x41\x6f\xa4\x0d\x3d\x2f\x2d\xfb\xed\x7d\xc4\x79\xb1\x43\xd1\xd8\xcd\xad\xdd\xe4\xcd\x9f\x01\xe9\x7f\x1c\xf9\x4f\xef\xf1\x9a\xdb\x8d\xf0\xf1\xe7\x38\x75\xec\xec\x0c\x0f\x0d\x6b\x3c\x53\x76\xff\x05\x00\x00\xff\xff\x3d\x78\xb0\x59\x04\x0a\x00\x00")
+var _templatesBenchmarkGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x52\xc1\x6e\xd3\x40\x10\xbd\xfb\x2b\x06\x4b\x05\x9b\x06\x87\x03\xe2\xd0\xd2\x03\x91\x0a\x12\x12\x01\xd1\x70\x42\x08\xad\xed\xb1\xbd\xd4\xbb\x6b\x76\xd7\x29\x21\xf2\xbf\x33\xb3\xd8\x29\x46\x15\x44\x08\x71\xb0\xe2\x8c\x67\xde\xbc\xf7\xe6\x75\xa2\xb8\x16\x35\xc2\x7e\x0f\xd9\xdb\xf1\x7d\x18\xa2\x68\xb9\x84\x4d\x23\x1d\x54\xb2\x45\xb8\x11\x0e\x6a\xd4\x68\x85\xc7\x12\xf2\x1d\xf8\x06\xc1\xdd\x88\xba\x46\x0b\xde\x98\x36\xe3\xfe\xcb\x52\x7a\xa9\x6b\xfa\x38\xcd\x29\x59\x37\x1e\x3a\x6b\xb6\x08\x55\xef\x03\x54\x83\x1a\x76\xa6\x07\x8b\x8f\x6c\xaf\x67\x48\xd3\x0a\x28\x8c\x52\x42\x97\x51\x24\x55\x67\xac\x87\x24\x02\x88\x51\x17\xa6\x24\xfc\xe5\x67\x67\x74\xcc\x15\x8f\x8e\x17\xc6\x51\x1a\xf8\xae\xa8\xa3\x51\xc2\x5e\x93\x96\x4e\xb8\x42\xb4\xf2\x1b\x42\xf6\xd2\x6c\x76\x1d\x8b\x7a\x2d\xac\x6b\x44\x0b\x0a\x85\xeb\x2d\xba\xb0\x5b\xfd\x28\x32\x6f\xa9\xbd\x81\x57\x57\x6f\xd6\x60\xaa\xe0\xc7\x61\x72\xc1\xf0\xbd\x1e\x7b\xc9\x82\xca\x1a\x15\xc6\xf1\xab\x50\x1d\xc9\xa2\x09\xfe\xcb\x53\x6b\xa1\x78\x06\x5c\xd1\xa0\x12\x51\xd5\xeb\xe2\x58\x6a\x49\x0e\x0f\x47\x51\xd9\x2a\x85\x3d\x89\x9c\x16\x9c\x5d\xc0\x87\x8f\xf9\xce\x63\xc2\x10\x96\xb8\x56\x10\x9f\x7c\x89\x21\xbb\x1c\x3b\x86\x81\x6c\x00\xd8\x0a\x0b\x6a\x4e\x9f\xaa\xb2\x02\xb4\x96\x51\xd8\xbd\xec\xfd\xa4\x25\x19\xf1\x17\x70\x5f\xa5\xe7\xa1\xe7\xde\x05\x68\xd9\x86\xe5\x00\x79\xf6\x42\x78\xd1\x56\x49\xfc\x8b\xda\x9f\x95\x96\x06\x9d\x7e\xe0\x6f\x1d\x3a\x83\x93\x6d\xbc\x60\xb4\x94\x50\x06\xa6\x95\x67\x57\xe8\x57\xc4\xdf\x25\xc4\xfd\xe9\x93\xa4\x45\x3d\x2d\x4f\xd3\x34\x74\xbc\x43\xbe\xf6\xf3\xb6\x35\x85\x4b\xa6\x92\x43\xbf\x91\x0a\x6d\x28\x54\xc6\x82\x64\x15\x8f\xcf\xe9\xf7\x19\x35\xac\xe9\xe5\xf4\x74\x64\x4b\x2a\x3f\x2d\x66\x42\x27\x63\xef\x56\x77\xd0\x97\x8c\x54\x99\x2c\x3f\xc3\x51\x81\x3a\xb8\x38\x8f\xd4\xc1\x06\x0e\xd5\x18\x8c\x3f\xe7\x84\xf6\x85\x00\xce\x2f\x77\x54\x7a\x6e\xaf\xf9\x0f\xf2\xf3\x5f\x0e\x75\x77\x48\xff\x3e\xa6\xbf\x39\xe4\x77\x92\x61\xe1\xdc\xe1\x04\x00\x00")
+
+func templatesBenchmarkGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesBenchmarkGotmpl,
+		"templates/benchmark.gotmpl",
+	)
+}
+
+func templatesBenchmarkGotmpl() (*asset, error) {
+	bytes, err := templatesBenchmarkGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/benchmark.gotmpl", size: 1249, mode: os.FileMode(420), modTime: time.Unix(1792223112, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesBuilderGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x55\x4d\x8f\x9b\x30\x10\xbd\xf3\x2b\xa6\xd1\x6a\x05\xd1\x8a\xed\xb9\xd2\x1e\xb6\x97\xb6\x87\xa6\x95\xba\x52\xcf\x06\x86\x80\x6a\x6c\x64\x9b\x46\x29\xe2\xbf\x77\x6c\x13\x96\x84\xaf\xdd\x53\xc8\xf8\xf9\x79\x66\xde\xf3\xb8\x6d\x21\xc3\xbc\x14\x08\xbb\x4a\x66\xc8\x93\xa6\xe4\x19\xaa\x1d\x74\x5d\xf0\xf8\x08\x6d\x0b\x35\xd3\x29\xe3\xe5\x3f\x84\xf8\xc0\x2a\xa4\x85\xcf\x1e\x03\x0e\xab\x81\x59\x54\xd1\x54\x4c\x8c\x41\x20\x89\xb3\x56\xb2\x46\x65\xce\xc0\x0c\xc1\x4c\x59\x61\x4c\xac\x96\xf8\xa5\x40\x10\x0d\xe7\x2c\xe1\x03\xac\x44\x22\x53\x08\x1a\x0d\xe4\x4a\x56\xf0\x97\xf1\x06\xf5\x03\x18\x02\xf7\x89\x81\x61\x7f\x08\x46\x91\x52\x01\xcb\x32\x85\x5a\xc7\x81\x39\xd7\xb8\x9e\xab\x36\xaa\x49\x0d\xb4\x01\x80\xab\x73\x1e\x4d\xab\x14\x57\x4c\x1c\x29\xf6\xf3\x35\xab\xae\xa3\x70\x99\x43\xfc\x95\xe9\x0b\xa3\x8b\xa5\xb4\x6f\xf6\xbc\x3d\x2d\xc6\x5f\xe4\x8b\x4d\x6c\x88\x7a\x7a\x14\x99\xdf\xec\x3f\x82\x2e\xb0\x0d\x39\xe0\x69\xb5\x80\x54\x21\x33\xb6\x41\x43\x27\x72\xa9\x16\x7a\x1f\xe4\x8d\x48\xb7\x18\xc3\xc8\x25\xb9\x7c\xa2\xed\x95\x42\xd3\x28\x01\xf7\x6b\xc0\xd6\x96\xb0\xd0\x36\x5b\xd9\xef\xd2\x14\xb3\xfb\xad\xd0\x4e\xca\x05\x03\xe5\x93\xb5\xbb\xeb\x0a\xc3\xe4\xa6\x84\xbb\x9b\xd4\xa2\xe5\xc3\x43\x67\x2e\xcb\x6e\xb0\xaa\x39\xf5\x16\x76\xb4\x03\xf3\x1c\xb3\x5f\x69\x81\x15\xb3\xda\xed\x20\x26\x6c\xb4\x71\x8c\x6b\x55\x12\x3b\x63\xc5\xf3\xa5\x3e\x81\x77\x10\x23\xcd\xe3\x6f\xfa\x70\xb1\x7e\x28\xa4\xb1\x81\xef\xac\x8e\xfc\x9f\x90\x64\x0d\x8f\xf4\xcb\x51\x40\xfc\xcc\xf9\x8f\x3c\x82\x8f\x91\x05\x3d\x0b\x29\xce\x95\x6c\x74\x14\x11\xe7\xfd\x60\x21\x57\x8a\x37\xd7\xc4\xa4\x49\xbc\x66\xd3\x27\x10\x25\x1f\xdb\xb2\x17\x3c\xf1\x92\x4e\xd8\x16\x67\x42\xef\x14\x7d\x75\x57\x67\x14\x7c\x93\xba\x0f\x70\x2a\xca\xb4\x80\xa3\xf5\x87\x25\xa3\xe9\xc1\xa5\x38\xc2\x89\xd4\x84\xd2\xbc\x59\xfd\x79\xe5\xa3\xf9\xdb\xe9\x54\xa4\x8a\x37\x3a\xe6\x5a\xe6\xb0\xb0\x05\xed\xaf\xe0\xe4\xa4\x30\x72\xbb\xfb\x06\x8f\xdc\xd0\x75\xee\xfc\x35\x23\x7d\x18\x9f\xbf\x91\x81\x27\xa2\x3c\xf6\xab\x9c\x8e\xca\x0d\x24\xae\x71\xcb\x32\x03\xe7\x2a\xe5\xeb\x74\x23\xe6\xd7\x39\xb2\xca\xec\x2d\x77\x3b\x1d\xc9\x70\x6e\xfd\xca\x60\xb3\x8e\x72\xe6\xb0\xab\xa3\xe7\xc4\x3e\x25\x5a\x42\xce\xd4\x82\x67\x26\x96\x71\x1f\x8b\xb3\xd1\x3f\x20\xf0\x69\x28\xff\x7d\x2f\xc6\xa6\xbb\xae\xd4\xad\x36\x66\x49\x3f\x3a\x46\xee\xd9\x0f\x7d\x5b\xd7\xb0\x2f\xb2\x17\x67\xda\xf5\xcb\xd8\xaf\xc6\x9a\x04\xff\x01\x0e\x52\x46\x7d\x2c\x08\x00\x00")
+
+func templatesBuilderGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesBuilderGotmpl,
+		"templates/builder.gotmpl",
+	)
+}
+
+func templatesBuilderGotmpl() (*asset, error) {
+	bytes, err := templatesBuilderGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/builder.gotmpl", size: 2092, mode: os.FileMode(420), modTime: time.Unix(1792211843, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesCharGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x56\x6d\x6f\xdb\x36\x10\xfe\xae\x5f\x71\xd5\x90\x40\x2a\x1c\x39\x03\x86\xa1\x48\xe1\x02\x41\xda\x01\x1e\xb0\x2d\x98\x93\x7d\x19\x86\x82\x96\x4e\x36\x37\x99\x72\x28\x4a\xae\x6b\xe4\xbf\xef\x8e\xa4\xde\xd2\x38\xf1\x87\x7e\x49\x2c\xf2\x5e\x9e\x7b\xee\xe1\x91\x5b\x91\xfe\x27\x56\x08\x87\x03\x24\xb7\xfe\xf7\xe3\x63\x10\x4c\xa7\x70\xb7\x96\x15\xe4\xb2\x40\xd8\x89\x0a\x56\xa8\x50\x0b\x83\x19\x2c\xf7\x60\xd6\x08\xd5\x4e\xac\x56\xa8\xc1\x94\x65\x91\xb0\xfd\xa7\x4c\x1a\xa9\x56\xb4\xd9\xfa\x6d\xe4\x6a\x6d\x60\xab\xcb\x06\x21\xaf\x8d\x0d\xb5\x46\x05\xfb\xb2\x06\x8d\x17\xba\x56\xa3\x48\x6d\x0a\x48\xcb\xcd\x46\xa8\x2c\x08\xe4\x66\x5b\x6a\x03\x51\x00\x10\x66\xc2\x88\xa5\xa8\x70\x5a\x3d\x14\xd3\x4c\xcb\x06\x75\xc8\xeb\xa8\xd2\x32\xa3\xbc\xd3\x7f\xab\x52\xd9\x95\x7c\x63\xec\xff\x5a\x49\xda\xc2\x69\x6d\xf2\x77\x61\x40\x2b\x95\xd1\xb4\x07\xe1\x4a\x9a\x75\xbd\x4c\x28\xcd\x74\x55\x5e\x94\x5b\x54\x62\x2b\xa7\x6e\x37\x0c\xe2\x20\xc8\x6b\x95\x82\x54\xd2\x44\x31\x1c\xc8\x31\x85\xab\x19\xdc\xac\x85\x8e\x2e\xe3\x2e\x4e\xf2\x11\x73\x51\x17\x26\xb9\xce\xb2\x28\x4c\x69\x37\x9c\xc0\x79\x3a\x81\x79\xc5\xa6\x71\xe0\x68\x74\x5f\x54\xaf\xa9\xb5\xaa\xc0\xe8\xda\xb3\x60\x4b\x37\x9a\x29\x23\xc2\xf0\x8b\x48\x4d\xb1\x87\x52\xd9\x66\xc8\x1c\xf0\x01\x92\x7b\x95\xa1\x2e\xf6\x6c\x13\x2e\xf7\x06\x43\x6a\xce\xf5\xe2\x66\x3e\x67\x1b\x54\x19\x7d\x72\x62\x72\x45\xed\x50\xbb\x74\x11\x05\xf6\xc1\x63\x58\x52\x87\x6c\x19\x9f\x27\x80\x5a\x73\x2d\xb7\x42\x57\xd8\x1a\x72\x49\x0e\x9e\xdd\x9e\xcd\x40\xc9\xc2\xa3\xef\x0c\x61\xcb\xbf\x2a\x10\x2d\xe6\x32\x1f\x61\xee\x60\x4c\x6c\x61\xdd\x27\x79\x68\xee\x68\xad\x58\x3b\x52\x01\x75\x1d\xab\x97\x2b\xe4\xc4\xd4\xff\x71\x20\xd8\xd4\x95\x81\x25\x82\xab\xdf\x94\xa4\x31\xc3\x01\x05\xb0\x5f\xc7\x87\xa3\x61\x54\x60\xc7\x44\xc4\x2b\x96\x84\x52\xbb\xce\x12\x0a\xc5\x84\xb0\x46\x92\x3f\x09\xda\x0d\x23\x9d\xab\x85\xf5\xb0\xec\xbc\x27\x8b\x37\x33\xf8\xd1\xda\x77\x4c\x5d\x4e\x80\x35\xf0\x89\x43\xe5\x51\x78\xf6\x00\x6b\x3a\x25\x67\xd9\xb0\x72\xa9\x2a\x83\x22\x63\xaa\x88\x22\x52\x07\x85\x9b\x80\x62\xbe\x1f\x99\x73\x5a\x90\x5f\xb1\x4b\xff\x11\x59\xae\x0c\x62\x94\xdf\xa1\xb4\x7d\xe9\x50\xda\xb4\x70\x7e\xee\x02\xcc\x4e\x40\x47\x12\x53\xa5\x21\xb2\x1a\x51\xc8\x0c\xee\xef\x7e\xb9\x78\xd7\x63\x75\xd8\x2c\xb0\x57\x5a\xe3\xc1\x7c\x18\x80\x59\x60\x91\x9f\x9c\xbe\xa2\x70\x34\x07\x38\xde\xb1\xf4\xbe\x8f\x5d\x34\xdb\x46\x1d\x4f\x06\xb2\xf4\x47\x6a\xab\xb1\x42\x65\xaa\x3e\x6e\x2f\x97\x5c\x97\x1b\xab\xa0\xeb\xdb\x39\x85\x47\x2d\xa9\xf0\xaf\xa4\x41\x71\x82\x88\x29\x07\xa7\xf1\x93\xe9\xca\x0f\x0e\xde\x0e\xcc\x7e\x8b\x2e\x3f\xcf\xcc\x01\x47\x7e\x6c\xba\xce\x91\xe2\x15\x4d\x28\x82\x66\xc7\x61\x0f\x4b\x2a\x52\x6e\x9b\xdf\x69\x35\x4a\x6d\xbc\xd8\xbb\xd2\xcc\xf1\xe8\x0e\x3d\x07\x6e\x25\xe2\xc3\x13\xa5\x71\x3b\x5b\xee\xd5\x86\x74\xbe\x16\xc5\x1d\x7e\x31\xfd\x09\x35\xfc\xd5\xb1\x23\x8c\x2c\x55\x9b\xf7\xc9\xb4\xa0\xd4\x6f\x5d\xee\x51\xa8\xc8\x46\xf8\xfb\x1f\xee\x52\xec\x8e\x8b\x9b\x83\xe9\xf3\x13\x84\xa1\xb1\x4b\xec\xc5\xca\x36\x6f\xec\x18\x19\xeb\x82\xd6\xbd\xf6\xdf\xa6\x30\xa3\x70\x7d\x81\x7d\x6f\x7f\x1b\x94\xd4\xb5\xed\x1b\x1e\x8f\xb3\x38\xf0\x27\x2a\x23\x57\xc5\xe8\xd0\xfb\x94\x6e\x27\x4a\x93\x96\xf7\x91\xc4\x7c\x98\x5f\x17\x7f\xfc\xfe\x1a\x0c\x67\xf3\x02\x16\x36\x78\x19\x0b\x5f\x5e\x89\xb7\x1e\x22\x7a\xda\x68\x9b\xaa\x6b\xf4\x20\xf1\x51\x29\x9f\xde\x79\x8b\x92\xaf\xd9\x67\x3a\x4f\x4d\xf5\x7d\x66\x83\x98\x47\x4e\xa8\xea\xa2\x08\xc7\x0d\x66\xfa\x5c\x83\x1b\x61\xc7\x6e\x4b\x4b\x27\x0b\x92\x8e\xad\xb5\xcb\x6a\x03\xd2\xc5\xe9\x46\xed\xeb\xca\x39\xa6\xc1\xef\x21\xbd\x45\x2a\xe8\xa8\xd1\x9f\x6a\x48\x98\x1b\x25\x02\xda\x17\x08\xb8\xd7\x07\xf0\x24\x78\x4a\x26\x47\x88\xb4\xd8\x31\xeb\xa8\x73\x91\xe2\xe1\x71\xc8\x63\xb5\x93\x26\x5d\x43\xc3\xf0\xc9\x2c\x89\x38\x88\x7f\x64\x70\x68\xc7\xfc\xd5\x10\x79\x9a\x8c\xcf\x66\x13\xb7\xc6\x8e\xdb\x17\x8d\xbd\xc6\x9b\xb8\x73\x22\x60\x3f\xff\x74\x8a\xcf\x70\xe4\x90\x7f\x1f\x81\xf8\x72\xfe\x96\xc9\xcb\x6f\xfb\x9f\xb9\x87\xd1\x28\xc9\xf0\x3e\x20\x8e\xf8\x32\xa0\x87\x5c\x62\xf9\x8a\xdd\x3c\x65\x9a\xaf\xe0\xec\x87\x86\xae\x83\xc6\x5d\x92\xae\x2d\x7f\x89\xa2\xc6\xa3\x13\xd5\xaa\x7b\xab\xe5\x86\x9e\x9f\xf4\xd0\x6c\xac\xb1\xa6\x7b\x77\xcf\x5b\xf4\x60\xd8\x69\x69\x0c\x3f\xb8\xca\x41\x13\x9f\x1c\x54\x9b\x82\x8f\xa8\xeb\x6d\x62\xbf\x9f\x3b\xa8\xfd\xd9\x6c\x87\xc5\xff\xa8\x58\x3a\x80\x43\x0b\x00\x00")
+
+func templatesCharGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCharGotmpl,
+		"templates/char.gotmpl",
+	)
+}
+
+func templatesCharGotmpl() (*asset, error) {
+	bytes, err := templatesCharGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/char.gotmpl", size: 2883, mode: os.FileMode(420), modTime: time.Unix(1792217654, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesCharserializerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8d\x91\xc1\x4a\xc4\x30\x10\x86\xef\x7d\x8a\x9f\x3d\x25\x8b\x74\x9f\xc1\xab\xe0\x0a\xee\x7a\x12\x0f\x63\x3b\xb5\x81\x34\x5d\x26\xa9\x58\x43\xdf\xdd\xc4\xa5\xd2\xb2\xab\x78\x9b\x4c\xf2\xfd\x33\x1f\x89\x11\x35\x37\xc6\x31\x36\x55\x4b\x72\x60\x31\x64\xcd\x27\xcb\x06\xd3\x54\xec\x76\xb8\x27\xf1\x2d\xd9\xbb\xc3\xc3\x1e\x7e\xbe\xf5\x08\xad\xf1\x88\x11\xed\xd0\x91\x4b\x1d\x94\x7b\xea\x38\x31\x20\x0f\x82\x0f\x62\xdc\x1b\xfa\x26\xd7\xa9\xb2\x8c\x1c\x4f\x55\x60\x29\x9a\xc1\x55\x50\x09\x2e\x1f\xb9\x62\xf3\xce\x32\xb3\xa9\x77\x22\x5f\x7d\xcf\xf8\x49\xd4\xcb\x1d\x94\x86\x7a\x7e\x79\x1d\x03\xdf\x80\x45\x7a\xd1\x88\x05\x20\x1c\x06\x71\x99\x2f\x6f\xad\x21\xcf\xf5\x71\x3c\x65\xf8\xda\x18\x5d\xae\x02\x8b\xa9\xc8\xa2\x4f\xae\x5b\xa8\x0e\xf3\xe9\x2f\xd5\x46\xfa\x6e\x25\xcb\x1f\xc9\xd0\x8e\xe8\xdd\xff\x7c\xb7\xbf\x08\xaf\x76\x51\x35\x05\xc2\x59\x5a\x9f\xa5\x97\xce\x6a\x7b\x69\xad\xaf\x6b\x5f\xc6\x66\xf9\xf4\x94\x5d\x9d\xbf\xfb\x0b\x32\xec\xfd\xc9\x0d\x02\x00\x00")
+
+func templatesCharserializerGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCharserializerGotmpl,
+		"templates/charserializer.gotmpl",
+	)
+}
+
+func templatesCharserializerGotmpl() (*asset, error) {
+	bytes, err := templatesCharserializerGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/charserializer.gotmpl", size: 525, mode: os.FileMode(420), modTime: time.Unix(1792217518, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientClientGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcd\x57\x4b\x6f\xdc\x36\x10\xbe\xeb\x57\x4c\xb6\xa9\xb1\x6b\xc8\xf2\x7d\x5b\x1f\x8c\x38\x45\x7c\x48\x1c\xd8\x46\x73\x28\x8a\x80\xd6\xce\xae\x08\x4b\xa4\x42\x52\x36\xdc\xc5\xfe\xf7\x0e\x39\xd4\x6b\x1f\x5e\x23\x87\xa2\x01\x9c\x95\x38\x0f\xce\x7c\xf3\x54\x2d\xf2\x47\xb1\x42\x58\xaf\x21\xfb\x22\x2a\x84\xcd\x26\x49\xce\xcf\xe1\xbe\x90\x16\x96\xb2\x44\x78\x16\x16\x56\xa8\xd0\x08\x87\x0b\x78\x78\x01\x57\x20\xd8\x67\xb1\x5a\xa1\x01\xa7\x75\x99\x79\xfe\x8f\x0b\xe9\xa4\x5a\x11\xb1\x95\xab\xe4\xaa\x70\x50\x1b\xfd\x84\xb0\x6c\x5c\x50\x55\xa0\x82\x17\xdd\x80\xc1\x33\xd3\xa8\x91\xa6\xf6\x0a\xc8\x75\x55\x09\xb5\x48\x12\x59\xd5\xda\x38\x98\x26\x00\x13\x85\xee\xbc\x70\xae\x9e\xf8\x97\x95\x74\x45\xf3\x90\x11\xe3\xf9\x4a\x9f\xe9\x1a\x95\xa8\xe5\x39\x1a\xa3\x8d\x7d\x85\xc1\xdf\xf4\x0a\x99\x0c\x72\xb2\xc2\x57\x38\x9e\x44\x29\x17\x64\xe2\x24\x21\x1e\xeb\xcc\xb2\x72\x07\xef\x0a\xd4\xc0\x48\xc8\x1a\xa1\x08\xe2\xec\x0a\x97\xa2\x29\xdd\x75\xf0\xcb\x12\xd2\x44\xaa\x8d\x54\x6e\x09\x93\x5f\x7f\x4c\x20\x23\xec\x03\x3f\xaa\x05\xb4\xcf\x2c\xfb\xfe\x11\x5f\x52\x78\x4f\x16\x34\x08\xf3\x0b\xc8\x46\x4a\x3c\x95\x9e\x60\x4b\x5f\x64\xdf\xd2\x3a\x0b\xf1\xfd\x82\xcf\x90\x1b\x24\x6f\x2c\x08\x50\xf4\x46\x1c\x45\x43\xc8\xcb\x7f\xb0\x4b\x05\xb8\xfc\x7a\x0d\x79\x29\x51\xb9\x2c\x59\x36\x2a\xf7\x72\x53\x47\x36\xd9\x10\x9b\x88\x59\xf6\x21\xb0\xdc\xb7\xe7\x29\x2c\xb5\xa9\x04\x99\xc7\x38\x64\xb7\xb8\x92\xf4\xf8\x32\x83\x53\x66\x85\x35\xd9\x64\xd0\x35\x46\xc1\x09\x1f\xad\x3b\xb5\x73\x70\x3b\x9a\xe6\xed\xc3\x26\xf1\x09\x7a\x9a\xb4\x7a\xd6\x20\x97\x90\xdd\x35\x94\x34\xe6\x85\xe1\x18\xbf\x79\xf2\x15\xda\xdc\xc8\xda\x49\xad\x42\x82\x7b\xa6\xf1\x59\x87\x8f\x7f\x28\x2d\x6e\x8b\xb1\xe2\x5d\x19\xcf\xba\xd9\x90\x6d\x07\xf1\xeb\x91\x3f\x3d\x4f\xdc\x4b\x8d\x10\x4d\x27\x40\x9a\x9c\x91\x38\x8a\x28\xf1\x1c\x80\x34\xe1\x7a\x65\xfe\x1b\x36\x2d\x6f\xac\xd3\x15\x19\x62\x43\x89\x51\x52\x52\x65\x79\x82\x6d\x1e\x2a\xe9\x62\x1d\x0b\xa8\xd0\x15\x7a\x01\x7a\x19\xd8\x38\xce\x29\x94\xf2\x11\xe1\x1b\xa5\xf5\x27\x14\x0b\x34\x43\x9b\xa3\x7e\x9f\x09\xd3\xd3\xb1\xa9\x37\xed\x25\x9c\x5f\xbd\x3c\x58\x72\x9f\x0d\x29\xf8\xe0\x99\x68\xe1\xdd\xe0\x8f\x06\xad\x6b\x0d\xe8\xec\x0c\x1d\xe5\xb2\x65\xaf\x85\x21\x34\x1d\x3d\x6d\xf3\xf5\x9a\xac\xc7\x5b\xf9\xff\xa8\xfd\x58\x8f\xae\x54\xd6\x91\x3c\xf7\x1c\xe9\xf8\x9c\xbc\xb3\x38\x74\xee\xe6\x89\xda\x86\x5c\x60\xcc\xee\x9e\x30\xf5\x25\x45\xf8\x52\x4f\x4b\x21\xd4\x91\x85\x2c\xcb\xf8\x64\x36\x86\x63\x90\xcb\x01\x19\x5d\xc3\x41\x70\x02\x33\x90\x0b\xd9\x57\xef\x96\x85\x8b\xe8\xe6\x37\x23\xc9\xc5\x35\x5d\x3b\x87\x50\xec\x7c\xe9\x3c\xfe\xa6\x0c\x03\xbd\x77\xa2\xbe\xac\x37\x31\xfc\xbb\x1e\xfd\x04\xec\xa9\xd7\x24\x15\xd4\xa5\xc8\xb1\xa5\xfe\x4c\x10\xb6\xc1\x6c\x6d\xfa\xff\x81\xaa\xa3\x65\xbe\xe5\x34\x78\x04\xe4\xa1\x4e\x78\xf6\x3f\xbe\x77\x46\x80\x44\xa9\xc3\xf8\xc3\x1e\x2a\xeb\xb1\x12\xaa\x87\x8a\x4b\x69\xa4\x66\xd0\x04\x3c\x38\xe1\x1f\xe3\x41\x27\x11\x22\x80\xbf\xfe\xee\xce\x5a\x8b\xe1\x81\x46\x2f\xbd\xb3\xc5\xc4\x33\x06\xe7\x96\x63\xcc\xb7\xb4\x49\xe2\x5f\xee\x75\x24\xb5\x1e\x0c\x82\xfc\x80\xd4\x64\x70\xcb\x09\x02\x89\xbc\x5b\x7a\x63\x89\x50\xd1\xc8\xef\x6d\x10\x3b\xe9\xc1\xa1\x9f\x16\x23\x2f\x67\x5b\x57\x4f\xcd\x7e\x6b\x53\x8a\xf8\x6a\x77\x66\x84\xc9\x1e\x20\xa2\xa6\xfc\xae\xc8\xba\xeb\x39\xec\x74\x48\xef\x7e\x2a\x9a\xec\x0e\x1d\xa7\x5c\x88\xde\xb4\xc8\x42\xd4\x8b\x8c\x91\xa4\x5c\x9b\xfd\x16\x98\xdf\x5d\x80\x92\x65\x54\xd0\x25\x1a\x51\xc2\x01\x07\x7d\xa8\xb9\xc8\x18\xe7\x6c\xdb\x91\x60\xf2\x3e\xa5\x23\x95\x51\xd9\x8e\xe9\x91\xe9\x2d\x76\x47\x2d\x51\x82\xee\x49\x78\x8e\xc5\xdd\xa2\x2b\x06\xbf\x12\xd0\x78\xf4\xab\x80\xb0\x39\x6d\x2c\xc3\x71\xb4\x6f\x5a\xd6\x65\x63\x02\xdb\x1f\xd2\x50\xc2\x68\xb3\xa0\xf0\x75\x83\x2c\xb2\xce\xfe\xbb\x59\x7a\x7c\x8e\x46\x43\x3e\x3e\x51\xe6\xdc\x39\x5a\x62\x2a\x7f\x7a\xef\x3b\x10\x1a\x02\xf8\x2c\x0c\x00\xf4\x64\x2a\x50\x83\x3c\x10\x28\x6f\x7d\x62\xc7\xe3\xbc\x10\x4a\x61\x09\x22\x14\x00\x0d\x43\x0a\xcb\x13\x35\x00\x9e\x14\x79\xa9\x2d\x0d\x49\xad\x72\xae\x06\xcb\xb7\xf8\x8e\x9a\x25\x43\xf7\x1c\x56\xd4\x2d\x69\x6b\x9d\x2c\xb0\x36\x98\xfb\x1d\xf9\x4a\xe7\x77\xa1\x5a\x69\xa3\x23\x2e\x9a\xfa\x5c\x13\xa2\x5d\x7e\x66\xb0\x37\x3a\xd3\x58\xca\xa7\x7b\xa9\xdc\x8e\xa2\xeb\x97\x0d\x0d\x6e\x43\x64\x6f\x46\x0a\x82\x5e\xaf\xd5\x52\x6f\x55\xd5\x65\x3c\x8e\x5d\x70\x4d\x56\x77\x51\xfc\x24\x2c\x43\x47\x76\xde\x22\xed\x18\x2a\x80\x9f\x72\x57\x30\x20\x75\xd6\x8a\xf5\xde\xee\xa2\x9e\x0e\xf1\xfc\xfd\x0c\x46\x90\xf0\x42\x11\x04\xee\xa9\xf1\x4d\x46\xc2\xfc\x3c\xcc\x16\x6a\x34\xb5\x0b\x03\x61\x38\x08\x66\x7d\xd2\xe6\x39\x5a\x3b\x30\x76\xba\x85\xd4\x16\x47\x8b\x5c\xda\x2f\xbf\xa1\x93\x1c\xd4\x37\xeb\xf8\x42\x79\xfa\xaf\xa0\x9b\xab\x9b\x39\xfc\x19\xf7\xfe\xbe\x31\xda\xb6\x55\xfa\x21\xcb\x7d\x99\x54\x46\xd2\xc5\xb0\x0d\xb4\x67\x7e\x71\x7e\x25\xae\x53\xae\x6f\xdf\xdf\x6b\xdf\x6f\x4e\x0e\x8c\x3a\x56\x7a\x7d\x35\xdf\xde\xf5\x3b\x67\x03\xc3\xe7\xb0\xd8\xed\x32\xf1\x79\xc7\xf6\x55\xb8\x82\xfe\x28\xca\x6a\x97\xd7\x13\x7b\x4e\xa3\x17\x0d\xc1\xf5\x19\x17\x52\xf8\x60\xda\xb1\xc0\x2f\x4f\x5e\x62\x87\xa9\x93\xff\x40\x10\x37\xd5\x11\xf9\x5d\xa6\x4e\xfe\x2e\xa7\xd1\xb3\x57\x28\x52\x06\x3e\xf1\x04\x67\xe0\xf9\xec\x36\xb4\xd6\x39\x9c\xec\x8d\x00\x53\xd7\xdd\x87\x86\xc8\xe2\xe3\xdb\x4a\x65\x1e\x7f\xdf\x56\x28\xf3\xf8\xdb\x71\x6f\xd2\x7d\x35\x1d\xcc\x6e\xeb\x77\xde\x15\x78\xca\x62\x9b\x76\x40\xf9\xef\x8e\xef\xa1\x6e\xc2\xf8\x0b\xa3\x20\x14\x51\xbb\x10\x39\xda\x9b\xba\xd4\x3a\x98\xf8\x06\x2d\x7d\x97\x52\x83\xa0\x0e\xbc\xd9\x7c\x1f\x54\x64\x1c\x7f\x22\xeb\xbe\x50\x48\xdc\x7f\x43\x44\xbd\x71\x42\xee\x9b\x7c\x07\x6f\x23\xce\x71\x49\x8e\x47\xdb\x11\x2b\xb3\x37\x56\xfd\x6c\x70\x07\x4f\xcb\x7e\x80\xf8\x65\x88\x26\x6e\xf7\x75\x15\xba\xd7\x2a\xee\x42\xfd\xb7\x98\x56\x83\xef\xa2\xdd\x26\x3e\xd4\x70\xfc\x9b\x98\x97\xd4\x01\x90\xd4\x13\xba\x67\xb2\xee\x5f\xfe\xcb\xe9\xe2\x89\x11\x00\x00")
 
 func templatesClientClientGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -130,12 +242,12 @@ func templatesClientClientGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/client.gotmpl", size: 2564, mode: os.FileMode(420), modTime: time.Unix(1466470505, 0)}
+	info := bindataFileInfo{name: "templates/client/client.gotmpl", size: 4489, mode: os.FileMode(420), modTime: time.Unix(1792218950, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesClientFacadeGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x54\xdd\x6e\xdb\x3c\x0c\xbd\xf7\x53\x10\xf9\xfa\x01\xc9\xd0\xca\xf7\x03\x72\xb3\x1f\xac\xbb\xe9\x8a\x35\x2f\xa0\x2a\xb4\x2d\xd4\x96\x3c\xfd\x24\xc8\x8a\xbc\xfb\x48\xcb\x4e\xea\xd4\x4d\xb3\xdd\xc9\x22\x79\x78\x78\x78\xac\x56\xaa\x27\x59\x22\x3c\x3f\x83\xb8\xef\xcf\xfb\x7d\x96\xe5\x39\xac\x2a\xed\xa1\xd0\x35\xc2\x56\x7a\x28\xd1\xa0\x93\x01\xd7\xf0\xb8\x83\x50\x21\xf8\xad\x2c\x4b\x74\x10\xac\xad\x05\xe7\x7f\x5d\xeb\xa0\x4d\x49\xc1\xa1\xae\xd1\x65\x15\xa0\x75\x76\x83\x50\xc4\xd0\x41\x55\x68\x60\x67\x23\x38\xbc\x71\xd1\x8c\x90\x86\x16\xa0\x6c\xd3\x48\xb3\xce\xb2\x4c\x37\xad\x75\x01\xe6\x19\xc0\xcc\x60\xc8\xab\x10\xda\x19\x7f\x94\x3a\x54\xf1\x51\x50\x66\x5e\xda\x1b\xdb\xa2\x91\xad\xce\x09\x31\xe8\x06\x39\x83\x33\x83\x93\xc6\x77\x00\xe7\xf3\x73\x55\x6b\x34\xe1\x0c\x30\x53\x3c\x17\x6e\x51\x9d\x09\xa3\x73\xd6\xf9\x4b\x78\x53\x8a\x0f\xae\x68\xde\x64\x9c\xa2\x5d\x22\xad\x8c\xe6\xa3\x7d\x89\x2f\x58\xc8\x58\x87\xef\x9d\x58\x9e\xf6\x47\xa1\xd6\x69\x13\x0a\x98\xfd\xff\x6b\x06\x82\x36\xda\xe5\xa3\x59\xc3\x70\x4e\xb5\x57\x4f\xb8\xbb\x86\xab\x8d\xac\x23\xc2\xc7\x25\x88\x11\x08\x47\xe9\x04\x27\x78\x7d\xfa\x09\xea\xa2\x73\x4d\xcf\x85\xef\xab\x48\x5b\xd4\xbf\x89\xe0\x9d\x6c\x38\x1d\x6e\x57\xab\x7b\x48\x62\x8b\x6c\x23\xdd\x21\x7b\x09\x77\xb8\xe5\xe8\xe7\x2e\x38\x37\xba\x4e\x70\xa3\x6b\x50\x0e\xc9\x1f\x1e\x24\x18\xdc\x5e\xd0\xa2\x88\x46\x9d\x20\x17\xd6\x35\x92\xe6\x4b\x42\x8a\x9f\x58\x6a\x3a\xee\x16\xf0\x81\x87\x94\x5e\xc9\x7a\x84\xf7\x4c\x33\xea\x02\x86\xb2\xe5\x12\x88\x5b\x77\x0b\xc7\xcb\x01\xad\x1f\x87\x82\x2c\xcd\xd1\x7e\x24\xec\xc8\x8f\x82\x38\xcd\x5f\x8a\xfa\xdf\x86\xb6\x74\x6b\x7d\xa0\x96\xd7\xf0\x2a\xf2\x49\x7a\xbc\x97\xa1\x9a\x8e\x3e\xa8\x0a\x1b\xe4\x95\x2d\xa8\xab\xc3\x10\x9d\xe1\xa9\xe7\x87\x7e\xd7\x03\xd5\x45\xb6\x1f\x64\xbd\x44\xcc\xa4\xe3\x41\xc6\x23\x20\xf4\x8e\x15\x49\xd5\xd5\xab\x46\x7f\xa5\x2f\xb5\x61\x89\x4c\x2f\xca\xab\xa4\x45\xca\x11\x87\x36\xa4\xf8\x81\xca\xe8\x4f\xf8\xd1\xf2\x0b\xa2\xad\xf9\xe6\x6c\x6c\x7d\xb2\x28\x97\x4e\x37\x5f\x76\xef\x5e\xff\x25\xde\x92\x6c\xfc\xeb\xf4\xfa\x12\x68\xaf\xe5\x34\xb4\x66\x61\x93\x7e\x8c\x34\xa9\x6f\x16\x76\x2d\xbe\x51\x4f\xa2\x45\x15\x3a\x79\xde\x19\x6f\xba\x9e\xe5\xf6\x46\x3e\xbd\xbc\xec\xb7\x75\x32\xd0\xea\xbd\xa5\xf2\x9c\x3c\xe8\x03\x1e\xef\x40\x55\x4c\xc9\x77\x0f\xf8\xd1\x16\x36\xbd\xe8\xfd\xdc\xf4\x86\x83\xac\x6b\xd0\xec\x87\xf8\xe8\xd0\xdb\xe8\x14\xfa\x64\xa8\xb9\x62\x92\x27\xd4\x69\xd9\xa3\x3e\xef\x5b\x6e\x91\x2c\xf4\xcf\xe6\x98\xb6\x86\x98\x26\x31\x36\xc3\x3e\xfb\x13\x00\x00\xff\xff\x7e\x64\xcb\x82\x3f\x07\x00\x00")
+var _templatesClientFacadeGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x5a\xdd\x6f\x1b\xb9\x11\x7f\xf7\x5f\xc1\x53\x93\x60\x95\x6c\xd6\x29\x70\xbd\x07\xa7\x3a\xa0\x49\x7c\x49\x8a\x20\x0d\x6c\xb7\xf7\x10\x1c\x0a\x5a\xa2\x24\xd6\xab\xdd\xcd\x2e\xd7\xb6\xea\xd3\xff\xde\x99\xe1\xf7\x8a\x92\xe5\xdc\x1d\x8a\x2b\x9a\x87\x58\x22\x87\x33\xc3\xf9\xf8\x71\x86\x54\xc3\xa7\x57\x7c\x21\xd8\xdd\x1d\x2b\x3e\x99\xcf\x9b\xcd\xd1\xd1\xf1\x31\xbb\x58\xca\x8e\xcd\x65\x29\xd8\x0d\xef\xd8\x42\x54\xa2\xe5\x4a\xcc\xd8\xe5\x9a\xa9\xa5\x60\xdd\x0d\x5f\x2c\x44\xcb\x54\x5d\x97\x05\xd2\x9f\xce\xa4\x92\xd5\x02\x26\xed\xba\x95\x5c\x2c\x15\x6b\xda\xfa\x5a\xb0\x79\xaf\x88\xd5\x52\x54\x6c\x5d\xf7\xac\x15\xcf\xdb\xbe\x8a\x38\x59\x11\x6c\x5a\xaf\x56\xbc\x9a\x1d\x81\x52\x72\xce\x8a\xb7\xf5\x5b\x3b\x03\xaa\x1d\x1f\x2f\xea\x13\x47\x8a\x7a\xc7\xf3\x30\x22\xaa\x19\x6d\x42\xae\x9a\xba\x55\x2c\x3b\x62\x6c\x74\xb9\x56\xa2\x1b\xe1\x27\x59\x9b\x3f\xc7\xb2\x46\xa5\xe8\x5b\x25\xd4\xf1\x52\xa9\x06\xbf\x18\xb1\xe7\x72\x51\x71\xd5\xb7\xc8\x75\x34\x6d\xd7\x8d\xaa\x8f\x97\x2b\x3e\x25\x7a\xf3\x1d\xc5\x3b\xba\xe2\x1d\xef\x96\x48\x8c\x04\xa2\x9a\xd6\x33\x30\xc7\x80\xe4\xd4\x0c\x1b\x32\xa7\xac\x97\x2a\xda\x6b\xb0\x05\x4c\xcf\x57\xca\xa9\xd6\xb7\x5a\xcd\x4e\xb5\xb0\xb8\x4b\x2f\x7d\x5d\x4a\x51\xa9\xd7\x75\x35\x97\xc8\x3f\x31\x8a\x1a\xbe\xe9\xc1\x52\xb2\xae\x50\x84\x92\x2b\x11\xf1\x0a\x98\x8e\x16\x52\x2d\xfb\xcb\x02\x9c\x01\x16\x7f\x5e\x37\xa2\xe2\x8d\x3c\x06\xa7\xd9\x55\x68\x2f\xd5\xf2\xaa\x23\x2b\xef\xa7\x3f\x9e\x92\x1a\xb4\x89\x34\x21\x46\xc1\xbe\xe9\x46\x4c\xf7\x4c\x8b\xb6\xad\xdb\x6e\x0f\x81\xd3\x1b\x48\xc0\x8a\x60\xdc\x9d\x92\x68\x96\x08\xc1\x1c\xb0\x3f\x48\x89\xe2\x8d\x98\xf3\xbe\x54\xef\x29\xa2\x3a\x6d\xa9\x06\x7c\xa1\xe6\x6c\xf4\xf8\xcb\x88\x15\x10\x6f\x81\x1d\xc3\xb5\x8f\xae\xc4\x3a\x67\x8f\xae\x79\xd9\x0b\x76\x32\x61\x45\xc4\x04\x67\xd1\xde\x03\x7e\x86\x7c\xc0\x75\x4c\x89\x69\x74\xc1\xf1\x65\x0f\x89\x22\xff\x0d\x0a\x7e\xe4\x2b\x24\x67\xef\x2e\x2e\x3e\x31\x6d\xec\xe2\xe8\x9a\xb7\x8e\x7a\xc2\x3e\x8a\x1b\x9c\xd5\x01\x91\x55\xb2\xd4\xec\xa2\x61\x36\x6d\x05\x24\x52\xc7\x38\xab\xc4\xcd\x01\x22\xe6\x7d\x35\x1d\x70\x9e\xd7\xed\x8a\xc3\xfe\xb4\x21\x8b\x33\xb1\x90\xf0\x71\x3d\x66\x4f\x71\x93\xbc\x9b\xf2\x32\xe2\x77\x07\x7b\x6c\x05\xa4\x47\x15\x33\xfa\x11\xdc\x13\xb3\xcc\x19\x69\xbd\xd9\xd6\xdb\xd3\x3e\x70\x07\xac\x03\xdb\x6a\xd8\x12\xa0\xc5\x97\x5e\x74\xa0\x7a\x3d\x67\xbc\x2c\x99\xc4\x8f\x8d\xd0\x09\xd3\xa1\xd0\x1b\x90\x43\xa4\x0b\x79\x0d\x48\x86\x29\x60\x18\xe5\x0c\x94\xa4\x15\x00\x78\xb7\xe0\xf1\x8b\x0f\xe7\x80\x64\x98\x76\x26\xe3\x72\xf8\x5a\x5f\x49\xc1\xfe\x05\x6e\x01\x5a\x8c\x47\x40\xa0\x42\x83\xad\xb0\xdf\x49\x3a\x8a\xf0\x92\xc1\x94\x12\xd4\xe1\x4d\x53\xae\x59\x8d\xf8\xa0\x3c\xbd\x25\x8f\x74\xe1\x68\xa9\x70\x88\xf6\xd9\x45\xbb\x8c\xf6\x33\x33\x61\xe2\x12\x3a\xe5\xda\x6d\x8f\x0c\x9d\x9c\x5b\x71\x4f\x51\xb6\x01\x9f\xbd\x9e\x07\x8c\xb2\xbc\x26\x13\xd2\x1a\x47\x99\x1f\xb4\x22\x4c\x20\xc3\x24\x26\x85\x07\x1e\x48\xa9\x08\x89\x0a\x50\x39\x0b\xd3\xe9\x0f\xd7\x90\x9f\xef\xea\x4e\x81\xc8\x9c\x6d\xcd\xbc\xe2\x9d\xf8\xc4\xd5\x32\x3d\x7b\x3e\x5d\x8a\x95\xc0\x64\x1d\xfb\xa3\x01\x60\xf4\x14\xdc\xaf\xce\x15\xc4\xda\x0a\xe6\xc0\x92\x74\x92\x11\x78\x3f\xef\xd0\x00\x02\x09\x20\x0a\x5b\x34\x38\x9f\xb1\x79\x5b\xaf\x88\xe8\xb2\x9e\xad\xad\xcf\x5a\x01\x2a\x57\x9d\x08\x37\x54\x00\x56\x77\xfd\x4a\xb4\xdd\xe7\x91\x12\xb7\xea\x98\x38\x3d\xef\x48\xd6\xe8\x27\x30\x88\xc1\xb2\xe2\x15\x9c\x69\x5a\x05\xbb\x24\x1b\x87\x78\x01\xaa\x1a\x6f\x7c\x13\x5a\xd6\x4b\xba\x70\x46\x9c\x18\x4a\x37\x72\xa7\xbf\x9f\x98\xf1\x8d\x31\xbb\xcf\xd3\xcc\xb1\xc9\xad\xaf\x6c\x66\x9e\xe9\x00\xc3\x53\x0f\x42\xb5\x83\x3f\xdd\x56\x7a\x0d\x02\xbc\xae\xa6\x42\xd3\xe8\xbd\xb1\x25\xd4\x1c\x60\x4e\x49\x11\x33\xc3\xa9\x55\xce\x2e\x05\x48\x22\xba\x35\x19\xb6\x23\x08\x02\x89\xef\x15\x30\x9f\x0a\xc8\xc9\x48\x92\x0f\x6f\x32\xba\xb4\x59\x00\xc5\x05\x9b\xf2\x8a\x4d\x97\x84\xd0\x98\xb4\x4b\xf0\x11\x98\x3c\x67\xa5\xbc\x12\x8c\xcf\x08\x13\x38\x29\xaf\x8b\x00\x38\x25\x9a\x1e\xeb\x9f\xc8\x91\xc5\x91\x5a\x37\x62\xb0\x63\x4c\x9c\x0c\x54\x30\x49\x60\x26\x73\xad\xc4\xe7\x9f\xb0\x14\x19\x33\x3a\xb1\xd2\x40\x66\xd8\x3c\x10\xc8\x20\xb9\x4a\x0b\x64\x9d\xe6\x50\x57\x07\xc2\x9a\x31\x6c\x80\x85\xab\xbd\x50\x77\x08\xbc\x7c\x05\xb6\xe8\x8d\x3f\x08\x5b\x72\xbb\xd7\xc8\x05\xff\x47\x9c\xff\x0e\xe2\x54\xb0\x1a\xcd\x93\x80\x18\x6d\xf8\x14\x1e\xd1\xa2\x43\x01\x28\x0d\x5e\x18\x03\x10\xb8\x7e\x31\xf2\x3c\x21\xce\x36\x40\x4e\xcc\xdf\xc3\x50\x2c\xd1\x03\x80\xc5\xb7\x10\x0d\x2c\x2b\x45\xe7\x6d\x3b\x8c\xfc\xb8\xf8\xd7\x9e\xc5\x30\x34\x0b\xa8\x32\xdc\xd9\x20\x30\xea\x21\xc4\x0c\x13\x54\x56\x09\x7e\xef\x08\xb3\x90\x52\xa3\xd7\x50\xe9\xe2\x53\x2b\xe6\xf2\x16\x09\xf8\x5c\x01\xe5\xb0\x68\x4d\x50\xfa\x62\x93\x52\xd4\x64\x24\x16\xa9\x16\xb8\x62\xac\x0b\x8a\xb7\xc3\x61\xcf\x38\x1e\x9a\x29\xca\x25\xf8\x6b\x53\x68\xbb\x9b\xc2\x99\x1c\x2d\x35\xb6\x6b\x8a\x1f\x5b\xa9\x44\x86\x6c\xf5\x18\xc8\x34\xb6\x80\xf6\x49\x65\xbb\x77\xe9\x0c\x96\xb3\xdd\xb6\x3a\xc0\x48\xcf\xc2\x86\x09\xd8\xc0\x9e\x53\x2e\x1c\x5d\x42\xa2\x7f\xf7\xed\x08\xe8\xf4\xa7\xe2\x5c\xcd\xec\xac\x26\x13\x17\xf5\x39\xf5\x74\xc8\xb1\xec\x30\x38\x96\xe2\x36\x31\x47\xd2\x32\xdc\xfd\x79\xbf\xa2\xe2\x7d\x6c\x37\x4f\xc6\x87\x11\xca\x10\x1d\xbb\xa1\x72\xc3\xbe\x10\xa2\x29\x1a\x59\xd6\xa5\x41\xee\x4e\x28\xec\xe0\x5d\x3c\x27\x8f\x1c\x7b\x04\x74\x8a\x43\xea\x11\xc6\x18\x9c\x8c\xb8\xaa\x1a\x7c\x26\x9a\x10\xff\xe9\xa4\x31\xac\x9d\xa8\x52\xcc\x15\x83\xc0\xa1\x93\x78\xa6\x4f\xd3\x88\x11\xa0\x50\x3f\x55\x14\x31\xbe\x1d\x8b\x9a\xda\x1f\xa4\xc0\x1d\x50\xaf\x04\x12\xf4\x95\x80\x55\x57\x76\x2e\x71\xec\x90\x91\x6d\xac\xf3\x46\x74\xd3\x56\x36\xa6\x25\x3e\x21\xca\x78\xcc\xd9\xb3\xf0\x78\x6c\xbb\x2a\x32\x27\x54\x1f\x76\x8b\xb8\x6f\xcd\xc1\xce\x0f\xda\xeb\x40\x35\xfd\xed\x02\x77\x3c\xe8\xf3\x36\x61\x9f\x17\x59\x43\x7b\xfb\xc1\xee\x72\x88\x24\x5b\xef\x0d\x4a\xf0\x84\x90\x6c\x1c\x7b\x20\x48\xf1\x70\x5c\xe7\xf0\x7d\x3e\xd9\xb2\x57\x64\x83\x93\x81\xb1\x72\xcb\x73\x70\x1d\x61\x43\x3b\xd9\xf6\x69\x2d\x1f\x58\x2d\x0d\xaa\x1b\x6b\xcb\xe2\x68\xc7\xb5\xc9\x85\x69\xb6\xc8\xe1\x41\xb7\x06\xe8\xd6\x63\xe5\x23\xf8\x74\xe9\xca\x4e\x3a\x8e\xcd\x3d\x99\xcd\x17\xf5\xc0\x0e\x4f\xaa\x62\x6f\x26\xa3\x52\x67\x02\xf0\x81\x4a\x05\xa3\x94\x3b\x81\xe6\x5c\x52\x31\x08\x58\x0b\xe7\xdb\x8d\x68\xa1\x70\xa5\x2d\x73\xf6\xa7\xdb\x5b\x4c\x5e\xd5\x77\xae\x86\x66\x7c\xc1\x25\xf4\xa7\x50\x73\x83\xcd\xd6\x6c\x45\x55\xb6\xc4\x2a\x84\xdb\xa2\x8e\x24\x05\x2a\xed\xe8\x0e\x75\x04\xed\xae\xe0\xb4\xb3\xc2\x8d\xfc\x16\xb5\xda\xb2\xa6\x83\xc7\xd4\x59\x00\x56\xa6\xa6\x82\xd3\xe6\x37\xa8\xd3\x8e\x92\x57\x70\xe8\x20\xc3\x1b\x0b\x1f\x33\x86\x03\x50\xfd\x8c\x46\x66\x23\xa8\x29\x16\x3f\x7e\xda\x6c\xe1\x3e\xdf\x07\xca\x79\xf6\x6e\x30\x14\x61\xcd\xe0\xc5\x58\xb2\x03\x45\xb9\xba\xc5\x0b\x32\x43\xa1\x18\x6b\xe3\x09\x9c\xf5\xfa\x9e\xf2\x2e\x22\xde\x0c\xa5\xdd\x53\x50\xef\xf0\xe1\xef\xaf\x28\xbe\x17\x51\xbc\x59\xed\xd8\xf7\xec\xc5\x57\x35\xeb\x4f\x82\xce\xe8\xce\x30\x3b\x19\x30\xdf\x6c\x0e\x74\xbb\x47\x17\xaf\xa0\x1d\xbb\x4f\x41\xc4\x8b\x75\xa2\x22\x4f\x50\xe7\x16\x5c\x4e\x06\x42\xb6\xf4\x3c\xa8\x6c\x8f\xb6\xe4\x6e\xd3\xb1\x78\xa7\xcf\xff\xe0\xad\xe4\x97\xa5\xd0\x21\x81\xce\xa7\x9b\x56\x07\xc9\xd7\x6e\xde\x55\x2a\xc4\xa2\x6f\x4b\x5d\x7d\xd3\xd7\xe2\xef\x67\x1f\xe8\xb8\x32\x11\x27\x56\x8d\x82\x68\xaa\xf0\xfa\x81\x5f\x89\xf8\xa4\xd5\x45\xcd\x50\x7c\xb2\xae\x31\xdc\x3d\xd5\xde\xa2\x46\xdf\x11\x83\x9e\x41\x75\xf3\x75\x55\x4d\xb2\x88\x89\xaa\xe0\xe8\x00\x47\x09\xa7\x55\xbf\xa2\xce\x02\x98\x48\xbc\xc0\xb1\x9a\x98\x5b\x6f\x99\xb3\x47\xfa\xbe\xdb\x50\xea\x75\x8f\xa4\x45\x53\xe7\xa8\xe8\xde\x5b\x84\xaa\x0d\xee\xd3\x03\x0b\x68\x80\x49\x14\x4d\xda\x82\xe8\x9e\xcb\x5e\xda\xc2\x16\x9d\x17\xbb\xd3\x1d\xff\xde\xfb\x78\x2b\xe2\xbc\x4f\x9e\x95\x8a\xce\xd1\x4e\xbf\x5a\x71\x63\x6f\xd8\x6c\x55\x2b\xbb\x61\xf2\x3e\x6e\x70\xc0\xc1\xb4\x4f\x56\x9b\x0c\xc6\xbb\x61\x0c\x8c\x59\xf6\x14\x54\xc3\x60\xca\x75\x63\x34\xa6\x78\xf0\x31\x78\x62\xc0\xd4\x46\x0a\x34\xcb\x60\x80\x5c\x6b\x92\xa3\xc3\xbc\x25\x98\xd6\x23\xfc\x67\x81\x18\x13\x69\x58\xae\x25\x42\xed\x6e\xcb\xeb\xc6\xdc\x28\xb0\xed\x8a\xc8\x05\xf9\x9e\x10\x71\xed\x95\x71\xbd\x3b\x10\x7e\xe5\xf8\x60\x1b\xdf\x36\x41\x6d\x10\x8c\x0f\x0a\x49\x42\x12\x2c\x63\xf9\xcd\xb0\x06\xa0\x16\x2f\x4c\xea\x23\xaa\x2e\xd8\x3f\x61\xd3\x48\xab\xd5\xf5\x2e\xd1\x76\x04\x4d\xaf\x0b\x1d\x0f\x93\xe0\x0c\x64\x7e\x14\x3e\x81\x7b\x68\x74\x43\xff\xc3\xb8\x9c\x21\xc7\x52\x54\xd9\x75\x81\xce\x1a\xe3\xe2\x17\xb6\xa0\x41\x91\x22\x10\x49\x24\x9e\x31\x2d\x9f\x98\xbf\x3f\xff\xcc\x48\xb2\x11\x17\x88\x01\xcd\xbe\xd1\x34\x76\xa5\xef\x10\x01\x2c\xa1\x54\x3a\xc5\x48\x9b\x67\x23\x59\x69\x3a\xad\xf0\xe3\x2f\xa4\x43\x90\x23\x76\xcf\xec\x71\x07\x8a\xdd\x36\x62\x8a\xd7\x9f\x3a\xee\x4f\x60\x70\x94\x5b\xf1\xf8\x41\x07\xa6\x79\xa0\x2c\xfe\x5a\x4b\xbb\xc9\x9c\x01\xe1\xc8\x74\xab\x5a\x47\x74\xc3\xc4\xd1\x9e\x89\xa6\xe4\x53\x91\xc1\x28\x10\xde\x8d\x9e\x69\x66\xcf\x46\x9b\x50\xc2\xf3\x3f\x8e\xe3\x3b\x68\xcc\x9c\x4f\x10\x96\xb4\x70\xe7\xa3\xd0\x10\x76\x1f\xd8\x26\x0c\x4c\x82\x40\x82\xb8\xb2\xd5\x3f\xb8\xf8\x70\xaf\x39\x54\xd8\x21\x38\xd2\x72\x53\x1b\xad\x64\xd7\x61\x59\xee\xaa\x10\x64\x68\x0f\x22\x3a\x3e\x2c\x4c\x81\xb5\x77\xde\x92\xc6\x5b\xda\x5d\x6c\xef\xc2\x9c\x64\xb9\x1d\x21\x50\x4f\xdf\x30\x16\x63\x04\x1b\xeb\x92\x1c\xe7\xa2\x2b\xbc\x30\xc4\x60\xd2\x38\xea\xd7\x2f\xde\x7b\xaa\x90\x73\xf8\xab\xa7\x1c\xb0\xf4\x41\x91\x09\x62\x75\x55\xbd\x5d\x66\xef\x2a\xfe\xbd\xc2\xbe\x58\x4e\x57\xd0\xa3\xe3\x91\x27\xb6\x52\x23\x62\x5f\x07\xef\x6f\x19\x0e\xb8\x53\xfe\x9f\x29\x81\xf7\x57\x6e\xf4\xac\x1b\x95\x6f\xb0\x8b\x41\x89\x9b\x7a\x60\xb0\xaa\x9b\x27\x23\xdd\xdf\x46\x2f\x15\xba\xf4\xda\x62\xe5\x0f\xd4\xc4\x8b\x82\x7d\xc4\xc2\xbe\xfe\x02\xea\xa5\x6d\xc9\x3e\xf9\xa3\x47\x91\x9b\xa5\x9c\x2e\x61\x53\x65\x59\xdf\x58\xfa\x99\x6c\x01\x36\xf5\x8b\x13\x36\xf7\x58\x3b\x63\x99\x10\xbc\x04\x13\x46\xe8\xd2\x5c\x27\x7c\xa6\x86\x1a\x8f\xbd\x36\xdb\x17\xac\x98\xd1\xe6\xbb\x76\x61\x94\xc9\xc6\xf2\xaa\x30\x0f\xf6\x6f\x6a\xe4\x60\xe1\x72\x78\x6f\x4e\x8f\x48\xdd\xe0\x09\x89\x83\xb6\xcd\x7a\xe7\x25\x37\x14\xba\x14\x48\x18\x5b\xf9\xf0\x25\x49\x06\xc6\xa2\x7b\xfe\x16\x37\x02\x21\x26\x9b\x46\xb4\xda\x3d\x5b\x4a\x04\xfe\xa1\x35\xfa\xa7\x26\x85\xb3\x01\xae\x64\xc9\x77\x9f\x2d\xdf\xa1\x52\x5d\x22\xd8\xb5\x1f\x1d\xba\xd3\x62\xeb\x41\x7d\x17\xa9\x57\x99\x67\x41\x7a\x39\x4b\x99\xc1\x79\x6c\xb8\x89\x5f\xe0\x32\xd2\x86\x2a\x85\x0a\x31\x20\x5c\x07\xb3\x4f\xcd\xf4\x84\x3d\x05\xbe\x8e\xdc\xde\x6d\x4f\xd8\x0a\x1a\x10\xbd\x4a\x0f\xe5\x54\x70\xf8\x3b\x72\x3a\x89\x71\xe7\x57\x51\x85\xe3\x09\x2c\x8e\x85\x7c\x3f\x5f\x61\xba\x43\x04\x83\x5f\x33\x8b\xba\x74\x0d\x8d\x47\x74\x51\x8c\x6d\x85\x85\x3f\x39\x09\x2e\xfc\x35\x4e\x22\xef\x57\x38\x18\x1d\x1a\x48\x8a\x27\x89\x7e\x09\x25\x9c\x05\x1a\x7d\xf2\x4c\x98\xfe\x71\x16\xec\x9c\xcf\xfe\x52\x96\x99\x65\xe1\x6f\xfc\xf1\x1b\xa4\x6c\x0d\x05\xc0\xd8\x56\x3e\x5b\x07\x53\xea\x68\xb2\x55\x88\xd9\x20\x29\xe6\xe4\x7d\xac\x1b\xe2\xd9\x66\xf4\x8b\x31\x84\xe1\x33\xb2\x80\x7e\x6f\x18\x87\x2b\xdf\x0a\x65\x16\xd3\xd3\x07\x78\x55\xd6\xa4\xb0\xe6\x10\x39\x35\x50\xe5\x40\x49\xb9\xb9\xd3\x1f\xa8\x0b\x00\xab\x20\x91\x3f\x88\x6a\x41\x07\x12\x9c\x2f\xdf\x7d\x9b\xa1\x8b\x9d\x82\x1b\x7f\x4a\xe3\x5b\x5c\xa1\xc3\x3b\xd3\x0c\xf4\x7b\xcc\xf8\xe5\xa1\x87\xb8\x03\x10\x4c\x45\x9f\x83\x86\x5b\xf8\x58\x76\xd8\xef\xcf\xa2\x3b\xcb\xad\x6b\x82\x14\xcc\xd3\xe5\xe4\x3e\x18\xd1\xfd\x19\xfd\x2a\x00\xfb\x35\xba\xf4\x04\x0c\xda\x7d\xf1\xa9\x71\x27\x21\x7c\x0b\x78\x92\xc8\x63\xee\x2b\xd0\xf4\x0f\x04\x1c\xfc\x99\x43\x8e\xed\x35\x6e\x13\xc1\x31\xd8\x1b\xdd\x20\x73\xa5\xf0\x2e\xc1\xc1\xca\x96\x8e\xbf\x00\x57\x7e\x37\xa9\x69\x5b\x30\x63\x0c\x0c\xe2\x17\x2f\xd9\x4b\xfb\xfd\xd9\x33\x8b\x50\x78\x74\xef\x80\x49\x04\x4a\x9c\x76\x30\xb9\x67\xb7\x9a\xd3\xd7\x00\x81\x69\x67\x44\x97\xfb\x6c\x1b\xe6\x09\xfe\x00\xca\xca\xb7\x3b\xfa\x1e\x09\x6d\x18\x41\x2f\x87\x7a\x51\x62\xdf\xaa\x6c\x8c\x0d\x1a\x80\x89\x51\x11\x66\x33\x32\xbb\xfe\xfa\xe4\x09\x8a\x2b\xce\x29\x8e\x5f\xd7\x33\xc1\xfe\xac\x63\x54\x8f\xbc\x07\x1e\x6d\xc5\x4b\x5d\xb6\x9f\x26\xf1\xc7\xaa\x1b\x37\x8d\x81\x0c\x4f\xdf\x6d\xbb\x72\x93\x7a\x66\x74\x55\x1b\x58\xe9\x90\x16\xcb\x54\x67\xb6\xbf\xf1\x45\xa1\xab\x28\x5f\xc7\x15\x90\x2b\x16\x1f\xf4\xab\x42\x10\x63\xe3\x23\x49\x34\xd6\x34\xd1\xcd\xa5\x0a\x7e\xb0\xe0\x6f\x4b\xfe\x66\x5f\x6a\xde\x02\xf2\x34\xa6\x82\xc7\xa5\x69\xe1\x93\xf0\x4a\xae\xd8\x75\x61\x19\x5f\x50\x18\xef\x00\x53\x03\x2a\x69\xd6\x12\x0d\x1b\x74\xa7\x29\xfb\x6a\x7c\x4b\xaf\x4f\xde\x3b\x26\xb7\x97\x5e\x8f\xe6\xee\x2a\x7e\x15\x0e\xda\xca\x39\xde\xd0\xc5\x7d\x4e\xc5\x7d\xea\x3b\xbb\xa0\x38\x0f\xab\x2f\x1f\x16\xe6\x97\x4c\x66\xdf\x54\x4d\x9b\x1f\x32\x75\xfd\x25\xc4\x69\xdd\xb7\x53\x61\x9e\x34\xb3\x29\x2a\x39\x50\x1d\x9c\x1d\xc9\xb9\x3f\xe4\x74\xde\x4c\xbf\x3a\x38\xd2\xa1\x51\xa4\x95\x18\x0f\xae\x32\xff\x03\x24\xd0\x01\xc6\x98\x2f\x00\x00")
 
 func templatesClientFacadeGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -150,12 +262,12 @@ func templatesClientFacadeGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/facade.gotmpl", size: 1855, mode: os.FileMode(420), modTime: time.Unix(1462143626, 0)}
+	info := bindataFileInfo{name: "templates/client/facade.gotmpl", size: 12184, mode: os.FileMode(420), modTime: time.Unix(1792232579, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesClientParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x59\x5f\x6f\xdb\x38\x12\x7f\xd7\xa7\xe0\xf9\x72\x85\x14\xa4\xf2\x3d\xe7\xe0\x03\xda\x34\xdd\x66\x81\xed\x66\xb7\x41\x17\xd8\xa2\x58\x30\x32\x6d\xab\x95\x44\x85\xa4\x9c\x7a\x0d\x7d\xf7\x9d\xa1\x28\x89\x92\x29\x59\x46\x1b\x60\x81\xed\x53\x64\x72\x66\x38\xfc\xcd\x6f\xfe\x48\xc9\x69\xf4\x99\xae\x19\xd9\xef\x49\x78\x6b\x9e\xcb\xd2\xf3\xe6\x73\x72\xb7\x89\x25\x59\xc5\x09\x23\x8f\x54\x92\x35\xcb\x98\xa0\x8a\x2d\xc9\xfd\x8e\xa8\x0d\x23\xf2\x91\xae\xd7\x4c\x10\xc5\x79\x12\xa2\xfc\xf5\x32\x56\x71\xb6\x86\xcd\x5a\x2f\x8d\xd7\x1b\x45\x72\xc1\xb7\x8c\xac\x0a\xa5\x4d\x6d\x58\x46\x76\xbc\x20\x82\x3d\x17\x45\xd6\xb1\x54\x1f\x41\x22\x9e\xa6\x34\x5b\x7a\x5e\x9c\xe6\x5c\x28\xe2\x7b\x84\xcc\xb8\x9c\xe1\x9f\x8c\xa9\xf9\x46\xa9\x5c\xff\x58\xc7\x6a\x53\xdc\x87\x20\x3f\x5f\xf3\xe7\x3c\x67\x19\xcd\xe3\x39\xd8\x55\x71\xca\x46\x24\xf0\xc4\x91\x6d\x26\x04\x17\x72\x44\x60\x4b\x93\x78\x09\x9e\xa2\x48\x24\x8e\xf8\x31\x8f\x92\x98\x65\x6a\xe6\x81\xb0\x54\x62\x95\xaa\x41\xb7\xf4\xae\x16\x84\x78\x08\x9a\x41\x30\xc2\x57\x6c\x45\x8b\x44\xdd\x68\x28\x24\x04\x07\xb6\x72\x11\x67\x6a\x45\x66\xff\x79\x98\x91\x10\xc2\xa5\xe5\x59\xb6\x24\xf5\x73\xa5\x7b\xf6\x99\xed\x2e\xc8\x19\x78\x5b\x30\x72\xb9\x20\x61\xc7\x08\xee\xc2\x13\xe9\xd9\x33\xe2\x3d\xab\x81\xa6\xc4\x5b\xf6\x88\xd2\x54\x46\x00\xc0\x9f\xe0\xdc\x5b\x9a\xa2\xe8\x2d\x15\x34\x95\x00\x05\x03\x50\x24\xa1\x24\x63\x8f\x64\x4c\x92\xdf\x7f\x62\x91\x42\x93\x8f\x80\x84\x66\xc1\xb2\xba\x27\xd1\xc7\x4b\x12\x67\xc0\x26\xad\xbb\x0c\xbd\x55\x91\x45\x47\x0e\xf7\x03\x72\x3e\x76\xe2\xbe\xba\x4e\xbc\x42\x9e\xeb\x95\xb2\xdc\x52\xa1\xb9\xd5\x82\xdd\x6c\x19\xd1\x37\x54\x1a\xfc\x9b\xb5\x8c\x2b\x00\x52\xbe\x06\x36\x6b\xe9\x6a\x23\x82\xc3\xda\x63\xcb\xb2\xd6\xc2\xbc\xfa\x81\xdf\xed\x72\x74\x85\x2c\x6a\x17\x6e\xe4\xad\x88\x53\xb8\xe1\x96\xa1\xba\x11\x29\x4b\xbf\x42\xbc\x1b\xe4\x7f\x6f\x67\x0d\x0d\x5a\xd7\x2c\x13\xb0\x18\xf4\x08\x50\x3d\x5b\x0f\xda\x2a\xec\x75\x04\x05\x53\x85\xc8\xc8\xb3\x43\xe0\x6a\xdc\xf6\x27\xc1\x73\x60\xe4\xd2\x5c\x18\xb2\x99\xf8\x06\xb9\x17\x42\xd0\x5d\xd0\xfc\xfc\x89\xe6\xf5\x0f\x34\x17\xcb\x08\xaf\x95\x51\xc5\x05\xac\x73\x81\x32\x6f\x8b\x24\xa1\xf7\x50\x3e\x48\x00\x07\x3d\xb3\xef\xd7\x05\x9e\x34\xc8\x5f\x38\x71\x80\x45\x42\x30\x29\x79\xa1\x2e\x81\xaf\x35\xac\x77\xd5\x12\x2a\x95\x5e\x39\x81\xeb\xbf\x01\x6d\x8d\xd2\x53\xd1\xfe\x42\xa3\x86\x32\xf4\x3e\x4e\x62\x05\x65\x97\x13\xc9\x14\x9c\x63\x6e\x40\x78\x06\x3f\x04\x7b\x00\x4d\x35\x25\x49\x2c\xaf\xfd\xda\x06\xfe\x0d\x5f\x15\x50\x78\x63\x9e\x7d\x4f\xa2\xef\x49\x74\x62\x12\xa9\x7e\xea\x8c\x32\x28\xe2\x99\xa2\x71\x06\xc9\x92\x24\x9a\xdb\x39\xae\x33\xc5\x84\xac\xe8\x8d\x94\xe7\x7a\xe7\xc5\xed\x0d\x1e\x98\x73\x88\xa0\xb7\x82\x3b\xe0\x22\xd8\xde\x14\x30\x1c\xd8\xa6\x09\xf4\xcf\x8a\xbe\x44\xed\xf2\x18\x0e\x4e\xf4\x88\x22\x21\x73\x04\x8c\x1c\x22\x56\x0a\xa6\x0e\x30\x4b\x09\x8e\x0e\xe1\xaf\x26\x63\xce\xe7\x9e\x42\x52\x8d\x39\x0c\x3d\xb9\x88\x80\x82\x9e\x3b\x86\x03\xb7\xdd\xef\x31\xb2\xaf\x18\xc6\x21\xd7\x9e\xd5\x9c\xea\x2f\xda\x08\x83\x3f\xc4\xed\xcc\xd7\x32\xc0\x08\xdd\x64\x00\xf4\x8a\x46\xac\x5d\x7a\xa7\xa0\x7a\xa5\x03\x24\x39\xb7\x83\x3f\x98\xb6\x6d\x6e\xa2\x78\x22\xf1\x89\xcb\x10\xa5\xda\x94\x69\xd9\xe3\x35\xe4\xe9\xd6\x1e\x24\x8f\x03\x61\xac\x96\x58\xb7\xdc\xb8\xd0\xe5\x52\xd6\xc4\xe8\xb1\x18\xb7\x0d\x95\x6c\xd6\x9c\x35\xba\x9a\x7a\xb2\xaa\x9b\x58\x33\xce\x80\x18\x11\x83\x52\x20\x6a\x89\x6e\x70\xcf\xba\xd4\x08\x86\xdd\xf2\x1d\xab\xdf\x2e\x8a\x4f\x1d\x32\xa3\xdf\xef\x05\x67\xee\x66\x70\x80\x5a\xe8\x8e\xd4\xc2\xc5\xec\x4e\x05\x45\xbf\x7a\xb6\x0c\x27\x0c\x73\x90\x09\x90\xcc\xec\x8e\x9b\x0c\xd6\xb9\xcd\xa4\x49\xf6\x2a\x9e\x55\x9e\xd7\xef\x14\x9d\xe6\xe8\x3b\x4e\x18\x6d\x78\x41\xef\x3c\x1f\x0c\x56\x43\x7d\x78\xa5\x87\x7a\xb3\x7e\x01\xe7\xac\xcd\x70\x0f\x07\xac\x63\x78\x84\xa8\xea\xf7\x88\xaa\x74\x88\xf0\x1d\xab\xa7\x0c\x97\x1b\xa1\x49\x89\x00\x64\xb1\xa3\x0a\xb8\xd5\x87\x8f\xda\x80\x86\xb9\x93\x15\x75\xd9\x30\xe1\x35\x3c\xd0\x54\x32\x24\xc2\x3f\x2f\xf9\x72\xa7\xe5\x83\xa6\xf8\x18\xf2\xd9\xa4\xa9\x48\xf5\x22\x49\xf8\xe3\x75\x9a\xab\xdd\x7b\x9c\x43\x50\x03\x64\xd1\x4f\xfd\xfb\xfa\x4b\x0e\x0e\xc9\xaa\x64\x91\x7f\x2d\x48\x16\x27\x64\x4f\xea\x3e\xdb\x4e\x05\x37\xf2\x97\x82\x89\x5d\xcd\x34\xd8\x80\x98\x3d\xe0\x52\x15\x1d\x6d\xb2\x8e\xad\xa5\xd5\xb8\x53\xcd\x13\x0f\x62\xb0\x0a\xb6\xcc\x05\xfd\xe3\x3e\xea\x5e\x35\x64\x6e\xa1\x83\xef\x50\xc7\x5e\xd6\x26\xc6\x90\xfa\xe5\x62\xe0\x74\x0b\x97\x07\x57\x43\x37\x9a\x78\xf5\xd7\x5c\xa4\x14\xda\x93\x30\x79\x69\xff\xf6\x07\x0e\x0e\x8e\xba\xd6\xe0\x7a\x55\x48\xc5\x53\xdb\x68\x08\xd5\x03\xde\xd2\xfd\xc0\x94\xe6\xe6\x4f\x53\x2c\x7a\x5c\x68\x90\x76\x5c\xc5\x20\x3d\x9b\x35\x64\x68\xa4\x81\xba\x78\x4d\xcd\xfb\x96\x13\x7e\xff\xe5\xd5\x58\xb9\x18\xb0\x1e\xfc\x4f\x1b\xea\x44\xd3\x54\x0b\x58\xd7\x23\x47\xcd\xa2\x01\xdf\xcb\xfe\x4c\x87\xc0\x99\xd1\x8f\xaa\x4d\x97\xa9\x39\xac\x38\x89\xda\xbb\x50\xa3\x39\x7c\x9f\x29\xf1\x75\xd1\xff\xbc\x0d\x88\x83\x59\x56\xe8\x4f\x57\x36\x9b\x53\x31\xb5\x90\x7a\xc3\xe8\x92\x89\x2e\x56\x1b\xbd\x36\x05\x2d\x4b\xfb\x1f\x82\x17\x7a\x6d\xa1\xd5\x1c\x6d\x77\x61\x57\xf9\x9b\x5e\xd4\xda\xc1\x4a\x07\x03\xc6\xe3\xb4\xfa\xe6\xe6\x8a\xc7\x41\x44\x1a\x3f\x8e\xc5\xc3\x4c\x0f\xad\x7f\xcf\xc6\xe1\x76\x40\xd5\x03\xcb\x4e\xd9\xfe\xcd\x1d\x1f\xb2\x4c\xf0\xbc\xf6\x96\xa7\xf4\x91\xd5\xb7\xed\x23\x43\xe6\x26\xf6\x91\x21\xf5\x29\x7d\x64\xf5\x35\x7d\x64\xe0\xe0\xe0\xa8\x6b\x4f\xd2\x47\x1c\x57\x99\xd8\x47\x9a\xb4\x1a\xa6\xad\xdb\xf8\x13\xb4\x91\x81\xe7\x53\x26\xac\xd2\x7e\x3b\xb2\xaa\x47\x35\xc8\x95\x3d\x9f\xac\x81\xae\x8d\xcc\xd5\x26\x4e\xda\x59\x1f\xe7\x40\xbd\x62\x85\xdf\x2c\xb8\x42\x88\x19\x52\x7d\x76\x72\x47\xe4\xc3\x47\xa9\x63\x8c\xf4\x03\xd3\x7f\x5c\x90\xad\x0e\x85\x1e\x45\x4f\x79\x95\xb1\x5e\x59\x2c\x60\x82\xa3\x05\xd8\x44\x6a\xcc\xc7\x05\xa1\x79\x0e\x26\xfc\x11\x21\x5d\xcc\x0e\x80\xe9\x62\x78\xd0\x68\xaa\xa0\x6e\x3b\x32\x47\xf2\xa0\xf3\xde\xe5\x30\xdb\x8a\x04\xbd\xae\x81\xb1\x18\xbe\x63\x93\xe5\x23\x68\x37\x00\x77\xd0\x3f\x09\x6d\x77\xe5\xfd\x9b\x39\xf6\x89\xc7\x19\x5b\x0e\x15\x43\x7c\xeb\x0b\x7f\x04\x91\x97\xbb\x0a\xf8\x71\x5a\xcc\xf6\xfb\xf0\x8a\x27\x09\x8b\xf0\xfb\x43\xa5\x51\x96\xb3\x60\xf0\x7d\xa6\x79\x99\xa1\x3a\x45\x27\xcc\x3e\x53\x46\xdf\xa1\x3b\x21\xbb\xc2\xf0\xd4\xf1\xc3\x94\x1f\x7b\x04\xa9\x5b\xe7\x64\xaf\x27\x14\xda\x6f\xe4\x74\xef\xcb\x62\xff\x43\x51\xf5\x1d\xcd\x2a\x7d\xfd\x3a\xfb\x2e\xda\xb0\x94\x62\x66\xf2\x34\x4f\xd8\x97\x9f\xf5\x17\x76\x6b\xbd\x57\x86\x0f\xbf\xaa\x8c\xbd\xe9\x2e\x6c\xff\x87\x64\xf4\xc6\xef\x4c\x70\xab\xcd\x75\xaf\xd4\x43\xb7\xb9\x8c\x3f\x7d\x8a\x72\x80\x67\xf5\x0e\xf3\x04\xe7\x24\x2c\xf3\xc1\x52\x40\xfe\x4f\xfe\x7b\xa0\xca\x85\x0c\x11\x27\x2e\x63\xc5\xde\x57\xff\xc3\x84\x23\xaf\x71\x07\xb5\x30\x70\xc6\xbc\x51\x02\x07\xbc\xd2\xfb\x2b\x00\x00\xff\xff\xa7\x83\x3c\xc3\x25\x1e\x00\x00")
+var _templatesClientParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x59\x6d\x6f\xdb\x36\x10\xfe\xae\x5f\xc1\x79\x59\x21\x05\xae\xb2\x01\xc3\x3e\x64\xf0\x80\x36\x4d\xd7\x0c\x6b\x97\xad\x41\x07\xac\x28\x06\x46\xa2\x6c\xb5\x92\xa8\x90\x54\x52\xcf\xd0\x7f\xdf\x1d\x49\xbd\x5a\x92\xed\xa6\x2b\x0a\xac\x5f\x62\x89\x3c\x1e\x8f\xcf\x3d\xf7\x42\x25\xa7\xc1\x3b\xba\x64\x64\xb3\x21\xfe\xa5\x7d\x2e\x4b\xc7\x39\x39\x21\x57\xab\x58\x92\x28\x4e\x18\xb9\xa3\x92\x2c\x59\xc6\x04\x55\x2c\x24\xd7\x6b\xa2\x56\x8c\xc8\x3b\xba\x5c\x32\x41\x14\xe7\x89\x8f\xf2\xe7\x61\xac\xe2\x6c\x09\x93\xd5\xba\x34\x5e\xae\x14\xc9\x05\xbf\x65\x24\x2a\x94\x56\xb5\x62\x19\x59\xf3\x82\x08\xf6\x50\x14\x59\x47\x53\xb5\x05\x09\x78\x9a\xd2\x2c\x74\x9c\x38\xcd\xb9\x50\xc4\x75\x08\x99\x71\x39\xc3\x9f\x8c\xa9\x93\x95\x52\xb9\x7e\x59\xc6\x6a\x55\x5c\xfb\x20\x7f\xb2\xe4\x0f\x79\xce\x32\x9a\xc7\x27\xa0\x57\xc5\x29\x9b\x90\xc0\x1d\x27\xa6\x99\x10\x5c\xc8\x09\x81\x5b\x9a\xc4\x21\x58\x8a\x22\x81\xd8\x61\xc7\x49\x90\xc4\x2c\x53\x33\x07\x84\xa5\x12\x51\xaa\x46\xcd\xd2\xb3\x5a\x10\xfc\x21\x68\x06\xce\xf0\x9f\xb0\x88\x16\x89\xba\xd0\x50\x48\x70\x0e\x4c\xe5\x22\xce\x54\x44\x66\xdf\xdc\xcc\x88\x0f\xee\xd2\xf2\x2c\x0b\x49\xf5\x6c\xd6\x1e\xbd\x63\xeb\x39\x39\x02\x6b\x0b\x46\x4e\x17\xc4\xef\x28\xc1\x59\x78\x22\x3d\x7d\x56\xbc\xa7\xd5\xd3\x94\x78\xc1\xee\x50\x9a\xca\x00\x00\xf8\x07\x8c\x7b\x41\x53\x14\xbd\xa4\x82\xa6\x12\xa0\x60\x00\x8a\x24\x94\x64\xec\x8e\x4c\x49\xf2\xeb\xb7\x2c\x50\xa8\xf2\x0e\x90\xd0\x2c\x08\xcd\x39\x89\xde\x5e\x92\x38\x03\x36\xe9\xb5\xa1\xef\x44\x45\x16\xec\xd8\xdc\xf5\xc8\xf1\xd4\x8e\x1b\x73\x9c\x38\x42\x9e\xeb\x91\xb2\xbc\xa5\x42\x73\xab\x01\xbb\x9e\xb2\xa2\xcf\xa8\xb4\xf8\xd7\x63\x19\x57\x00\xa4\x7c\x0a\x6c\xd6\xd2\x66\x22\x80\xcd\x9a\x6d\xcb\xb2\x5a\x85\x71\xf5\x33\xbf\x5a\xe7\x68\x0a\x59\x54\x26\x5c\xc8\x4b\x11\xa7\x70\xc2\x5b\x86\xcb\xad\x48\x59\xba\x06\xf1\xae\x93\xbf\xbe\x9d\xd5\x34\x68\x4c\x6b\xa9\x80\x41\xaf\x47\x00\xf3\xdc\x7a\xd0\x5a\x61\xae\x23\x28\x98\x2a\x44\x46\x1e\x6c\x03\x57\xe1\xb6\x39\x08\x9e\x2d\x25\xa7\xf6\xc0\x10\xcd\xc4\xb5\xc8\x3d\x12\x82\xae\xbd\xfa\xf5\x39\xcd\xab\x17\x54\x17\xcb\x00\x8f\x95\x51\xc5\x05\x8c\x73\x81\x32\x2f\x8a\x24\xa1\xd7\x90\x3e\x88\x07\x1b\x3d\x68\x9f\xaf\x0b\x3c\xa9\x91\x9f\x0f\xe2\x00\x83\x84\x60\x50\xf2\x42\x9d\x02\x5f\x2b\x58\xaf\xcc\x10\x2e\x2a\x9d\x72\x0f\xae\xff\x09\xb4\xb5\x8b\xfe\x2b\xda\xcf\x35\x6a\x28\x43\xaf\xe3\x24\x56\x90\x76\x39\x91\x4c\xc1\x3e\xf6\x04\x84\x67\xf0\x22\xd8\x0d\xac\x54\xfb\x04\x49\xcb\x6a\xb7\xd2\x81\xbf\xfe\x93\x02\x12\x6f\xcc\xb3\x2f\x41\xf4\x25\x88\x0e\x0c\x22\xd5\x0f\x9d\x49\x06\x05\x3c\x53\x34\xce\x20\x58\x92\x44\x73\x3b\xc7\x71\xa6\x98\x90\x86\xde\x48\x79\xae\x67\x1e\x5d\x5e\xe0\x86\x39\x07\x0f\x3a\x11\x9c\x01\x07\x41\xf7\xaa\x80\xe6\xa0\xad\x9a\x40\xfd\x34\xf4\x25\x6a\x9d\xc7\xb0\x71\xa2\x5b\x14\x09\x91\x23\xa0\xe5\x10\xb1\x52\xd0\x75\x80\x5a\x4a\xb0\x75\xf0\xff\xb0\x11\x73\x7c\xe2\x28\x24\xd5\x94\xc1\x50\x93\x8b\x00\x28\xe8\x0c\xfb\x70\xe4\xb4\x9b\x0d\x7a\xf6\x09\x43\x3f\xe4\xda\xb2\x8a\x53\xfd\xc1\x36\xc2\x60\x0f\x19\x36\xe6\xbe\x0c\xb0\x42\x17\x19\x00\x1d\xd1\x80\x35\x43\x2f\x15\x64\xaf\x74\x84\x24\xc7\x6d\xe7\x9b\x78\xc1\x90\xd5\x5b\x57\x71\xfb\xfa\xcd\x31\x97\x3e\x0e\xa3\x70\x02\xa0\x8f\xc6\x77\x13\xc4\x95\x68\x59\xd6\x6b\xab\xd8\x6a\x68\xe6\xd4\x2c\xeb\x26\x29\x64\xd9\x80\x2b\x30\xad\x62\x82\x1b\x06\x90\x86\xa1\xac\x18\xd4\xa3\x3b\x4e\x5b\xce\xb5\xe9\x75\x54\xaf\xd5\x1c\x95\x26\xc1\x62\x72\x39\x02\x06\x05\x0c\x72\x86\xa8\x24\xba\x2c\x38\xea\x72\xc8\x1b\x37\xcb\x1d\x18\xfd\x78\xee\xfe\x6c\x7c\x6b\x37\xf2\xa6\x71\xaa\xca\xcb\x16\xbc\xfe\xb0\x4b\x17\x64\x18\xbd\x26\x27\xa3\x5d\x3d\x5d\x96\x3c\x96\x62\x48\x19\x48\x0f\xec\x8a\xdb\x9c\xa0\xb3\x05\x93\x36\x7d\x18\xc7\x9b\xcc\x51\xdd\x52\x3a\xe5\xd6\x1d\xd8\x61\xb2\x84\x7a\xbd\xfd\x5c\x50\x68\xae\x09\xfe\x99\xbe\x26\xd8\xf1\x39\xec\xb3\xb4\xd7\x05\xd8\x60\x19\xc3\x23\xb8\x5f\xdf\x4c\x4c\x32\x12\xfe\x4b\x56\xf5\x2d\x43\x66\xf8\x36\x76\x3c\x90\xc5\x1a\x2d\xe0\x54\xaf\xdf\x68\x05\x1a\xe6\x4e\xf8\x54\x89\xc8\xba\xd7\x12\x46\x73\xc1\xb2\x0d\x7f\x1e\xf3\xd0\x50\xc3\xab\xd3\x99\x65\x69\x9b\x5d\x86\x7d\x8f\x92\x84\xdf\x9d\xa7\xb9\x5a\xbf\xc2\xce\x06\x57\x80\x2c\xda\xa9\xdf\xcf\xdf\xe7\x60\x90\x34\x49\x90\x7c\xb5\x20\x59\x9c\x90\x0d\xa9\x2a\x77\xd3\x67\x5c\xc8\xdf\x0b\x26\x6a\x46\xc2\x04\xf8\xec\x06\x87\x8c\x77\xb4\xca\xca\xb7\xad\x55\xb5\x39\xa6\x43\xb9\x11\xa3\x79\xb5\x61\x2e\xac\xdf\x6d\xa3\xae\x7e\x63\xea\x16\xda\xf9\x03\xcb\xb1\x3a\x36\x81\x31\xb6\xfc\x74\x31\xb2\x7b\x0b\x97\x9b\xa1\x16\xc1\xae\xc4\xa3\x3f\xe5\x22\xa5\x50\xf0\x84\x8d\xcb\xf6\xbb\x3b\xb2\xb1\xb7\xd3\xb4\x1a\xd7\xb3\x42\x2a\x9e\xb6\x95\xfa\x90\x66\xe0\xde\xef\x7a\x36\x87\xd7\x3f\x75\xb2\xe8\x71\xa1\x46\xfa\x66\x18\x05\x40\x7a\x36\xab\xc9\x50\x4b\x03\x75\xf1\x98\x9a\xf7\x0d\x27\xdc\xfe\x75\xd8\x6a\x99\x8f\x68\xf7\x7e\xd4\x8a\x3a\xde\xb4\xd9\x02\xc6\x75\x13\x53\xb1\x68\xc4\xf6\xb2\xdf\x25\xda\xbc\x88\xcd\x24\x55\xab\x2e\x53\x73\x18\x19\x24\x6a\xef\x40\xf5\xca\xf1\xf3\xec\xe3\xdf\x21\xfa\x1f\x37\x0e\x19\x60\x56\xcb\xf5\x87\x2f\xb6\x93\xfb\x62\xda\x42\xea\x19\xa3\x21\x13\x5d\xac\x56\x7a\x6c\x1f\xb4\x5a\xab\xff\x27\x78\xa1\xd5\x2d\xb4\x3a\x25\x7b\x68\xbc\x32\x69\xff\xa4\xd6\x74\x60\xda\x19\xd0\x70\xa7\xe6\x2b\xde\x90\x3f\xb6\x3c\x52\xdb\xb1\xcb\x1f\xb6\x7b\x68\xec\x7b\x30\x0d\xf7\x00\x54\x3d\xb0\xda\x21\xdb\x3f\xf9\xc0\xa7\x31\xeb\x3c\xa7\x39\xe5\x21\x75\x24\xfa\xb8\x75\x24\xba\x5f\x1d\x89\xee\x51\x47\xa2\xfb\xd4\x91\x68\x67\x1d\x89\x3e\x61\x1d\x89\x3e\xb8\x8e\xd4\x61\x35\x4e\xdb\xe8\x53\x95\x91\x91\xe7\x43\x3a\xac\xb2\x7b\x8d\x6a\xa5\x8f\x7e\x67\xef\xf4\x82\x9c\xea\x56\x6f\x28\x12\x40\x0c\x6f\x46\xb6\x4b\xd5\x37\x74\x49\x78\xc6\x6c\x72\x00\xd7\x59\x2d\x2c\x09\xe7\x5a\x94\xc3\x1f\xb8\xce\xdf\xf1\x22\x09\xc9\x35\x23\xa1\xe0\x79\xce\xc2\x0e\xfa\xd5\x17\x74\xff\x39\x7d\x7f\xa1\x58\x2a\x27\xf3\x46\x67\xe2\x57\x1e\x50\x7b\x7b\x9e\x13\x18\xff\xe1\x7b\x37\x61\x99\x3b\x92\x3f\xbc\x39\xf9\x6e\x2f\x67\xe1\x17\x86\xbf\xc1\xdd\x9a\x1b\xba\x37\x1e\x09\xe0\xcd\x07\xe6\xbf\x68\xef\x5c\x66\xfc\xd7\x72\x9f\x69\xc4\xcb\x1e\xa7\x5a\x0d\x79\x13\x59\x67\xab\x38\x69\x2e\x75\xd8\xc7\xeb\x91\x56\xf8\xda\x81\xa1\x10\xc4\x0c\x67\x3e\x44\x0e\x47\xd4\xeb\x37\x52\xc7\x68\x03\xd7\x6d\x07\xae\xbd\xef\xac\xad\xbb\x69\x8b\xd8\xde\xce\x02\x6a\x51\x9b\xb2\x71\x41\x28\xb0\x2d\x0b\xdd\x09\x21\x4d\xaa\x2d\x60\xba\x18\x6e\x35\x0a\x26\x28\x6f\x3b\x32\x3b\xf2\x58\xf7\x82\xbd\xad\xb6\x11\xf1\x7a\x55\x1f\x7d\x31\x7e\xc6\x3a\x4b\x4f\xa0\x5d\x03\xdc\x41\xff\x20\xb4\x87\x2b\xe7\x67\x66\xd8\x5b\x1e\x67\x2c\x1c\x2b\x66\x78\x6b\xf7\x7f\x01\x91\xc7\x6b\x03\xfc\x34\x2d\x66\x9b\x8d\x7f\xc6\x93\x84\x05\x98\x61\xcc\x8a\xb2\x9c\x79\xa3\xf7\xd1\xfa\x32\x3a\x9e\x40\x3f\xe0\xea\x32\x76\x26\x64\x97\xef\x1f\xda\x3e\xda\xf2\xd1\x6e\x21\xab\xdc\xbf\xb7\xd5\x7b\x14\xca\x8f\x64\x74\xef\x5b\x73\xff\x8b\xa0\xf9\xb2\xda\x4a\x7d\xfd\x3a\xf9\x32\x58\xb1\x94\x62\x64\xf2\x34\x4f\xd8\xfb\xdf\xf4\xff\x5c\x5a\xe3\xbd\x32\xba\xfd\xf9\x6c\xea\x4b\xc5\xa2\x6d\xff\x98\x8c\x9e\xf8\x8b\x09\xde\x6a\x53\xba\x47\xea\xa1\x5b\x1f\xc6\xdd\xbf\x0b\x1e\x00\xaf\xa9\xfd\xd5\x13\xec\x83\xb5\x11\x34\x79\xe4\x27\xf2\xed\xd6\x52\x2e\xa4\x8f\x38\x71\x19\x2b\xf6\xca\xd4\x64\xd8\xf2\x1c\x67\x70\x15\x3a\xce\xaa\xb7\x8b\xc0\x00\xa7\x74\xfe\x05\xfc\xe5\x61\xa4\x37\x20\x00\x00")
 
 func templatesClientParameterGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -170,12 +282,12 @@ func templatesClientParameterGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/parameter.gotmpl", size: 7717, mode: os.FileMode(420), modTime: time.Unix(1466470505, 0)}
+	info := bindataFileInfo{name: "templates/client/parameter.gotmpl", size: 8247, mode: os.FileMode(420), modTime: time.Unix(1792242966, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesClientResponseGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xdc\x58\x4b\x73\xdb\xb6\x13\x3f\xff\xf9\x29\xf0\x67\x13\x8f\xe8\x2a\xd4\xf4\xea\x4e\x0e\x79\x38\x89\x0f\x49\x3c\x76\x3a\x3d\x64\x32\x1d\x84\x5c\x49\x6c\x48\x80\x01\x40\x2b\xaa\x46\xdf\xbd\x8b\x07\x49\x90\x04\x65\x27\x3d\xb5\xbe\x98\x02\x16\xfb\xfc\xed\x03\x38\x1c\x48\x0e\xeb\x82\x01\x89\xb3\xb2\x00\xa6\x04\xc8\x9a\x33\x09\x31\x39\x1e\x57\x2b\xf2\x0e\x76\x48\x52\x53\x99\xd1\xb2\xf8\x0b\x48\xfa\x8e\x56\x80\x5b\x24\x13\x40\x15\x48\x42\x49\x78\x7f\x57\xa8\xad\x66\x4d\x9b\x52\x91\x2d\xd0\x1c\x84\x24\x77\xb4\x6c\x40\x46\xeb\x86\x65\xb3\x9c\x17\xb8\x5a\xac\x09\x7c\x25\xe9\x0b\x9e\x03\x79\xf2\x0b\x2e\x66\xfa\xab\x60\x0a\xf7\x80\xe5\xb8\x60\x89\xd2\xdb\x6c\x0b\x15\xed\x7e\x53\xdc\x5b\x78\x27\x93\x96\x22\xbd\x92\xb7\x68\x1a\xad\x90\x74\x79\x38\x20\x8f\x11\x0b\x9f\x60\x27\x0a\x05\x82\x14\x3c\xfd\xdd\x7c\xf9\x42\xed\x47\x42\xce\xc3\x56\x1f\x22\x42\x04\xa8\x46\x30\x72\x16\xa4\xd0\x04\x84\x84\x4c\xfc\x43\x2a\xaa\x1a\xa9\x17\x2e\x88\xb6\x77\xd9\x92\x76\xc2\x05\x65\x1b\x64\xf5\xc6\x79\xb3\x33\xe1\x0d\x95\x2f\x9d\xa7\xcd\xda\x54\xec\x85\x89\x92\x40\x0f\xae\x49\xfc\xf8\xa7\xbb\x98\xa4\xfd\x89\xe5\xd4\xc0\xb0\x7b\x03\xbe\xba\xa6\xfb\x92\xd3\xfc\x82\x58\xa7\x4d\x75\xb6\x1f\xc7\xe8\x18\x45\xab\x80\xd3\xd0\x67\x5b\x8c\x5a\x89\x48\x52\xdb\x42\x92\x8c\x4a\x08\x61\xc7\x41\x27\x8d\x22\xa7\xca\x4b\x90\x99\x28\x6a\x55\x70\x66\x05\x4d\x56\xa0\x94\x30\xe3\x0e\xad\xe1\xb6\xa9\x28\x1b\x84\xc6\xc2\x22\x3a\x5f\x45\x6a\x5f\xc3\x0c\xae\xa5\x12\x4d\xa6\x4c\xa0\x43\x51\xc4\x65\x2f\x90\x1a\xb2\x51\xf4\xb0\x20\x0e\xd5\x37\xbe\x1a\xad\x21\xa3\xf3\x55\xc7\xca\xb2\x0d\xdb\x96\xbe\xe6\x1f\xb4\x09\x2d\x95\x7f\x62\x10\x57\x5c\x72\x11\x24\x5e\x06\x31\xae\xbc\x58\x3f\xc7\x90\x68\x6e\xc9\x78\xe3\x8a\x61\xc4\xd7\x34\x03\x3f\xcd\x5e\xf0\xaa\x2e\xe1\xdb\xfb\xcf\x7f\x02\xba\x69\x74\xc2\xc2\x26\x41\xc1\xe7\x23\xa8\xcd\x12\x6a\x6b\xdc\x72\x67\x94\x3e\x8b\xc1\xc5\x2f\x2f\x47\x6d\xf0\x7c\x73\x8f\xc1\x00\x45\x58\xd5\xcc\xcf\x0d\x28\x0d\x3a\x20\x36\x5e\x26\xe7\xc8\x9a\x0b\xb3\x16\x02\x08\x69\x6b\xa3\x2d\x60\xba\x50\xa5\x37\x90\x41\x71\x07\xa2\x25\x09\xd7\x85\xc4\x48\x5c\x24\x1a\x0f\x7e\x8d\x08\x70\x48\x3d\xf8\x60\xd2\xf4\xd6\x44\x3f\x20\xf5\x52\x08\x2e\x50\x2c\x82\xb6\x60\x1b\x94\xfc\x3f\x27\x78\x5d\xa9\xf4\xd6\xd6\x83\x45\xfc\x11\x4f\x37\x75\x8d\x49\x96\xbe\x05\xb5\xe5\x79\x8b\xa2\x6b\x8a\x79\x78\x3c\x7e\xfa\xf8\x38\xff\xd4\x42\xa7\x4b\x96\x01\xe0\x5c\x38\x1a\xf6\x85\xf1\x1d\x23\xa0\xe5\x92\xd9\x6a\x42\x1e\xff\x7c\xd7\x6d\xc6\xcb\x60\x22\xdd\xe3\x9a\x5e\xa6\x26\x34\xc7\x4e\x94\xaf\x25\xe1\xa9\xc3\x79\x5f\xc3\xa3\x1f\xf3\x29\x02\x33\xbf\x71\x40\x58\xb4\x88\x20\xa2\x61\xaa\xa8\x20\x7d\x61\x9a\x68\xbb\xbf\x44\x50\x31\xd9\x54\xe8\xda\x8e\xc0\x2d\x2c\x35\xd4\x2a\x8a\x10\xc4\xe0\xe8\x70\xdc\xc0\xa6\xc0\xcf\x7d\xd2\x7a\xcf\x62\x79\x52\x2e\x70\x19\x11\xdc\x09\x76\xe5\xf1\x70\x70\xe5\xd4\x9c\xd2\xc6\xa3\x20\xb4\x46\x37\x32\xe3\x8f\x0c\x77\x07\x96\x2c\xb5\x1c\x72\xf1\x94\x58\x07\xf6\xc4\x9d\x51\xe9\x6b\x50\x56\xee\x22\xf6\xe2\x1d\x27\x09\x0a\xd1\x01\xc3\xf3\xff\x7f\x4a\x58\x51\x12\xdb\xd6\x1c\xb8\x8c\xfe\x32\xbd\x62\x58\xb3\x8b\x5c\xe7\xec\xc2\x43\xd3\x92\xc4\x56\x67\x0c\x7c\x3c\xa8\x55\xb8\xf0\x20\xd1\x2e\xcb\x27\xf0\x08\x97\x43\x63\xe0\xc4\x7a\x57\x28\x34\x84\xb4\xb3\xb0\x6e\x35\x52\xf1\xea\x95\x89\x89\xf5\x83\x25\x99\xf7\x9b\x8b\x1f\xda\x25\xa4\xb1\xb0\xeb\xaf\x5f\xb1\xbd\xde\xee\xe8\x66\x03\xc2\x32\x34\xc7\xfe\x6b\x6e\x3d\x5f\x84\xdc\x93\x2e\xce\x07\xd2\x0d\x6b\xe7\xea\x70\x56\xcf\xf1\xbf\x57\x69\xc3\x78\x5a\xf6\x83\x5d\x6e\x3c\xc0\xb4\x4d\x6d\x9a\x50\xb5\xeb\x87\x54\xea\x6a\x6d\x1b\x1c\xd1\x03\x01\xd2\xb9\xbd\x41\xea\xbc\xc5\xda\x53\xca\x6b\x9a\x7d\xa1\x1b\x63\xcf\x6f\xac\x42\x44\x6c\x69\x89\xbb\xba\xf0\xd6\xed\xde\xa8\x8f\x4d\x4e\x8e\x75\x7c\x26\x04\xdd\x1f\x8f\xb7\x65\x91\x41\x67\x5e\x9f\x9d\xcf\x79\xbe\x5f\x24\x7d\x89\xb9\x1f\x3e\x27\x82\xdc\x4e\x01\x4f\x5b\x1b\x47\x09\x32\xd3\xdf\x8f\xf7\xf3\x63\xb0\x5b\x84\x9a\x78\x32\x9a\x8b\x50\x4a\x78\xee\x98\x0d\x51\x6f\x2f\x86\xa2\xf5\x42\x5b\x60\xa7\x7e\xea\x65\x2c\xb0\xbc\xce\x59\x14\x9a\x41\xce\xac\x9e\x61\xf8\x3a\x4b\x93\x5f\x7d\xcf\x9f\x9d\xb5\xbf\x70\x42\xb9\x7c\xff\xea\x44\x28\x46\x93\x72\x3f\x1c\x20\x1f\x7f\x00\xa8\x1d\x4e\x6c\xce\xb7\x98\x31\xe3\xcc\x07\x3d\x39\xaf\x8b\x12\x27\x67\x44\xed\x06\x18\x08\xbc\x9b\xe5\xe4\xf3\xde\xce\x37\xb6\x14\x11\xc5\x79\x99\x6a\xfa\xcb\xbc\x50\x7a\x1e\x50\xdd\xb9\xaa\xd8\x6c\x15\x16\x30\x7e\x87\x23\x50\xa3\x0c\xab\x2d\x30\xb2\xe7\x0d\xaa\xf3\x04\x9b\xd7\x80\x53\x2b\x02\xbd\x5e\xe1\x90\x94\x63\x23\x2d\xaa\x9a\x0b\x74\x2d\xea\x1f\x17\x3c\xd6\xff\x18\xa8\xd5\x56\xa9\x3a\xd6\x23\x70\xbc\xc1\xa1\xbe\xf9\x9c\xe2\x89\xd5\x86\x3f\xe1\x35\x30\x5a\x17\x2b\xd7\x16\xe3\x79\x0a\x2d\xf3\xc4\xb6\xad\x8a\x27\x08\x4c\xb5\x44\x5d\x4f\x90\x74\x4a\x20\x89\xed\xc6\xb3\xca\x98\xdd\x38\x1a\xf4\x66\x77\x93\xba\x32\x1e\x70\x13\xfd\xa0\x17\x84\x0a\x94\x3d\xfb\xe8\x0b\xec\x97\xe4\x91\xb9\xdc\x68\x14\xa7\x03\x26\x7a\xd7\x0d\x58\x3e\x3f\x47\x3e\xe2\x9a\x18\x28\x04\x8b\xe9\x8d\x9d\x11\x0a\x7d\x5b\x77\xdf\xde\x9c\x3b\x7b\xc9\x69\x04\xa4\x27\xae\x42\x8e\x93\x77\x21\x9a\x99\x68\xfa\x5b\xaa\x4d\x29\x84\x5e\x3b\x20\x59\x23\xdc\x85\x3b\x70\xe3\x8e\x2c\xc0\x6f\xbc\x99\xcb\x0c\x60\xda\x12\x09\xe2\x4e\x0f\x56\xed\x3a\x3a\x88\x1b\x9b\x04\x64\x05\xdc\x41\x1e\x2c\x4c\xdf\x3d\xf1\x59\x33\x93\x81\x0e\xff\x60\xee\xc3\x5b\x54\xd7\x56\x0e\x76\x98\xe0\xb8\xa8\xdd\x27\xf1\xde\x9b\x6d\xfb\xbe\xe7\xee\x0c\x87\x93\x80\x69\x45\xba\xd1\x90\xd8\x1b\x74\x0f\x9e\x0b\xb3\xa8\x8b\x8a\xd4\xb7\x69\x3c\x32\x7e\x78\xb1\xcc\x46\xcf\x2f\x6e\x71\xf4\x00\x30\x58\xf5\x9f\x01\xb4\xbc\x89\xab\x77\x73\xaf\x27\x4e\xa5\xbe\x78\x5b\xe5\xd2\xe0\x68\xdd\xfb\xd2\x94\xf0\xa9\x18\x87\xba\x61\x01\x3e\x38\x19\x7e\x35\x5d\xba\xc2\xab\xff\x8e\xd1\x60\x77\x60\x1e\xda\xd5\x64\x19\x48\xed\x52\xab\xd9\x52\x1f\x6f\x5f\x14\x0c\x27\xbb\xee\x8f\x1f\xfe\x9b\x92\xab\x08\x1e\xca\x71\xc3\xbc\x6a\x04\xb6\x8c\x26\xee\xb5\xe3\xfe\x68\x75\x71\x1a\xc1\xe4\xc1\x2f\x36\x33\x5e\xfc\xd7\x05\xeb\xfb\xc3\xe4\x19\x67\x49\xc2\x9e\xef\x35\x68\x73\x17\x63\xf0\xec\xfa\xca\x5e\xa6\xe3\xc1\x1d\xd7\x9b\xad\x97\xe3\xc4\x4d\xfc\x8a\x6f\x2a\xd9\x03\xb3\x18\xc9\x14\xe0\x4c\xa2\x1b\xec\xe4\x55\xb6\x2f\xfe\x3d\xfb\x59\xd4\x9d\x66\x35\x73\xa0\x7d\x6f\xe8\x1b\xdc\xe5\x37\x25\xa8\x45\x92\x51\x30\xf4\x90\xe7\xfa\x54\x2f\x2d\xe7\x99\x7d\x76\x40\x41\xfa\x90\x9b\x1d\x2e\x2a\x3d\xf5\x12\x6f\x90\xd7\x0f\x6e\x83\x93\xd2\x48\x72\xc7\x7a\x85\xfe\x0e\x00\x00\xff\xff\xd9\xad\xda\x75\xab\x16\x00\x00")
+var _templatesClientResponseGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xdd\x5a\xdd\x73\xe3\xb6\x11\x7f\x2e\xff\x0a\x94\xcd\x5d\x48\x47\xa6\x92\x57\xb5\xce\xcc\xdd\xc5\x49\x9c\x99\x24\x1e\xfb\x3a\x7d\x70\x3d\x1d\x9a\x84\x24\xe4\x28\x52\x01\x48\xeb\x54\x8d\xfe\xf7\xee\x62\x41\x02\x24\x41\xf9\x23\x7d\x69\xef\xe5\x28\x7c\xec\x62\xbf\x7f\x0b\xf8\x70\x60\x39\x5f\x8a\x92\xb3\x30\x2b\x04\x2f\x6b\xc9\xd5\xb6\x2a\x15\x0f\xd9\xf1\x38\x9f\xb3\x5f\xf8\xee\x70\x60\xdb\x54\x65\x69\x21\xfe\xcd\x59\xf2\x4b\xba\xe1\x30\xc5\x32\xc9\xd3\x9a\x2b\x96\x32\xff\xfc\x4e\xd4\x6b\x24\x9d\x36\x45\xcd\xd6\x3c\xcd\xb9\x54\xec\x31\x2d\x1a\xae\x82\x65\x53\x66\x93\x94\x23\x18\x15\x4b\xc6\x7f\x67\xc9\x87\x2a\xe7\xec\xfc\x1b\x18\xcc\xf0\x4b\x94\x35\xcc\xf1\x32\x87\x01\x5a\x94\xdc\x66\x6b\xbe\x49\xbb\xdf\x29\xcc\x45\xce\xce\xb8\x5d\x91\x5c\xa9\x5b\x10\x2d\xdd\xc0\xd2\xd9\xe1\x00\x34\x06\x24\xdc\x05\x3b\x29\x6a\x2e\x99\xa8\x92\x7f\xe8\x2f\x97\x29\x7d\xc4\xec\xcc\x2f\xf5\x21\x60\x4c\xf2\xba\x91\x25\x7b\xeb\x5d\x81\x0b\x18\xf3\x89\xf8\x2f\x55\xa7\x75\xa3\x70\x60\xc1\x50\xde\x59\xbb\xb4\x63\x2e\xd3\x72\x05\xa4\x7e\x34\xda\xec\x44\xf8\x31\x55\xdf\x19\x4d\xeb\xb1\x31\xdb\x85\xb6\x92\x04\x0d\x2e\x59\xf8\xe6\x2f\x8f\x21\x4b\xec\x8e\xd9\x58\x40\xbf\x7a\x3d\xba\xba\x4e\xf7\x45\x95\xe6\x0b\x46\x4a\x1b\x9f\x99\x3e\x8e\xc1\x31\x08\xe6\x1e\xa5\x81\xce\xd6\x60\xb5\x02\x3c\xa9\x5e\x0b\xc5\xb2\x54\x71\x9f\xef\x18\xd7\x49\x82\xc0\x1c\xe5\x3b\xae\x32\x29\xb6\xb5\xa8\x4a\x62\x34\x1a\xe1\x85\xe2\x13\xea\xc0\x13\xae\x9b\x4d\x5a\xf6\x4c\x43\x6e\x11\x9c\xcd\x83\x7a\xbf\xe5\x13\x7e\xad\x6a\xd9\x64\xb5\x36\xb4\xcf\x8a\x30\xec\x18\x12\x5d\x36\x08\x9e\x67\xc4\xfe\xf1\xb5\xae\x06\x63\x40\xe8\x6c\xde\x91\x22\xb2\x7e\xd9\x92\x1f\xaa\x8f\x28\x42\xbb\xca\xdd\x61\xc2\xa4\xb5\x6d\x54\x56\x35\x03\x8b\x5e\x3e\x42\xf0\x93\x59\x63\x5a\x6b\x4c\xeb\xec\xa1\xb5\x9d\x13\xbc\x07\x5b\x21\x9b\x78\x38\x71\x55\x82\x2b\x2c\xd3\x8c\xbb\xf1\xf7\xa1\xda\x6c\x0b\xfe\xf9\xd7\x87\xdf\x38\xe8\x6f\xb0\xc3\x32\x3e\x1b\xf8\xe0\xe4\x42\x14\xd3\x0c\x77\xd2\xe2\x5e\xb0\x3a\x7c\x39\xc1\x4b\x56\x75\xf5\x70\xf4\x5a\x2e\x80\x74\xa7\x7f\xae\x78\x8d\xde\xc8\x19\x19\x52\x07\x23\x5b\x56\x52\x8f\xf9\x3c\x87\xb5\x49\x93\x32\x1b\x66\xb0\xe4\x86\x67\x5c\x3c\x72\xd9\x2e\xf1\x27\x8c\x58\x73\x8c\x62\x74\x14\x37\x79\x78\x28\x24\x8e\x5f\x41\x34\x59\x69\x82\x57\x70\xbd\x94\xb2\x92\xc0\x16\xbc\x59\x94\x2b\xe0\xfc\x27\xc3\x78\xb9\xa9\x93\x5b\x4a\x14\x51\x78\x07\xbb\x9b\xed\x16\xa2\x2f\xf9\x99\xd7\xeb\x2a\x6f\xdd\xeb\x3a\x85\x00\x3d\x1e\xef\xef\xde\xe4\xf7\xc6\x3f\x6c\x14\xf5\x3c\xd1\x98\xa3\x29\x3f\x95\xd5\xae\x64\x1c\xf9\xb2\x81\x89\x9f\xe1\x8e\xec\xcd\x57\x8f\xdd\xae\x70\xe6\x0d\xbd\x27\x74\x66\x0f\x83\x0b\xf5\xb6\x51\xc2\x7b\xc6\x49\x66\xac\x4a\x4c\x64\xd8\x72\x10\xbc\xce\x0a\x40\x33\xbf\x31\xae\x13\xb5\x3e\xc4\x64\x53\xd6\x62\xc3\x93\x0f\xba\x1e\xb7\xf3\x33\x70\xc3\x52\x35\x1b\x30\x46\xb7\xc0\x0c\xcc\xd0\x39\x37\x29\x38\x2d\x98\x13\x0d\x78\xc3\x57\x02\x3e\xf7\x71\xab\x6f\xf2\xfe\x51\xe6\x81\x61\xf0\xf9\x8e\xb1\xc9\xb4\x87\x83\xc9\xcc\x7a\x17\x9a\x16\x18\x81\x34\x58\x13\xb5\xa2\x32\x98\xed\x49\x32\x43\x3e\x6c\x71\xc1\x48\xb3\x76\x71\x27\x54\xf2\x03\xaf\x89\x6f\x14\x3a\x1e\x12\xc6\x31\x30\x41\x4b\xc2\xfe\x3f\x5f\xb0\x52\x14\x8c\x2a\xa4\x71\x47\x7d\x7e\x95\x5c\x95\x90\xfe\x45\x8e\x51\x1e\x39\xfe\x37\x63\x21\x9d\x19\x3c\x22\xec\xa5\x3d\x18\x78\x16\x6b\x93\x17\x46\x7e\xe3\xcf\xac\x5a\xc0\x91\xf4\x26\xb5\xa0\x6f\xa1\xb2\x20\xd3\x35\xaa\xae\x36\xdf\x6b\x9b\x90\x1e\x68\xc9\xb4\xde\x8c\xfd\x40\x2e\xa9\xb4\x84\x5d\xa9\xfe\x1d\x2a\xf5\xed\x2e\x5d\xad\xb8\x24\x82\x7a\xdb\xff\x9b\x5a\xcf\x22\x9f\x7a\x92\xe8\xac\xc7\x5d\x93\x36\xaa\xf6\x87\xfb\x14\xfd\x27\x0f\xad\x09\x8f\x0b\xc5\xcb\x0a\xe6\x10\x24\xb5\xf5\x71\x1c\x69\x5b\x53\x5a\x53\x85\x89\x9f\x6a\x25\x43\xd0\x01\xeb\xcc\x5c\x2f\xa6\x7e\x86\x6c\x55\xa8\xeb\x34\xfb\x94\xae\xb4\xa0\x7f\x2f\x37\xe0\x2a\xeb\xb4\x80\x59\xcc\xe1\xdb\x76\x6e\x50\x12\x47\x3b\x87\x67\x7c\x27\x65\xba\x3f\x1e\x6f\x0b\x91\xf1\x4e\x6e\x1b\xb6\xef\xab\x7c\x1f\xc5\x36\xf7\x3c\xed\x57\x27\xac\xdf\x02\x8a\x8b\x56\xc6\x41\xe4\x4c\x40\x85\xe3\xd3\xf4\x4a\xbe\x8b\x7c\x78\x20\x1e\x60\x2f\xe0\xe2\x87\x30\x93\x26\xb2\xf2\x82\x29\x5a\x2d\xb4\x99\x77\xac\x27\xcb\x23\x82\xbc\x3b\x25\x91\x0f\xce\xbc\xa5\x73\xfa\xfd\xda\x48\x1a\xff\xd5\xd5\xfc\xdb\xb7\xed\x2f\x00\x3b\x97\xbf\x7e\x7f\xc2\x14\x03\x34\x6e\x71\x06\xd0\x71\xb1\xc4\x61\xd8\x0d\x6a\x27\x47\x05\x85\xfd\x1a\xe9\x87\x73\x63\x48\xd8\xc3\x82\x03\x70\xd7\x87\x6d\x27\xf8\xdf\x50\x32\xc2\x83\x83\x85\xbc\x31\xee\xac\xd3\x55\xd5\xe0\x37\x2e\x41\x89\xe7\x0a\xe6\x18\xc7\x15\x8a\x55\xcb\x69\x14\x57\x01\xd2\x49\x11\x6b\x9f\xc0\xff\x2e\x23\xa7\x15\x80\x65\x65\x09\x43\x67\x0f\xcd\x12\xac\x71\x4b\x3f\x03\x02\x95\x53\x9d\xae\x4b\xeb\x89\x76\x7a\x28\x1f\x82\x36\x94\xc3\x48\xb5\x94\xd5\x86\xc9\xd3\x7d\xb5\x43\x22\xd2\xcd\x2d\x7d\x4f\xb5\xb2\x2e\xc7\x27\xbb\x5a\x67\xf1\xc1\xa8\x62\xc1\x48\x15\x70\x1c\xa3\x8d\x48\xc6\xc7\x4e\x23\x9f\x6b\x43\x91\x2c\x55\xe2\x80\x16\xa6\xb5\x90\xd2\x91\x01\x68\x4b\xb6\xee\x5d\x95\x98\x24\xbb\x29\x74\x19\x65\xd2\x99\x93\x8b\xbb\xe2\x81\x7c\x2e\x49\x3d\xbb\xb5\xc8\xd6\x2c\x95\x5c\x47\x66\x09\x0b\x74\x57\xe3\xdb\xa6\x57\xa9\x4f\x02\x50\xaf\xc5\x77\x06\xda\xc9\xa7\x55\x15\x6b\xd1\x00\x58\x47\x24\x0c\xac\xaf\x39\x04\x0a\xd8\xd6\x13\x52\xbd\x03\xe8\xef\x0e\x14\xe8\x0a\x1d\x3b\x6d\xa6\xef\xb0\x8f\xa9\xd4\xd2\x18\x18\xef\xc6\x3a\x4e\xe5\x69\x9d\xb2\xbb\xfb\xbb\xfb\x87\x7d\x8d\x95\x05\x5b\x18\x99\x18\xfb\x68\x1f\x8d\x62\x93\x34\x0a\x8c\x39\xc8\x70\xb8\x52\x25\x1f\xa5\xd8\xdc\x36\xcb\xa5\xf8\x1c\xd9\xf5\xef\x71\x0a\x73\x1c\xd1\x8b\xc2\x7f\x4a\x2a\xf6\x3a\x43\x16\xbc\x8c\x90\x48\xcc\x2e\x2e\xd8\xd7\x86\x6a\x37\x83\x27\x89\xd9\xb7\xec\xeb\x69\x59\x30\x9d\x69\x59\x2e\x2e\xd8\x10\x00\x79\x96\x77\x82\x76\xac\xb4\xf0\xfa\xe6\xc0\xab\xd6\x3f\x9e\xbc\xc8\xa2\xb6\xd8\xf4\xaa\x0c\xfd\x43\xcb\x5d\xb0\xdf\x54\x55\xda\x0a\x1d\x91\x4e\x7f\xaa\x04\xe9\xc1\x51\x60\x09\x0a\x9c\x91\xdb\xc7\xb6\x3d\x79\x15\x91\xb7\x96\xca\x28\xd7\xd3\x3f\x0a\x37\xf3\xb3\x1d\x86\x18\x49\xa1\x56\x6c\xb6\xf5\x9e\x7c\x20\x17\x6a\x9b\xd6\x50\x9e\x94\xcd\x2f\x33\x6c\x84\x25\x44\x26\x24\x53\xd2\x70\x17\xb2\x55\xc9\x83\x4e\xf9\x13\x96\x45\xab\xce\xac\x67\x6a\xaf\xbc\x38\xb1\x3e\x0c\x9d\xd5\x58\xa0\x88\x01\x14\xdf\x5a\x94\x0d\xf1\xa3\xf3\x2f\x05\x2f\x00\x28\xe9\xab\x21\xf4\x5e\x94\xa0\xd3\x0c\xec\xec\xbc\x53\x58\xdf\xbe\x2a\x73\xfe\x19\x7d\x39\xa2\xd5\x5f\x2e\xbe\x84\xb2\x2a\xd8\xb7\xae\xdb\xf6\x08\x13\xdd\xbb\x85\xb8\x9f\x39\xf1\x71\x2d\x39\xc6\x87\x9e\x12\x5f\x7d\xb3\xb8\xb7\x26\x61\x6d\x5c\xd0\x29\x41\xc7\x59\xb5\xd9\xe8\x4c\x84\xfe\x87\xca\x13\xb9\xfe\x04\x9b\xc8\x3d\x71\x53\x3a\xf3\x88\x55\x59\x49\x9e\xeb\x7d\x0a\x54\x0d\x69\x8b\x62\x3b\xd2\x8b\xda\x70\x9d\x56\x9e\xbe\x3f\x0b\xb5\xd9\xc2\x85\x91\x86\xc2\xaa\x25\xa4\x85\x8a\xfb\xf7\x74\xb4\x0b\x2d\xd3\x6d\x32\x66\x4a\x21\x11\x96\xb9\xf1\x39\xf3\xc3\x51\xb0\xd1\x51\x92\x24\x8e\xc8\x47\x0b\x9a\x2e\x9c\x84\x73\x29\x65\xd4\x47\x30\x2e\x60\x31\xfb\x68\x13\x65\xfc\xae\xea\x98\xaa\x01\x75\x3c\xff\x58\xe9\xff\x94\x5b\xfd\xea\x4a\xff\xca\xd6\xc8\xa7\x40\x50\x0d\x3f\xf7\xa0\x4f\x09\x18\x0a\xb0\x6b\x51\x29\xac\x97\xa2\xf6\x96\x90\x97\x24\x77\x3a\x41\x64\xf8\x22\xc3\xbf\x9d\xbf\x26\xc7\xbb\x8d\x39\x00\x1e\xac\xff\x70\x48\x6e\x08\xc7\x26\x57\x93\x7a\x4c\x08\x1a\x08\x2a\x13\x2a\x30\xad\x67\x6b\x7d\x0d\x10\x60\x0f\xdd\x59\x3f\xf4\xe1\xf6\x01\x5c\x6c\x97\x1a\x01\x41\x38\xfd\xa5\x6d\xe3\xc0\xc4\xad\x69\x27\xa8\x67\x6c\x5b\x0b\x6d\xa3\x8f\x78\x89\xbb\x14\x05\x67\x3b\xb0\xc3\x8a\x97\x08\xa9\xa0\xdc\x3e\xec\x49\xef\xd4\xca\x82\xc9\xaa\x82\x2a\x74\x2e\x6a\x02\x33\xed\xbe\x8d\x58\xad\x6b\xc8\xff\x90\x6d\xd8\xb2\xa9\x35\xa9\x35\x2f\xd9\xbe\x6a\xe0\xb0\xe7\xb2\x29\x7b\x94\x5a\x16\x3a\xc6\x20\xa6\x82\x20\x10\x9b\x6d\x05\xe9\x2a\x82\x63\x87\xbc\xcc\x2a\x44\x4b\x73\xcc\xa7\x21\x8e\x88\xca\xfc\x37\x17\x15\x92\xd7\xbf\x4a\x5e\xcf\xd7\x75\xbd\x0d\xf1\xb6\x36\x5c\x41\x8e\x6b\x1e\x12\xa0\x38\x5f\x55\xe7\x00\x0b\xcb\x74\x2b\xe6\xe6\xda\x25\x9c\x5e\x81\x67\x3a\x31\x4d\x5d\xf7\x89\x05\xba\x1b\x07\x59\xc2\x67\x1c\x02\x41\xa7\xbe\xed\x99\x3c\x8c\x9e\x0d\x83\xde\xdd\x8f\xb9\xf4\xbf\xd2\x1a\x32\x97\xcf\xbd\x52\xeb\x6b\x80\x69\xef\x17\x9f\xf8\x7e\xc6\xbe\xe8\x92\x6d\xd2\x23\x82\xb3\xe6\xca\xcf\xa5\x67\x96\x0f\xa8\xc6\xc1\x24\x90\x37\x88\x53\x20\x12\x36\xdf\xce\xcd\xeb\xe4\x7d\x7c\x23\x79\x72\x02\xb5\x8f\x01\xfb\xc4\x8d\x99\x7d\x50\xa1\x88\x05\xcf\x69\x2f\xe0\x48\x08\xf3\x36\xe4\x7f\x1c\x1a\xe4\x64\xda\x61\x62\xe9\x0f\x64\x0b\xe7\xe2\x5a\x2b\xee\xc6\xb9\x36\x34\xdd\x4e\x6a\x7a\x1d\xdb\xbf\x82\x0d\x28\x2d\x4a\x9e\x09\x38\x43\xee\x6d\xa1\x5f\x7c\x69\xd9\x66\xc2\x9b\xff\xce\xd5\x25\x40\xad\xee\x02\xe4\x40\xd0\xb7\x85\xbd\xa6\xfc\x75\x2d\xb6\xb9\x28\x3f\x9c\xf4\xc9\x96\xa5\x6a\x91\x8f\xae\x6c\xd6\x3f\x17\x5d\xd6\x53\xf8\xb6\x04\x5b\x86\xed\x12\x11\x1b\x3c\x46\x9a\xc1\xc1\x73\x58\x6f\xd4\x7d\x14\x43\x7e\x23\x55\xef\xa6\xde\x12\x2d\x54\x6e\x73\xbc\x3e\x5c\xe2\xbd\x1d\xb6\xba\xd4\x20\x69\xcc\xc6\x38\xb6\xaf\xd0\xf6\x2b\xc3\xac\xcb\xf9\x16\x0e\xf6\xe4\xea\x5d\x71\xe1\x93\xca\xd2\xcf\xd1\x38\xf8\x88\x95\x15\x68\xa4\x63\x6f\x4f\xda\xbf\x4b\x89\x13\x53\x6c\x4f\xf0\x9c\x12\x72\x4a\x4c\x2b\xa8\x23\x30\x59\xc1\xbe\xbb\xb8\x0a\xb8\x6d\xb2\x8c\x2b\xa5\xe7\xd1\x26\x33\xa4\xd8\xbe\x2c\x6a\xe2\x34\xee\xde\x1d\xba\x6f\xcb\x26\xdd\x3a\x29\xa4\xeb\x51\xc6\x53\xfa\x48\xe6\xd5\xf3\x69\x3f\xed\x3c\x74\x10\x20\xcf\x7e\xb9\x9d\xf0\x9f\xff\x05\x37\xed\x19\xeb\xe5\x66\x72\x84\xa3\x25\x3d\xcd\xf7\x4d\xa7\x1f\xca\x6c\x1b\x45\xfd\x52\x03\x3d\x44\x56\x00\x5e\xcf\x9d\x7c\xab\x80\x02\xbe\x14\xea\x5b\x5d\x7a\xbc\xb5\x14\x6c\xb3\x48\x60\x46\x40\xc0\x3c\x80\x9f\xe3\x36\x44\x26\x33\x4a\xd5\xe9\xae\x1b\xfd\xc4\xb7\x80\x57\x61\x54\xee\x84\x6a\x9b\x2c\x9c\xec\xa0\x20\xc1\x17\x7d\x8d\xf3\xae\x28\x46\xf1\xd3\xb7\xd7\x0b\x35\xdc\x5e\x50\x2f\x6c\xbb\xeb\x17\x67\xec\x16\xc3\xc6\x55\x1f\x79\xeb\xbb\xc0\xf4\x1f\xa6\x2d\x11\xe0\xf0\xef\xae\xaf\xe8\xa1\x32\xec\xbd\x1f\x42\x7f\x48\x54\x07\xae\x1f\xfb\xdd\xe4\xf9\x54\xbb\xab\xf7\x21\x61\xdb\x9c\xbf\x96\xb4\x0d\x0c\x1f\xed\x51\xcb\xae\x4b\xfd\x33\xcb\x9c\x07\x51\xd8\x3f\xe2\xb1\x00\xcc\x92\x9f\x4c\x4e\xa7\x49\x4d\x6c\xe8\x13\xed\x97\x8c\x29\xb0\xd3\xde\xe8\x26\x3d\xb1\xad\xc0\xc9\xe5\xe7\x5a\xa6\x94\xb3\xb4\x8c\xf3\xb3\xc9\xbf\x75\xb0\x0c\xf2\x2a\xa3\x1e\x97\x08\x07\xa6\x45\x58\x6c\xf0\x0d\x84\x39\xef\x3d\xf8\x27\x1e\xbd\x9d\x4a\x73\x32\xdb\xec\x81\xfe\x03\x9c\x5b\x13\x73\x1d\x25\x00\x00")
 
 func templatesClientResponseGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -190,12 +302,112 @@ func templatesClientResponseGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/response.gotmpl", size: 5803, mode: os.FileMode(420), modTime: time.Unix(1466470505, 0)}
+	info := bindataFileInfo{name: "templates/client/response.gotmpl", size: 9501, mode: os.FileMode(420), modTime: time.Unix(1792217140, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesDocstringGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xaa\xae\x4e\x49\x4d\xcb\xcc\x4b\x55\x50\x4a\xc9\x4f\x2e\x2e\x29\xca\xcc\x4b\x57\xaa\xad\xad\xae\x56\xc8\x4c\x53\xd0\x0b\xc9\x2c\xc9\x49\x55\x00\x73\x91\xd9\x20\x29\x97\xd4\xe2\xe4\xa2\xcc\x82\x92\xcc\xfc\x3c\xa0\x20\x17\x17\x48\x09\xaa\x18\x50\x24\x35\x2f\x05\xca\xc8\x29\x4e\x45\xd7\x06\x31\x16\x53\x0f\x48\x29\x98\x95\x51\x9a\x9b\x98\x97\x59\x95\xaa\xa0\xe7\x97\x98\x9b\x8a\x6c\x22\xd0\x36\x20\x03\x48\x03\x02\x00\x00\xff\xff\x32\x9e\xda\x0e\xbe\x00\x00\x00")
+var _templatesConformanceGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9d\x55\xdd\x6f\xd3\x30\x10\x7f\xcf\x5f\x71\x8b\xb4\x91\xa0\x34\x7d\x1f\xda\x13\x0c\x24\xa4\x0d\x24\x0a\x2f\x08\x21\xd7\xb9\x24\xde\x12\x3b\xd8\x4e\xbb\x52\xf5\x7f\xe7\x6c\x27\xe9\xda\x7d\x30\xed\xad\xb6\x2f\xbf\xaf\xf3\xb9\x1d\xe3\xb7\xac\x42\xd8\x6e\x21\xff\x3a\xfc\xde\xed\xa2\x68\x3e\x87\x45\x2d\x0c\x94\xa2\x41\x58\x33\x03\x15\x4a\xd4\xcc\x62\x01\xcb\x0d\xd8\x1a\xc1\xac\x59\x55\xa1\x06\xab\x54\x93\xbb\xfa\xcb\x42\x58\x21\x2b\x3a\x1c\xbf\x6b\x45\x55\x5b\xe8\xb4\x5a\x21\x94\xbd\xf5\x50\x35\x4a\xd8\xa8\x1e\x34\xce\x74\x2f\x0f\x90\x46\x0a\xe0\xaa\x6d\x99\x2c\xa2\x48\xb4\x9d\xd2\x16\x92\x08\x20\x46\xc9\x55\x41\xf8\xf3\x1b\xa3\x64\xec\x76\x2c\x1a\x47\x18\x47\xb4\x30\x56\x97\xad\x85\xb8\x12\xb6\xee\x97\x39\x21\xcc\x2b\x35\x53\x1d\x4a\xd6\x89\x79\x38\x8d\xa3\x34\x18\xa3\xef\xc8\x6f\xc7\x0c\x67\x8d\xf8\x8b\x90\x7f\x52\x8b\x4d\xe7\x8c\xbf\x57\xb2\x54\x9a\xc8\x39\xa9\xa8\x91\xdf\x1a\x52\xc8\xac\x8f\x67\x2a\x02\xad\x7a\x59\xcc\xac\x16\x9d\xf1\x06\xf0\x8e\xb5\x1d\x99\x53\xa5\x5f\xba\xe2\x6b\xd6\xfa\x52\x43\x28\x2d\xa3\x1d\x51\x42\x7e\xd5\x5b\x66\x85\x92\xc6\x45\x4c\x42\xc8\x23\xe5\x70\x83\xdc\x06\x1c\x5e\x33\x59\x21\xfd\x56\x81\x75\xc4\x5d\xd7\x82\xd7\xb0\xd4\xc8\x6e\x43\x1d\x41\x58\xcd\x84\xa4\xef\x06\xce\x89\x07\x09\x93\xd0\xcb\x5e\xf2\x17\x1a\x4d\x2c\xbc\x1d\xa2\xcc\x17\x29\x6c\x29\xcd\x91\xf8\xfc\x02\x7e\xfe\x5a\x6e\x2c\x26\x0e\x46\x13\x61\x09\xf1\xe9\x9f\x18\xf2\xcb\xa1\x62\xb7\x4b\x5d\xfc\x2b\xa6\xa1\x3d\x4c\x89\x76\xc9\x33\x6a\xed\x50\x5c\xcf\xf2\xef\xb2\x65\xda\xd4\xac\x49\x06\xfc\x0c\xce\xda\xf4\x9d\xaf\x39\xb9\x00\x29\x1a\x4f\x0e\x60\xf3\x8f\xcc\xb2\xa6\x4c\xe2\xa3\x74\xef\x27\x5b\x28\x34\xf2\x8d\x85\x7e\x44\x3d\x87\xd3\x55\x9c\x39\xb4\x94\x50\x0e\xf9\xdb\xfc\x07\x25\x50\xd0\xe5\x4a\xc2\x5d\xc8\x3f\x60\xc9\xfa\xc6\xbe\x9a\x9e\xee\xb8\x90\x2b\x07\xfa\x90\x57\xb3\x75\x76\xe0\xfc\x6a\xf0\xdd\xa6\x7b\x55\xaf\x77\xfc\xa4\x5f\xd7\x05\xbc\xeb\xe8\x3e\x61\x91\x01\xe3\xb6\x67\x0d\xa9\xb4\xa8\x4b\xc6\x71\xfb\xc2\x96\x8c\x08\xcf\x44\x93\x3c\x1a\xf2\x11\xa2\x4f\xe1\x2c\xa8\x78\x39\xd6\x09\x0f\x37\xd3\x2c\xd4\x70\xc7\x92\x00\x91\x4d\xd6\xd2\x09\xe0\x52\x6b\xa5\x5f\x16\xda\x7e\x66\x33\xa8\x94\x85\x53\x43\xd9\x91\xc4\x40\xbd\xdd\xce\x1e\x4e\x28\x9d\xb4\xd3\xda\x8f\x02\xdd\x9d\x9e\xdb\x81\xde\x33\xd0\x0e\xcd\x8d\x5f\x7f\xfe\xf6\xe5\x7a\xbf\xde\x6d\x9d\x0c\xed\x06\xfa\x08\xd6\xd5\xfa\xc3\x83\x81\x1a\xf4\x66\x0f\x0e\x3c\x2c\x19\xd9\x65\xfb\xe9\x0e\x69\x51\x4c\xf0\x3b\x9b\x34\x3a\x89\x81\x6f\xaf\x3a\x28\x7d\x7c\x3a\x9f\x69\xdd\x30\xf4\x23\x8e\xd7\x90\x3e\x35\xad\x00\xf4\x94\x85\x67\x8c\xfe\x1d\xfc\x03\x3f\xcd\xe4\x98\x0d\xb8\x37\x8b\x5e\x98\x1e\xfd\xf2\x98\xfe\x7f\xe3\x79\x71\xc8\xb7\x6f\xfc\xfd\x4e\x33\xce\xb1\xb3\x87\x2f\xb2\xef\xf2\x64\xc3\x55\xa6\x93\x80\xd0\xf5\x21\xd1\x5d\xf4\x0f\xa5\x85\xe2\x05\x0a\x07\x00\x00")
+
+func templatesConformanceGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesConformanceGotmpl,
+		"templates/conformance.gotmpl",
+	)
+}
+
+func templatesConformanceGotmpl() (*asset, error) {
+	bytes, err := templatesConformanceGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/conformance.gotmpl", size: 1802, mode: os.FileMode(420), modTime: time.Unix(1792220799, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesConformancehelpersGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa5\x54\xc1\x8e\xda\x30\x10\xbd\xe7\x2b\x66\x39\xac\x88\x14\xc2\x1d\x89\xdb\x72\xe9\xa1\xad\x54\x6e\x88\x83\x71\xc6\x89\x85\x63\xa7\xce\x04\x16\xb1\xf9\xf7\x8e\x63\x42\xd9\x2d\xdb\xee\xaa\x97\xc4\xf1\xcc\x7b\x33\xef\x8d\x9d\x46\xc8\xbd\x28\x11\xce\x67\xc8\xbf\x5f\xd6\x7d\x9f\x24\xf3\x39\xac\x2b\xdd\x82\xd2\x06\xe1\x28\x5a\x28\xd1\xa2\x17\x84\x05\xec\x4e\x40\x15\x42\x7b\x14\x65\x89\x1e\xc8\x39\x93\x87\xfc\x55\xa1\x49\xdb\x92\x83\x23\xae\xd6\x65\x45\xd0\x78\x77\x40\x50\x1d\x0d\x54\x15\x5a\x38\xb9\x0e\x3c\xce\x7c\x67\x5f\x31\x8d\x25\x40\xba\xba\x16\xb6\x48\x12\x5d\x37\xce\x13\x4c\x13\x80\x89\x47\x65\x50\xd2\x24\xac\x49\xd7\x38\x49\x78\xd5\x92\x57\x35\xc1\xa4\xd4\x54\x75\xbb\x9c\x81\xf3\xd2\xcd\x5c\x83\x56\x34\x7a\x1e\xa3\x93\x24\x1d\xf4\x48\x67\x95\xf3\x75\xbb\x76\xab\x67\x51\x37\xdc\x0c\xa1\x31\x2d\x68\x05\x02\xbe\xfc\xf8\xf6\x15\x0e\xc2\x74\x98\x41\x81\xd2\x15\x2c\x94\x55\x0b\x0b\xda\x12\x7a\x25\x24\x9e\xfb\x0c\x2a\x67\x8a\x16\xf0\x80\xfe\x14\xb3\xc1\xa9\x41\x03\x46\xca\x3c\x1a\x87\x31\xd8\x8e\x51\xb6\xa0\x41\x4f\x9a\x77\x0c\x2a\x02\xd7\xd1\x1b\x20\x08\x8f\x60\x1d\x81\xac\x50\xee\xb1\xc8\xb8\x74\x11\xdd\x21\xcf\xae\xb6\x81\x38\x54\x8f\x0e\xf3\xb6\xa8\x11\x0a\x76\x6b\x16\xcc\xe0\x2e\xa1\xd0\x4a\xa1\x47\x4b\xec\xad\x2d\x70\x40\x41\x2d\x48\x56\x79\xa2\x3a\x2b\xff\x34\x60\x2a\x24\x75\xc2\x64\xd7\x26\x6e\xb4\xa6\xb0\xe3\xc1\xc2\x39\x98\x7c\xd4\x4c\xc2\x49\xb0\x58\x5e\x85\x4e\xe9\xd4\x60\x3a\xc4\xa5\x68\x79\xd6\xa2\xd9\xc4\x56\xb7\x37\x2c\x0b\x0e\x03\x70\x99\x0c\xdc\x3e\xc0\x63\xc5\x7c\x7a\x3f\x3d\x1d\xd2\x79\x20\x0f\x9c\x7d\x1e\x3e\x80\xd5\x50\xe7\x2d\x28\x61\x5a\x1c\xb6\xfa\xe1\xc9\x52\x60\x9f\xc1\x21\xb0\x7a\x61\xcb\x60\xe5\x15\x13\x28\xee\xca\xdd\xec\xb7\x8c\x49\xaf\x89\x77\xe8\xc7\x02\xf1\x79\x09\x93\xef\x70\x94\xba\xf9\x90\xc2\xcd\xbb\xc2\x5e\x5e\xf8\x14\xd8\xd0\x4d\x0a\x0f\xcb\x61\x8d\xcf\xe9\x47\xf4\xea\x4f\x8a\xd5\xdb\x30\x5b\x7e\xfd\x9f\xe0\x38\xa8\x77\x95\xc6\xf0\x67\x66\x17\xae\x9c\x24\x58\x2e\x6f\x65\xbc\x2e\x3c\xa6\x0a\x56\xe0\x7d\x28\x17\x6f\x33\xff\xa5\x7c\x8b\x4f\x7c\xf0\xd7\x7c\xee\x07\x13\x47\xca\x90\xc7\x7e\x5a\x6d\xfe\x55\x1f\xff\x4e\xca\xd3\xb8\xb5\x22\xa4\x2e\x23\xef\xe3\x23\x84\xeb\x96\xc7\xda\x69\xbe\xfa\xc9\x0e\x4c\x7f\x6f\x61\x1a\x90\x05\x2a\xd1\x19\x5a\xdc\x92\x5c\x7e\x5f\xf9\x13\x62\x13\x51\x6f\x2e\x5f\x00\xf6\x49\x9f\xfc\x02\x2d\xbe\x9f\x97\x92\x05\x00\x00")
+
+func templatesConformancehelpersGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesConformancehelpersGotmpl,
+		"templates/conformancehelpers.gotmpl",
+	)
+}
+
+func templatesConformancehelpersGotmpl() (*asset, error) {
+	bytes, err := templatesConformancehelpersGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/conformancehelpers.gotmpl", size: 1426, mode: os.FileMode(420), modTime: time.Unix(1792220799, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesContextvalidatorGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcd\x56\x4d\x8f\xdb\x36\x10\xbd\xfb\x57\x4c\x8d\x0d\x60\x27\x5e\x66\x5b\xf4\xd4\xad\x0b\x14\xc1\x16\x0d\xda\x7c\xa0\x08\xf6\xd0\xa2\x07\xae\x3c\xb2\xd9\xa5\x45\x95\xa4\xbc\xde\x1a\xfa\xef\x9d\x21\x29\x59\xb2\xe5\xc4\x59\x14\x68\x2e\x36\xc5\x8f\x99\x79\x6f\x66\x1e\xb9\xdb\xc1\x02\x73\x55\x20\x8c\x33\x53\x78\xdc\xfa\x8d\xd4\x6a\x21\xbd\xb1\x63\xa8\xeb\xd1\xcb\x97\xf0\x2a\xce\xdf\xc6\x79\x84\xb4\x01\x1d\xf8\x55\xf8\xaa\x68\x68\x72\xfa\x52\x0e\x76\x3b\x58\x55\x6b\x59\xa8\x7f\x10\xc4\x5b\xb9\x46\x32\x02\x0f\x2b\x95\xad\xa0\x72\x08\x12\x92\x97\x4b\xf9\x20\x2d\x42\x6e\xec\x5a\x7a\x41\x6e\xd8\xd3\x87\x55\x33\xe3\xc0\xe2\x52\x39\x6f\x1f\x21\x5b\x61\x76\x1f\x7c\xb9\xd6\xdb\x83\xf2\x2b\x50\xb4\xab\x09\xca\xa5\x20\x27\x99\xdf\xce\x92\x8d\x59\xdc\x3d\x85\x35\xfa\x95\x59\xcc\xd8\x83\xdc\xdb\x65\x1b\xa6\xf2\x60\x08\x7b\x2e\xb5\x76\x70\x27\xb3\x7b\xf0\x26\xc0\x2a\xb5\x54\x45\x32\x04\x2d\x25\x8e\x23\x6d\x9c\x2a\x53\x80\xf3\xa6\x4c\xe1\xf0\xa9\x04\x0e\xd0\x5a\x63\xc9\x72\x46\x53\x7e\x0b\xc4\xcb\x82\xdc\x88\x51\x5e\x15\x19\x4c\x88\x23\xf1\x1b\x66\xa8\x36\x68\x1b\x8a\x68\x4e\xe5\x40\x87\xc4\x6b\xf7\xa1\x2a\x35\xf2\xe0\x95\x59\xd3\x68\xfb\xee\xee\x2f\xcc\x3c\x4f\xfc\xb8\x58\x28\xf6\x2b\xf5\x7b\x6b\x4a\xb4\x5e\x11\x19\x75\xfd\x9c\x4e\x63\xb1\xa0\x51\x34\x53\x98\xb0\xfb\x66\x5b\x1a\xeb\x31\xcd\x37\xd9\xe0\xbd\xda\xa5\x51\x29\x5d\x46\x68\x3a\xc9\x6a\x4d\x4d\x0f\x13\xcf\xdc\x36\x08\x45\x5a\x9b\xb5\xf9\x22\x4e\xf3\xb5\x27\x5c\x91\xde\x69\xe2\x60\x37\x02\xc2\x76\x99\xc0\x4d\x24\x99\x66\x1c\xd6\xca\x47\x1a\x78\x5c\xbb\x69\x3b\xfb\x46\x96\x20\x86\x20\x4e\xb9\x12\xd9\x0e\xd0\x01\xca\x8c\xdf\x57\x6b\x66\xb4\x26\x72\xe8\x40\xa7\x6e\x45\xdc\x6f\xd1\x57\xb6\x80\x42\xe9\x14\x44\xc2\x4d\x5f\x1b\x69\x69\xd9\xc1\x1f\x7f\x86\x30\xa3\x71\x2b\x8b\x25\x11\xd1\xa3\x36\x12\x2a\x7e\x96\xae\xcf\x06\x39\x74\xd1\x14\x2d\x93\x0d\xf8\x6e\xce\x26\x2e\x0e\x13\x2b\xb2\x3e\x89\x83\x9c\x77\xab\xd6\x4d\xaf\x83\xbd\xaf\xe6\x1c\x78\xe0\x0f\x42\xa8\x73\x90\x65\x49\xa9\x99\xd0\xc7\x8c\xb7\x4c\x69\x29\xd1\xd2\x26\x3f\x0e\x62\x54\x1a\x0b\xde\x3b\x85\x1f\xe0\x2a\xd9\xd9\xc7\x4a\x1e\xc5\x8d\xb5\x93\x21\x6f\x2d\x73\xb4\x12\x26\xea\x51\x7f\x92\x1b\x81\x6b\xd3\x38\xe5\x71\x4f\xc8\x0d\xaf\xb0\x4b\x21\x44\x13\xdc\x71\x0e\x62\x84\xf5\xe8\x89\x84\xb7\x2d\x74\x44\x35\x3c\xdf\x57\xff\xc5\x51\xf9\x5f\x7c\xa2\xfe\x2f\x06\x1a\xe0\xdc\xdc\x3d\xa9\x2b\x28\x52\xf7\x20\x97\x14\xe8\xef\x68\x4d\x10\x85\x5b\xd6\x2b\x0a\x9b\x28\x74\xac\x2e\x1c\xc3\x0e\x48\x71\x18\x92\x43\xdf\x4d\x43\x64\x34\xe5\x9f\x19\x4b\xbe\x7f\x8a\x92\x95\xaa\x9c\xec\x71\xb6\xc9\xb7\x2a\x96\x93\xb4\xf3\xb5\x7b\x5b\x69\x2d\xef\x34\x1e\x6a\xc7\x50\x04\x31\xd4\x6c\x33\x03\x73\xcf\xb6\x12\x34\x31\x51\xe4\xcf\xe6\x92\x34\x2e\x96\xcd\x90\x16\x9f\x62\x26\x69\x73\x0a\x2c\xd1\xc2\x78\xa8\x1e\xc9\xcd\xae\x8b\x34\xdb\x88\x61\x99\xe7\x7c\xd0\x71\x9f\xc3\xf8\xd9\xdf\xe3\x63\x06\x9a\x0b\x20\xf1\xb4\x2f\xfe\xe6\x06\x13\x71\xeb\xbb\xbc\x61\xe6\xbd\x24\x21\x8f\x4c\xec\x87\xa9\x5a\xc6\xe3\x96\xa9\x63\xd7\xbf\x9a\x2c\x5e\x08\x43\x8b\x27\xe2\xfa\x44\xc7\x77\x3a\xb0\xee\xea\xd7\xff\xa1\xa4\x7d\xf1\xec\x29\xde\x40\xc9\x88\x81\x8b\xe3\x09\x60\x1b\x29\xeb\x14\x7c\x50\x8d\x43\xb1\xeb\x8d\x0e\x1e\x33\x83\xa0\x5a\x91\x89\x44\x45\x4c\x0c\xe7\x8a\x06\x39\xb7\xe7\x6e\x98\x3d\xf1\x0b\x3e\xde\xd2\xcd\x41\x5a\x43\xbb\xa3\x7a\x0d\x53\x90\x80\x51\xeb\x6a\x69\x97\xfc\x30\x68\xe2\x70\xe1\xcd\x00\x28\xad\x7e\x8c\x2f\x84\xee\xc3\x21\xbd\x14\x1a\xb1\x56\xcf\xbe\xbe\xfa\xe6\x5b\x98\xcf\x5b\x05\x3f\x5f\xc3\x8f\x54\xbc\xd1\xf1\xf8\xab\x5e\xbc\x08\xff\x43\xa5\x80\x1a\xd7\x58\x74\x5f\x82\xe2\xc4\xcb\x23\xe4\xab\x5f\x1d\x4c\x60\x64\xf3\x9a\xfe\xbf\x0f\xd7\xd0\x09\x69\xb9\xe6\x28\xbe\x7c\xaa\xce\xa4\x28\xf4\x60\x8f\x93\xa6\x2a\x2f\x4f\x17\xe8\xb1\x9d\xba\xa6\x8f\xd3\xcd\xd5\xe0\xed\xde\x1d\x74\x60\xe0\xa2\x80\x40\x95\x2a\x2a\xec\x61\xb9\x3c\x75\x5b\x9c\xab\xf3\xff\x8d\xd2\x1f\x68\x7d\x2f\x59\x4f\x17\xfc\xb3\x2e\x3a\xa6\xeb\x33\x0b\x61\x90\xcb\x2f\xe8\x42\x39\x1b\xf7\x47\x75\xf8\xc4\xc3\xaf\xdf\xdf\x07\xb8\x3f\x5b\xec\x3f\xe6\xe4\xa8\x61\xfe\x05\xdc\x11\x88\x39\x9f\x0e\x00\x00")
+
+func templatesContextvalidatorGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesContextvalidatorGotmpl,
+		"templates/contextvalidator.gotmpl",
+	)
+}
+
+func templatesContextvalidatorGotmpl() (*asset, error) {
+	bytes, err := templatesContextvalidatorGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/contextvalidator.gotmpl", size: 3743, mode: os.FileMode(420), modTime: time.Unix(1792210194, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDiffGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x57\xdd\x6f\xdb\x36\x10\x7f\xd7\x5f\x71\xf1\x43\x27\x21\x9a\xda\xbd\x0d\xee\x52\xa0\x58\x53\xa0\xe8\xd6\x06\x48\xb7\x97\x20\x18\x18\x8b\xb2\x09\xcb\xa4\x46\xd1\x72\x3d\x37\xff\xfb\xee\xf8\x21\x51\xb2\x9b\x66\x5d\xfb\x62\x4b\xe4\xf1\x3e\x7e\xf7\xbb\xe3\xa9\x61\x8b\x35\x5b\x72\x38\x1c\xa0\xb8\xf2\xcf\xf7\xf7\x49\xf2\xf4\x29\x7c\x58\x89\x16\x2a\x51\x73\xd8\xb1\x16\x96\x5c\x72\xcd\x0c\x2f\xe1\x6e\x0f\x66\xc5\xa1\xdd\xb1\xe5\x92\x6b\x30\x4a\xd5\x05\xc9\x5f\x96\xc2\x08\xb9\xc4\xcd\x70\x6e\x23\x96\x2b\x03\x8d\x56\x1d\x87\x6a\x6b\xac\xaa\x15\x97\xb0\x57\x5b\xd0\xfc\x47\xbd\x95\x23\x4d\xc1\x04\x2c\xd4\x66\xc3\x64\x99\x24\x62\xd3\x28\x6d\x20\x4d\x00\x66\xd5\xc6\xcc\xe8\x5f\xf3\xaa\xe6\x0b\xf7\xdc\xe2\xae\x7b\x30\x7a\xa1\x64\x37\x4b\x32\xeb\xfa\x6b\xc1\xeb\xf2\xd7\x15\x93\x18\x0d\x3a\xc3\xd0\x1d\x5c\x40\xe3\x62\xb1\x82\x52\x54\x15\xd7\x2d\xdc\x71\xb3\xe3\xe8\x8d\xd9\x29\xe8\x70\x41\x28\xd9\x82\xaa\x50\x7a\xa3\x4a\x5e\xe7\x80\x51\xd7\xa2\x1d\x42\x16\x1a\x5e\xe1\xd9\xd7\x5a\x6d\x60\xc3\xcd\x4a\x95\x89\xd9\x37\x7c\x64\x0d\x1d\xd9\x2e\x0c\x1c\xd0\x29\xf4\xe3\x8a\x99\x15\x39\x40\x51\x36\xf4\x8c\xea\xed\xb3\x56\x0d\xd7\x66\x8f\xfa\xd7\xe8\xa1\x21\x99\x96\x6b\xc1\x6a\xf1\x0f\x2f\x73\xb7\x3c\x53\x3b\xc4\xa3\x90\x6c\xc3\x67\xa0\x34\xcc\x0c\x5b\xb6\xc5\x4f\x14\xaf\xd5\x8b\xa6\x10\xee\xc4\x59\x7a\x8f\xe1\x79\x43\x1d\xab\xb7\x3c\x58\x72\x81\x0b\x07\xb4\xaa\x4b\x84\xd9\xc7\x9a\x83\x14\xb5\xcb\x87\x75\x40\xfe\x60\xd0\x07\x43\x82\x9a\xa3\x52\xab\x51\x1a\xae\x2b\xb6\xe0\x87\x7b\x6f\xe7\x1d\xdf\x7d\xd9\x8e\xe4\xbb\xc7\xdb\xb1\x1a\x23\x3b\x8e\x7c\x2e\x49\x64\x0a\x39\x50\xf3\x0d\x97\x11\xf5\x6c\x7e\x5a\xd8\x09\x44\x81\x9d\xce\x49\x38\x1f\x14\xdb\x8c\xd0\xe2\x4b\x93\x36\x48\x21\xf1\xd1\xe3\x97\x83\x22\x4f\x62\x17\x32\xb8\xb9\x8d\x72\x1a\x79\x64\x57\x81\x35\x0d\x97\xa5\x03\x61\x61\x45\x3c\x6f\x1c\x08\x15\xf9\x22\x4c\x4b\x70\x63\x75\xd8\x47\x44\xc4\xe1\x95\x54\x5b\xb9\x18\x74\xa5\xe1\xfc\xc8\x62\xee\xc8\xd2\x3b\x58\x23\x25\xe4\x18\xa6\x89\x8f\x36\x3c\xcd\xcd\x56\x4b\xab\xfc\x4f\xb2\x15\x94\x3b\x75\x39\xf8\xca\x29\xec\xe6\xfb\x2a\x45\xbd\xd9\xf1\x2a\x1a\xca\xb2\x28\x66\xbb\x4e\x25\xd9\x30\xcd\xdb\x18\x7f\x17\x2e\xe6\xc4\x3e\xe4\x76\x8b\x69\xcd\xf6\x98\x34\x59\xf2\x8f\xb4\xe5\x1e\xb0\x98\xdd\x41\xd6\xb4\xb0\xe6\x7b\xda\xc1\xbf\x9c\x6c\x58\x62\xda\x14\x58\x80\xb0\x5a\x75\x6f\xae\xa4\x1a\x64\x48\x1e\x55\x47\xc8\x8d\x82\x7b\x1c\x72\xa3\x20\x4f\x61\x27\x2a\x38\x43\xe9\xe2\x4d\x8b\x22\xa2\x4c\x33\xf8\xf4\x09\xce\xf0\xe8\x78\x85\x44\x3e\x20\xbf\xf0\xed\xec\x82\x34\x87\x37\xd2\x61\x0b\x84\x59\xba\x0f\xbc\x53\x7d\x5c\x48\x11\xc7\x4a\xe4\x32\x10\x49\x5b\x7b\x06\x2d\x5b\xfc\x5c\x54\x94\x13\xb8\xb8\xb0\x4a\x9e\x3c\x89\x77\x28\x2f\x61\xc7\x59\xeb\x33\xee\xa1\xb0\x8b\xf7\x49\xb4\xe1\x98\x3a\xf0\x20\x8a\xfa\x40\x3d\x64\xee\x99\x81\xb5\x3e\x9f\x7a\x91\x53\x65\xce\xa7\x1e\xdc\x67\x09\xd9\xc0\x9f\x16\xcb\x0f\xbb\x29\x61\xf0\x16\xb3\xec\x31\x58\xb0\x96\xf7\x68\x5f\x19\x3d\xf0\xeb\x4d\x80\x64\x1e\xc2\x76\x78\xbf\x13\xb5\xc3\xd6\x81\xed\x5e\x43\x80\x13\x29\x8f\xf9\x54\x0a\xfa\x32\xbc\xf8\x5e\x21\x0f\xd0\x3e\x80\x3a\x7a\x8b\x7c\x53\x6b\x98\x7b\x3f\x43\xc8\x69\x56\xa4\x2e\xf7\xd9\x73\xda\xc7\xcc\x46\xb8\x61\x52\x23\xc4\xa6\xc9\x9d\x06\x54\x16\xa1\x8d\x61\x1c\xe7\xb3\x62\x96\x3b\x88\x06\x5b\x59\x51\x14\xd9\x31\x19\x3e\xdb\x17\xe8\xf8\x25\xf6\xd8\x34\xb3\xc5\xe2\x9f\xb3\x64\x9a\xce\x6b\x7b\xb7\xcd\x07\xa6\xdb\x3e\x00\x2b\xdf\x05\x5c\x7f\x9b\xe2\xc0\xca\x12\xdb\x46\xeb\x1b\xcb\x18\x86\xff\x1e\xe9\xa0\x8d\xf2\x15\x07\x7a\xe4\x6c\x2d\x16\x7c\x60\xdf\x4b\xea\x4b\x3d\xf3\x86\xc2\xf5\xc1\x9e\x48\xc5\x1f\x58\xc3\x3f\xf7\x2e\x62\xbc\x77\x7b\xf3\x40\x77\x72\x62\x77\x9a\xb3\x75\x04\xbd\x24\x08\x08\xdf\xdf\xb8\x4c\xb3\xd8\xbc\x5d\x80\x17\x28\x11\x4c\x48\xb8\x18\x76\x22\x1d\x15\xb6\x10\x41\x7a\x9e\x3d\xc7\xff\x5f\x40\xe2\xdf\xf9\x79\x7f\x8c\x19\xda\xb3\x6d\xef\x1c\x10\x24\xfc\xf5\xd3\x50\xf1\xc6\x28\x96\x8a\x40\x5f\x5f\xb0\xe1\x9c\x45\x4b\xc0\x8b\xc8\xbf\xf9\x57\xd5\x13\x33\x27\xeb\x06\x19\x89\xad\x1f\xcd\x0f\x05\x34\x98\xec\xe3\xfc\x7a\x93\x27\x0a\xf8\x84\xc9\x92\x57\x6c\x5b\x9b\x53\x66\x4e\xd4\x03\xe9\x8d\xf5\xe4\x30\x0a\x64\xd4\x07\x46\xb5\x15\x7a\xc1\x94\x85\xbf\xb3\xc6\x99\xc6\xdb\xae\xa5\x3c\x6d\xd8\x9a\xa7\x78\x0d\xde\xb8\x2b\xea\x76\x74\x31\xe5\x43\x2a\xce\x7b\x84\xb2\x9e\x04\x7f\xe5\x60\x4b\x4a\xdb\x5b\xcb\xa3\x44\x27\xd0\xca\x5b\xd4\x1f\xea\xb7\x7f\xa5\xf2\xe8\xf3\x4d\x1e\xdc\xe0\x10\x5d\x5c\x37\x68\xd9\xa4\xeb\x51\xcb\xb8\x45\x40\x46\xc4\xc5\xa9\x73\x70\xf8\xe6\x36\xdc\xa8\xcf\x70\x38\x45\xaf\x48\x59\xe4\x19\x49\x0f\x9e\xd9\x58\x7b\x56\x5b\x45\x7d\x52\xed\x6b\x6e\x57\x63\x8e\xd3\x20\x4f\xfd\x05\x6d\xb4\x4e\x66\x14\xf6\x58\xbf\x53\xf9\x00\xff\x49\xc0\xef\xaa\x0e\x4f\x77\xa1\x0a\x11\x19\x97\x4c\x0b\x06\x89\xdd\x0e\x98\x1d\xed\x3c\x50\x37\x67\xaa\x1b\x06\x83\x6f\x58\x36\xdd\xa4\x58\xce\xe4\x37\xb1\x73\x54\x2b\xdd\xff\xaa\x10\x8b\xe9\x23\xaa\xc1\xf7\x65\x9a\xad\x02\xcf\x5f\x71\xde\x5c\xfe\xbd\x65\x75\x3a\xb9\xb4\xf2\xe9\x8d\x19\x98\xfb\xdd\xaf\xf6\xfb\xe4\xc8\x71\x37\xfd\x0e\xc2\x7e\x3f\xfe\xee\x09\xb7\x1e\x0b\xd3\xef\xc9\xaf\x1c\x37\xb4\x46\x56\xbb\xe9\x28\x1a\xcd\xf4\x36\x60\x62\x7c\x94\x73\x1a\x15\xd2\xee\x33\x93\x02\x0e\x4d\xa7\xb6\x7a\x10\x03\x84\x98\x80\xee\x68\x68\xf2\x21\xa3\xdb\x51\x02\x3b\x84\xb9\xf3\x77\xa2\x87\x86\xb2\x17\x3b\x74\x48\x8e\x4e\x47\x08\x76\x71\x0a\x3d\x8e\xfd\xa5\xdd\xc3\xc8\xa0\x51\x36\x70\xfa\x3e\x62\x78\x9f\x36\xfb\xf0\x21\xd9\xb9\x46\x48\x30\xd8\x2f\x06\xfb\x4d\x67\x87\x67\xfa\x8e\xf2\xc7\x1c\xac\xc3\x30\xf0\x45\x54\x1b\xdb\x3d\xbc\x0c\x32\x01\x21\x75\xf7\x3f\x45\xd9\x84\x21\xe0\x9a\x9b\xd4\xf2\xda\x07\xd3\x4c\x82\xf9\x17\x04\x95\x86\x36\x2d\x11\x00\x00")
+
+func templatesDiffGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDiffGotmpl,
+		"templates/diff.gotmpl",
+	)
+}
+
+func templatesDiffGotmpl() (*asset, error) {
+	bytes, err := templatesDiffGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/diff.gotmpl", size: 4397, mode: os.FileMode(420), modTime: time.Unix(1792219516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDirtytrackingGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x55\x4d\x6f\xdb\x30\x0c\xbd\xf7\x57\x70\x46\x56\xd8\x5b\xa0\xae\xd7\x2d\x39\x14\xdd\x07\x76\x58\x3b\x6c\xbd\x0d\xc3\xa0\xda\x74\xad\x56\x96\x5c\x49\x6e\x9b\x65\xf9\xef\x23\x25\xc7\x41\xd2\x14\x28\x76\xd8\x21\x80\xf8\xa1\xc7\xc7\x47\xca\x59\x2e\xa1\xc2\x5a\x19\x84\xac\x52\x2e\x2c\x82\x93\xe5\x8d\x32\x57\x19\xac\x56\xcb\x25\x4c\x1c\x96\xa8\xee\xd0\xc1\xdb\x39\x88\x6f\x83\x71\x26\x5b\xa4\xf8\x01\x25\x38\x69\xae\x10\x26\x6a\x0a\x93\x5a\xa1\xae\x62\xde\x7b\x46\xfa\xc8\xa6\xe7\xb4\xa3\x23\xf8\x8e\x81\x92\x3b\xe9\x4b\xa9\xd5\x6f\x04\x31\x40\x80\xc7\xe0\x21\x34\x08\x14\x6e\xfa\x56\x9a\xad\xa8\xad\x29\xa6\xfc\x56\x70\x32\x46\xa5\xa9\x80\xf8\x59\x47\x65\x54\x00\xe9\xa1\x6c\x98\x4e\x75\x50\xf7\xa6\x84\x7c\x8b\x3f\xe5\xbf\x22\x87\xaa\xc1\xd8\x40\x20\x9f\xfd\x87\x87\xce\xba\x80\xd5\xd0\xe9\x1a\x96\xce\xa8\xfd\x70\xda\x30\xde\x8a\x1b\xbe\x54\x3c\xd9\x55\x7e\x27\x75\x1f\x5b\x0a\xd8\x76\x5a\x06\x52\xd7\x97\x0d\xb6\xf2\x62\xd1\x61\x06\x22\xde\x5e\x1e\x00\xec\x50\x14\xfb\x45\x9a\x43\x04\xdc\x93\x3f\x34\x0c\x7f\xe6\x70\x0c\xb3\x59\x8c\x2b\xd6\x3c\x0e\x27\xf1\x64\xfd\x4f\x53\xde\x30\x13\x87\xa1\x77\x26\xe9\x7e\xed\xad\x01\x43\x85\x7c\x52\x1b\xa1\x73\xb6\x43\x17\x14\x79\x68\x3a\x70\xaf\x42\x13\xfd\x64\x04\x74\x7e\xef\x50\xd6\x54\xa7\x5c\x4c\x99\x98\x4f\x73\x21\x92\x03\xa8\x0f\xae\x2f\x03\xc4\x1d\xf1\x82\xb2\x38\xf1\x82\x02\xa9\x83\x88\x2a\xc1\xa0\xe7\x81\xb4\xb6\x42\x0d\xd2\x21\x68\x15\x1d\x23\x07\x26\xba\xc3\x73\x01\x8d\xd5\x15\xad\xec\xb0\x03\x9d\xa3\x7d\x7e\x88\x44\xb4\xba\x21\xe5\xed\xbd\x41\x27\xf8\x66\x36\x4d\xc4\xcc\x50\xcf\x00\xb6\x97\x58\x55\x63\xc9\xd2\x12\x3c\x17\xb3\x7d\x18\x80\xc0\x2b\x53\x22\xdf\x5b\x80\x6f\x98\x53\x6c\x3e\xca\x66\x2f\xaf\xb1\x0c\xe2\x7f\xad\xdb\xd6\x10\xf3\x02\x7e\xfc\x24\x55\xb9\x71\xde\x24\xaa\xb4\x4b\x60\x3e\x07\xa3\x74\x8c\xc2\x30\x73\x76\x90\xb9\xa2\xdf\x9d\x74\xe4\xf4\x23\x4a\xda\xae\x67\xbc\xe7\xc7\x95\xd6\x6b\x78\x98\x1f\xcf\x66\xeb\x15\x2c\xe0\xc5\x1c\xde\x8c\xd5\x3d\x6d\xb1\xec\x3a\xea\x25\x27\x63\xca\x10\x1d\x95\x0d\x35\x64\x2f\x6f\xb3\x71\x81\x8a\x81\x5d\xd2\x8e\x5f\x38\x49\x77\x6a\xe9\x15\xe1\xc3\x79\x94\x1b\x72\x56\x94\xbc\x27\xc6\x9a\x45\x6b\x7b\x5f\x8c\xae\x2f\xb2\xdb\x18\x27\xce\xc9\x05\x99\x78\x0b\xb9\x46\x03\xe2\x44\xeb\xf3\xba\x80\x37\x45\x6a\x22\x2d\xdb\x14\xec\x0d\x37\x49\x54\xd0\xd5\xb2\xc4\xe5\x2a\xdf\x0c\x8e\x60\xce\x7a\xad\xe5\xa5\x66\x72\x87\xe3\x30\x9e\xf5\x6e\x0b\x91\x6f\x50\x9f\x9e\xde\xaa\x78\xc7\x1c\x92\x50\xb5\x75\xf0\x6b\x0a\x35\x53\x4a\xb3\x48\x34\xc5\xee\xf5\x94\xfe\x0c\x65\xf3\x68\x0c\xa4\x32\x91\x71\xf7\xaf\xeb\x22\x5e\x5f\x6d\xd4\x1e\xfb\x1a\x0f\xa9\x7c\x52\x2d\x79\x36\xa2\x8c\xda\x27\x29\xd7\xcf\x68\x9f\x98\x87\x7b\xb4\xe2\xce\xbb\xaf\xf4\x4f\x23\xb9\xc2\x27\xcb\x9f\x45\xc8\xb2\x7f\xd4\xec\xb1\x04\x6b\x3e\xbb\xaa\x09\x21\x8a\x27\x5b\x1e\x5e\x08\x01\x6c\x7d\x3d\xff\x02\xc2\x74\x83\xa1\x22\x07\x00\x00")
+
+func templatesDirtytrackingGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDirtytrackingGotmpl,
+		"templates/dirtytracking.gotmpl",
+	)
+}
+
+func templatesDirtytrackingGotmpl() (*asset, error) {
+	bytes, err := templatesDirtytrackingGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dirtytracking.gotmpl", size: 1826, mode: os.FileMode(420), modTime: time.Unix(1792211361, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDocstringGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x56\xc1\x6e\xe3\x20\x10\xbd\xfb\x2b\x46\x3e\x35\x39\xc4\xf7\xdc\xba\xcd\xae\x14\x29\xaa\xaa\xa6\xed\x1d\xe1\x49\x8a\x6a\x83\x65\x58\x6d\xd3\xa8\xff\xbe\x60\xbb\x64\x8c\xb1\x93\xae\x56\x39\x05\xc3\x83\x79\xf3\x66\x78\xe4\x78\xcc\x71\x27\x24\x42\x9a\x2b\xae\x4d\x2d\xe4\x3e\xfd\xfc\x3c\x1e\x41\xec\x60\xf1\x24\x4c\x81\xd0\x7c\xd2\xb1\x5b\x5a\xa1\xe6\xb5\xa8\x8c\x50\xd2\x4e\x26\x89\x83\xf4\xe7\xec\x0c\xca\xbc\x1b\x14\x1a\xc3\x6d\xed\xb1\xc3\x3d\x0e\xda\x8c\x5e\x7f\x97\x4c\x8a\x0f\x84\xc5\x3d\x2b\x91\x9e\x68\xa3\xd9\x41\xf3\x0b\x84\xfe\x46\xc8\x37\xbd\x52\x7c\xdb\xa6\x41\xc8\x2a\x7e\xaf\x0c\xb6\x3b\xc3\x4f\x4f\xd2\x21\x7f\xbe\x1b\xac\x25\x2b\x2c\x44\x77\xeb\xe1\xf4\x90\xf2\xd4\xf2\x12\x68\x88\x3e\xf2\xf9\x71\x13\x72\xf0\xf9\x9d\xf2\xc2\xaa\x46\xce\x0c\xe6\xd1\xcc\xfc\xaa\xdb\x76\xfa\x6a\xc2\x0e\x04\x04\xa1\xe1\x74\x1e\x08\x09\xe6\x15\xe1\xf6\x61\xdd\x46\x13\x8e\xf3\x39\x3a\x8a\xdf\xa9\xb2\x44\x69\x08\x0b\xd2\x1b\xbc\x5d\x3c\xd7\x2f\x59\x96\x50\xf0\xf7\x3a\x27\x88\x34\xd6\x42\x59\x06\x93\x5d\xe4\x6b\xcb\xca\xaa\xa1\x1a\xb0\xba\xa9\xac\xd8\x06\xd2\x0e\xb0\x84\xd4\x83\x67\xa1\x3c\x43\xa1\xcc\xa1\xc2\xd5\x85\x62\x75\x91\x6e\x2a\xa6\x39\x2b\x3c\xd9\x99\x8d\x98\x76\x1b\x66\xd7\x90\x72\x9a\x07\xd9\x36\x8b\x08\x1d\x6c\x72\xed\x76\x35\xf9\x0d\xda\x69\xdb\xd3\x3d\x1b\xf0\xd2\x2f\x5a\x38\x01\xd1\x3b\xd5\x83\xb5\x14\x2c\x2f\xa9\xac\x96\x6b\xfd\x83\x69\x7c\xb2\x95\x6c\xb3\xd4\x7f\xd8\x7e\x8f\xf5\xb2\x54\x39\x16\x2e\x3b\x9a\x94\xd7\xe2\x0b\x95\x0b\xa7\x57\x29\x24\x33\xaa\xa6\xe8\x66\xbc\xa2\xab\xbf\x04\x16\x79\x58\xae\xd8\xdd\x1b\xe4\x16\xf1\xb8\xb0\x21\x2e\x76\x3b\x9f\xfc\x19\xbf\x0b\x2a\x34\x8e\x4e\xbb\xa2\xf5\x3d\x2f\xd6\x3c\x31\x63\x8c\x5c\xb0\xf3\x0e\x19\x11\x86\x5a\xa4\x4d\xd1\x06\xbc\x82\x4f\xee\x5c\x45\x2d\x1f\x42\x64\x83\x7b\xc6\x0f\x5f\x5a\xcf\x21\xec\xdb\xee\xf5\x1d\xb6\x6b\x55\xab\x0a\x6b\x73\x78\xb1\xd7\x2b\x67\x2e\x38\x79\x0a\x16\x53\xbd\xbd\x25\x47\xc2\xbc\x29\xef\xe9\x7d\xed\x05\x1f\xbf\x2c\xd1\xe8\xff\x72\xb7\xa6\x05\xeb\xfc\x72\x5c\xaf\x51\x8b\xb9\x4c\xc4\xc8\xff\x83\x69\xee\x01\x2a\xea\x09\xc9\x05\x8e\x90\xfc\x0f\x3f\x48\xe6\xd9\x48\xed\xc2\x77\x66\x44\xe9\xbf\x32\x04\x37\x2a\xe8\x09\x00\x00")
 
 func templatesDocstringGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -210,7 +422,147 @@ func templatesDocstringGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/docstring.gotmpl", size: 190, mode: os.FileMode(420), modTime: time.Unix(1462143626, 0)}
+	info := bindataFileInfo{name: "templates/docstring.gotmpl", size: 2536, mode: os.FileMode(420), modTime: time.Unix(1792230545, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesEnumconstsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xab\xae\x56\x48\x49\x4d\xcb\xcc\x4b\x55\x50\x4a\xcd\x2b\xcd\x75\xce\xcf\x2b\x2e\x29\x56\x52\xa8\xad\xe5\x4a\x06\x31\x15\x34\xaa\xab\x15\x8a\x12\xf3\xd2\x53\x15\xf4\x5c\xe1\xf2\x20\x69\x05\x05\x7d\x7d\x05\xa0\xa4\x9e\x5f\x62\x6e\x2a\x50\x40\x21\x39\xb1\xa0\xa4\xb4\x28\xb5\x58\xa1\x24\x23\x55\x01\x64\x96\x42\x59\x62\x4e\x69\x2a\x58\x4d\x18\x98\x05\xd6\x85\xac\x05\xc4\x76\xcf\x0f\xa9\x2c\x00\xf3\x6c\x51\x94\x02\xd9\xa9\x79\x29\x20\x3d\x9a\x5c\x08\x36\x00\x60\xfa\x76\x91\xaf\x00\x00\x00")
+
+func templatesEnumconstsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesEnumconstsGotmpl,
+		"templates/enumconsts.gotmpl",
+	)
+}
+
+func templatesEnumconstsGotmpl() (*asset, error) {
+	bytes, err := templatesEnumconstsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/enumconsts.gotmpl", size: 175, mode: os.FileMode(420), modTime: time.Unix(1792221835, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesExtrafieldsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x54\xc1\x8e\xd3\x30\x10\xbd\xe7\x2b\x86\x4a\xd0\x64\x15\xb2\x2c\xdc\x16\xc2\x0d\x0e\x48\xbb\xa0\x5d\x71\xaa\x7a\x70\xd3\x49\xeb\x4d\xe2\x04\xdb\x6d\x59\xaa\xfe\x3b\x93\xb1\x93\xa6\x28\x45\xdd\x43\x2b\xd9\x9d\x79\xef\xcd\x9b\xe7\xee\xf7\xb0\xc4\x5c\x2a\x84\x09\xfe\xb6\x5a\x7c\x95\x58\x2e\xcd\x23\x6a\x29\x4a\xf9\x07\xf5\x04\x0e\x87\xe0\xfa\x1a\x7e\xaa\x4a\x68\xb3\x16\xe5\xb7\xc7\xef\xf7\xb0\xe9\x4e\x06\xec\x5a\x1a\xd8\xef\x61\xbd\xa9\x84\xa2\x0e\x48\xee\x45\x85\xd4\x05\xb9\xae\x2b\x68\xcb\x63\x28\x10\x1b\xa9\x56\x54\x8c\x90\x33\x03\xec\xd6\x32\x5b\x83\xd0\xa8\xa6\x16\xa4\x35\xd0\xe8\xba\x41\x6d\x25\x1a\x90\x0a\xbe\xb4\x62\x82\x7c\xa3\x32\x08\x09\x3d\x79\xc0\x0c\xe5\x16\x75\x07\x7e\x45\x97\x8d\x30\x19\xab\xec\x39\xa3\x53\x9d\xe1\x52\x58\x01\xb3\xf9\xe2\xd9\x62\x04\xa8\x75\xad\x61\x1f\x00\xd8\xe7\x06\xa1\x29\x05\xf1\x8c\xc2\x50\xc9\x56\x68\x56\x64\x5c\x1d\xdd\xc8\xbc\x45\x80\xdb\x14\x9e\x4c\xad\x92\x9e\x88\x49\x62\x78\xc3\xd5\xd1\x47\x2e\x7a\x95\x82\x92\x25\x73\x01\x68\xb4\x1b\xad\xda\x7b\x3a\x1e\x02\x0f\xee\x6d\xa8\x44\x33\x33\x56\x93\x39\x73\x86\x7d\x10\xbb\x3b\x34\x46\xac\xf0\x02\x4a\x87\x71\x01\x27\xb4\x73\x6a\xa1\x56\x34\xe3\x8f\xa3\xcf\x87\xc3\x12\x4b\xb4\x18\x3a\xa0\x98\xdd\x20\x2d\x36\x87\xc9\xeb\x5f\x93\xa3\xad\x0e\x00\xd5\x92\x0e\x24\xaa\x44\xe5\x5b\x22\xf8\x0c\xef\x3c\x27\x1b\x90\xf0\xe2\x20\xf5\xf3\x75\x13\x5f\x8d\xed\x30\x1d\x77\x3f\x74\x4e\x06\xfd\x14\x34\x56\x40\x28\x94\xc2\xbb\x41\x06\x2f\x48\x20\x4d\x52\xfb\x04\xee\xb4\xb4\x6d\x02\x17\x22\x2b\x86\x31\x2c\xb0\xb1\x7d\xde\x40\xe4\x16\xf5\x3f\x69\xfc\x4f\x08\xcf\x64\x70\xa0\x32\x8c\x20\x74\xf9\x8b\x5d\xfe\xa2\x4b\x03\xc8\x26\xc4\x27\xfb\xf7\xb8\x21\x37\x8e\x09\x8a\xa2\x63\x68\xc6\xe2\x40\x17\xf1\x30\x87\xe4\x68\x6b\xc5\xe0\xe5\xed\x48\x51\x4d\x80\x7c\xcf\x7f\x08\x9d\x51\x75\xce\x77\xa6\x65\x52\xf4\x45\xed\xee\x77\x52\x57\x89\x02\xc3\xf3\x49\x8e\x39\x30\x23\x7a\x5d\x58\x58\x75\x4e\x4f\xb3\x88\x61\xdb\xc2\xb9\xa0\x9e\xad\xf7\x33\x31\xfb\xac\x98\x53\x8c\xb6\x17\x85\x9c\x1b\x5e\x94\x71\xee\x88\x20\x4d\xfb\x8c\x7b\x23\xfd\x72\xda\x60\x7a\x2b\xbb\x17\x34\xb6\x2f\x07\xf3\x82\xd5\x70\x65\x2b\xc0\xbd\x04\xf8\x04\x1f\x4e\xab\x3b\xb6\x81\x00\xda\x65\x56\xab\x4c\x58\x54\xf4\xe1\x5d\xbd\x87\x7a\xf1\x84\x99\x35\x5d\x9c\x66\x47\xcc\xb7\x37\xad\x71\xd3\x78\x7a\x7c\x66\xa2\x69\x68\xfa\xd0\xcf\xe6\x28\x66\x37\xb7\xf3\x24\x49\xa2\xd8\x3f\xc2\xde\xa1\xe0\x2f\xce\x92\x92\x16\x39\x06\x00\x00")
+
+func templatesExtrafieldsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesExtrafieldsGotmpl,
+		"templates/extrafields.gotmpl",
+	)
+}
+
+func templatesExtrafieldsGotmpl() (*asset, error) {
+	bytes, err := templatesExtrafieldsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/extrafields.gotmpl", size: 1593, mode: os.FileMode(420), modTime: time.Unix(1792218229, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesFieldmaskGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x56\x4b\x6f\xdb\x30\x0c\xbe\xe7\x57\xb0\xc6\x52\x24\x5d\xe6\x76\xd7\x14\x19\xd0\x0d\xeb\xb0\x43\x1f\x18\x76\x2b\x8a\x41\xb1\xe9\x46\x8b\x2c\xb9\x92\xb2\x36\x0b\xfc\xdf\x47\x3d\xec\x38\x8f\x76\x39\xf4\x10\x20\x16\x4d\xf2\xe3\xc7\x8f\x94\x57\x2b\xc8\xb1\xe0\x12\x21\x29\x38\x8a\xbc\x64\x66\x9e\x40\x5d\xaf\x56\xf0\x4e\x63\x86\xfc\x0f\x6a\x18\x4f\x20\xfd\x11\x1f\xae\x59\x89\xd1\x2e\xdd\x5f\xb2\x55\xcc\x64\x4c\xf0\xbf\x08\x69\xb4\xf6\x4e\x4f\xe1\xa2\xaa\xc4\xf2\xd2\xc5\xbc\xa2\x98\x90\xa9\x8a\xa3\x01\x3b\x43\xf0\x89\x0c\x38\xf7\x1c\xa6\x4b\x7f\x56\x31\x3b\x33\xa0\x0a\x60\x50\x69\x65\xd5\x74\x51\x7c\x30\x76\x29\xe2\xdb\xe0\x70\x41\xa1\x55\x09\x46\x67\xc0\xa5\x55\xe4\xc6\x0d\x10\x8e\xd9\xa2\x64\xb2\x9b\x3d\xa5\xf4\x1e\x81\x8f\xea\xf3\x98\x10\xb7\x42\x6d\x97\x20\xf8\x1c\x81\x5b\x20\x77\x83\x9a\x7b\xe8\xf9\xa8\x0b\xcd\xe3\x90\x68\x2c\x01\x2c\x55\x8e\x02\x98\xc6\x35\x60\x06\xb9\xb2\xce\xe6\xe3\xfb\x70\x89\x7a\x92\xa8\x53\xf7\x4a\x92\xfa\xe4\x5a\xb3\x25\xa5\x95\x0e\x7b\x65\x7c\x00\x8d\x15\x32\xe7\x17\xd2\xf8\x94\x4b\x6f\xf1\xec\xe4\xc0\x1c\xce\xa7\x99\x12\x98\x12\x7a\xc9\x85\xaf\x36\x13\xc8\x74\xa0\x2e\x40\x08\xee\x69\xaf\x58\xc8\x0c\x06\x1b\xad\xaa\x6b\x38\x69\x7b\x53\xd7\xc3\xad\x36\x0c\x02\xcf\x77\xf7\xc6\x6a\x2e\x1f\x46\x3e\xfe\xa6\x03\x6a\xad\x34\xac\x7a\x00\xbc\xf0\xe6\xc9\xc4\x23\x71\x27\x10\x0e\x88\x9a\xa7\x41\xd7\x89\x4c\x35\xfd\x0a\x72\xfc\x35\x0a\xac\x90\x2e\x34\x93\x0f\x4d\x67\x83\x37\x45\xa4\xf0\xce\xb6\x05\x3a\x65\x1b\x30\x6f\xc9\xc7\x43\xf5\x00\x87\xe7\xde\xeb\xa8\x8b\x03\x88\x4c\xbb\xd0\x12\x8a\xd2\xa6\x5f\x1d\xe4\x62\x90\x74\xa4\xe2\x31\xf4\x1f\xc7\xd0\xff\x93\x04\x44\x23\x17\x64\xe8\x9d\xeb\x88\x37\x86\xa0\xa8\xbd\xba\x77\x10\x9b\x2f\xc0\x84\x43\xe8\x74\x47\x23\xca\x69\x6c\x18\x1a\x07\x29\x49\x02\xcf\xdc\x1d\x85\x20\x26\xfd\x2e\x73\x7c\x8e\xe5\x27\x69\x42\xe5\x73\xf8\x34\x81\xb3\x58\x7a\x27\x4e\x08\x73\x37\xe6\xf7\xa1\xc6\x3b\xfe\xfe\xe3\xf8\x3e\x56\x67\x9e\xb8\xcd\x82\xfc\xbd\x27\xc1\x0a\x1d\x49\xdb\x02\x2e\x83\xdc\xeb\x3a\x63\x06\xdd\x0b\x15\x21\xb0\x05\x24\xfd\xc7\xa4\x1d\xa7\xb1\xcf\x4a\x46\xc2\xe9\xe4\x9c\x7e\x37\x5f\x54\x59\x09\x7c\xbe\x99\xfe\xc6\xcc\xc2\x40\x2a\xeb\x4e\x2f\xa4\x92\xcb\x52\x2d\xcc\xb0\x3d\xba\x62\xd5\xfa\xc1\x8f\xc4\xfa\xf1\x33\xe5\xfc\xb9\xac\x90\x4e\xf0\x11\x06\x02\x25\xa4\x17\x42\xdc\x14\x43\x38\x1b\x52\x5e\x4a\x17\x8a\x9c\x10\x4d\x6d\xdb\xb7\x85\xe3\x40\xef\xec\x1f\x22\x86\x3a\xb1\xdf\xb6\x29\x1f\xd7\xfb\x46\x12\x4d\x91\x84\xed\x7a\x21\x04\x9b\x0a\x0c\x30\x0e\xcc\xb9\xa9\xcf\x43\x81\xc6\x59\x4a\xbf\x29\x47\x46\x9c\xa6\x06\x90\xdf\x78\xe3\xff\x55\x43\x10\xfd\x8b\x5b\x08\xc2\xd9\xeb\x09\xc8\x80\xd4\xd3\xba\x0e\xdb\x6e\x04\x6a\xee\xf2\x91\x0a\x50\x17\x2c\xc3\x55\x3d\x08\xa4\xc4\xa6\x75\x88\x39\x6e\x7d\x0f\x2a\x75\x98\x0e\xd6\x51\xf7\x0d\x52\x33\x43\x27\x9b\x60\xe3\x04\x45\xd4\x84\xe4\x88\x20\xbe\xb2\x04\xfa\x06\x66\xb4\x47\xa5\xea\xdc\x1c\xb4\x02\xdc\x18\x74\x0b\x6f\xfa\xef\xcb\xde\xb7\x7e\x9c\xf6\x46\x7b\x15\xe1\x78\x75\xb5\x0b\xe3\x69\x78\xb1\x37\x2d\x3f\xc3\x96\xea\xe0\xd2\x28\xfb\x68\x43\xd9\xbb\xb5\x84\xe4\x54\x7c\x33\x3c\x71\xa4\x28\x84\x2b\x93\xbb\xdb\x62\xf3\x4e\x49\xd6\x49\xb6\x89\x30\x49\x8b\x67\x97\x8d\xb7\x99\xaa\xae\x20\xc2\x1f\xfa\xb6\x60\x0b\x61\xdb\x15\x12\x17\x90\x1f\xf3\xf0\xde\x5a\x5a\xed\xfa\x08\x0c\x61\x39\xc5\x3c\xdf\x2f\xc9\xe3\x3d\x78\x9d\x7c\xaa\x5b\x96\xcd\x99\xcb\x10\xf5\x93\x24\x6f\xa1\xbc\x73\xe8\x48\x6e\x7d\x87\x35\x08\x5f\xbe\xbc\x1a\x6d\xbc\x82\x2d\xdc\x6d\x5b\x93\xbb\xb3\x9e\x9a\x3e\xbd\xc4\xf3\x9e\x39\x58\xc8\xb9\xa4\x8f\x92\x38\x05\xfd\x47\xf7\x51\xd3\xb9\x99\x3a\x23\xb1\x73\x15\xb6\x61\x7b\xff\x00\xf6\x1a\x93\x9e\x1f\x0a\x00\x00")
+
+func templatesFieldmaskGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesFieldmaskGotmpl,
+		"templates/fieldmask.gotmpl",
+	)
+}
+
+func templatesFieldmaskGotmpl() (*asset, error) {
+	bytes, err := templatesFieldmaskGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/fieldmask.gotmpl", size: 2591, mode: os.FileMode(420), modTime: time.Unix(1792216035, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesFixedarrayGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x52\xb1\x4e\xc3\x30\x14\xdc\xf3\x15\x47\x06\x94\xa0\x28\x5d\x10\x03\xa8\x43\x17\x24\x90\x5a\xa4\x16\x26\xc4\xf0\x9a\xbc\x10\x23\xc7\xad\x6c\xa7\x6d\x88\xf2\xef\xd8\x49\x40\x54\xed\xc0\xc0\x64\xf9\xdd\xbd\xbb\x7b\xcf\x6e\x5b\xe4\x5c\x08\xc5\x08\x0b\x71\xe0\x7c\xa6\x35\x35\x2b\xd6\x82\xa4\xf8\x64\x1d\xa2\xeb\x82\xc9\x04\x2f\xaa\x22\x6d\x4a\x92\x8f\xab\xa7\x05\xea\xef\x9b\x81\x2d\x85\x41\xdb\xa2\xac\x2b\x52\xae\x03\xe9\x82\x2a\x76\x5d\x09\xf6\xa5\xc8\x4a\x94\x64\xc0\x07\xca\xac\x6c\x3c\x2f\xbd\xf7\x2e\x2b\xcf\xec\x3a\x08\xcb\x95\x09\x8a\x5a\x65\x88\x3c\xb8\xe4\x8c\xc5\x8e\xf5\xa8\x81\x2b\x57\xdc\x92\xc9\xfa\x30\x3f\xd2\xf1\x71\x9c\x28\x27\x4b\x78\x7d\x5b\x37\x96\x63\xb0\xd6\x1b\x8d\x36\x00\x76\xa4\x07\x03\x87\x7d\x98\x8d\x4a\x97\xb4\x9f\xb3\x31\xf4\xce\x0e\x15\x85\xa7\xe2\x76\x8a\x1e\xfb\x51\xec\xd5\x12\x5c\xf6\x9d\xf1\x5d\x4f\xba\x98\x42\x09\xd9\x8b\x02\x9a\x6d\xad\x95\xaf\xbb\x6b\x37\x28\x0d\x36\xd3\x91\x06\xb7\x30\x55\x4b\xf9\x9b\xee\x80\x91\x6e\xfc\x28\xce\x56\x28\x7b\x73\x1d\x49\x56\xd1\x60\x15\x1f\x85\xda\xb9\x91\x5d\x12\x4e\xe7\x42\x3d\x78\x3c\x0a\xc3\x04\xe1\x7a\x93\x37\xee\xf4\x1a\xc9\xc9\x3a\xff\x9a\xf6\xc4\x82\x0e\xff\x6a\x31\x16\xce\xee\x35\xf2\x4f\x9a\xce\xa4\x20\xc3\xf9\x73\xb3\xed\x45\xcf\xbd\xbd\xdb\x47\x17\xb8\x3a\xab\xdc\xff\xc1\x2f\x9f\x48\x4a\xd2\xa8\x02\x00\x00")
+
+func templatesFixedarrayGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesFixedarrayGotmpl,
+		"templates/fixedarray.gotmpl",
+	)
+}
+
+func templatesFixedarrayGotmpl() (*asset, error) {
+	bytes, err := templatesFixedarrayGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/fixedarray.gotmpl", size: 680, mode: os.FileMode(420), modTime: time.Unix(1792212098, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesFormatregistryGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6d\x91\x41\x6b\xe3\x30\x10\x85\xef\xfe\x15\x8f\x40\xc1\x86\x54\xf9\x05\x39\x14\xba\x5b\xf6\xb2\xf4\x90\x9e\x96\x1e\x14\x6b\x2c\x8b\x5a\x92\x2b\x8d\x9a\x35\x21\xff\xbd\x92\x9c\x50\xba\xec\x4d\x9a\x99\xf7\xe9\xbd\xd1\x2c\xfb\x37\xa9\x09\xe7\x33\xc4\xf3\xf5\x7c\xb9\x34\xcd\x6e\x87\xc3\x68\x22\x06\x33\x11\x4e\x32\x42\x93\xa3\x20\x99\x14\x8e\x0b\x78\x24\xc4\x93\xd4\x9a\x02\xd8\xfb\x49\x94\xf9\x1f\xca\xb0\x71\x3a\x37\x6f\x3a\x6b\xf4\xc8\x98\x83\xff\x20\x0c\x89\x2b\x6a\x24\x87\xc5\x27\x04\xba\x0f\xc9\x7d\x23\xdd\x9e\x40\xef\xad\x95\x4e\x35\x8d\xb1\xb3\x0f\x8c\xb6\x01\x22\x87\xc1\x32\x36\xda\xf0\x98\x8e\x22\x8f\xec\xb4\xbf\xf7\x33\x39\x39\x9b\xdd\xda\xdd\xe4\x14\x41\xba\x1c\x41\xfc\xaa\xca\x58\xb2\xa0\x84\x9b\x83\x71\x3c\x60\x73\xf7\xbe\x81\xc8\xd5\x5c\x22\xa7\x4a\xbb\xab\x61\x8d\x33\x9c\x3d\x69\x13\x99\x42\xac\xb6\xfa\x14\xd9\x5b\x0c\x3e\x58\x99\x49\x7e\x58\xcd\xce\xd4\xe3\x94\x4d\xd4\x9b\xa2\x41\xa6\x89\x6f\xee\x56\x40\x58\xb6\x05\x99\x85\x75\xc6\x7a\x45\x53\x44\x8a\xeb\x72\xc8\xe6\x95\xe1\x43\x4e\x46\x95\xac\x15\xb5\xca\xc5\xe3\x4a\x6b\x86\xe4\xfa\xea\xa8\xed\x70\xc6\x57\xa8\x9f\x57\x2b\x35\xd4\x77\x8d\x78\x50\xaa\xfd\x37\xe7\x6f\x69\xcb\x6f\x6e\xe1\xe8\x54\x9a\xe2\xc9\x1f\x96\xb9\x94\xba\x2d\xca\x23\x6d\x86\x14\x50\x76\xd6\xe1\x98\x3f\x12\xe7\x4c\x46\xce\xc1\x29\xb8\xff\xc8\xc4\x8b\xb3\x32\xc4\x51\x4e\x07\xfa\xcb\xed\x9f\xd7\xe3\xc2\x54\x28\x5d\x87\xfd\x1e\xce\x4c\x59\x7f\xe9\xbe\xb6\x7b\x69\x3e\x01\x23\xe9\xfa\x6d\x62\x02\x00\x00")
+
+func templatesFormatregistryGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesFormatregistryGotmpl,
+		"templates/formatregistry.gotmpl",
+	)
+}
+
+func templatesFormatregistryGotmpl() (*asset, error) {
+	bytes, err := templatesFormatregistryGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/formatregistry.gotmpl", size: 610, mode: os.FileMode(420), modTime: time.Unix(1792224226, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesGobGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x85\x91\xcd\x6a\xc3\x30\x10\x84\xef\x7e\x8a\x21\x27\xab\x04\xe7\x25\x5a\x0a\x85\x26\xd0\xd0\x53\xe8\x41\x96\xd6\x95\xc0\x96\xcc\x4a\x4e\x48\x85\xdf\xbd\xb2\x9d\xfe\x1c\x9a\xe6\xb4\xb0\xcc\xce\xcc\xc7\xa6\x04\x4d\x8d\x75\x84\xd5\xbb\xaf\xf7\xc4\x56\xb6\xf6\x83\x78\x85\x71\x2c\x36\x1b\x3c\xfa\xfa\xc1\x29\xaf\x09\x34\x8f\x80\x68\x6c\x40\x4a\x30\x43\x27\x5d\x96\xa2\xda\xca\x8e\xb2\x1c\x27\x1b\x0d\x6c\x0c\x78\xda\xef\xb6\x08\x17\x2f\x19\xad\x77\x6b\x9c\x8c\x55\x06\xc6\xb7\x7a\xb2\x20\xf4\xec\x7b\xe2\x68\xb3\xa3\x6f\xe6\xab\x5a\x06\x42\x3c\xf7\x54\x34\x83\x53\x28\x73\x46\xf5\x42\x8a\xec\x91\xf8\x2b\x22\xef\x7a\x19\xd4\xdc\xf1\x3b\x58\xfc\xb4\x2c\x05\xca\xc3\x5b\x7d\x8e\xb4\x06\x31\x7b\x16\x48\x05\xc0\x14\x07\x76\xf8\xc3\xb1\x7a\x96\x1c\x8c\x6c\xa7\xca\xa5\x28\xc6\xe2\x02\x7d\x4f\x33\xb4\xa6\x5b\xd0\x0d\xfb\xee\x0a\xf4\x3f\x1c\x77\xd7\x41\x96\xe4\x52\xcb\x28\xb1\xa0\x88\x05\xe5\x16\xc9\xab\xeb\x7e\xb1\x4c\xf7\x13\x4f\x56\x92\xd3\xd3\x37\x3f\x01\x38\x24\x05\x03\xeb\x01\x00\x00")
+
+func templatesGobGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesGobGotmpl,
+		"templates/gob.gotmpl",
+	)
+}
+
+func templatesGobGotmpl() (*asset, error) {
+	bytes, err := templatesGobGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/gob.gotmpl", size: 491, mode: os.FileMode(420), modTime: time.Unix(1792216473, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesGobregistryGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x90\x41\x6e\xc3\x30\x0c\x04\xef\x7a\xc5\x22\x87\x22\x01\x1a\xe7\x15\xbd\x17\x45\x3e\x20\x4b\xb4\x44\xd4\x12\x0d\x49\x6e\x60\x18\xfe\x7b\x25\x35\x39\xf4\x46\x90\xcb\xe1\x72\x17\x6d\xbe\xb5\x23\xec\x3b\x86\xcf\x67\x7d\x1c\x4a\xdd\x6e\xb8\x7b\xce\x98\x78\x26\x3c\x74\x86\xa3\x48\x49\x17\xb2\x18\x37\x14\x4f\xc8\x0f\xed\x1c\x25\x14\x91\x79\x68\xfa\x0f\xcb\x85\xa3\xab\xc3\xd7\x5e\x60\xe7\x0b\x96\x24\x3f\x84\x69\x2d\x1d\xe5\x29\x62\x93\x15\x89\xae\x69\x8d\xff\x48\xaf\x13\x30\x12\x82\x8e\x56\x29\x0e\x8b\xa4\x82\xb3\x02\x4e\x14\x8d\xd8\xca\xbf\x39\x19\x4f\xea\xd2\x2d\x72\xe4\x52\x49\x8e\x73\xa1\x94\x3b\xcc\x48\x34\x89\x2a\xa4\x6c\x0b\x65\xc8\xd4\xbb\x8b\xcc\x5b\x90\xb4\x78\x36\x08\x62\x69\xce\x78\x70\xf1\xa8\xac\xf7\x06\x9a\x24\x75\x1d\xc7\x0a\x9a\xb4\xa9\x7e\x99\x66\x9b\xe1\x65\xb6\x7f\x4f\x51\xa8\xaf\x62\xa4\xb6\x73\xed\x66\xc8\xaa\x69\x8d\xa6\xbb\x38\x5f\xb0\xb7\x10\x93\x8e\x35\xc1\xe1\xde\x8f\xd7\x20\xd1\xe4\xc3\xd7\xd3\xe2\xf9\xad\xe5\x5c\xfb\xfb\x71\xa9\x15\x45\xdb\x34\x87\xfa\x05\xa9\x45\x18\x02\x86\x01\x00\x00")
+
+func templatesGobregistryGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesGobregistryGotmpl,
+		"templates/gobregistry.gotmpl",
+	)
+}
+
+func templatesGobregistryGotmpl() (*asset, error) {
+	bytes, err := templatesGobregistryGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/gobregistry.gotmpl", size: 390, mode: os.FileMode(420), modTime: time.Unix(1792216473, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -235,7 +587,7 @@ func templatesHeaderGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesModelGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd4\x51\xbb\x4e\xc3\x40\x10\xec\xfd\x15\xab\x94\x29\xce\x3d\x5d\x10\x41\x72\x01\x42\xc0\x0f\xac\xee\x16\xfb\xa4\x7b\x71\x7b\x88\x40\xe4\x7f\x67\x6d\xc7\x89\x2d\x11\x89\x96\x6e\x3d\x33\x3b\x9e\x9d\x3b\x1e\xa1\x90\x4f\x0e\x0b\xc1\xa6\x23\x34\x94\x37\xa0\xa0\xef\xab\x4a\x28\xfb\x06\xaa\x09\xda\x7d\x18\x7a\x88\x86\x9c\xe0\x13\x4a\xef\xa0\x1e\xd1\xcb\xce\x2e\xd9\x67\xe2\x14\x03\xd3\x46\xe8\xba\x86\xdd\x53\x33\x23\x60\x19\x4a\x47\x90\xe7\xef\x12\x01\xc3\xa0\x00\x8d\xce\x29\x31\x23\x27\xf0\x6c\xab\x1a\xde\x1f\x52\xcc\x85\xcc\xe0\xb5\x15\x34\x21\x8b\xd4\x7e\xd3\xe9\x87\x7d\x0f\xab\xcc\x26\x6a\x2e\xd9\x86\x76\x8a\x3d\xf9\x84\x58\x06\xaf\x5b\x64\x7a\xfd\x4a\xc3\x52\xc5\x9f\xd8\xb6\x94\x6f\xfc\x78\x87\xc8\x66\xbb\x4b\x86\xb3\xc6\x58\xd6\xd9\x7a\x1b\xb0\xc4\xbc\xd4\x8e\xf3\xdd\x92\xbd\xb7\xe4\xcc\xc9\x25\xac\x86\x6a\x5b\xff\x02\xae\xb2\xb3\xee\xc8\xe3\xa2\xef\x8c\xa1\x95\x43\xf7\x87\x92\xf1\x65\x24\xf9\x4a\x37\x57\x5e\xe7\x5f\x56\x76\x6e\xea\x6f\x45\x5d\x34\x3f\x01\x00\x00\xff\xff\x4c\x5b\x4d\x15\xbd\x02\x00\x00")
+var _templatesModelGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8d\x53\x41\x6e\xdb\x30\x10\xbc\xeb\x15\x1b\x1f\x0a\x1b\x28\x94\x7b\x8b\x1c\x9c\x36\x6d\x7d\x68\x20\xd4\x68\x73\x08\x0a\x64\x4d\xad\x23\x16\x14\xc9\x90\x74\x62\x55\xf0\xdf\xbb\xa4\xa2\xda\x95\x2d\xa3\x27\x89\x33\x9c\xd9\xe5\x70\xd9\xb6\x10\xa8\xb6\x0a\x03\xc1\xa4\x22\x2c\xc9\x4d\x20\x87\xdd\x2e\xcb\xda\x16\xe4\x1a\xf2\x85\x16\x6a\x53\xd2\x57\x53\x92\x62\xbc\x43\xe9\x09\xf2\x5b\xac\x59\x33\xb7\xf2\x1b\x79\x6b\xb4\xa7\x09\xd3\x97\x97\x30\x2f\x16\x3d\x02\xd2\x43\xa8\x08\x5c\xbf\x0e\x06\x50\xc7\x1d\x20\x50\xa9\x9c\xcd\x48\x31\xdc\xdb\xe6\x0b\x7f\xb3\xb5\xc6\x05\x2a\x3b\x6c\xdf\x5b\x68\x2c\x7d\x34\xa2\x6b\x2e\xea\x74\x79\xf2\x27\xfb\x47\xe5\x45\x45\x35\xbe\x9e\xe8\xb5\xc6\x9d\x0c\x55\x81\x41\x54\xc3\x12\x36\x82\x75\x3c\xe7\x71\x95\x28\xfc\xae\x5f\x1c\xda\xc2\x19\x4b\x2e\x34\x43\xf5\x26\xb1\x4b\x72\x12\x95\xfc\xdd\xc7\xd8\x9f\x30\xea\x3f\xc9\x2d\x95\x4b\xe6\x86\xd2\x75\x24\xe6\xce\x61\x73\x46\x8e\xdc\x0a\xe7\xf3\xa1\x42\x17\xbf\x73\x25\xd1\x1f\xc7\x24\x98\x3e\xd7\xc3\x17\xf4\x85\x51\x4d\x6d\x9c\xad\xa4\x58\xb0\xd0\x1f\xc5\xb0\xe7\x97\x4a\x0a\x3a\x65\x77\x18\x0b\x5b\x5e\x6f\xa4\xe2\xc9\x19\x3a\xa5\x2c\x57\x1d\x37\xaa\xfd\x6c\x56\x43\xdd\xa3\x59\x8d\x16\x8d\xb7\xe8\x50\x3f\x12\xe4\x37\xdb\xe0\x70\x99\x6e\xd8\x8f\x8c\xd0\xf8\x10\x9f\x9d\xac\xff\x9e\xa7\xa3\x9e\x0a\xe3\x65\x90\x46\xa3\xda\x37\x96\xf1\xa3\xe0\x1d\x07\x5c\xca\xfd\x07\xc6\xc0\xfa\x27\xd2\x39\x83\x59\xa7\x95\xfd\xbb\x15\x64\xba\x23\xc6\xa3\x45\x7a\x73\xec\xf8\xcc\xda\x71\x4b\x6f\x49\xe4\x5d\xfd\x2c\x5b\x6f\xb4\x00\xa9\x65\x98\xce\xa0\xcd\x20\xbd\x5e\xe7\xe0\xdd\x15\xfc\xf2\x46\xf3\x50\xd7\xe8\x7c\x85\x6a\x7a\xff\x73\xd5\x04\x9a\x3e\x9c\xf0\x65\xd3\x87\xd9\x5b\x78\x33\x5a\x72\xf6\x3e\x99\x5e\x5c\x81\x96\x2a\x95\x01\xb0\xa8\xa5\x98\x32\x3c\xe3\xe5\x2e\x3b\xcc\xeb\x0f\x07\xaf\x24\x85\x76\x04\x00\x00")
 
 func templatesModelGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -250,7 +602,27 @@ func templatesModelGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/model.gotmpl", size: 701, mode: os.FileMode(420), modTime: time.Unix(1467233158, 0)}
+	info := bindataFileInfo{name: "templates/model.gotmpl", size: 1142, mode: os.FileMode(420), modTime: time.Unix(1792242422, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesModeldiffGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x94\xc1\x8e\xd3\x30\x10\x86\xef\x7d\x8a\x51\x04\xab\x14\x22\xaf\xb8\xee\xaa\x87\x0a\xb4\x88\x0b\x20\xc4\x0d\x21\x34\x9b\x4c\x5a\x6b\x1d\x3b\xd8\xce\x96\x52\xe5\xdd\x99\x89\xdd\x6c\xbb\x0b\x82\x03\x87\x56\x89\xc7\xfe\xe6\x9f\xf9\xc7\x39\x1c\xa0\xa1\x56\x5b\x82\xa2\x73\x0d\x99\x37\xba\x6d\x0b\x18\xc7\xc3\x01\x9e\x79\xaa\x49\xdf\x93\x87\xab\x15\xa8\x4f\xf9\xe5\x3d\x76\x94\xe3\x56\x1e\x39\xd6\x63\xa8\xd1\xe8\x9f\x04\x2a\x47\x17\x97\x97\x20\xa4\x1b\xef\x3a\xf0\x14\x07\x6f\x03\xc4\x2d\x41\xbd\x45\xbb\xa1\x00\xae\x9d\x5e\x5b\x4d\xa6\xc9\x6f\x3a\x00\x43\xb7\x43\x87\xf6\x14\x05\xad\x30\x1c\xef\xf6\x15\xa0\x05\x67\x1a\x56\xc4\x42\x82\x76\x56\x4e\xea\xa8\x38\x9d\x64\x5c\x67\x3c\x30\xca\x53\xef\x7c\xa4\x06\x5a\xe7\x81\xb0\xde\x42\xc3\x7a\xc8\x6b\xbb\x49\x59\x2b\xb8\xdd\x4f\x1a\x7a\x8c\xdb\xc4\x09\xd0\x7b\xd7\x93\x8f\x7b\x30\xfa\x8e\x31\x51\x48\x81\x0f\x4d\xd5\x35\x57\x50\xb8\x9d\x25\xaf\xa4\xf0\x42\x32\x0a\x1c\x13\x4f\x10\x08\x96\x82\x24\x9d\x5a\x59\x41\x11\x71\x13\xd4\xab\x22\xed\xb3\x40\x86\x3a\xb2\x71\xda\x6a\x01\xbd\xc7\x3d\x3f\x34\x50\x18\xbc\x25\x13\xd4\xe0\x37\x1c\xce\xdb\xe1\x1e\xcd\x40\x09\xdb\x61\xaf\x52\x85\x29\x57\x20\x96\xc6\xd5\xb3\x6d\x73\x27\xac\xd9\x8b\x5c\xcc\x4d\xa8\x44\x8d\x36\xa9\x73\xb9\xf8\x20\x35\xa3\x31\xa7\xbd\xdf\x6d\x35\x37\x07\x3d\x09\x54\x2d\xda\xc1\xd6\x50\x9e\xb9\xcf\x1e\xbc\x98\xed\x1e\xc7\xe5\xec\x6c\x99\xd8\xe7\xc1\x2f\x5f\x6f\x04\xfc\x3a\x39\x71\x58\x40\xf6\x1f\x1e\x31\x95\x48\x5a\xc7\xb2\x28\xaa\xa4\x71\xb9\x18\x17\x52\x63\x5a\x67\x07\x42\xfc\x2f\x33\xc3\xff\xda\x4f\x2e\xb3\x97\x11\x7d\x84\x9d\x66\xc7\x7b\xcf\x63\xff\xe3\x9f\xea\xcd\x4a\xd3\x09\x66\xc8\x10\xb1\x68\x76\x20\x92\x6f\xb1\xa6\xc3\x6f\xeb\xce\xf9\xbf\xc9\x15\x71\xaa\x3c\x63\x72\x58\xb7\xd9\x9b\xd5\x6a\x32\x4a\x8e\xc0\x71\x89\x07\x69\x57\x3e\x3a\x30\xf2\xaf\x1e\xbc\x97\x09\x62\xe4\x23\xcd\x89\x78\x8c\x9f\x31\xe7\xc5\x3f\x51\xef\xd1\xcf\x5d\x3e\xab\x83\x63\xbc\xdd\x4f\x25\xa9\xb5\x31\x1f\xda\x74\xf5\x39\x93\x75\x11\xd4\xbb\xb0\xb6\xce\xee\x3b\x37\x04\x0e\xf0\x2a\x75\xb7\xd4\x34\xc4\xb7\xcb\xdd\x89\xc8\x93\x16\x95\x17\x59\x87\x62\x80\x34\xb1\xff\x88\xf5\x1d\x0a\xf9\xad\xfb\xbc\xef\xf9\x0b\x24\x1f\x9e\xa5\x2a\xd3\xb0\x2e\xaf\x85\x91\x2b\xc8\xe2\x56\x80\x7d\x4f\xb6\x29\xf3\x42\x35\x27\x54\x67\x26\x55\x70\x31\x35\xf2\x6f\xa9\x94\x3a\xb6\x80\x37\x32\x37\x55\x37\x3f\xe4\xc2\xa7\x79\x4f\x53\x37\x8e\x0f\x52\x9a\xe3\xf2\x83\x9a\x94\xfd\x25\x1f\xed\x79\x48\x62\x0b\xc5\xf3\xef\xc5\x3c\x96\x79\xd0\x45\xd4\xd3\x4f\x66\x05\x27\xed\x79\x1a\x5e\x9e\x6a\xcc\x37\x2a\xa7\xe5\x7b\x33\x47\x16\xbf\x00\x8d\x64\x22\xb1\xd0\x05\x00\x00")
+
+func templatesModeldiffGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesModeldiffGotmpl,
+		"templates/modeldiff.gotmpl",
+	)
+}
+
+func templatesModeldiffGotmpl() (*asset, error) {
+	bytes, err := templatesModeldiffGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/modeldiff.gotmpl", size: 1488, mode: os.FileMode(420), modTime: time.Unix(1792219516, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -275,7 +647,87 @@ func templatesModelvalidatorGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesSchemaGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x58\x4b\x8f\xdb\x36\x10\xbe\xfb\x57\x4c\x8d\x6d\x60\x05\x86\xb7\x08\x7a\xda\x22\x87\x3c\xfa\xd8\x43\x9b\xa2\x4e\x73\x09\x82\x86\x96\x46\x6b\x26\x92\xa8\x90\x94\x37\xee\x62\xff\x7b\x87\x2f\x89\xb2\x25\x3f\x12\xb4\x41\xd1\x02\x3e\xc8\xe4\x70\xf8\xcd\x37\xa3\x79\xe8\xee\x0e\x78\x0e\x8b\xeb\x2a\x2d\x9a\x0c\x7f\x16\x19\x16\x70\x7f\xef\x56\x59\x95\xd1\x8e\x7a\xca\x14\xbe\xdc\xd6\x68\x9e\xbf\xff\x58\x0b\xa9\x31\x23\x19\x6d\x96\x48\xb0\x66\x2a\x65\x05\xff\x93\xf6\x7f\x61\x25\xd2\x0e\xf0\x4a\xa3\xcc\x59\x4a\xfb\x13\x20\x19\xaf\x6b\x56\x09\x6d\x94\x5c\x87\xed\x04\x66\x42\xc2\xe2\x37\xfc\xd0\x70\x49\x4a\x17\x3f\x31\xf5\x8a\x74\x65\x4c\x73\x51\xa9\x84\x74\xc9\xa6\xd2\xbc\xc4\x85\x5f\x66\xab\x02\xe9\x4e\xac\x0c\x02\xab\x1b\x24\xab\x6e\xe8\xee\x27\x45\xf1\x22\x6f\x17\xad\x4d\xea\x49\x25\xaa\x6d\x29\x1a\xe5\x4c\xf2\x92\xbf\x4a\x51\xa3\xd4\x1c\x55\x2c\x7e\x41\xf2\x2f\x9b\xba\x40\x27\xab\xb1\xac\x0b\xa6\x11\xa6\xda\x2c\xe6\x1c\x8b\xec\xda\x60\x9e\xc2\xc2\x49\x60\xa1\x9c\x6c\x27\xaa\xb4\x6c\x52\x3d\x24\x1b\xe1\x75\xcf\x1e\x23\x19\xfc\x24\xcb\xb8\x31\x97\x15\x3d\x60\x5e\x60\x64\xf7\xf2\x21\xf4\x40\x66\x22\xa5\xcb\x79\x75\x33\x1d\x3d\xd2\x93\xaf\xdd\xce\xb6\x63\xfb\xb9\x48\x97\x87\x34\x90\x5b\x1f\x5e\x3a\x0b\x22\x8f\x0f\x49\x86\x30\x98\x25\x50\xb2\xfa\xb5\xc3\xf5\xa6\x77\xbd\x4a\xd7\x58\x32\x13\x54\xe3\x78\xcd\x55\xc4\x55\xe0\x2f\xf6\x6c\x77\xe2\x9a\x74\x9e\xce\x47\x90\xfe\x24\x2a\xec\xe1\x63\x2c\x58\xa1\x88\x80\xd7\x27\xd9\x1d\x70\xc5\x01\xe2\x9f\x5d\x90\xb9\x3f\x8b\x1f\x85\x7d\x0f\x47\x42\xca\x3e\xef\xc5\xf8\x17\x08\xf1\x1d\x6f\xfd\x1f\xe3\xa3\x78\x77\x32\x42\xec\xd3\xff\x4c\x9c\xdf\x4f\x26\x97\x97\xf0\x7b\x55\x32\xa9\xd6\xac\x18\xac\x28\xcb\x82\x53\x31\x69\x82\x8c\x82\x5a\x14\x94\xd8\x65\xbd\xe6\x29\x28\xb3\xa9\x40\xe4\xc3\xd5\x68\x92\x37\x55\x7a\x8a\xfe\x99\x44\x96\xa1\x04\x2e\xa8\x22\x99\xa7\x39\xa4\x54\x85\x9a\x92\xd6\x42\x19\x7a\xe6\x17\xa8\x72\x59\x93\xf7\x55\xcd\x01\xa5\x14\x24\x60\x4a\xdf\x86\x49\x7a\x89\xb0\xc4\x4a\x2b\xe2\xe8\xf5\x9b\xd5\x56\x23\xad\x93\x73\x49\x0a\xae\x1e\xb7\x37\x04\xcd\x1e\xc4\x1c\x1e\x84\x73\xc9\x77\x56\xf6\xab\xc7\x50\xf1\xc2\x6a\x05\x90\xa8\x1b\x59\x99\x05\x7b\x1d\xad\x11\x8b\xee\x3a\x89\xaa\x29\x34\x8c\xa0\x23\xa1\x9c\x4a\xee\x1f\xf3\x00\xcb\x60\x70\x39\xa3\xc5\xe9\xae\x10\xab\x77\xf3\x00\xb2\x39\x48\xde\xcc\x9f\xec\xe8\x4a\xac\x06\x6f\x64\x0f\xf8\x10\x74\x03\xde\xed\x58\xe4\x8f\x81\xd5\x35\xc5\xc6\xcc\xfd\x9f\x1b\x24\xc9\xc4\x09\xf9\xc3\x10\xb6\x48\x8b\x89\x9f\xe3\x01\x34\x16\x3b\x9f\x1c\x31\x67\x06\xcb\xf1\x50\x21\x13\x6e\x11\x2a\xa4\x36\x48\x0b\x30\xda\x41\xaf\xb9\x02\x7d\x4b\xa1\x39\x07\x25\x20\xe7\x52\x69\xd3\x5b\x09\x60\xb0\x6a\xf2\x1c\x0d\x7b\xa6\x29\x6a\x1d\xc5\x45\xa3\x79\x61\x11\x51\x3f\xe4\x31\x26\x93\x61\x5f\x0c\x05\x51\x47\xf1\x11\x9f\xbb\x6b\x3b\x87\x93\x17\x2c\x6b\x27\x1c\x03\xf7\x1a\x7c\x2e\x61\xc4\x80\x31\xd9\xa8\xa2\x4c\x84\xb7\x4f\x2d\x23\xf6\x86\xc4\x6d\x3f\x1a\xdf\x77\x84\xeb\x35\x7a\x56\xcd\xf5\x8e\x6f\xfa\x59\xf2\x0d\xf5\xc4\x39\xea\x74\x6d\xe5\x36\xac\x68\xd0\x24\x19\xf3\xc7\x14\xe3\xe7\x5c\xa5\x92\x97\xbc\x62\x5a\xc8\x1f\x4c\x41\x34\x71\x16\xb2\xec\xc2\xbf\x8e\x37\xa8\x6d\xcd\x76\x75\x13\xee\x76\x22\x6e\x58\x89\x4b\xe9\xf0\xf6\x9d\x12\xd5\x95\x39\x40\x7f\x75\x0e\xd3\xaf\x3f\x4c\x47\x8e\xbc\xb5\xbe\x3b\x90\x56\x88\x0e\xca\x29\x1e\xcd\x19\x29\xa5\x53\xb9\x71\x75\x03\xdb\x76\xdd\xd5\x8e\xd9\x49\xf8\xe6\x30\x5d\x89\x6c\x3b\x9d\x07\x42\x16\x27\xf0\x70\x06\x4c\x72\xe6\xcb\xd8\x49\xe3\x0e\x22\xbf\x36\xca\xbd\x64\x19\xd2\x1c\x42\xfb\x08\xb7\x94\x0b\xc8\xcd\xc6\x51\xb4\x9e\x52\x00\x50\x15\x33\x23\x4b\x1b\xce\xd6\xed\x36\x7a\xcd\x0b\x48\x37\xaa\x5b\x6e\x42\xe3\x0c\x73\x9c\xf3\x5d\xb2\xbd\x78\x3f\x87\x8b\x8d\xa1\x35\x96\x0d\x3d\x01\x40\x4a\x33\x17\xec\x30\x7b\xf1\x9e\x76\xaf\x7c\x1a\x8d\x52\x3d\x89\x91\x2a\x7f\xf0\x58\x10\x3c\xa2\x28\x70\xe7\x86\xd8\x1d\x4b\xd0\x21\x45\xb7\xbb\x0f\xe2\x0c\x6c\xd6\xe3\xee\x25\x4a\x23\x41\x8b\x90\xf6\x1d\x9c\x7d\xfb\x88\x00\x4c\x79\x65\x83\xe9\x80\x97\xac\x23\xaf\x80\xcc\x3e\x2f\x62\x26\x94\x88\xe2\xc6\xd5\xb0\x61\x46\xcc\x6b\xf5\x4c\x50\x73\x82\x1f\x5f\xac\xde\x61\x6a\xa7\x50\xd7\x09\x9b\x29\xf1\x60\x73\xea\x53\x4f\x98\x76\x69\xc9\x4f\xb1\xd1\x28\x6c\xec\xf0\x72\xbd\xcb\xf7\x33\x58\x4b\x53\xaf\xef\xdb\x6d\x9c\x9e\x9a\x57\xc5\x36\xd6\x91\x31\x07\xe6\xed\x7e\x33\x4f\x72\xcb\xb8\xff\x0a\x6a\xe2\xb9\xf3\x5a\x2d\x9b\x95\x1f\x26\x26\x03\x43\xf4\xd8\xb4\xdc\x1e\x6f\x3f\x0a\xdc\xdf\xdb\xbc\x4f\x69\xe0\x82\x32\x43\x8a\x7c\x83\xd2\xe0\x32\x1d\x64\x0f\xed\xc5\xc2\x2d\x27\x03\x46\xd8\x1e\x72\xbc\x83\x34\xb8\xdb\xae\x18\x3f\x90\xaa\x01\xef\x07\x36\x7c\xe4\xed\xbe\x3c\xfd\x23\xaf\x6c\xa2\x88\xdd\xd5\x1d\xeb\xdb\x41\x5b\x14\x79\x29\x3d\xc5\x70\xed\x95\x61\xd2\xf1\x0d\xc3\x39\x14\x2c\x51\x0f\xb2\x40\x71\x7f\x98\x87\x04\x3a\x26\x2a\x3c\xcc\xc4\x39\xb6\x80\x4d\xf0\x9d\x45\xfb\x63\x49\xf7\x86\xff\x4b\xe7\xba\x7f\x6c\xaa\xeb\x7d\xb7\x88\x08\xfb\xd2\xe3\xdc\xdf\x34\xcc\xed\xe4\x16\x9b\x1e\x29\x36\xa2\x0c\x31\xe9\x1b\x19\x15\xbc\x6c\x89\x92\x5b\x40\x32\xfe\x92\x10\x25\x3e\x97\x6e\xc2\x67\x8b\xc9\xfe\x77\x8b\x5d\x0d\x3b\x27\xc7\x26\xef\x9e\x22\x36\x20\x34\xa8\x77\xe7\xdb\x5d\x64\x63\x4f\xdf\x9a\xa9\xe7\xc7\xad\x1c\x7b\x88\x3e\xc8\x7a\xef\x52\x09\x33\x3b\x07\xbe\xa3\xfa\xa5\x00\xe8\xc8\x97\xd5\x1e\xf8\x64\x8f\x0e\xe7\xf1\x4d\xb8\x7b\x9f\xd7\x1b\x0d\xb3\x02\x2b\x5f\x30\x12\xf8\xe6\x7c\x15\x06\xf0\xcc\x95\xe6\xd6\x0e\x5b\x97\x34\x75\x5f\x65\xdf\x16\x7a\x51\x2e\xc1\xc3\xc7\xb6\x11\x55\xae\x61\x27\x9d\xeb\xa6\x64\xd5\xfe\x04\x47\x09\x79\x37\x1f\xc7\x1d\x41\xdb\x00\xec\xb5\x06\x23\x31\xf3\x70\x28\xd2\x3f\xb7\x11\x48\x5a\xc3\x66\x34\x99\x97\x8c\x06\x70\x4a\x01\x79\xa9\x09\xfa\x0d\xa7\xc7\x6d\xe2\x1a\x28\x9b\xf8\xbb\xb6\x6a\x32\x94\x99\xff\x0a\x00\x00\xff\xff\x1c\xe5\x6f\x0c\xce\x17\x00\x00")
+var _templatesNormalizeGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x56\xdb\x6e\xdb\x30\x0c\x7d\xcf\x57\x70\xc1\x50\xd8\x45\xeb\xf6\xb9\x43\x1e\x8a\xdd\x30\x60\x6b\x8b\x35\xd8\xcb\x50\x0c\x8a\xcd\xa4\x5a\x64\xc9\x93\xe5\x74\x9d\x91\x7f\x1f\xe5\xfb\xb5\x75\xbb\xee\x21\x88\x24\x1e\x4a\xe4\x39\x24\x93\x34\x85\x00\xd7\x5c\x22\xcc\xa5\xd2\x21\x13\xfc\x0f\x06\xd7\x46\x73\xb9\x99\xc3\x7e\x9f\xa6\xc0\xd7\x40\x56\xef\xa3\x5a\xde\x47\x84\x8a\x9b\xb6\xf2\x74\xbf\x77\x68\x87\x32\xa8\x5c\xbc\xcf\xea\x0e\xb5\xcf\x62\x6b\xcc\x7d\x62\x6f\xa9\xb2\xd3\x1e\x76\xa9\x79\xd8\x84\xd1\xf6\x3a\x62\x3e\x76\x81\xc3\x71\xe4\xab\xde\xa5\x9f\xe2\x8b\x44\x08\xb6\x12\x36\x82\xc3\xa6\xd5\xfb\x8a\x3e\xf2\x1d\xea\x0b\x16\x5a\xa3\x47\x67\x11\x8b\xfd\x2c\x79\xf0\x8a\xd3\x87\x9e\x74\x47\x32\x70\x1f\x62\xc1\x9d\x94\x4d\x0b\x95\x2f\x66\xe9\x80\x48\x85\x02\xaf\x75\x91\x0b\x9c\x2d\x7a\x89\xcd\x4e\x4e\xe0\xa2\x74\x00\x5f\x20\x93\x31\x24\x11\x98\x5b\x1e\x03\x39\xdf\x26\x21\x93\xcd\x9c\x81\x4b\x88\x04\x31\x7f\x46\x18\x84\x44\xc6\x68\x20\xd2\x2a\x42\x6d\x38\xc6\xb0\xa1\x2d\x19\xb8\xb6\xf1\xb0\x44\x98\x23\xfb\x84\x85\x16\xda\x41\xc8\xf4\x16\x03\xb8\xe3\xe6\x16\x7e\x1f\x1b\x4b\x0b\xd3\x08\x76\x11\xd2\x39\xa3\x84\x2c\x5c\x49\xec\x62\x45\x45\x95\x75\xa8\x76\x81\x67\x9f\x58\x92\x0f\xb9\x18\x82\x87\x2a\x40\x11\x67\xa0\xba\x62\xc1\x28\xe5\xcd\xd6\x89\xf4\xc1\x69\xb1\x42\x39\x1d\x0e\xca\xeb\xd6\xcc\x38\x2e\xa4\x33\xb0\x92\x74\x5d\x17\x0b\x90\x5c\x64\x56\x00\x8d\x26\xd1\x92\x96\x7b\xfa\x10\x52\x33\xb9\xa1\xfb\xce\x85\xb8\x5c\xd7\xa2\x2a\x63\x4b\xef\x5c\x2a\x79\x1f\xaa\x24\x26\x03\x9d\x62\xb8\xc2\x20\xc0\xe0\x08\xd4\xd6\x0a\xc5\xa5\x41\xbd\x26\x9e\xd3\xbd\x73\xd0\x79\xd4\x56\xa3\x65\x33\xba\x62\xfe\x96\x6d\x1a\x45\x92\x95\x87\xe7\xd4\xce\xad\x14\xf6\xee\x1b\x7b\x7b\x1e\x6b\xf9\xa0\xd7\x40\xd4\x91\x77\xea\xab\xce\xa5\x42\x7f\xe0\x28\x82\xb8\xca\x4a\xe9\xa2\xc4\x5b\x05\x3d\xd4\x68\x7d\x12\x87\xbb\x0b\x5e\x35\xa9\x3d\x9c\xe6\xb3\xb0\x77\x1b\x0c\xa9\x44\xcd\xe0\xc0\xf2\x6c\xd1\xd7\x69\x8a\x32\xcc\x17\xbc\x7b\x90\x3e\x4b\xc3\xbb\xbc\x23\xbe\x31\x91\x60\x8b\x38\xaa\x06\xad\xd9\xbd\x5d\x7c\x61\xd1\x53\x38\x6a\x95\xdf\x13\xd2\xe8\xc6\xd2\xa1\xe4\xf9\xa2\xb5\x02\xda\x65\xb7\x9f\x8d\xbd\x38\x3d\xe2\x83\xec\xa6\x9e\x6e\x4f\x89\x2a\x67\x1b\x7f\x81\x77\x7d\xc7\x36\x1b\xd4\xdd\xb1\x3a\x9f\x37\xb2\xef\xe1\x56\x4a\xd9\xe1\x68\x81\x6b\x46\xa0\x3a\x88\xd3\x4a\xe6\x17\x95\xa1\xaa\xa0\x22\x22\x3b\x17\x49\x93\xb7\x8a\xea\x0f\x7f\x5f\xae\x7e\xa2\x6f\xc0\xe9\x0e\x13\xb7\x3a\xa2\x42\xaa\x37\x59\x79\xd1\x96\xd2\x72\x04\xca\x62\x24\xb9\x70\xea\xe6\x2c\xe6\x83\x73\x68\xf6\xb4\x66\x56\xa3\x20\x0e\x9a\x31\x3e\x9e\xee\xc4\xa1\x94\xc7\x31\x32\x92\xda\x44\x94\x1d\x43\x0d\x19\x17\x5f\x63\xf4\x14\xb6\x3e\x49\x85\xa1\x49\x55\x89\xcd\x09\x23\xb1\xa9\x3f\xb9\x25\x25\x9f\x7f\xd3\xb4\xcd\x93\x99\xcc\x6b\xf1\xe6\xf3\xd9\xfd\xce\x6f\x26\x12\x3c\x42\x71\x4e\xf2\x30\xd1\x76\x22\x79\xe7\x41\xc0\x0d\x57\x92\x89\xab\xfa\xb7\xde\x7b\x2f\x30\xcc\xda\x63\xd0\x3e\x22\xc7\x08\xb4\xa7\xce\x08\xae\x21\xd6\xd8\x4d\x4d\xed\x8a\xd1\x5b\x46\xda\xa6\xf9\x47\xdd\xc5\xdb\x8a\xf1\x23\xd8\xfd\x5f\xbd\x87\x63\xa9\x25\xdf\xfd\x83\x96\x8f\x3e\x53\x78\x4e\x2b\xab\xed\x0d\x8d\xa9\x5d\xfd\x2f\xb3\x5b\x28\xcd\x5f\xb9\x63\xb2\x67\xff\x43\x0b\xec\x5f\x2e\x0d\xac\x48\x37\x0c\x00\x00")
+
+func templatesNormalizeGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesNormalizeGotmpl,
+		"templates/normalize.gotmpl",
+	)
+}
+
+func templatesNormalizeGotmpl() (*asset, error) {
+	bytes, err := templatesNormalizeGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/normalize.gotmpl", size: 3127, mode: os.FileMode(420), modTime: time.Unix(1792232803, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesPatchGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x56\x4d\x6f\xdb\x46\x10\xbd\xeb\x57\x4c\x89\x34\x20\x0d\x95\x09\x10\xa0\x2d\x52\xe8\x60\x14\x28\xe0\x02\x76\x02\x3b\x3d\x19\x46\xb3\x22\x87\x12\xe3\xe5\x2e\xbb\xbb\x94\xaa\x28\xfa\xef\x9d\xd9\x5d\x89\x94\x43\x39\xae\x0f\x39\x18\x96\x47\xf3\xf1\xe6\xcd\x9b\x59\x6f\xb7\x50\x62\x55\x2b\x84\xa4\x15\xae\x58\x36\xba\x44\x99\xc0\x6e\x37\x79\xf5\x0a\xb6\x5b\x68\x85\x2d\x84\xac\x3f\x23\xe4\x57\xa2\x41\xfa\xe2\x3d\xbb\x41\x6d\x41\xc0\x9f\x37\xef\xae\xa0\x41\xb3\x40\xf0\xc1\x90\x5e\xff\xf1\x3b\xfc\xf2\xe6\xd7\x9f\x33\xa8\xb4\x19\x8f\xcf\x29\x33\x27\x3f\x07\x55\x4b\xa8\x6a\x94\x25\x48\x14\x2b\xb4\xe0\x96\x94\xc8\xe8\x16\x8d\xdb\x40\xa7\x9c\xee\x8a\x25\x96\xd3\xbd\xad\x26\x17\xfc\xb7\x95\x75\x51\x3b\xb9\x01\x8b\x0e\x9c\x06\xd5\x49\x09\xc2\x20\xc8\xda\x3a\x2c\xa1\x56\x70\x45\x26\xcb\x35\x84\x2a\x61\x41\x6e\xd4\x13\xf2\x77\xeb\x25\xaa\x50\xe5\xd0\x44\x4b\xf9\xb0\xcc\xe1\x0a\x7d\xb4\x9e\x7f\xc2\xc2\x59\x9f\xd0\x60\x2b\x45\x41\x46\xc1\xcd\xae\x97\x5a\x62\x3e\x71\x9b\x16\x1f\x23\xc6\x3a\xd3\x15\x0e\xb6\x13\x60\x2f\x23\x14\x91\x93\xbf\xef\x1b\xd8\xed\xc8\x5c\x57\xa0\xb4\x83\xfc\xc2\x5e\x2c\x94\x36\x54\x82\x08\x87\xf1\xb4\x70\x46\x66\x87\x0d\x61\x71\x34\xa5\x12\x0d\x56\x15\x96\x37\x44\x4d\x23\x3e\x10\x9a\x04\x72\x76\xfb\xf8\xc9\x6a\xf5\x36\x21\xe7\x7d\xe4\x54\x37\x35\x07\xba\x4d\xf2\x31\x64\x47\x55\x06\x00\xe1\x03\x19\x89\x24\xcf\x16\x50\x77\x65\x98\x00\xe7\x01\x45\x29\x2c\xe8\x6a\x38\x13\x86\x3f\x20\x9d\xa2\x43\xe8\xed\x1d\x35\x5d\xab\xc5\x1e\xc2\x4f\x54\x6e\x37\x61\xfe\x2f\x2c\x7b\x10\x91\xae\x33\x8a\xb2\x9b\x0e\xf7\x33\x20\xee\xc3\x10\xc2\x6c\x1e\x0c\x7f\x5d\xbb\xa5\xb7\xd8\x16\x8b\x9a\x44\x52\xf6\xb0\x26\x55\xa7\x0a\x48\xb9\xd1\x6b\x2c\xb0\x5e\xa1\x19\x52\x75\x62\x30\x59\x04\x93\x72\x0a\x08\x80\x33\x98\x6b\x2d\xfd\xac\x58\xae\x7f\x4f\xe1\x1e\xde\xce\xe2\xd0\x46\xf2\xe7\xa1\x5f\xf6\x07\x9e\xe1\x3d\xcc\x66\x1e\x52\x34\x41\xec\xd4\x37\xea\x2d\x4c\x31\xff\x44\x7b\x25\xa4\xc5\xc8\xcd\x39\x29\x6f\xf3\x41\x87\xfd\xb1\x43\x46\x6a\x52\xbe\x6f\xde\x09\xfa\xca\x3d\xb7\xdf\x58\x20\x0d\x59\x4e\xf8\x66\xcf\x53\x2a\x99\xe9\xcb\x17\x5f\x11\x14\x39\xe6\x52\x44\xb0\xab\x20\xf9\xf1\x9f\xe4\x41\x31\x80\x95\x30\xf0\x19\x8d\x86\x23\x65\xdb\x07\x8a\xf6\xae\x01\x7c\x3e\xbe\x19\x33\x9f\x85\x29\x06\x24\x62\x4f\xa2\x1a\x8f\xfe\x61\xe6\x2f\xd0\x1e\x92\x24\x6d\xd2\xf0\xcf\x9e\x9e\xe1\x29\x00\x03\x85\x7c\x87\x22\x37\x62\x2e\x11\xd2\xc8\xe9\xa5\x68\xb3\xf0\x47\x4a\xfa\x4b\x17\xf4\x5b\xd2\x76\xe4\xe7\x52\xbe\xab\x32\x78\x9d\xb1\xd3\xb9\xd2\x6a\xd3\xe8\xce\x66\x19\xe5\x7c\x79\xd8\x5e\x0f\x39\xea\x6b\x64\xb7\x83\xca\x2e\x85\xb1\x4b\x21\xc3\xa5\x0e\x9f\x87\x5a\x9b\x92\x32\x15\x9d\x14\xde\x5e\x56\xdc\xfe\x50\x0e\x36\x9e\x8e\x9f\xdf\xf6\xd3\x2a\x7c\x4c\x84\x83\xfa\x29\xb5\x7a\x7b\x37\xdf\x38\x9c\x02\x1a\xa3\x4d\x90\x83\x3f\xa8\x24\x01\xba\xdb\xa7\x13\xf1\x0a\x89\xb5\x8f\xe3\x21\xf1\x2d\xc8\x63\xea\xd4\xc7\x8e\x01\xcb\xb2\xa0\x54\x0e\x8a\xc3\xfe\xf2\x85\xde\x9a\x51\xe7\xb0\xdb\x19\x6f\xf4\xeb\xa8\x89\xb8\xb4\xfb\xc2\x91\x6a\x16\xaf\x7f\xb5\x2c\x11\xda\xde\x86\x4b\x72\xe7\x11\x5d\x8b\xf5\x25\x5a\x2b\x16\xd8\x17\xde\xa3\xfd\x4b\x45\xfa\x53\x9f\xef\x65\x48\x91\xfd\x36\x44\x77\x54\x96\x0c\xc3\xb2\xff\xff\x40\x85\x0a\xb7\xf7\x77\x10\x21\xf4\xf0\xd2\x84\x47\x9a\x64\xc7\xd7\xe9\x88\xd5\x88\x2f\xca\xe8\x80\xde\x0b\xa9\x53\x07\x29\x89\xe1\xfb\xcf\x6a\x6a\xb0\x99\xf7\x7a\x3a\xf1\x72\x3c\xf3\xa2\x1d\xa1\x60\x1e\x21\xe8\x29\x0b\x7a\xf2\x7d\x7f\xaf\xf1\xf4\x93\x79\xaa\x80\x19\x59\x29\x9c\x08\xbe\xdf\x86\xc0\xbe\x4f\x02\xc0\x8e\x71\xf2\xde\x2f\xaa\xe5\x7e\x0a\xab\x5e\x2d\x91\x93\xc3\xdb\x15\x88\x49\x57\x5e\xf1\x41\x0e\x87\x57\xec\x28\x21\xfd\x87\x44\x27\x22\xed\x6d\x24\xc2\xec\xe8\x71\xb3\xda\xb8\xfc\xc6\xe7\xb3\x03\x3f\x76\x3a\x1b\x9b\xf1\xec\x11\x9e\x7c\x7c\x36\x19\x6e\x01\x49\xb0\xbf\x6a\xff\x01\xbb\xfb\x40\x02\xb0\x0a\x00\x00")
+
+func templatesPatchGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesPatchGotmpl,
+		"templates/patch.gotmpl",
+	)
+}
+
+func templatesPatchGotmpl() (*asset, error) {
+	bytes, err := templatesPatchGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/patch.gotmpl", size: 2736, mode: os.FileMode(420), modTime: time.Unix(1792208204, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesPolymorphicsliceGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x75\x52\xc1\x6e\xd4\x30\x10\xbd\xe7\x2b\x1e\x3d\xa0\xa4\x8a\x52\xce\xa0\xbd\xc0\xa1\x2a\x12\x8b\xd4\x85\x13\x42\xc8\x9b\x4c\xba\x2e\xf6\x38\xb2\x9d\x56\x21\xca\xbf\x33\x4e\x36\x81\x45\xe5\x38\x9e\x37\x6f\xde\x7b\xe3\x71\x44\x43\xad\x66\xc2\x55\xe7\xcc\x60\x9d\xef\x4e\xba\x3e\x18\x5d\xd3\x81\xbc\x56\x46\xff\x22\x7f\x85\x69\xca\x6e\x6e\xf0\x95\xad\xf2\xe1\xa4\xcc\xc7\xc3\xe7\x3d\xfa\xb5\x0a\xa0\x27\xf2\x03\xc8\x90\x25\x8e\x70\x2d\xe2\x49\x07\x8c\x23\x4e\xbd\x55\x2c\x14\xa8\xf6\xca\x92\xd0\x40\x73\x74\xd2\x26\xd4\x8e\x6b\x4f\x91\x10\x87\x8e\xc0\xd2\x6e\x70\x1c\xa0\x63\x40\xa3\x43\xed\xb5\xd5\xac\xa2\xf3\x59\xdb\x73\x8d\x5c\xc8\xaa\x7b\xaa\x49\xcb\xaa\x95\xeb\x5a\x1e\x3b\x15\xea\x59\xe5\xb6\xa2\xb8\xd4\x99\x37\x2a\x2a\x7c\xfb\x7e\x1c\x22\x15\x20\xef\x9d\xc7\x98\x01\x4f\xca\xaf\x8a\x83\xb4\x1f\x83\xe3\xea\x5e\x3d\x7f\xa2\x10\xd4\x03\x09\x40\xb7\x09\x8d\xb7\x3b\xcc\xbd\x8d\x74\x26\x2c\xf1\x7a\x1d\x2e\xde\xcd\xb8\x57\x3b\xb0\x36\x33\x35\x20\xc6\x7a\xcf\xe9\x5d\xca\xe9\x4c\xb6\x2e\xdb\x9d\x91\x90\x48\xb9\x37\x66\x9e\xb8\x7e\xc9\xe0\x0c\xfc\x9b\x70\x29\x13\xa1\xa7\xd0\x9b\x98\xd4\x59\xf5\x93\xf2\x17\x93\x28\xf1\xa6\x84\x21\xce\x37\xa9\x85\x4c\xb6\x12\xc0\x8f\x72\xbb\x96\x30\x78\xc5\x0f\xf4\x47\xdf\x62\xc1\x1d\x1f\xcb\x35\x80\xcd\x7b\x12\x79\x17\xc9\x86\xea\xd6\x7d\x49\x77\x9b\xa6\x3c\x05\x1b\xaa\x3d\x3d\xbf\xef\xdb\x96\xfc\xba\xac\x28\xe1\x7b\x8e\xda\x52\x95\xce\xf0\xc1\x71\xe8\xad\xb4\x67\x0d\x5b\xba\x17\xa9\xfd\x93\xdb\x62\x74\xb3\xba\x83\xea\x3a\xe2\x26\x5f\xea\x32\x29\x2c\xce\x69\xfc\x27\xbd\x05\x99\x5d\xc4\x37\x65\x82\x15\x9a\xf4\xa7\x7f\x03\xd3\x9f\xdc\x3e\xfe\x02\x00\x00")
+
+func templatesPolymorphicsliceGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesPolymorphicsliceGotmpl,
+		"templates/polymorphicslice.gotmpl",
+	)
+}
+
+func templatesPolymorphicsliceGotmpl() (*asset, error) {
+	bytes, err := templatesPolymorphicsliceGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/polymorphicslice.gotmpl", size: 766, mode: os.FileMode(420), modTime: time.Unix(1792215890, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesReadwriteGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc5\x55\x4d\x8f\xd3\x30\x10\xbd\xe7\x57\x0c\x15\x82\x06\x15\x2f\xe7\x45\xe5\x82\x40\x70\x80\x03\x07\x38\x20\x0e\x56\x32\xd9\x5a\x4a\xec\x60\x3b\x5d\x95\xa8\xff\x9d\xf1\x47\x9a\x6c\x9b\x84\x6d\x01\xf5\x96\x64\x9e\x67\xde\x1b\xbf\xc9\xb4\x2d\xe4\x58\x08\x89\xb0\xd0\xc8\xf3\x6f\x5a\x58\xfc\xa4\x72\x2c\xcd\x02\xf6\xfb\xe4\xe6\x06\xda\x16\x6a\x6e\x32\x5e\x8a\x5f\x08\xec\x33\xaf\x90\x02\x5f\xf0\x67\x83\xc6\x82\x30\xc0\x1d\x62\xd3\x54\x5c\x0e\x01\xc0\x0d\x18\x94\x84\x90\x84\xd0\x01\xbe\x82\x7b\x61\x37\xaa\xa1\xaf\xd6\x80\xab\xf7\x52\xc9\x72\x07\xb5\x56\x35\x6a\x2b\xd0\x24\x76\x57\xe3\x7c\x49\x63\x75\x93\x59\x68\x13\x70\x38\xcd\xe5\x1d\x61\x62\xf0\xbd\xc0\x32\x37\x8e\xb8\x0f\x5a\xac\xea\x92\x5b\xd2\x16\x0e\x15\x2e\xbc\x00\x76\x00\xa0\xcc\xdd\xf3\x3e\x71\x42\xbd\x6c\x62\x65\x1b\x2d\x0d\xd8\x0d\x8e\x0b\xeb\x54\xd9\x8d\x30\x0f\x85\x4d\xaa\x82\x46\x1a\xb4\x49\xd1\xc8\x0c\x96\x94\x94\xe8\x66\x28\xb6\xa8\xbb\x9c\x2f\xe6\x14\xa7\x81\xd9\x32\x9d\x80\xf9\x56\x04\xda\xf0\x6c\x14\xd1\xce\x77\x0a\xc6\x1b\x7e\xeb\x3e\x3f\x3d\xe6\xca\x46\xb1\xab\xbe\x99\x00\xbe\xa1\xf4\x41\x14\xc0\x3e\xca\xac\x6c\x72\xfc\x4a\xf0\x9c\x5b\xa5\xa3\xa9\xe2\x3b\xc2\x36\x3e\x84\x86\x0f\x5a\xa6\x8a\x73\xaf\xc0\xc1\xfb\xee\x2b\x49\x49\xb8\x46\xf9\xdc\x7a\x88\xd0\x98\x5f\x7e\x03\x1d\xdf\x65\xa1\x74\xc5\xe9\x9a\xc9\x50\x45\x65\x29\xcd\x9d\xa0\xc7\x5d\x0a\xa8\x35\xa9\x8b\xae\x74\xca\x3f\x70\x13\x4f\x09\x25\x5d\xa7\x2b\xef\xaf\xdb\x35\x8c\xd4\x67\xf1\x8a\xe9\xf8\x96\x6b\x22\x6c\xe0\xfb\x0f\x9f\x72\xd6\xe6\xa1\x14\xd5\xf5\x11\x27\x71\xa4\x2e\x65\x20\x10\x25\x73\xb5\x3d\x09\xd6\x75\x7d\x54\x74\xa7\x31\x7d\xed\x0f\x3d\x59\x83\x14\xa5\x57\x06\x9e\xd8\x1a\x78\x5d\xd3\x65\x2f\xe9\x65\xe5\x20\xa9\xbf\xf3\xc1\x40\x0d\xcd\x40\xa5\x4b\x94\x0e\x9b\xc2\x1b\x78\x75\xc8\xe3\xed\xea\x15\x1a\xf6\x56\x55\xb5\x32\xf4\xe7\xe9\x89\xbf\x73\x11\x77\x8a\x31\x76\x92\x3f\x9e\x26\x5a\xe4\xb4\xbe\x18\xf9\xaa\xfb\x49\x0c\xa7\xf8\xd8\x54\xe4\x99\x59\x57\x8d\xfc\xae\x4e\xad\x75\xb6\x95\xd2\x8e\xdb\xe4\x1c\x77\xdc\xff\x38\xce\x11\x78\x8d\xa9\x9e\xde\x07\xa6\x26\xb7\xe1\xe3\x17\x42\xc0\x3f\xdc\x08\xf7\x6e\xfb\x9c\xb5\x12\x62\xd5\x89\x9d\x10\xa2\x57\x58\x0a\x43\x71\xd3\xca\x2e\x5e\x0b\x21\xfd\xbf\xde\x0b\xa7\xdd\xfa\x5f\x16\x3a\xdc\xdb\xdf\x8e\xe9\xb1\x89\x5c\x9e\x41\xab\x2f\x1e\xd4\x90\x77\x66\x52\x23\xff\x47\x8c\x6a\x40\x5e\xa3\xd3\xfd\xfb\x6f\x83\x98\x2b\x02\xe5\x09\x00\x00")
+
+func templatesReadwriteGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesReadwriteGotmpl,
+		"templates/readwrite.gotmpl",
+	)
+}
+
+func templatesReadwriteGotmpl() (*asset, error) {
+	bytes, err := templatesReadwriteGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/readwrite.gotmpl", size: 2533, mode: os.FileMode(420), modTime: time.Unix(1792231736, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSchemaGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xdd\x59\xdd\x6f\xdb\x36\x10\x7f\xf7\x5f\xc1\x19\x5e\x61\x15\x86\x3b\x14\x7b\xca\x90\x87\xb6\x49\x37\x03\x6d\x53\xcc\x59\xf7\x10\x14\xab\x2c\x53\x31\x1b\x89\x74\x49\xca\xa9\x17\xf8\x7f\xdf\x1d\x49\x49\x94\x44\xf9\xa3\x45\x3b\x6c\x40\x81\xca\xe4\xf1\x78\x5f\xbc\xfb\xdd\xe5\xe1\x81\xb0\x94\x4c\x67\x3c\xc9\x8a\x25\x7d\x2d\x96\x34\x23\xbb\xdd\x83\x59\x8d\xf9\x12\x76\xd4\xf3\x58\xd1\xeb\xed\x9a\xe2\xf7\xe5\xe7\xb5\x90\x9a\x2e\x81\x46\xe3\x12\x10\xae\x63\x95\xc4\x19\xfb\x1b\xf6\xdf\xc4\x39\x85\x1d\xc2\xb8\xa6\x32\x8d\x13\xd8\x1f\x10\xa0\x71\xbc\xc6\x5c\x68\x64\x32\x2b\xb7\x23\x32\x16\x92\x4c\x7f\xa7\x9f\x0a\x26\x81\xe9\xf4\xb7\x58\xbd\x03\x5e\xcb\x58\x33\xc1\x55\x04\xbc\x64\xc1\x35\xcb\xe9\xd4\x2d\xc7\x8b\x8c\xc2\x9d\x94\xa3\x04\x86\x37\x91\x31\xbf\x85\xbb\x9f\x65\xd9\x55\x5a\x2d\x1a\x9d\xd4\x33\x2e\xf8\x36\x17\x85\xb2\x2a\x39\xca\xb7\x52\xac\xa9\xd4\x8c\x2a\x9f\x7c\x04\xf4\xd7\xc5\x3a\xa3\x96\x56\xd3\x7c\x9d\xc5\x9a\x92\xa1\xc6\xc5\x94\xd1\x6c\x39\x43\x99\x87\x64\x6a\x29\x68\xa6\x2c\x6d\x4d\xaa\xb4\x2c\x12\x1d\xa2\xf5\xe4\xb5\xdf\x4e\x46\x50\xf8\xd9\x72\xc9\x50\xdd\x38\x6b\x08\xe6\x08\xfa\x77\xeb\x6b\xcd\x85\x17\x22\x19\xf6\x92\x93\x96\xa3\x42\x64\xa5\xf7\xc6\x11\xc9\xe3\xf5\x0d\xe8\xc2\xf8\xed\xfb\xc6\x45\x2a\x59\xd1\x3c\xc6\x58\xd8\x7f\x15\xa8\x58\xaa\xed\x3b\xa4\x3e\x31\x03\x9e\xc7\xa9\x51\x52\xf6\x6b\x60\x28\x3c\xe1\x6f\x8e\x92\xb9\xe4\xeb\xfb\xc4\x7d\x5b\xbf\xda\x1f\xd3\x5f\x85\x09\xfd\x1e\x2f\x9a\xef\x4e\x58\xfd\x0b\x51\xd5\xb2\xf4\xff\x2f\xac\x5a\x6f\xc7\x77\xc5\x7f\x22\xb4\x76\x83\xc1\x93\x27\xe4\x0f\x9e\xc7\x52\xad\xe2\x2c\x98\x37\xe7\x19\x83\x94\x59\x94\x34\x8a\xac\x45\x06\xe9\x4b\xae\x57\x2c\x21\x0a\x37\x15\x11\x69\x38\xe7\x0e\xd2\x82\x27\xc7\xf0\x1f\x4b\x1a\x2f\xa9\x24\x4c\x40\xde\xc5\xaf\x09\x49\x20\xd7\x16\x39\xac\x95\xc9\xf6\x85\x5b\x80\xfc\x6c\x54\xee\xb2\x9a\x10\x2a\xa5\x00\x02\x4c\xf0\x9b\x58\x42\xdc\xd2\x9c\x72\xad\xc0\x46\x37\xef\x17\x5b\x4d\x61\x1d\x1c\x03\x54\xe4\xec\xbc\xba\xa1\xe4\xec\x84\x98\x90\x47\xe5\xb9\xe8\x17\x43\xfb\xc3\x39\xe1\x2c\x33\x5c\x09\x91\x54\x17\x92\xe3\x82\xb9\x0e\xd6\xc0\x8a\xf6\x3a\x49\x55\x91\x69\xd2\x23\x1d\x10\xa5\x50\x58\xfe\x9a\x94\x62\xa1\x0c\xf6\x99\x56\x72\xda\x2b\xc4\xe2\xe3\xa4\x14\xb2\xd8\x6b\xbc\xb1\x3b\x59\x9b\x2b\x32\x1c\x9c\x92\x0d\xc1\x43\xa2\xa3\xf0\x76\xc7\x48\x7e\x4e\xe2\xf5\x1a\x62\x63\x6c\x7f\x4f\x50\x92\x68\x60\x89\xdc\x61\x52\x6e\x01\x17\x8c\x9f\xc3\x01\xd4\x17\x3b\x5f\x1c\x31\x27\x06\xcb\xe1\x50\x01\x15\xee\x29\xe1\x14\x8a\xbd\x16\x04\xb9\x13\xbd\x62\x8a\xe8\x7b\x08\xcd\x09\x51\x82\xa4\x4c\x2a\x8d\x08\x42\x90\x98\x2c\x8a\x34\xa5\x68\x3d\x2c\xfd\x95\xa3\x98\x28\x34\xcb\x8c\x44\x50\xf5\x9d\x8c\xd1\x20\xec\x8b\x50\x10\xd5\x26\x3e\xe0\x73\x7b\x6d\xed\x70\xf0\x82\xb1\xda\x11\xc7\x88\x7d\x06\x5f\x6b\x30\xb0\x00\xaa\x8c\xac\x20\x13\xd1\xfb\xe7\xc6\x22\xe6\x86\xc8\x6e\x3f\xed\xdf\xb7\x06\xd7\x2b\xea\xac\x8a\xd7\x5b\x7b\xc3\x3f\x63\x7c\x34\x3d\xd8\x9c\xea\x64\x65\xe8\x36\x71\x56\x50\x4c\x32\xf8\x03\xeb\xdf\x05\x53\x89\x64\x39\xe3\xb1\x16\xf2\x25\xa6\x51\x8c\xb3\xb5\x4d\xcd\xdb\xa9\x7b\x8e\xb7\x54\x9b\x32\x69\x4b\x15\x79\x68\x45\x5c\x98\x89\x2d\x05\xe4\xc3\x47\x25\xf8\x19\x1e\x80\x9f\x3a\x25\xc3\x1f\x3f\x0d\x7b\x8e\x7c\x30\xbe\xdb\x93\x56\xc0\x1c\x90\x53\x9c\x34\x27\xa4\x94\x9a\xe5\xc6\xe2\x4c\x5a\x81\xd2\xb9\x91\x72\x7c\x94\x7c\x13\x32\x5c\x88\xe5\x76\x38\x29\x0d\x32\x3d\xc2\x0e\x27\x88\x09\xce\xbc\xf6\x9d\xd4\xef\x20\xf0\x6b\xa1\xec\x23\x5b\x52\x40\xdb\xb0\x4f\xc9\x3d\xe4\x02\x70\x33\x3a\x0a\xd6\x13\x08\x00\xa8\x62\x08\xcc\xab\x70\x36\x6e\x37\xd1\x8b\x0f\x10\x6e\x54\xf7\x0c\x43\xe3\x04\x75\xac\xf3\x6d\xb2\x1d\xdd\x4d\xc8\x68\x83\x66\x6d\xd2\xbe\x43\x05\x5c\x89\x24\x24\x81\xfe\x82\xb4\xec\x3b\xba\x83\xdd\x33\x97\x4c\xbd\x84\x0f\x64\xc0\xd0\x1d\x3c\x14\x0a\x4f\x21\x16\xec\xb9\x90\x8d\xfb\xd2\x74\x99\xa8\xab\xdd\x47\x7e\x1e\xc6\x75\x1f\x7f\x78\xc9\xa4\xe4\x22\xa4\x79\x89\xe3\x9f\x9f\x82\x00\x43\xc6\x4d\x48\xed\xf1\x95\x71\xe7\x19\x01\xb5\x4f\x8b\x9b\x01\xa4\xa3\x12\x31\x82\x21\xb0\x93\x9a\xa9\x2b\x4e\xaf\x5c\xef\xb3\x35\xfd\x50\x03\xb1\x14\x1c\x10\x8a\xc5\x8f\x03\x1f\x6c\xd6\xe7\x5f\x08\xa0\xa5\x9f\xaf\x16\x1f\x69\x62\x9a\x35\x8b\x5e\x91\xe1\x5e\x40\xe9\x72\x57\xd9\x14\xc2\x92\x6b\xf6\xbc\x8e\x11\x4d\xe0\xe8\x1a\x97\x77\x53\x60\x65\xe1\x06\xe8\x6b\x23\xaf\xe7\xf8\xd6\x2a\x65\x50\x8a\x0b\x06\x49\xe9\x5a\xc6\xc9\x1d\x66\x96\xd6\xa1\x25\x6e\x6a\xb7\xd9\x02\xd1\x35\x6e\x9e\x27\xb2\x58\x74\xef\x83\xc5\xde\x23\x6f\x84\xcc\xad\xfc\xad\x63\xbc\xdc\xe8\x3d\x6a\x9c\xf9\x3a\x56\x77\x41\xc4\x9a\xc3\x46\xef\xd1\xcb\xcf\xa0\x8b\x39\xdf\x81\xbb\xb4\xde\x9a\x53\xc9\x8c\x08\xb2\x97\xd1\x05\x4b\x3b\x81\x92\xe3\x20\x00\x37\xc2\xa7\xe6\xeb\x8c\x69\xac\xbf\x7f\x4a\xa6\x3b\x6a\xcb\x72\xc3\x8c\x13\x54\x98\xc5\x25\x2f\x72\x7c\xab\xba\x2b\x7d\xb5\xd3\xee\x74\xea\xa8\xd9\x33\x7c\x68\xb6\x59\x40\x37\xf7\x61\xba\x17\x2c\x5f\x20\x81\x3d\x49\x3f\x55\x7d\xe1\x90\x53\x3d\x9d\xbd\x1d\x22\x5b\xc8\xce\xb6\x58\xb8\x84\xa0\x4c\x36\x75\x55\x0e\xf0\x68\x6e\xeb\x2a\xa4\xe6\x78\xb9\x84\x9c\xa2\x2c\x96\xc0\xd2\x02\xd5\x26\xa1\x6c\x43\xa5\xa7\x44\x57\xb5\xc8\xf1\x87\x4e\xc4\x71\x7d\xf0\xb2\x8f\x11\x24\xc4\x2c\x9a\x96\xc7\x5c\x17\xf2\xda\x26\xfb\x6b\x08\x13\x52\xe1\x45\x5f\x30\x2c\x0f\x0c\xcc\xe2\xc9\x7e\xba\xac\xde\x2d\x63\xd3\x48\x58\x38\xe4\x61\x9b\x23\x24\x6f\x30\x69\x37\x51\x46\x81\x35\x7c\x43\x22\x8a\x79\x25\x7c\x2a\x45\x7e\x82\xf8\x8f\x7b\xe4\x6f\x5c\x33\xd6\x78\x97\xd5\x21\xb2\x3a\xf8\x2a\x8c\x1f\x5b\x25\xa2\xb0\x16\x5d\x56\x91\xcb\xdd\x5e\x54\x99\x69\x1b\xc6\x24\x66\xcd\xb7\x98\xee\x35\x30\xa9\x86\x66\x2f\x0a\xa5\x45\xfe\x12\x33\x8a\xd6\x06\x3c\x9a\x8d\x57\xf4\x36\x4e\xb6\x78\xcc\x8c\xcb\xd0\x3e\x80\x89\x83\x2a\xb9\xaa\x5b\x06\x67\x9c\xd9\x72\x0f\xff\x8b\x7b\xc8\xcf\x1b\xbb\x8d\x8d\x53\x0f\x2c\x65\xdc\x1c\x10\x12\x5b\x02\x87\x11\xd5\x9a\x26\x53\x73\x2b\xf8\xf1\xde\x76\xa9\x16\x5d\xe2\x2d\xc0\x56\x70\x42\xc1\x16\x5b\x28\xf3\x59\x36\xb5\x7e\xd8\x2f\x61\xd9\x68\x87\xde\x76\x6d\xf2\x1e\x12\x0f\x7d\x58\x63\x3a\xac\x00\xab\xb7\xe2\x15\xe4\x24\x09\x28\x07\xdf\xf4\xa4\x59\xc5\x6d\x68\xcd\xec\xf8\xb1\x7e\xc0\xb2\x40\xd8\x44\x79\x85\x9a\x05\xaf\xe0\xf1\xb1\x86\x3b\xfd\xe9\x38\x31\xc0\x10\x0b\x21\x2c\x5e\x71\xfd\xec\xa6\xee\x64\x0f\x1a\xf1\xa1\xec\x4f\x37\xe4\xfc\x9c\x04\xaf\x6f\x02\x1d\xd4\xb6\xea\x54\x3d\x60\x93\x42\x7e\xa0\xad\x80\x6d\xe4\xf2\x99\x9a\x17\x0b\x37\x27\x1b\x04\x46\xb2\x7d\xb3\xd7\xea\x78\x35\x62\xde\xed\x4a\x63\x8d\x1a\xd2\x76\xde\xe9\x68\x6a\x97\xa3\x80\x0d\xcd\xac\xa6\x7f\x52\x83\x72\x57\x93\x23\xc8\xe5\xa3\x10\xbe\x2a\xad\xe9\x4c\xd0\x86\xa7\x01\x3c\xdb\x40\x35\xf5\xb1\x51\xdb\xea\x80\xed\x12\xf8\xf2\xc5\x35\x57\x96\x43\xbc\x3a\x5e\x8e\x36\xc1\x9c\xea\xa0\x15\x20\x32\xf7\xdb\x21\x22\xb5\x25\x38\xdd\x6f\x89\x53\x74\x21\xa6\x91\xaa\x35\x0a\x46\x4e\x5d\xd4\xbf\xe3\xc8\xf2\xbb\x0d\x2c\x1b\x53\x70\x4f\xcf\xaf\x99\x54\x7e\xa3\x39\x65\xeb\x39\x9b\xba\x02\xee\xf0\x1e\xe5\xa0\x05\xa4\xab\x20\x81\xfe\x38\x08\x30\x77\x8d\xd6\xc4\x1b\x82\x0f\xba\x53\xf0\x36\x87\xd6\xc9\xbe\x81\x70\x83\x51\x1c\x20\x0a\xf2\x6d\x61\x5f\x4f\xc7\x06\xbf\x15\xc2\xe2\x83\x5a\xf6\x7d\x78\x7f\x51\x73\x7f\xb7\x82\xec\xdd\xec\xb3\x8e\xe8\xd3\xaa\xa3\x5d\xb3\xf4\xfd\x35\xcd\x2d\x95\x5a\x1d\xf8\xfb\x5a\xc3\x02\x51\xc7\xa6\x36\x6c\x36\xfd\x52\xdc\x6a\x32\xce\xa0\x36\xda\x44\x1f\x91\x9f\x4e\x67\x81\x02\x8f\xad\x45\x2a\x3d\x4c\x3d\xd1\xd0\x44\xe4\x4d\x5d\x76\x3b\xa8\xd0\x4e\x7c\x5a\x0d\x6a\x1c\x72\x05\x9e\xab\x22\x8f\x79\xb0\xf0\xb6\xf3\xa8\xef\x88\xaa\xbf\xed\x74\xbe\x3d\x81\xf7\x38\xf4\x5c\xbe\xb6\xcf\x8d\x2a\xc5\xc6\xa9\x81\x78\x06\xbd\xa6\xb9\x06\xd1\x6f\x19\x7c\x6e\x03\xa8\xd3\xcc\x85\x43\x28\x12\xed\xb5\xbc\x34\x93\x88\x86\x68\xe3\x13\xcc\x6d\xe2\x61\xe0\x59\x7c\x6e\x66\x7b\x85\xb4\x88\xe7\x90\xed\x49\xc6\xee\x68\x75\x76\x02\x3f\x95\x46\x30\x4e\xe3\x64\x45\x36\x4c\x64\x26\x04\x49\x0c\x38\x94\xd4\x21\x69\x64\xfe\x16\x6e\x0b\xbd\xb8\x86\x23\x43\x30\xac\xab\x79\xbf\x73\x6e\xde\xb7\xb4\xf0\x1d\xd5\xda\x52\x5d\xdd\xa6\x6d\xf7\x47\xd1\x20\xd4\xab\x43\x57\x8a\xed\x83\xff\x88\xdb\x6f\x2e\xb1\x24\xad\x47\x17\x28\xbc\xff\x00\xe0\xe3\xed\xa9\xfb\x1f\x00\x00")
 
 func templatesSchemaGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -290,12 +742,12 @@ func templatesSchemaGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/schema.gotmpl", size: 6094, mode: os.FileMode(420), modTime: time.Unix(1467233158, 0)}
+	info := bindataFileInfo{name: "templates/schema.gotmpl", size: 8187, mode: os.FileMode(420), modTime: time.Unix(1792240134, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesSchemabodyGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x59\x4b\x6f\xd4\x30\x10\xbe\xf7\x57\x58\x51\x0f\x6d\x05\xe9\x9d\x5b\xab\xf2\xd8\x0b\x20\x5a\x71\xa9\x90\x6a\x62\x6f\x6b\x94\xd8\xc1\x76\x80\x65\x95\xff\x8e\x1f\x79\x38\xde\xcd\x26\x2d\x91\x68\x82\x6f\xee\x7a\x3c\xe3\xf9\x66\xe6\xfb\xac\x74\xbb\x05\x08\xaf\x09\xc5\x20\x12\xc9\x03\xce\xe0\x25\x43\x9b\x08\x94\xa5\x90\xbc\x48\x24\xd8\x1e\x01\xa0\x6c\x38\xa4\xf7\x18\xc4\x17\x69\xfa\x61\xad\x36\xed\x8f\x64\x0d\x18\x07\x27\x90\x22\x70\x1c\xaf\xc4\x75\xf1\xf5\x66\x93\x2b\xab\x95\xb8\x84\x02\xd7\xeb\xd7\xbf\x72\xc6\x25\x46\xa7\xfa\x8f\x0b\xca\xe8\x26\x63\x85\x50\x4e\x5a\xb7\x1f\x39\xcb\x31\x97\x04\x0b\xd7\xb7\xba\xd3\x71\x7c\x45\x44\xc2\x49\x46\x28\x94\x8c\xbf\x21\x38\x45\x20\x7e\x0f\x33\x6c\xcf\x57\x37\xa0\x4c\x9a\x1b\xb4\xa1\x0e\x5d\xea\xb4\x39\xab\x0d\x6e\x8a\x3c\xad\xbc\x49\x9c\xe5\x29\x94\x0a\x8a\x9c\x93\x1f\x52\x6f\xac\x75\xc4\x08\xc4\xd6\x00\xa7\xc2\x9a\x76\x2d\x2d\x54\x9e\xa9\x8a\xdf\x3d\x73\x30\xe0\xb8\x60\x87\x03\x51\xe4\xfc\x60\x51\x6c\x36\x55\xec\xf8\x1d\x14\x17\x08\x11\x49\x18\x85\x69\x07\xf2\xca\xa0\x67\xf7\xfc\x0c\x74\xee\x8a\x58\xa2\x2e\x42\xe8\x7d\xd4\x7b\xc4\x03\xd3\xec\x6c\x3e\xc3\x94\x20\xa8\xad\xaf\x58\x72\x7d\xc8\x43\x59\x82\xb3\xf3\xa6\x0f\x74\x29\x9d\xe2\xda\x72\xb7\xa5\xad\xca\x99\x43\x91\xa8\x00\xbf\xf1\x7e\x97\x4e\xd3\xb4\x15\x19\xb4\x34\xf0\x81\x0c\xe6\xb7\x36\xe3\x2f\x9d\xc4\xec\xc0\xe8\x3b\xf4\x23\x01\xee\xbe\x09\x46\x5f\x45\x2f\xa3\x3b\x9d\x8f\x53\x24\xa7\xd3\x9d\xc3\x2b\xe5\x7e\x3c\xe8\xb5\xf5\x93\xf0\x36\x87\x27\x83\xda\x78\x1b\x42\x79\xc7\xc8\x02\x7c\x3b\x0a\xd7\x3a\x59\x07\x52\xb7\xcb\xab\xb5\x0d\xdb\x92\x88\x4a\xe0\xa4\x4a\x6b\x3f\x2b\xd9\x3b\xbe\x65\x66\x67\x67\xa4\xbc\x51\x32\xeb\x1d\xd6\xf2\x08\x31\xd0\x51\xa7\xc3\xbd\x5e\x0f\x34\xe4\x57\x62\xe4\x19\xa3\x7e\x93\xb3\x92\x27\x14\x6e\xcb\x2f\x9e\x99\x86\xcd\x3d\xcc\xa7\x21\xaa\xb2\x9e\x09\xf7\xf1\x65\xf3\x78\xda\xeb\x6b\xb2\x17\x97\x47\x5c\x4b\x67\xa6\x69\xde\x47\xd5\xae\x0f\xdb\xec\x1e\x24\x03\x73\xbf\x7f\x0e\xc3\x83\x60\xe0\x41\xf0\xbf\xcd\xd4\x44\x6a\xff\xd8\x6e\x7b\xce\x12\xdb\xdf\x38\x8f\x1a\xb5\x99\x4b\x5e\xbd\x74\x44\xef\x27\x91\x0f\xf5\x0c\x06\xe5\x7b\xc6\xca\x37\x63\x6d\x0b\x92\x36\x95\xa4\x35\xbd\xd0\xc4\x18\x1a\xa0\x11\x33\xb0\x8f\x98\x34\x76\x0a\x82\x7e\xbc\x1c\x80\x74\x5e\x9d\xef\x81\x86\x24\x3f\xe1\xef\x05\xe1\xe6\x16\x2f\x58\x46\xb4\x1b\xb9\x69\x12\xac\x19\xd9\x55\xac\x3d\xbc\x3c\x34\x15\xcb\x10\xa7\xa0\x49\xbb\x9a\xc4\x0a\x19\x64\x69\x06\xb2\xb4\x58\xd1\xfa\x8b\x89\x0c\xea\x75\x48\xbd\x2a\xba\x0a\x0a\x16\x14\x6c\xe9\x0a\xd6\xba\x09\xff\xd2\x9d\xb3\x30\xf6\xb3\xdc\x3f\xff\xb6\x15\x0a\xd5\xf3\xf9\xab\xac\x17\x47\x7f\x02\x00\x00\xff\xff\xcb\xbc\xe1\xef\x60\x21\x00\x00")
+var _templatesSchemabodyGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x59\xc9\x6e\xdb\x30\x10\xbd\xe7\x2b\x08\x21\x40\x13\xc0\x55\x2e\x45\x0f\xbd\x39\x75\xda\xba\x40\x93\x22\xf1\x2d\x28\x60\x5a\xa2\x23\xa6\x12\xa9\x92\x54\x1d\xd5\xf0\xbf\x97\x8b\x16\x4a\x5e\xe4\x14\x3a\xd8\x2e\x6f\x92\x38\x9c\xe5\x71\x66\xde\x48\x5a\x2e\x41\x88\xe6\x98\x20\xe0\xf1\x20\x42\x09\xbc\xa6\x61\xee\x81\xd5\x8a\x0b\x96\x05\x02\x2c\xcf\x00\x58\x2e\x01\x83\xe4\x09\x01\x7f\x18\xc7\x77\x73\xb9\x68\x1e\xe2\x39\xa0\x0c\x5c\x40\x12\x82\x73\x7f\xcc\x1f\xb2\xd9\x24\x4f\xa5\xd4\x98\x5f\x43\x8e\xca\xeb\x9b\x97\x94\x32\x81\xc2\x4b\x75\x33\x24\x94\xe4\x09\xcd\xb8\x54\x52\xab\xfd\xce\x68\x8a\x98\xc0\x88\xdb\xba\xa5\x4f\xe7\xfe\x08\xf3\x80\xe1\x04\x13\x28\x28\xfb\x84\x51\x1c\x02\xff\x16\x26\xc8\xec\x2f\x3c\x20\x54\x68\x0f\x6a\x53\xbb\x9c\xba\xac\xf6\x2a\x81\x49\x96\xc6\x85\x36\x81\x92\x34\x86\x42\x42\x91\x32\xfc\x5b\xa8\x85\xb9\xb2\xe8\x01\xdf\x08\xa0\x98\x1b\xd1\xa6\xa4\x81\xaa\x25\x2a\xed\x37\xf7\xec\x34\xb8\x9f\xb1\xdd\x86\x48\x68\x3d\x30\x28\x56\x8b\xd2\xb6\xff\x05\xf2\x61\x18\x62\x81\x29\x81\x71\x03\xf2\x42\x60\xfb\x6a\xed\x82\x36\x3e\xa2\x81\xb7\x55\xbc\x3c\x3e\x75\x02\xd6\x99\x98\x53\xaa\x4f\xa4\x38\x85\x14\xf2\x00\xc6\xf8\x0f\xda\xac\xcf\x3a\xeb\x1a\xc8\x4e\x49\x1d\x35\x48\x60\xfa\x28\x11\xc3\xe4\xe9\x47\x23\x04\x93\xe7\xca\x87\x1d\x41\x4c\x9f\x39\x25\x1f\xbc\xb7\xde\x54\xc5\x63\x61\x6b\x25\xa8\xb5\x79\x2c\xd5\xef\x87\x55\x29\xd9\x03\x4c\x5a\x55\x17\x42\x6b\x42\x06\x9c\xc7\xbd\x30\xa9\x9c\xad\xe1\xb0\x13\xab\xb8\x36\x66\xeb\xba\x95\x01\x5c\x14\x61\x6d\x6e\x04\xc6\xc7\xcf\x54\xaf\xac\x65\x71\x2b\x7b\xf5\xf5\x5a\xa3\x68\xf5\x20\xd7\x01\x1a\xd9\xd9\xca\xd3\x93\xa9\xfc\x3d\xf7\x68\x9e\xe8\xbd\x11\xb4\x5a\xaa\x9d\xa9\x07\xdd\x0c\xba\xc5\x5b\x78\xf5\xd3\x1b\x0a\x58\x46\x98\x89\x7c\xc2\x60\xf0\x53\xe2\x6f\x00\xbb\xba\x02\x41\xa4\x0a\x3a\x04\x0c\x05\x94\x85\x1c\x88\x08\x81\xb4\xc6\x9d\x23\x01\x16\x58\x44\xfa\xb9\xbc\x11\x88\xf1\x01\xa0\x72\x22\x98\x61\x01\xa4\x54\x29\x9c\x4b\x75\xa5\xae\x0c\x13\xf1\xfe\xdd\x66\xe2\xbb\x79\x11\x0c\xea\x30\x79\xe5\x83\x7e\x06\x22\x1a\x17\xf6\xe7\x66\x99\xce\xf5\xdd\xd7\x87\xbb\x5b\x40\x67\xcf\x48\x0e\x41\x8b\x08\x07\x11\x80\x0c\x91\x37\xc2\x76\x53\x8b\x62\xae\x0c\x46\x59\x02\x89\xc6\xb8\xc0\x74\xa0\xb4\xe4\x6a\x93\xcc\x3f\xc6\x23\x18\x4b\x17\x67\x12\x06\x00\xb9\x59\x0a\xa4\xa0\xf4\xc4\xb8\x61\xe5\xa8\x82\xd3\xbf\x87\x8b\x6f\x88\x73\x28\xbb\xde\x16\x7c\x57\x65\x99\x2f\xad\x11\xce\xe4\xc9\xbf\xcd\x70\xbd\xcd\x6d\xad\x5e\x7c\xea\xcd\xb6\xb7\x29\x4b\xad\xb6\x61\x3b\xba\xf9\xa8\xbb\x27\x6e\xe8\x73\x6e\xc6\xe9\x98\x71\xfe\xb7\x9a\xea\x6f\x80\x79\x55\xb6\x1d\xf2\xf8\xb1\x3d\x71\x5e\x55\x6a\xc7\x3c\x52\xac\x1a\xd3\x45\x49\x7a\x6a\x50\x28\x6b\xd0\x31\xdf\x01\x33\xdf\x11\x73\x9b\xa3\xb4\xbe\x28\xad\xca\x85\xca\x46\x57\x01\xed\x51\x03\x9b\x1a\x93\xc2\x4e\x42\xb0\x1d\x2f\x0b\x20\x15\x57\xe3\xab\xa2\x6e\x92\xf7\xe8\x57\x86\x99\xf6\x62\x40\x13\xac\xd4\x88\xbc\x0a\xd0\x2b\x22\xf9\x98\x71\x41\x93\x09\x54\x2f\x37\xca\x5a\xe3\x41\x25\x3d\x5d\xa7\xb7\x0d\x4d\xbc\xab\x84\x4e\x83\xc9\x1c\x81\xad\x13\x18\xcd\x84\xe3\x30\xf7\xf6\xe6\xde\xde\x4e\x8e\xea\x8a\x76\xe5\xe8\xce\xd1\x9d\xa3\x3b\x8b\xee\x6a\x35\xee\x97\xf3\xc9\xfd\x72\x76\x7f\x06\x0f\xfa\xc3\x5a\xf5\xef\xe0\xec\x2f\x85\xf4\x47\xfd\x00\x22\x00\x00")
 
 func templatesSchemabodyGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -310,12 +762,32 @@ func templatesSchemabodyGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/schemabody.gotmpl", size: 8544, mode: os.FileMode(420), modTime: time.Unix(1462143626, 0)}
+	info := bindataFileInfo{name: "templates/schemabody.gotmpl", size: 8704, mode: os.FileMode(420), modTime: time.Unix(1792230545, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSchemaregistryGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x54\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x58\x03\x1b\x62\xc0\x75\xee\x1d\x72\x28\xd0\xee\x30\x6c\xdd\xd0\x76\xbb\x14\x39\xb0\x36\x6d\x6b\xb5\x25\x4f\x92\x1b\x64\x41\xff\x7d\xa4\x64\xa7\x69\xb6\xf6\xb4\x53\x64\x8a\x7c\x7c\x7c\x7c\xca\x80\xe5\x03\x36\x04\xbb\x1d\x14\xdf\xa6\xf3\xd3\x53\x92\x2c\x97\x70\xdb\x2a\x07\xb5\xea\x08\x36\xe8\xa0\x21\x4d\x16\x3d\x55\x70\xbf\x05\xdf\x12\xb8\x0d\x36\x0d\x59\xf0\xc6\x74\x85\xe4\x5f\x56\xca\x2b\xdd\xf0\xe5\x5c\xd7\xab\xa6\xf5\x30\x58\xf3\x48\x50\x8f\x3e\x40\xb5\xa4\x61\x6b\x46\xb0\x74\x6a\x47\xfd\x02\x69\x6e\x01\xa5\xe9\x7b\xd4\x55\x92\xa8\x7e\x30\xd6\xc3\x22\x01\x48\x49\x97\xa6\x62\xfc\xe5\x4f\x67\x74\x2a\x91\xba\xf7\x69\x22\x87\x46\xf9\x76\xbc\x2f\xb8\x6c\xd9\x98\x53\x33\x90\xc6\x41\x2d\xdd\x40\xa5\xe4\x39\x6f\x39\xf3\xd5\xac\x70\x9b\xbe\x01\xc3\xec\xde\xb8\x7e\xc4\x4e\x55\x4c\x3a\x4d\xb2\xa0\xda\x4d\xd9\x52\x8f\x1f\x8d\x85\x1e\x07\x17\xe6\xd3\xd8\x93\x03\x53\x87\x8f\x8a\x6a\xa5\x59\x28\xa3\xf7\x21\x21\xca\x32\xca\x59\x59\xf8\x74\xf3\xf5\x0a\x5c\x40\xc9\x05\x70\xd3\xaa\xb2\x85\x12\xad\x55\xe4\xfe\x82\x50\x9e\x95\xac\xc9\x3a\x01\xa8\xb9\x2b\x07\xf8\x34\xb3\x02\xa3\x39\xc2\x9d\x36\x3a\x79\x44\x7b\xc0\x6e\x25\xfc\xee\x78\x7a\x96\x74\x1d\x7f\x76\xe2\x02\x8b\x9a\x2d\x50\xc4\x44\x27\x5e\x00\x09\x0f\x9c\xe0\x6b\x48\xdf\xfd\x4a\xa1\xb8\xe2\x81\xf8\xe6\x2c\xb8\xe6\xb3\xf2\xbc\xb5\x8e\xbf\x73\xfe\x24\x5d\x49\x4d\x74\xd0\x8f\x89\x45\x04\xdb\x93\x72\x80\x30\xe0\xb6\x33\x58\x01\x36\xa8\xb4\xf3\x61\xae\x83\xc9\x67\x69\x44\xba\xea\x60\xe0\x1c\x36\xbc\x05\x33\xc6\x82\xde\x54\xd4\xb9\x22\x9a\x95\xf6\x98\x6c\x3f\xe2\x2c\x76\x94\xc5\x4d\x40\xcd\x01\xa5\xe9\xdd\xfa\x7e\x2b\x9a\x58\x3e\x8b\x8b\x8a\x6b\xdc\x7c\x21\xe7\xd8\xf4\x79\x8c\x32\xc5\x91\x17\x42\x56\x31\xd7\xdf\xdc\x9a\xb5\x0c\xb4\x6a\x65\x9d\x0f\x9d\xce\x41\xab\x8e\x45\x6f\x14\xab\xb6\x3d\x18\x4a\x18\xf1\x06\x7a\x64\xbd\x85\xe5\x64\xbc\xe2\x82\x6a\x1c\x3b\x2e\xae\x47\x5d\x1e\x69\xb2\x90\x01\x21\xca\x9f\x3f\x0f\xa0\x59\xd2\x1a\x4b\xda\x3d\xe5\x7b\xc8\x09\xed\x7a\x6a\x9c\x01\x59\xcb\x9c\x77\xbc\x1f\x1e\x93\xf9\x3f\xc0\xd9\xea\x79\xc1\x77\x82\xbc\xe6\x4b\x55\xc3\x09\xdf\x49\x1e\x67\x92\x1f\xad\x06\x01\xba\x94\xf2\x7a\x91\x6a\x33\x6b\x2e\xf6\x79\xe9\x2f\xe0\x75\xe7\x61\x09\x19\x97\x8b\x15\xc4\x44\x53\xba\xd8\x76\xf2\x49\x6c\xc3\x84\x84\x42\x50\xf6\xbb\xee\xd1\xba\x16\xbb\x45\x14\x7d\xc1\x1c\xb3\x1c\xde\xc7\xda\xec\x43\x48\x3e\x59\x05\x2d\x5f\x50\xe3\x78\x68\x35\xf5\x62\xa9\xf0\x50\x0f\x79\xcf\x2c\x2e\xbf\x88\x41\x7a\x4d\x8a\x15\x0b\xbf\x1d\x28\x0b\x48\x25\x3a\x9a\x36\x7d\x16\x80\x5f\x65\x36\x30\x1f\xc1\xff\x17\x9b\x23\x3e\x71\xf8\x00\x7d\x64\x9c\xff\xdd\xa3\x8a\x6e\x89\xb0\x61\xfa\x55\xf8\x83\x2c\x6e\xcd\xc5\x96\x37\xa1\x4a\xf1\xe3\x62\xc8\x66\x95\xb8\xf5\x6c\x91\xd5\x21\xfa\x3e\x78\xe4\xc3\x69\x91\x53\xeb\xd9\xbe\xc5\x79\x7c\x88\x93\x2d\xa7\x3d\xe5\x81\xc1\xde\x83\x19\xbf\xeb\x3f\x3e\x9b\xd3\xa4\x31\x06\x00\x00")
+
+func templatesSchemaregistryGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSchemaregistryGotmpl,
+		"templates/schemaregistry.gotmpl",
+	)
+}
+
+func templatesSchemaregistryGotmpl() (*asset, error) {
+	bytes, err := templatesSchemaregistryGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/schemaregistry.gotmpl", size: 1585, mode: os.FileMode(420), modTime: time.Unix(1792229508, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesSchematypeGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x90\x31\xce\xc2\x30\x0c\x85\xf7\xff\x14\x56\xa7\xe4\x1f\x22\xae\x50\x16\xc4\x00\x0c\x70\x81\x40\x1c\xa8\xe4\x26\x55\x93\x0e\x55\xd5\xbb\xe3\xb4\x52\x15\x50\x07\x36\x98\xaa\xda\x9f\x5f\xde\x7b\xc3\x00\x06\x6d\xe5\x10\x8a\x70\x7b\x60\xad\x2f\x7d\x83\x05\x8c\x23\x2f\x2a\x0b\xda\x19\x10\xbe\x05\x21\x08\x1d\xa8\x92\xe8\x64\x25\xdc\x23\x6c\x24\xa8\x7d\x28\x9d\x77\x7d\xed\xbb\x20\x41\x80\xf3\x31\xcd\x0e\xba\x91\xf3\x7d\xc4\xba\x21\x1d\x17\xe9\xad\x37\x7d\x01\x6a\x5e\x22\x05\x7c\x7d\x26\xbf\xe7\xef\xb1\x23\xd2\x57\x4a\xd0\x7f\xe2\x19\x99\x70\xb5\xf3\xc9\xe3\xf4\xc3\xc3\x59\x6c\x5a\xfe\x65\x61\x0c\xb6\x68\x2d\x9a\xf3\x97\x42\x7d\xe6\x32\x32\x92\x39\xfc\xe1\xd6\x19\x67\x1f\x95\x0e\x68\x96\x5c\x2b\x93\xf5\x02\x16\x99\xb7\x26\x9e\x01\x00\x00\xff\xff\x36\x20\x79\xff\x7d\x02\x00\x00")
+var _templatesSchematypeGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcd\x50\x31\x0e\xc2\x30\x10\xdb\x79\xc5\xa9\x53\xc2\x10\xf1\x85\xb2\x20\x06\x60\x80\x0f\x04\x72\x81\x4a\xd7\xa4\x6a\xd2\x21\x8a\xfa\x77\x92\x56\x2a\x05\x75\x60\x83\x29\x8a\xcf\xe7\xb3\x1d\x23\x28\xd4\x95\x41\x28\xdc\xed\x81\xb5\xbc\x84\x06\x0b\xe8\xfb\x18\xa1\xd2\x20\x8d\x02\x66\x5b\x60\x77\x0f\x8c\xd0\x80\x28\x89\x4e\x9a\xc3\x86\x83\xd8\xbb\xd2\x58\x13\x6a\xdb\x39\x0e\x0c\x8c\xf5\x19\x3b\xc8\x86\x8f\xfb\x1e\xeb\x86\xa4\x9f\xa4\xb7\x56\x85\x02\xc4\x38\x44\x72\xf8\x7e\x66\xbe\x9f\xde\x63\x47\x24\xaf\x94\x49\xeb\xcc\x4f\x94\x81\x2e\x76\x36\x7b\x1c\x3e\x09\x1c\xc5\x86\xe1\x2a\xbe\xc2\x28\x6c\x51\x6b\x54\xe7\x1f\x85\xfa\xce\xa5\x4f\x94\x99\xc3\x3f\x6e\x3d\xd1\x93\x89\x4a\x3a\x54\x53\xae\x05\x64\xb9\x80\x49\xe6\xa3\x89\x27\xc6\xf8\x40\xfb\x7d\x02\x00\x00")
 
 func templatesSchematypeGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -330,12 +802,12 @@ func templatesSchematypeGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/schematype.gotmpl", size: 637, mode: os.FileMode(420), modTime: time.Unix(1462143626, 0)}
+	info := bindataFileInfo{name: "templates/schematype.gotmpl", size: 637, mode: os.FileMode(420), modTime: time.Unix(1792207052, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesSchemavalidatorGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5c\x4b\x73\xdb\x38\x12\xbe\xeb\x57\x60\x55\xd9\x2d\x31\xd1\xd2\x39\x6c\xed\x21\xd9\x6c\x95\x27\x71\x66\x5c\x93\xc4\xae\x38\xc9\x61\x52\xa9\x31\x2c\x41\x12\x12\x3e\x64\x82\x74\xac\x51\xe9\xbf\x4f\x83\x00\x41\x10\x04\x29\x52\xa2\xfc\x54\x2e\x21\x09\xa0\xd1\xfd\xf5\x03\xdd\x4d\x5a\xcb\xe5\x98\x4c\x68\x40\x50\x7f\x1e\x51\x9f\xc6\xf4\x0a\x6e\x89\x37\xbe\xc2\x1e\x1d\xe3\x38\x8c\xfa\xab\x55\x6f\xb9\xa4\x13\xe4\x7e\x24\x97\x09\x8d\xc8\x18\x1e\xc0\x2d\x89\x22\xf4\xe2\x15\x92\xf3\x88\x1a\x5d\x2e\x11\x8c\xe2\x60\x8c\x06\xe4\x12\xb9\xbf\x86\x9f\x16\x73\xa0\xce\xe2\x88\x06\xd3\xbe\x83\x06\x41\x18\x23\xf7\x98\x7d\x48\x3c\x0f\x5f\x78\xc4\x41\xab\xd5\x59\x3a\x08\x2b\x09\x2c\x5b\xad\x06\x82\x86\x7b\x8a\xe3\x19\xdc\xc2\x5d\x7e\x49\x3c\x46\x56\xab\x7e\x1f\xae\x02\xe0\x64\x88\x60\x14\x38\x0f\xe2\x09\xea\xff\xf3\xb2\x8f\xdc\x77\xe1\x08\xc7\x34\x0c\x90\x1c\x04\x42\x7c\xc7\x41\x18\xf1\x5d\x0f\x83\x30\x58\xf8\x61\xc2\x4c\x16\xf8\x26\x92\xd7\x94\x81\x94\xfa\x72\xe9\x7e\xc1\x5e\x42\x8e\xae\xe7\x11\x61\x0c\xa8\xa6\x13\x1b\x92\x74\x24\x15\xe7\x65\x0a\xd6\x3f\x5e\xa1\x80\x7a\x68\xd9\x43\x28\x22\x71\x12\x05\xfc\x69\x8f\x83\x2b\xc5\x96\x30\xbf\xa7\xc1\x3b\x12\x4c\xe3\x99\x1d\x67\x35\xdc\x1d\x4a\x42\x37\x19\xbd\x5c\x08\x18\x7c\xaa\xb8\xb3\x61\xe1\x70\xc2\x3a\xc3\x6b\x45\x4d\xd9\xc9\x04\xc5\xd7\xb5\x82\x66\xc3\x77\x47\xd0\x9c\xe1\x56\x82\x02\xb7\x31\x89\x02\xbb\x98\x72\xf0\x6e\x08\x79\x0e\xcf\x15\xb7\xe7\xed\xb4\x49\x03\xea\x27\x7e\xa5\xd1\xf2\x41\xc1\x13\x0f\x0b\x67\x3f\xf1\x74\x4a\x22\x11\x1b\x40\x12\x02\x37\x7d\xe0\xeb\x38\x88\x77\x16\x06\xea\xf6\xa5\x62\x5f\xa0\x0a\x37\x13\x2f\xc4\x39\x1b\xff\xfd\xcf\x36\x9e\x21\x30\x49\xef\x8e\xae\x47\x5e\xc2\x20\xc0\xaa\xc7\x6d\xdd\xa5\x06\x60\x31\xf8\xe8\x00\xce\x30\x31\x00\xce\x1e\xb7\x03\x38\xf1\x62\x3a\xf7\xc8\xc9\xa4\x02\x63\x35\xde\x1d\x70\x29\x12\xdb\x00\xa0\xf1\xdc\x4a\xd8\xa3\x20\x35\xa5\x83\x03\x2e\x5f\x42\x60\xa3\xc4\xd7\x84\x06\xd2\x1f\xc9\x88\x00\x96\xd1\x07\xec\x83\x40\x6e\x06\x03\x17\x07\xb3\x11\xdc\xfd\x45\x90\xcb\x07\x05\x02\xda\xc3\xb3\x64\x32\xa1\xd7\xf0\x98\x6f\xd2\xb5\x91\xb5\xc2\xa8\x29\x22\xd9\xff\x59\x2e\xc4\x3c\x3a\x22\x46\x0a\x84\xf4\x1c\x08\xd5\x27\x41\x9d\x0a\x6d\xca\x85\xda\xa6\x14\x3c\x4f\x81\x98\x73\x1c\x13\x9f\xa5\x71\x44\x5c\x09\xa9\xdc\xe3\x60\x4c\xae\xbf\xe0\xa8\xa4\x46\xa9\xdb\x33\x7e\x03\x42\x02\x87\x60\xa8\x1e\xe1\x47\x95\x05\x6a\xa7\x7c\x1e\xa4\xdb\x54\x1e\x08\xe9\x68\xb7\x40\x35\x11\x25\x0b\xcc\x92\xb9\xb6\x21\xb8\x4e\x26\x39\x7a\x5b\x32\x29\xe6\x5a\xc9\xf4\x39\xa0\x97\x09\xa9\x11\x4b\x9b\xd0\xa5\x64\x5b\x78\x6b\x31\x7e\x4d\xc0\xbc\x53\x7f\xdd\x3c\x7c\x75\x1d\xa7\x36\x95\x2d\x8b\x70\xd2\x3d\xc5\x6d\x5a\x65\xf0\x27\x79\xf0\x91\xf7\xbf\x61\xf6\x45\x88\x05\x7b\xb0\xec\xe9\x31\xfb\x05\x33\x22\x2b\x99\x1e\x47\x07\x18\xca\xac\x68\xb5\xe2\xf0\x3c\x7f\x69\x3c\xfb\x1f\xaa\xf4\x6b\x63\xea\xb3\x67\xc0\xfd\x72\xf9\x93\x02\x34\x6e\x66\x35\x08\xe5\x55\x9f\x1e\x9f\x45\xad\x97\xb1\xcd\x6b\x22\x98\x0a\xf3\x18\x24\x09\x30\xef\x0f\x12\x85\x83\x8a\x00\x87\x96\x08\x74\xcb\xd7\x47\x72\x39\x2c\x45\x68\x14\x06\x31\x0d\x12\x02\x37\x62\x5b\x61\x13\xfc\x0a\x78\x99\x7b\xa0\x61\x5e\xc9\x86\x73\x12\xc5\x8b\x3c\x80\x23\x57\x0b\xf3\x2b\x85\xb6\x19\xfe\x51\x16\xff\x7d\x3c\xd7\x16\xe7\xe1\x1f\x10\x3f\x1c\x8f\x29\xc7\x1b\x7b\xa7\x62\x1b\x4a\x72\x5d\xb9\xb6\xd1\x5b\x39\x34\x64\x8d\x5a\xa8\x4f\x37\xaa\x72\x0d\x0a\x2d\x8a\x5a\x91\xeb\xf5\xb6\xd0\xb7\x24\x09\x3b\xe8\x87\x9a\xe0\xad\x02\xeb\x0f\x84\x8c\x35\xaf\xd0\x5c\xc0\x3a\xfd\x77\xb2\x50\x5e\x11\xe1\x60\x4a\x2a\x0e\xdc\x54\x42\x18\x12\x76\x5f\x61\x03\xca\x0f\x0a\x66\xbf\x5b\xab\x97\x29\xd1\x69\xd6\xbc\xc9\x4d\x11\xf4\xe6\x51\x88\x04\x39\x64\x16\x75\xf6\x6c\x49\x15\x3c\x00\xe3\x1c\xa2\xf0\x87\x88\xa5\x36\x56\x5f\xf2\xd1\xa5\x96\x69\x14\x0c\xdb\x95\x1a\x20\x03\x00\xdf\xc7\x31\x5b\x6f\x2e\x25\x2e\x56\x7a\x16\xa3\xac\x09\x2e\x85\x9e\xdc\x43\xcf\x3b\x99\x14\x1f\x15\xb5\x01\xcf\xeb\x63\x42\x46\x3a\xdf\x44\x5d\x75\x40\x50\x79\x57\x1e\x18\x3f\x25\x90\xaa\xeb\xe6\xa3\x12\x31\xd0\xfa\xa7\x93\x37\x27\x2f\xb2\xa8\x00\x15\x3c\xc2\x6a\x1a\xa2\xe9\x3c\x36\x0b\x13\x6f\x8c\xa6\x21\x9a\x91\x08\x0e\x7d\x20\xbc\x08\x13\xc4\x08\x41\xf1\x8c\x32\x60\x9a\x02\x48\x38\x40\x94\x31\x30\x16\xa0\x89\x63\x34\x8b\xe3\x39\x7b\x71\x70\x30\x05\xcb\x4d\x2e\xdc\x51\xe8\x1f\x4c\xc3\x7f\x33\x51\xa6\xe9\x97\xe9\x22\xa6\x1d\x45\x12\x72\x43\x6a\x7b\x93\x90\x07\x58\x1d\xc0\x74\xad\x50\xe9\xeb\x84\xc5\xa1\xff\x36\xb5\x83\x98\x44\x26\xc5\x2b\xe5\xab\x62\xa2\x30\x18\x15\xb1\x73\x3a\x87\x51\x84\x17\xe6\x6a\x23\x51\x2f\xaf\x7a\x8f\xe7\xc6\x92\x62\x6c\x77\x8b\xfc\x8a\x96\xde\xeb\x10\x26\x93\xeb\x93\x8b\xef\x64\x14\x6b\x8a\x3b\xb6\x47\xff\xbd\xab\xed\x5d\x6d\x2b\x57\x13\xe1\x5c\xc4\x73\x09\x4c\xe9\xbc\x4b\x33\x5e\xc9\xff\x24\x0a\x7d\x04\x76\x5c\xc8\x78\x51\x21\xe5\x45\x37\x9d\xf3\x6e\x53\xa6\x9a\x8a\xd4\x2a\xf1\x30\xf5\x41\xbd\x14\xaf\x73\xb0\x4c\xff\x5a\xa6\xac\xec\xfc\xe6\x92\xaf\x0d\xd2\x7f\xcd\x1f\x6c\x31\xa2\x22\x29\x51\xf4\x6c\xb1\xc1\xc6\xc7\x3e\x58\x3c\x94\x60\xa1\xbf\xb7\x33\x05\xd6\xed\x68\x7d\x62\x98\x43\x67\xfa\x5a\x0a\xdc\x3e\x11\xd8\x3c\x11\x58\x0b\x6d\x65\xff\x71\x34\x23\x3e\x2e\x94\xa0\x96\x53\x80\x37\x3e\xd2\x89\xbd\x2b\xcc\x4b\x1c\x34\x82\xd0\x5e\x0a\xf2\xe8\xeb\x37\xde\x87\x8f\x26\x78\x44\x96\x50\x0d\x25\xc1\x08\x0d\x2c\xc7\x45\xb1\x6a\xd4\xed\xe6\xa9\x79\x14\x1d\x33\x08\x2a\x61\x14\x67\x72\x1a\xa7\x8b\x61\x34\x5a\x93\x58\x50\x71\xd0\xfa\x93\x69\x0e\xc1\x77\x88\xbc\x2c\xb0\x8a\x97\x5a\x43\xd9\xac\x2e\x40\x3b\x06\x9f\x9b\x4c\xc8\xf8\x2c\x85\x82\xd7\xb6\x02\x5d\x87\xc7\x30\x5e\xfa\x89\xea\xab\x12\x9e\x57\x79\xec\x43\x88\x03\x09\xd1\x12\x30\x6b\xb4\x47\xba\x26\x0f\xb9\xdf\x59\x18\xb8\x9f\x03\x1f\x47\x6c\x86\xbd\xc1\xd7\x6f\x17\x0b\x08\xb5\xe7\x40\x8a\x8f\x28\xe5\x9d\x3b\x43\xf4\x2f\xd8\xa5\x1c\x7c\xf9\x3f\x2d\x00\xf3\x5b\xb1\x07\xd7\xf5\x9f\x20\x7e\x5e\xa7\x72\x2e\xb3\x25\xd5\xb2\x21\x3c\x9f\x03\xe8\x83\xaa\x19\x40\xd2\x51\xdb\xc8\x3a\xd5\x3c\x22\x2d\xfa\x90\x8a\x18\x56\xee\x6c\x13\xad\x20\xd8\x6a\x4d\x79\x2f\x7a\x4b\x12\xb0\x2a\xf3\xce\xe7\x34\xb5\xf1\xa7\x82\xfa\x93\x1a\x13\x7e\x62\xb3\x61\xf9\xb4\x85\x15\x2b\xde\xb6\x35\xe5\xec\x2c\x6a\x60\xcf\x39\x1e\x1b\x1b\xb5\x3a\xf9\xda\x59\xb6\xae\xad\x1b\x31\x6f\x4d\xd4\x3a\x1b\x57\xd3\x3a\x37\x74\x8d\xf6\x76\xc6\x5e\xdf\x52\xb2\x87\x7d\x2d\x05\xe1\xc7\x3f\xab\x3c\x00\x44\x06\xb8\x81\x87\xec\x38\xc6\x2b\xbe\x6e\x26\xd0\xe7\x30\x3c\xd0\x68\xaf\x09\x58\xe7\x0e\x6a\xda\x2e\xe2\xbe\x22\xbe\x85\x3f\x68\x57\x60\xea\x59\xb5\xa2\x78\x62\x22\xa1\x86\x49\xb3\xc4\xc7\x81\xbe\xbb\x32\x69\xe3\xcd\x8f\x4c\x6b\x44\x3e\xa7\xd2\xb7\x52\x62\x57\xe1\x7f\xdd\xa7\x3e\x66\x05\xc6\x2d\x7e\xe2\xc7\xc0\xf5\x94\xc2\xe5\x42\xb7\xe6\xdc\x3e\xd3\x67\xa2\x41\x60\x96\x59\xd2\x24\xa4\x8c\x79\xe5\x6b\xbc\x1d\x52\x33\xad\x75\x41\xb3\xc4\x5e\x52\xe8\x26\xa7\x2f\xd1\x6a\x9c\xd7\x97\x56\x36\xca\xed\x25\x4e\xd2\xba\xe4\x6d\xa9\x9e\xd4\x61\x4a\x3f\x62\x0c\x78\x46\xf0\x86\xb2\x11\xc7\x25\xe0\xf4\xde\x72\x60\x84\x6a\x1d\xf1\x11\x60\x15\xe8\x4e\x39\x68\xb4\x7c\x33\x59\x5d\xa7\x0b\xa7\x62\xb9\xbf\xc3\xcd\x90\x4f\x72\xb4\x40\x92\xbf\x29\xd0\x65\x2f\xbe\x41\x58\x57\x06\xaf\x8a\xe1\xa1\x75\xa3\x49\xbc\x39\xae\x91\xa3\x52\x0a\xdb\xbb\x8e\xaa\x0f\x2f\xb3\x77\x9e\x8e\x68\x98\xd4\x30\x5b\x60\x72\x30\x06\xcd\x9f\xe2\xd1\x0f\xcc\xcd\x40\xbc\x1a\xe3\x24\x1a\x34\x4a\xd6\x32\xae\xc3\xad\x5f\x6f\xe7\x80\xdd\xb9\xdf\xa6\xce\xb7\x89\xeb\x15\x1c\xaf\xca\xed\x3a\x75\xba\x9d\xb8\x1c\x9c\x49\x3c\xdf\x6a\x67\xb6\xf7\xd5\xd5\x52\x56\xd3\x9c\x62\x60\x36\x05\x1c\x54\xfa\x76\x6c\x2b\xc6\xd3\x54\xa3\xdf\x1f\xa2\xfe\x45\x38\x5e\xf4\x87\x36\x0a\x5b\x78\xa0\x60\x8e\x7f\xe2\xc0\xf3\x30\xf4\x7f\xf4\xbc\x94\x95\x84\x11\x73\x79\x52\x10\x32\x1a\x93\xdc\x9a\x8e\xf8\x08\x5f\xe5\xba\xae\x63\xcf\x5c\x6c\xb6\xac\x3e\xbb\xaa\x32\x52\xb3\x9f\x63\xe6\xef\xaa\xdc\xe4\x01\xc9\x0a\x19\xdf\xcf\x92\xd8\x4b\x0b\xd5\x35\x73\x17\xaa\xe1\xdd\xa7\xfa\x2d\x10\x7b\x60\x35\x40\x1b\xc9\x6d\xc5\x41\xf3\xf5\x5d\x56\x0d\xcd\x77\xdd\x6d\x2f\xe9\x89\xfd\x1b\xd6\x7d\x87\x69\x23\x94\x1e\x6c\xdf\xa9\x31\x00\xb5\x1e\x56\xbf\xb8\x7b\xf7\xb2\x97\xa8\x1d\x35\xae\x8a\xdf\xbb\xc9\x04\xc6\xfe\xbc\xf3\x63\xee\x2e\xf8\x5f\x17\xae\x57\x7e\xe5\x78\xcb\x47\x9c\xe5\x1d\x68\x3b\x8f\xac\xd0\xfc\xfe\x04\xbc\xb5\x13\x70\xe3\x4e\xb3\xd1\x65\x96\x53\xb5\xcc\xb2\xdd\x59\xba\x71\x2f\xfa\x06\x7c\xf9\x86\xfa\xd1\x4d\x01\x7a\xa8\x19\xea\x3a\xb1\xdb\x1f\x9e\x3b\xe9\x68\x37\xdc\xb2\x9b\x3e\x37\xfc\xeb\xae\xf1\x51\xd5\x04\xbf\x39\x4f\x6a\xd2\xd7\x5e\xee\xe4\x2b\x69\x0d\xe9\x0d\xfe\x3a\xc0\x50\x95\xae\x29\xf3\x2b\x0d\xbd\x03\xbf\xaa\x6d\x01\x74\xa2\xd3\xdd\x76\x0a\x38\x14\xfb\x3e\xc1\xbe\x4f\xf0\xd8\xb3\xa4\xdd\xf4\x09\xee\x84\x53\xed\x1b\x05\xfb\x46\xc1\x63\x6d\x14\xdc\x09\x07\xdc\x77\x0a\xf6\x9d\x82\xfb\x70\x06\x6e\xd6\x29\xe8\xa6\x0b\xd0\xa4\xdf\x70\x17\x7c\x79\xdf\x29\xd8\x77\x0a\x6e\xad\x53\x70\x5f\xca\xfb\xb6\xd5\x76\xaf\xae\xdc\xa6\xe6\x1f\xde\xeb\x3f\x5f\xd2\x22\x87\x78\x54\xef\xf4\x5a\xa5\x0b\x1d\xa6\xe7\xf7\x32\x2b\x68\x98\x9d\xef\x34\x0f\xef\x30\xe5\xae\x0d\x13\x65\x9b\x6b\x62\x92\xcd\xbb\x7a\xbc\x9b\x65\xea\x30\xef\x6e\x99\x23\xb6\x8f\x54\xc5\xef\x35\x14\x7e\xf9\x66\xdd\xcf\x33\xb8\xd5\x9c\x4b\xe8\xd6\xc5\x24\xab\xdd\x9a\xdf\xee\x19\x70\x17\x03\xd5\xdf\x01\x00\x00\xff\xff\xf9\x3a\xe6\xc4\x4a\x53\x00\x00")
+var _templatesSchemavalidatorGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x5c\x5b\x73\xdb\x36\x16\x7e\xd7\xaf\x40\x35\xda\x8c\x18\x6b\xe9\x4e\xa7\xd3\x87\xa4\xd9\x19\x37\x71\x5a\xef\x26\xb6\xa7\x76\xfb\xd0\x4e\x67\x03\x4b\x90\xcc\x9a\x17\x85\x20\x63\x6b\x35\xfa\xef\x7b\x70\x21\x08\x82\x20\x45\x4a\x94\x6f\x51\x1e\x1c\x92\xb8\x9d\x73\x70\xae\x1f\x48\x2d\x97\x13\x32\xf5\x42\x82\xfa\xf3\xd8\x0b\xbc\xc4\xfb\x02\xb7\xc4\x9f\x7c\xc1\xbe\x37\xc1\x49\x14\xf7\x57\xab\xde\x72\xe9\x4d\x91\xfb\x2b\xf9\x9c\x7a\x31\x99\xc0\x03\xb8\x25\x71\x8c\x5e\xbd\x41\xb2\x1f\x51\xad\xcb\x25\x82\x56\x1c\x4e\xd0\x90\x7c\x46\xee\xcf\xd1\xe5\x62\x0e\xb3\xd3\x24\xf6\xc2\x59\xdf\x41\xc3\x30\x4a\x90\x7b\x42\x4f\x53\xdf\xc7\x57\x3e\x71\xd0\x6a\x75\xc1\x1b\x61\x24\x81\x61\xab\xd5\x50\xcc\xe1\x9e\xe3\xe4\x1a\x6e\xe1\x2e\xbf\x24\x3e\x25\xab\x55\xbf\x0f\x57\x21\x50\x32\x42\xd0\x0a\x94\x87\xc9\x14\xf5\xff\xf1\xb9\x8f\xdc\x0f\xd1\x18\x27\x5e\x14\x22\xd9\x08\x13\xb1\x15\x87\x51\xcc\x56\x3d\x0a\xa3\x70\x11\x44\x29\x35\x49\x60\x8b\x48\x5a\x39\x01\x7c\xf6\xe5\xd2\xfd\x1d\xfb\x29\x39\xbe\x9b\xc7\x84\x52\x98\x95\x77\x6c\x38\xa5\x23\x67\x71\x5e\x73\x61\x7d\xf3\x06\x85\x9e\x8f\x96\x3d\x84\x62\x92\xa4\x71\xc8\x9e\xf6\x98\x70\x25\xdb\x62\x66\x98\xb4\x20\xb8\x90\x24\xee\xc9\x39\x08\x8e\x91\xc2\x45\x4d\xdf\x47\x71\x80\x13\x64\xdf\x07\xd1\x78\x36\xed\x54\x88\x85\xc6\x8b\x5b\x3c\x9b\x91\x58\x51\xc1\x7b\x98\x82\x82\xe7\xae\xd8\xd6\xa1\x33\x42\x53\xde\x97\x36\x95\x44\x4f\xd2\xfe\xd1\x0b\xdf\x01\x4f\x92\xd3\xc4\x0b\x88\x7b\x09\x7f\x32\xd6\x72\x71\x43\x8f\x97\x9a\x1c\xcb\xbb\xe6\xb8\x3f\x11\x20\x82\x0f\x65\xf3\x7c\xf0\x12\x12\x63\x5f\x5f\xc2\x31\x08\x8a\x62\xea\x9e\x92\xdb\xe1\xf7\xdf\x7d\x37\x02\xce\x29\xf2\x42\x04\x7f\xe9\x75\x94\xfa\x13\x74\x45\x10\x30\x0f\x9b\x85\xa7\x30\x13\x34\xf4\x33\x6d\xdb\x81\xc4\x35\x22\xcb\x0a\xe3\x7e\xc4\x77\x9d\x49\xe9\x88\x71\x53\x16\x92\x5a\x61\x43\x21\x5d\x71\xe1\xef\x5a\x4a\x39\x95\x45\x29\x49\x55\xfa\x40\xc2\x59\x72\x6d\xb7\x1a\xd5\xdc\x9d\xd9\x08\x8f\xb7\xc9\x2e\xb0\x89\x75\x82\xd7\x9a\x0d\x27\x47\x7a\x69\x90\x42\x2d\xa3\x59\xf3\xe3\x61\x34\x27\xb8\x15\xa3\x40\x2d\xa8\x67\x68\x67\x53\x36\x3e\x0e\x26\x3f\xc1\x73\x45\xed\xa7\x76\xbb\xe9\x85\x5e\x90\x06\x95\x4a\xcb\x1a\x05\x4d\x2c\x66\x48\xcf\x2c\x02\x07\x70\x42\xe0\xa6\x0f\x74\x9d\x84\xc9\xce\x82\x6b\xdd\xba\x9e\x58\x17\x66\x85\x9b\xa9\x1f\xe1\x9c\x8c\x1f\xbe\xdf\xc6\x32\x84\x4c\xf8\xdd\xf1\xdd\xd8\x4f\x29\xa4\x2d\xea\x71\x5b\x73\xa9\x11\xb0\x68\xfc\xea\x04\x9c\xc9\xc4\x10\x70\xf6\xb8\x9d\x80\x53\x3f\xf1\xe6\x3e\x39\x9b\x56\xc8\x58\xb5\x77\x27\x38\x2e\x89\x6d\x04\xa0\xd1\xdc\x8a\xd9\xe3\x90\xab\xd2\xe1\x21\xe3\x2f\x25\xb0\x50\x1a\x68\x4c\xc3\xd4\xbf\x92\x31\x01\x59\xc6\xa7\x38\x00\x86\xdc\x4c\x0c\x8c\x1d\x4c\xc7\x70\xf7\x3f\x82\x5c\xd6\x28\x24\xa0\x3d\xbc\x48\xa7\x53\xef\x0e\x1e\xb3\x45\xba\x56\xb2\x56\x32\x6a\x2a\x91\xec\xff\xac\xc2\xa0\xbe\x37\x26\x46\x61\x81\xf4\xca\x02\xd5\x97\x16\x9d\x32\x6d\x49\x58\x5b\xa4\xa7\x22\x51\x67\xae\xe8\x24\x21\x01\xe5\x7e\x44\x5c\x09\xae\xdc\x93\x70\x42\xee\x7e\xc7\x71\x69\x1b\xe5\xde\x5e\xb0\x1b\x60\x12\x28\x04\x45\xf5\x09\x0b\x55\x16\x51\x3b\xe5\x78\xc0\x97\xa9\x0c\x08\xbc\xb5\x5b\x41\x35\x61\x25\x73\xcc\x92\xb8\xb6\x2e\xb8\x8e\x27\xd9\xfa\x50\x3c\x29\xe2\x5a\xf1\xf4\x5b\xe8\x7d\x4e\x49\x0d\x5b\x5a\x87\x2e\x39\xdb\xc2\x5a\x39\x09\x11\xf5\xd8\x6c\xd8\xcf\x48\x07\x57\x36\x57\x0f\x91\xc7\x9e\xda\xd8\x39\x9a\x61\x2f\xa4\xc9\xc5\xf8\x9a\x04\x78\xf8\x82\xf3\x50\x9c\x4b\x08\x1b\x12\x29\x08\x65\xee\x65\xf4\x6e\x11\xe2\xc0\x1b\xff\xfb\xe2\xec\x74\x58\xe1\x88\x9b\x16\x8d\x76\x07\x0c\xa3\x11\x77\x38\x9b\xfb\xdf\xae\x1d\xed\xa6\x9b\x93\xb9\x68\xe9\x5f\x14\x4e\x20\x9e\xe4\xde\x53\xde\xff\x82\xa9\x84\x0a\x60\x0d\x9a\x3d\x3d\xa1\x3f\x61\x4a\x24\xc0\xd1\x63\xd2\x01\x82\x32\x33\x58\xad\x98\x78\xbe\x7d\x6d\x3c\xfb\x11\x55\x3a\x26\xa3\xeb\xc1\x01\x50\xbf\x5c\xde\x7a\x20\x1a\x37\xd3\x1d\x84\x72\x30\x48\x0f\x30\x02\x02\xca\xc8\x66\x50\x09\x74\x85\x7e\x5c\x35\x4e\xe8\x1f\x24\x8e\x86\x15\x1e\x1a\x2d\x11\xec\x2d\x1b\x1f\xcb\xe1\x30\x14\xa1\x71\x14\x26\x5e\x98\x12\xb8\x11\xcb\x0a\x9d\x60\x57\x40\xcb\xdc\x67\x65\x61\x7f\x1e\x47\x73\x12\x27\x8b\x3c\x02\x21\x57\x8b\x53\x2b\x25\x6d\x33\x7e\xa1\x2c\x80\x05\x78\xae\x0d\xce\xe3\x17\x48\xfc\x68\x32\x91\xda\x7e\x2e\x96\xf1\x48\xbe\x57\xae\xad\xf5\x41\xa2\x9e\x84\xae\x0a\xb0\xd5\x46\xe0\x97\x31\x43\x0b\xac\x4b\x24\xab\xbd\x2d\xf6\x5b\x4e\x09\x2b\x58\xe1\x33\xf7\x3f\x64\x21\xcb\x2d\x7e\x2d\x81\x2a\x85\x9f\xc1\x23\x9a\xd9\xc0\x0d\x13\x79\x8c\xc3\x19\xa9\xc8\x09\x38\x0f\x72\x07\xcc\x09\xb8\xd2\xca\x6d\xbb\x51\xad\xc3\x4a\xbf\xa5\x28\x97\xf3\x31\x81\x4f\x8e\x39\x88\x02\xd3\xc1\xa2\x90\xdf\xe5\x96\xcb\x36\xb9\x7a\xef\x0f\xfa\x6e\xff\x40\xe7\x9d\x71\x2a\x00\x37\xbe\xd7\xb0\xb4\x93\x4b\x1b\xee\xf2\xec\x25\xb3\x91\x22\x8a\xa4\x09\xcd\x60\xad\x79\x51\xcd\x89\xba\xc9\x97\x65\x8a\x29\x57\x59\xa3\x9a\x37\xbc\x4a\x36\xa8\xf8\x54\x23\x42\xa6\x4f\x95\x8c\xe4\x00\xa9\x95\x8f\xf5\x10\xe9\xe6\x8c\x98\x8d\x3a\x35\x9c\xcd\xf5\xda\x61\x61\xad\xac\xe7\x15\x3e\xe5\x94\x90\x89\xe6\xfd\x35\x57\x6f\xed\x0e\xd4\x29\xef\xdf\xc4\x0a\x84\x7f\xaf\xf0\x75\xca\x50\x0a\xee\x7d\xb7\xde\x5d\xd6\x2e\xe7\xd9\xd9\x45\xae\x06\xe0\x9f\x7c\x0f\x22\x5e\x2e\x32\x8b\xdb\xea\xd9\xaa\x1f\x78\x00\xaa\x32\x42\xd1\x8d\xc8\x19\x6c\xa4\xbe\x66\xad\x4b\xad\x24\x28\xa8\x59\x03\x5f\xd0\x9d\x27\xa8\xd0\xd2\x1a\x07\x60\x67\x7b\x55\x80\xdf\x33\x37\x0d\x97\x42\x31\xdc\x23\xdf\x3f\x9b\x16\x1f\x15\xb7\x9f\xa1\xc6\xb5\xc1\x36\x9b\x3a\x5f\x44\x5d\x75\x30\xa1\x0a\x5b\x79\xc6\x71\x99\x42\x11\xaf\xeb\xab\x2a\xd1\x40\xcd\x2e\xcf\xde\x9d\xbd\xca\x9c\x02\x73\x9b\x58\x75\x13\x79\x6e\x86\x62\xcf\x22\x74\x4d\x62\x28\x07\x60\xe2\x45\x94\x22\x4a\x08\x4a\xae\x3d\x0a\x44\x7b\x20\x24\x1c\x22\x8f\x52\xd0\x4e\x98\x13\xcc\xfc\x3a\x49\xe6\xf4\xd5\xe1\xe1\x0c\x4c\x25\xbd\x72\xc7\x51\x70\x38\x8b\xfe\x49\x05\x80\xa3\x5f\xf2\x41\x54\xcb\xf1\xa4\xc8\x0d\xae\xed\x87\x72\x2c\x73\xd1\x05\xc8\xc7\x8a\x2d\x7d\x9b\xd2\x24\x0a\x84\xd3\x61\x47\x13\xc6\x8c\x5f\x94\x5a\x89\x8e\x42\x43\x55\x2a\x94\xcf\x73\x14\xc7\x78\x61\x8e\x36\x4a\xf8\xf2\xa8\x8f\x78\x6e\x0c\x29\x26\x4d\x6e\x91\x5e\x71\x84\xf6\x36\x82\xce\xe4\xee\xec\xea\x6f\x32\x4e\xb4\x8d\x3b\xb1\xa7\x55\x7b\xdb\xde\xdb\xf6\xd3\xb2\x6d\x11\xb0\xea\x23\x3a\xaf\x5d\x25\xfd\xd3\x38\x0a\x10\x18\x4e\xa1\x76\x45\x85\xe2\x15\xdd\x77\xf5\xba\x0d\x62\x66\x6e\xa4\x06\x0a\x46\xdc\xe8\x75\x54\xb0\xce\xa2\xb3\xfd\xd7\x6a\x5e\xa5\xe7\xf7\x57\x46\x6d\x50\xc8\x1b\x27\xdb\xa6\x53\xaa\x48\xbb\xd4\x7c\x36\x67\x64\xa3\x63\xef\x9d\xf6\xde\x69\x43\xef\xb4\xd4\xde\x04\x32\x19\xd6\x15\x77\x7d\xae\x9d\x8b\xce\x34\x6e\x2e\xb8\xb2\xbb\x0b\x31\x28\xe0\x2d\x0c\xdf\xee\xbc\xe4\xd1\x1f\x8c\x68\xde\xf0\x11\xe5\x7a\x52\x65\x45\xbe\xa7\xed\xe5\xd0\x74\xc1\x4e\xa3\xed\x7d\xaa\x59\xe4\x5a\xad\xad\x3c\xd6\xe2\xa0\x77\x01\x18\xb4\x44\x74\x06\x47\xf3\x8e\xbd\x2f\x98\x15\xe4\x68\x0c\x4a\x5b\x52\x5f\xf4\xe7\x5f\xec\x78\x37\x9e\xe2\x31\x59\x42\xed\x9e\x86\x63\x34\xb4\x84\xfe\x22\x96\xa7\x9b\xe4\x4b\x33\xad\x38\xa1\xa0\xa3\x51\x9c\x64\x7c\x1a\x76\x63\xa8\xa3\x76\xf6\x28\x66\x71\xd0\x7a\x9b\x9b\x83\x85\x8d\x90\x9f\x59\x8f\x78\x57\x62\x24\xcf\x40\x0b\xa2\x9d\x80\x3b\x9b\x4e\xc9\x44\x1c\x14\xb0\xe0\x23\xa4\xeb\x88\x97\x89\xb8\xd1\x88\x70\x68\x17\xcf\x1b\x1d\x2f\x61\x82\x04\xe3\x03\x99\x35\x5a\x83\x8f\xc9\x9d\xcb\xdf\x34\x0a\xdd\xdf\xc2\x00\xc7\xf4\x1a\xfb\xc3\x3f\xff\xba\x5a\x40\xd8\x64\x28\x14\x6b\x51\x9b\xf7\xc9\x19\xa1\x17\xb0\x8a\x0d\xb0\x31\x20\x1b\x01\x4f\x20\xbe\xd7\xff\x05\xf6\x73\x54\x85\x51\x99\x0d\xa9\xe6\x0d\xe1\xf9\x1c\x84\x3e\xac\xea\x01\x53\x3a\x6a\x99\x0a\x6c\xcb\xb2\x1f\x72\x23\x46\x95\x2b\x37\xc2\xa2\xea\x40\x57\x81\xf8\x4b\x81\x55\xa9\x77\xde\xa7\xa9\x8e\xbf\x14\xb3\x0f\x6a\x54\x78\x60\xd3\x61\xf9\xb4\x85\x16\x2b\xda\xb6\x55\xe5\x2c\xcc\x37\xd0\xe7\x5c\x1e\x1b\x2b\xb5\x4a\x2a\xda\x69\xb6\xbe\x5b\xf7\xa2\xde\x1a\xab\x75\x3a\xae\xba\x75\xae\xe8\xda\xdc\xdb\x29\x7b\x3d\x00\x6a\x77\xfb\x5a\x76\xc7\x32\x2b\x5a\x19\x00\x44\x42\xb1\x81\x85\xec\xd8\xc7\x2b\xba\xee\xc7\xd1\xe7\x62\x78\xa6\xde\x5e\x63\xb0\xce\x1c\x54\xb7\x5d\xf8\x7d\x35\xf9\x16\xf6\xa0\x5d\x81\xaa\x67\x95\xa7\xa2\x89\x8a\x5a\x05\x3a\x5d\xa7\x01\x0e\xf5\xd5\x95\x4a\x1b\xf9\x3d\xd2\xce\xb6\xf3\xf4\xad\x94\xd8\x55\xd8\x5f\xf7\xa9\x8f\x59\x4d\x33\x8d\x9f\x06\x09\x50\x3d\xf3\xe0\x72\xa1\x6b\x73\xae\x9f\xfc\x99\x00\x7b\xcc\x0a\x56\xaa\x84\xe4\x31\x47\x31\x8c\x33\x7b\xd5\xd3\x5a\x72\x35\x2b\x19\xe4\x0c\xdd\x54\x0b\xa5\xb9\x1a\xe7\xf5\xa5\x91\x8d\x72\x7b\x29\x27\xa9\x5d\xf2\xb6\x54\xaa\xeb\x62\xe2\x5f\x9c\x84\x2c\x23\x78\xe7\xd1\x31\x93\x4b\xc8\xe6\x7b\xcf\x04\x23\xb6\xd6\x11\x5f\x6c\x54\x09\xdd\x29\x3b\x8d\x96\xf5\x67\xdd\xc9\x2f\xe2\xba\xa1\xec\x1d\x6e\x04\x50\xa2\x39\x92\xfc\x5c\x4b\xe7\xbd\x78\xde\xb5\x0e\x61\x30\x8e\x3c\x5b\x83\x86\xe2\x85\xa4\xda\x33\xca\x0a\x2e\x6c\x27\x73\x55\x5f\xc9\x64\x6f\xa2\x38\x02\xfc\xaa\x21\xb6\x40\xe4\x70\x02\x3b\x7f\x8e\xc7\x37\x98\xa9\x81\x78\x61\x81\x4d\xd1\xf0\xe8\xbd\x96\x70\x5d\xdc\x85\x6b\xa9\x74\xc7\xc1\x15\x99\x4c\xc8\xa4\x74\xac\x59\x87\x37\x16\xc0\x05\xc9\xd2\x08\xf5\xaf\xa2\xc9\x42\x7c\x08\x31\xb0\x31\xac\xd6\xca\xee\x7f\x8e\x64\xeb\xb6\x7c\x49\x5e\x4e\xa3\xa4\xc8\x46\xf9\xd0\x55\x84\x66\xd1\x7f\xe0\x8d\xd0\x20\x64\x14\x00\x9f\x9c\x8d\xfc\xcd\x96\x81\xc7\xd2\x90\x17\x2f\xf2\x95\xbe\x31\xce\x79\x07\xf5\x7b\x2a\x26\xe6\x2f\x90\xc8\x09\xea\xf8\x2a\x7c\x6e\x52\xfa\xfe\x83\x50\xca\x34\x83\xbf\x36\x69\xe5\x5f\x64\x6f\xd2\x7f\x2c\xde\x46\x69\x98\x28\x01\x50\x92\xa0\xb9\xf2\x2c\x3d\x76\xea\xcc\x9a\xd9\x6b\x51\x3d\x15\xe4\x59\x27\x15\xe6\x21\x87\x88\x22\x7f\xd9\x42\x50\xa3\x2e\xc5\x94\x09\x8a\x1d\xad\x03\x59\x99\x9b\xe1\x64\x1f\x1c\x14\xf2\x85\xfc\x43\x21\x61\x70\x82\xb5\x7f\x71\x8b\x13\x0f\xd7\x09\xfd\x32\x8a\x3e\xe2\x70\x91\xbb\xde\x61\x56\x9b\xe5\x40\xdb\x60\x0d\x2e\x6c\xec\xd7\xa0\x7c\xc0\x20\x68\x29\xef\x5e\xfe\x39\x98\x4e\xff\x8f\x62\x8c\x17\x36\xa3\xff\x3d\xb9\xdd\x35\xf9\x9c\x14\x2b\xf9\x9a\x16\x6e\x17\xd2\xbb\x0b\xe8\x9b\x86\xf3\x4d\x82\x79\x21\x94\x57\x05\xf2\x4e\xc3\xf8\x4e\x82\x38\x7b\x19\x16\x68\x6e\x17\x08\x9f\x6a\xf0\xe6\xa4\xf2\x2a\x65\x68\xc2\x8c\x0e\x2a\x7d\xe4\xb0\x15\xe1\xbc\x78\xe9\xf7\x0b\xa1\xd1\x9c\x61\x8b\xd8\x27\x88\x63\xaf\xb2\xb2\xca\x0e\xfc\xde\xb7\xa5\x3a\x87\xf9\x08\x56\x66\xb0\x77\x96\x49\xae\x4d\xfc\x60\x8c\x8d\x72\x5d\xd7\xb1\xd7\x42\x36\x5d\x56\xdf\x07\x54\x29\xa9\x89\x10\x9b\x88\x80\x02\xb0\x58\x8a\x63\x15\x19\x5b\xcf\x02\x15\x48\x0d\xd5\x77\xe6\x31\xe0\x6b\xbb\x07\x0f\x5a\x48\xec\x99\xa1\x0a\x6d\x38\xb7\xc1\x0d\xcd\xc7\x77\x89\x43\x34\x5f\x75\xb7\xe8\xf4\xc0\xfe\xb1\xd5\x1e\xb3\xde\x48\x4a\xcf\x16\xc9\x6e\x2c\x80\x5a\x0b\xab\x1f\xdc\xbd\x79\xd9\x41\xaf\x8e\xa0\xf0\xe2\x77\x0d\x32\x81\xb1\x3f\xef\x3c\xcc\x3d\x97\x98\x56\x7e\x3f\xe4\x81\x43\x9c\xe5\x85\x95\x76\x16\x59\xb1\xf3\xfb\x08\xf8\x60\x11\x70\xe3\xb3\x2b\xe3\xdc\x4a\x76\xd5\x32\xcb\x76\xb1\x74\xe3\xd3\xad\x7b\xb0\xe5\x7b\x3a\xe1\x6a\x2a\xa0\xe7\x9a\xa1\xae\x63\xbb\x7d\xf0\xdc\xc9\x19\x59\xc3\x25\xbb\x39\x39\x83\x7f\xdd\x01\x1f\x55\xc7\x6a\xf7\x67\x49\x4d\x4e\xca\x76\xf3\x95\x90\x26\xe9\x0d\xbe\x02\x35\xb6\xaa\xf0\xbe\x9d\xf1\xde\x97\x7e\xa6\xb7\xaa\x85\x00\x3a\xd9\xd3\xdd\x22\x05\x4c\x14\x7b\x9c\x60\x8f\x13\xec\x71\x82\x5d\xe0\x04\x7b\xa0\x60\x0f\x14\xec\x81\x82\x07\x04\x0a\xf6\x48\xc1\x1e\x29\xd8\xc7\xc0\x9d\x22\x05\xdd\xa0\x00\x4d\xf0\x86\x3d\x52\xb0\x47\x0a\xbe\x6a\xa4\xe0\xa9\x94\xf7\x6d\xab\xed\x5e\x5d\xb9\x5d\xfa\x81\x25\xfd\x77\xf6\x5a\xe4\x10\x5f\xd5\x99\x5e\xab\x74\xa1\xc3\xf4\xfc\x49\x66\x05\xcf\xec\x33\x95\x5a\x37\x51\xd6\xb9\x26\x2a\xd9\x1c\xd5\x63\x68\x96\xb9\x87\x39\xba\x65\xb6\xd8\x5e\x7b\x17\xbf\x57\x54\xf8\x89\xc6\x75\x3f\x4f\xe4\x56\x53\x2e\x45\xb7\xce\x27\x59\xf5\xd6\x7c\x83\xd4\x10\x77\xd1\x51\xfd\x1f\x0c\xa6\x5b\x2f\x49\x61\x00\x00")
 
 func templatesSchemavalidatorGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -350,7 +822,27 @@ func templatesSchemavalidatorGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/schemavalidator.gotmpl", size: 21322, mode: os.FileMode(420), modTime: time.Unix(1466476480, 0)}
+	info := bindataFileInfo{name: "templates/schemavalidator.gotmpl", size: 24905, mode: os.FileMode(420), modTime: time.Unix(1792242422, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesScrubGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd5\x56\x51\x6f\xda\x30\x10\x7e\xe7\x57\xdc\xd0\x84\x48\x85\xd2\x3d\x6f\xe2\x01\xb5\xdd\xd4\x87\xb5\x55\xdb\xa7\x55\x7d\x30\xc9\x05\x3c\x1c\x3b\x73\x1c\x5a\x86\xf8\xef\x3b\xc7\x0e\x04\x48\x80\x76\xda\xc3\x2a\xd1\xc4\xf6\xf9\xee\xbb\xef\xbe\xb3\xb3\x5c\x42\x8c\x09\x97\x08\xdd\x3c\xd2\xc5\xb8\x0b\xab\xd5\x72\x09\x1f\x35\x46\xc8\xe7\xa8\xe1\xf3\x10\xc2\x7b\x3f\xb8\x61\x29\xd2\x7a\xe7\xfc\x1c\x1e\xac\x31\x68\x34\x85\x96\x39\x30\x88\x54\xb6\x00\x95\x80\x99\xf2\x1c\xc8\xc1\xb4\x48\x99\xe4\xbf\x11\x42\xbf\x09\x5e\xb8\x99\x02\x37\x39\xe4\x28\x73\x6e\xc8\x1f\x64\x5a\x65\xa8\x0d\xc7\x9c\x3c\xc5\x2c\x32\x18\x0f\x20\x51\x1a\x84\x9a\x4c\xb8\x9c\x00\xbd\xe2\x6b\xa6\xc8\x84\x06\xdc\x84\x14\xd9\x06\x7f\x9c\x62\xcd\x4b\x6e\x34\x2d\xe7\xf0\x32\xe5\xd1\x14\x98\xb6\x6b\x86\x1c\xb2\x18\xba\x4f\xf7\x57\x97\xa3\x8b\xc7\xab\xcb\xe7\xee\x80\xb0\x21\x28\xfa\xa7\x6b\x9b\xe7\x4c\x14\x14\xde\xee\x8a\x04\xd2\x23\x0e\xab\x08\x12\x73\x02\x04\xa9\x8a\x51\x38\x8b\x92\xa1\x31\xcd\x19\xa5\x06\x07\x52\xa5\x79\x81\x89\x81\x42\x1a\x55\x44\x53\xeb\x33\x29\x64\x04\xfd\x2d\x66\xc9\xf0\x8c\x26\x32\x96\x47\x4c\xd4\xf7\x07\x8e\xdd\x7e\xd0\xb2\x0e\xcb\x0e\x00\x4f\x60\xd7\xdb\x70\x08\x92\x8b\x72\x15\x7c\x69\xec\x04\x0d\x57\xf4\x5b\x83\xa7\x8a\x9e\xed\x6c\xa5\x65\x9a\xd1\x4c\x4e\x28\xca\x48\x88\xdb\xc4\xc9\x80\x82\x48\x65\x20\xbc\xce\x47\x52\xc9\x45\xaa\x8a\x9c\x16\x68\x16\x53\xf2\x14\xdb\x72\xa9\x99\x75\xc8\xa5\x41\x9d\xb0\x08\x97\xab\x7e\x6f\xc7\x79\x48\x63\x5b\xa3\xec\x8e\x45\x33\x66\x23\x7c\x53\x8f\x8b\x8c\x14\x67\xc5\x16\x84\xfd\xcd\xe6\xad\xc4\x2b\x33\xca\x6c\x15\x7c\xb1\x81\x5c\x66\x55\x22\x87\xfd\x02\x65\x59\xa1\x0c\xbd\x5b\xcf\x04\xed\x43\x19\xbb\x0c\xd7\x2f\x3e\xf9\xd2\xf2\x2b\x47\x11\xe7\x6b\x0a\x28\xfd\x87\xb5\x60\xd6\x93\xf7\x5e\xb1\x75\xb3\x9b\x42\x08\x36\x16\xe8\x48\xaa\x03\x6d\xa8\xe2\x87\xed\x72\x79\x6f\x44\xa6\xcd\xbd\xe6\x7d\x2f\xe9\x06\x5f\x43\xe8\x55\x1e\x6c\x92\x36\x31\x91\x9f\x0c\xc3\x06\xfc\x81\x5a\x55\xda\x3a\x21\xdc\x3e\xc6\x0d\x9b\x9e\x62\x0b\x80\xc2\x53\x0f\xd7\x99\xb1\x5a\xd2\x9a\x2d\xec\xcb\x77\x96\xd9\xc7\x75\x55\x7f\xda\x7b\x34\xb0\x13\xf4\x26\xc1\x93\x90\xfa\xe4\x76\x6b\xef\x11\x32\x9a\x20\x18\x17\x2a\xcd\x04\xbe\xde\x8e\x7f\x62\x64\xa0\xbf\x2b\xfc\x60\x3d\x45\xb0\x37\x83\x32\x19\x1a\xe2\x2f\xe8\x0b\x94\xbe\x7d\x02\xf8\x14\x38\xf6\xdd\x29\xd2\xd4\x27\x5b\xfd\x55\x53\x4e\xaf\x8e\x71\xb7\x91\x1a\x4e\x8b\xbf\x6a\xa0\x36\xca\x1a\x91\x9d\xad\x91\xb9\xac\x1a\xfa\x6a\x9b\xd2\xaa\xd2\x06\xd3\xdc\x3f\xda\x88\xf6\x6b\xfb\x74\xfb\x85\x3a\xe9\x95\xad\xa3\xbe\xa4\xf9\x24\xaa\xb6\x5b\xee\x28\x0d\x29\x9b\xa1\xad\x12\x85\xcb\x04\x33\xe5\x15\x39\xc5\x94\x59\x52\xbb\x10\x92\xd1\x80\xce\x79\xd9\x3f\xad\x4c\x41\x19\xd4\xde\x6e\xdc\x2a\xc1\x9d\x37\xa7\xc1\x76\x78\x8f\x21\x7e\xe2\xcf\xae\x76\xc7\x3d\x92\xa9\x77\x79\xb2\x42\x3d\xe7\xef\xd7\x29\xc5\x3c\x20\x55\xe7\xbf\x45\xb0\x6f\xc8\xfd\x00\xde\x03\xea\xb5\x7f\xee\x9c\x5d\xb5\x6a\xb9\x3c\xac\x46\x71\x4c\xd7\x80\x92\x4c\xdc\x6d\xbe\x5d\xc2\x2b\x81\x69\x09\xbc\x71\xbd\x45\xf1\x2d\xa6\x7b\x0d\xd0\x62\x57\xeb\x87\x36\x4f\xff\x69\x7b\xcc\x06\x30\xff\x47\x1d\x32\xb3\x2a\x99\xbf\x51\xfa\x55\x75\xdb\xd4\x3f\x3f\x20\xeb\xc6\xd2\xbc\x5f\xe5\xb3\x1d\x95\x37\x43\x7b\xb3\xd0\xb7\x3f\x86\xfc\x97\x63\xaf\xc2\xd2\x59\x75\x36\x57\xfb\x1f\xc3\x7e\x7e\x0a\x30\x0c\x00\x00")
+
+func templatesScrubGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesScrubGotmpl,
+		"templates/scrub.gotmpl",
+	)
+}
+
+func templatesScrubGotmpl() (*asset, error) {
+	bytes, err := templatesScrubGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/scrub.gotmpl", size: 3120, mode: os.FileMode(420), modTime: time.Unix(1792212393, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -375,7 +867,7 @@ func templatesServerBuilderGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServerConfigureapiGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x57\x4b\x6f\xdb\x38\x10\x3e\xaf\x7f\xc5\xc0\xc8\x02\x76\xe1\x95\x81\x1e\x0b\xe4\xd0\x4d\x5f\xc1\xa6\x8d\xb1\x0e\xd0\xc3\x62\x0f\xb4\x34\x96\xb8\x91\x48\x96\xa4\x62\x7b\x0d\xfd\xf7\xce\x50\x94\xa5\xc4\x49\x9b\xc7\xa1\x40\x80\x88\xe4\x70\xf8\xf1\x9b\x07\x3f\x1b\x91\x5e\x8b\x1c\x61\xbf\x87\xe4\xed\xe2\x7c\x11\x87\x4d\x33\x1a\xc9\xca\x68\xeb\x61\x32\x02\x18\xa7\x76\x67\xbc\x9e\xfb\xd2\x8d\x79\xa8\xd0\xcf\x0b\xef\x4d\x18\x94\x3a\x1f\x8f\xe8\x03\xad\xd5\xd6\xc1\x38\x97\xbe\xa8\x57\x49\xaa\xab\x79\xae\xff\xd0\x06\x95\x30\x72\xde\xae\xf2\x06\x5b\x2b\x2f\x2b\x7c\xc8\x30\x2e\xb3\x65\x25\xb3\xac\xc4\x8d\xb0\x3f\x33\x9e\xf7\x96\x01\x52\xae\x4b\xa1\xf2\x44\xdb\x7c\xbe\x9d\x33\xd8\x54\x2b\x8f\x5b\x1f\x70\xee\xf7\x96\x16\x11\x92\x77\xb8\x16\x75\xe9\xcf\xc3\x3d\x5d\xd3\xec\xf7\xc6\x4a\xe5\xd7\x30\xfe\xfd\xdb\x18\x12\xe2\x80\x8d\x51\x65\xf1\xab\xdd\x76\x72\x8d\xbb\x19\x9c\xdc\x88\xb2\x46\x78\x73\x0a\xc9\x60\x3f\xaf\x35\x0d\x93\x39\xf4\xd4\xda\xde\x72\x37\x1d\x91\xcd\x49\x47\x3e\x7b\x19\x32\x3f\x9f\xc3\x55\x21\x1d\xac\x65\x89\x40\xff\x9d\x58\x23\x78\x0d\x98\x49\x9f\xc0\xa5\x4a\x69\xd6\x03\x6e\xa5\xf3\x8e\xbf\x36\xb2\x2c\x41\x69\x0f\x2b\x04\x7d\x83\x76\x63\xa5\xf7\xa8\x46\xa3\x75\xad\x52\xa0\xbb\xaf\x65\x5e\x5b\xfc\x50\x8a\xdc\x4d\x88\x36\x78\xb5\xdf\x77\x07\x36\x4d\xc2\x70\x85\x4b\x45\x29\xff\x27\x56\xbe\x88\x8a\x51\x50\x32\x4c\x61\x4f\x90\x09\x0c\x6d\x49\xce\x74\x55\x09\x95\x5d\x48\x85\x97\xc6\x4b\xad\xdc\x47\xab\x6b\xe3\xe0\x14\xfe\xf9\xd7\x6d\x44\xfe\x90\x05\x25\x56\x92\x40\x33\x6a\xee\xc2\xa1\x13\x9e\x04\x86\x13\x2e\xf9\x44\x27\x94\x68\x3b\x64\x07\x67\xe0\x0b\x64\x9c\x50\xa0\x45\x5a\x63\xc8\x4b\xb4\x37\xf8\x9e\xf3\x8e\x40\xb6\xf9\x37\x98\x1b\xb5\x1e\x96\xe8\x61\xa7\x6b\x0b\x69\xed\xbc\xae\x80\xb2\x39\x27\xff\x72\x0d\x0a\x31\xc3\x2c\x81\x98\x26\xa0\x55\x08\x06\x19\x24\x8b\x10\xdd\xd6\xc1\xfb\xad\xc1\xd4\x63\x06\x34\x85\x76\x2d\x28\x38\x7c\xcf\x89\xf3\x64\x94\xcf\xf8\xf6\x87\x95\x7d\x33\x0d\x9b\xba\x9d\xa2\x32\x25\xbe\x69\x47\x2e\x61\xcc\x17\xed\xf1\xa7\xc3\x63\x42\xde\x40\x4c\xda\x33\xe2\xb5\xae\xd0\x01\xe7\x1b\xc3\xe4\xfc\x2b\xb1\x42\xe5\x05\x93\x4e\xf3\xec\xe7\x5e\x1a\xe3\x5e\x76\xcf\xf5\x7e\xb4\xb1\x4d\xd0\xd2\xe1\xe3\x7c\xc4\xe2\xeb\x20\xd9\x0f\x7c\xed\x70\x77\xe2\x4f\x27\x7f\xa3\xc8\xd0\xce\xc0\x0b\x9b\x13\xc9\x43\x12\xda\x68\x84\x20\x52\x3f\x40\x5f\x5b\xd5\x05\xe8\x8b\xf6\x07\x5c\x98\x4d\xc6\x94\x1c\x7c\x32\x55\x55\xda\x9d\x5c\x08\x17\xb2\x7d\x87\x9c\xf1\xa8\x40\xf6\x1b\xc6\x4c\x70\x33\x1d\x96\x6d\xff\xd5\x71\xb8\xb0\x3a\xab\xd3\xe7\x71\x18\xf7\xbe\x88\xc3\x81\x8f\x8e\xc3\x6e\xaa\xe7\x70\xc3\x1c\x7e\xa5\x4a\x66\x0e\x33\xe1\xc5\xcb\x19\x34\xdd\xb9\xcf\x66\x30\x12\xb8\xc4\xb4\x26\x64\x3b\x2a\x0d\xa9\x64\xa8\xf5\x68\x10\xc8\x74\x7f\x0a\x27\xd3\xb7\xb5\x2f\xc2\xec\x31\x0f\xe7\xef\xb8\xa8\x69\x9d\x18\x08\x97\xad\x1d\xa1\xea\x2a\x86\x0c\x5d\x1c\x4c\x61\x12\x7c\x32\xd8\x09\xe0\x37\x08\x35\x91\x4a\x23\x4a\x18\x0f\xf8\x18\xc3\xb4\x69\xa8\x91\x00\x41\x0d\x51\xed\xed\x9a\x66\xd6\x32\x33\xbd\xcd\x96\x92\xe5\xec\x21\xca\x56\x8c\x1f\x04\x03\x64\x00\x11\xf0\xf4\x11\xbc\xf5\x7c\x75\x5c\x50\xef\xfa\x0b\x77\x4f\x21\xc3\xeb\x6b\x72\xfd\x4b\x09\xe0\x4e\x4a\x6f\x59\x4b\xc1\x90\x81\x3e\x99\xd6\x96\xba\x25\x0d\x97\xd4\x3c\x53\x9e\x78\x0e\x39\x97\x7c\xef\xd7\xcf\x21\x66\x06\x2e\x25\x15\xe0\xf8\xf5\xf9\x95\x4c\x69\xa6\xe8\x35\x5d\x98\xb4\x87\x3d\xe6\xeb\x29\xa4\xdc\x5f\x6b\x97\x06\xad\x88\x25\xd6\x72\x14\xde\xa6\x5e\x31\x74\x32\x22\x08\x98\x9e\xbd\x45\x3f\x1b\x29\xbf\xa7\x13\x75\xcf\x29\x37\xb3\x9f\x3d\xc2\xd1\xb6\xef\x50\xb1\x77\x7e\x25\x65\x76\xd6\xea\x2b\xb2\x4a\xfd\x16\xa2\xda\x4a\xe2\xec\x0c\x0e\x6c\x1b\x61\x45\xe5\x1e\x71\xd8\x22\x18\xb6\x69\xc2\x29\xa0\x2d\xad\x66\x1c\x20\x73\x88\xea\xcb\xc3\x1d\xa9\x99\x0e\x94\x26\x3d\x5b\xce\x68\x95\xe1\x9d\xe6\x3a\xb0\x38\x4a\x81\x2e\x42\xf0\xc3\xd8\x1c\x87\x24\xb9\x15\xb0\x58\x57\x8f\xe8\xcd\x83\x44\x19\x4a\x1d\xbb\x2c\x6a\x9f\xe9\x8d\xea\xea\x85\xd2\x98\x13\x6c\x74\xb8\x84\xa3\x7f\xe6\x63\xa9\x57\xa2\xfc\x7c\xb8\xcf\xe4\xe0\x60\x12\xd6\xfb\x15\x37\x9d\x8e\x3a\x39\x8a\x70\x75\xb1\x3c\xe8\xad\xf6\xba\x2b\x5c\x6b\x92\x5e\x9f\xae\xae\x16\x4b\xf2\xcd\x10\xa8\x3a\x05\x89\xe1\xe4\x8e\xd6\xa3\xbd\x13\xfa\xf1\x70\x16\xc6\xf0\x8a\x3e\x93\xf6\xfb\xa0\x31\x3f\x8b\x6b\x12\x70\xac\x63\x91\x5e\x66\x27\xec\x0e\xd2\x82\x2b\xc0\xb1\xf2\xf5\xf7\x9e\xcf\x5a\x2f\x19\x20\x1c\xfc\x5e\xb8\x6d\xc8\x5a\x9a\x5e\x4b\xf6\x52\xc4\x8c\xc7\x2d\xbd\x61\x9e\x2b\x9a\xb7\x3a\x84\x4c\x07\xda\x85\x31\xe5\xae\x3b\x92\x75\x2d\xc9\xb1\xe4\x3f\x47\x4e\x32\x9d\xd6\x1c\x86\xe4\x9e\xe3\x5a\x6f\x84\x55\xac\x29\xf3\x80\x74\xaf\xa7\x96\x04\xab\xda\x77\x24\x71\x67\xa0\xcd\x32\x0d\x88\x66\xb0\x92\x2a\x63\x13\x82\x03\xf4\xfb\x40\x66\x61\xbe\xa5\xed\x6e\x18\x26\x1d\xe8\xa1\x04\x3e\x12\xc4\xbf\xc5\x20\x47\xe3\xc7\xf0\x52\xd0\x6d\x51\xb9\x03\x46\xb5\xf3\x45\xe8\xad\x9e\x7f\x7e\x0c\xb6\x89\xd2\xe9\x40\x8d\x6c\xe3\xc1\xc1\x66\xf4\x3f\x26\x69\xa9\x5b\x47\xf4\x27\x20\xd7\x3a\x03\x53\xb2\x3e\x26\x07\xa6\xac\x73\x12\x34\x34\x6f\x84\xa2\xb7\x36\x80\x66\x8f\xfd\xa1\xb3\xa0\xc5\x3b\x8e\x2a\xa4\x2e\x9f\xba\x01\x41\x47\x79\xfc\x4c\x96\xbe\x07\x00\x00\xff\xff\x6c\x52\x16\x86\x03\x0f\x00\x00")
+var _templatesServerConfigureapiGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc5\x57\x4b\x6f\xdb\x38\x10\x3e\xaf\x7f\xc5\xc0\xc8\x02\x76\xe1\x95\x81\x1e\x0b\xe4\xd0\x4d\x5f\xc1\xa6\x8d\x51\x07\xe8\x61\xb1\x07\x5a\x1a\x4b\xdc\x50\x24\x4b\x52\x71\xbc\x86\xfe\xfb\xce\x50\x92\xa5\xc4\x49\x9a\xc7\x21\x80\x01\x8b\xe4\x70\xf8\xcd\x37\x0f\x0e\xad\x48\x2f\x45\x8e\xb0\xdb\x41\xf2\x7e\x71\xba\x68\x87\x75\x3d\x1a\xc9\xd2\x1a\x17\x60\x32\x02\x18\xa7\x6e\x6b\x83\x99\x07\xe5\xc7\x3c\xd4\x18\xe6\x45\x08\x36\x0e\x94\xc9\xc7\x23\xfa\x40\xe7\x8c\xf3\x30\xce\x65\x28\xaa\x55\x92\x9a\x72\x9e\x9b\x3f\x8c\x45\x2d\xac\x9c\x37\xab\xbc\xc1\x55\x3a\xc8\x12\xef\x13\x6c\x97\x59\xb2\x94\x59\xa6\x70\x23\xdc\xaf\x84\xe7\xbd\x64\x84\x94\x1b\x25\x74\x9e\x18\x97\xcf\xaf\xe7\x0c\x36\x35\x3a\xe0\x75\x88\x38\x77\x3b\x47\x8b\x08\xc9\x07\x5c\x8b\x4a\x85\xd3\x68\xa7\xaf\xeb\xdd\xce\x3a\xa9\xc3\x1a\xc6\xbf\xff\x1c\x43\x42\x1c\xb0\x30\xea\xac\xfd\x6a\xb6\x1d\x5d\xe2\x76\x06\x47\x57\x42\x55\x08\xef\x8e\x21\x19\xec\xe7\xb5\xba\x66\x32\x87\x9a\x1a\xd9\x1b\xea\xa6\x23\x92\x39\xb2\x2d\xdb\xac\x65\xc8\xfc\x7c\x0e\x17\x85\xf4\xb0\x96\x0a\x81\xfe\xbd\x58\x23\x04\x03\x98\xc9\x90\xc0\xb9\x4e\x69\x36\x00\x5e\x4b\x1f\x3c\x7f\x6d\xa4\x52\xa0\x4d\x80\x15\x82\xb9\x42\xb7\x71\x32\x04\xd4\xa3\xd1\xba\xd2\x29\x90\xed\x6b\x99\x57\x0e\x3f\x29\x91\xfb\x09\xd1\x06\x6f\x76\xbb\xee\xc0\xba\x4e\x18\xae\xf0\xa9\x50\xf2\x3f\x62\xe5\x9b\x28\x19\x05\x05\xc3\x14\x76\x04\x99\xc0\xd0\x96\xe4\xc4\x94\xa5\xd0\xd9\x99\xd4\x78\x6e\x83\x34\xda\x7f\x76\xa6\xb2\x1e\x8e\xe1\xef\x7f\xfc\x46\xe4\xf7\x49\x50\x60\x25\x09\xd4\xa3\xfa\x36\x1c\x3a\xe1\x49\x60\x38\xe0\x92\x2f\x74\x82\x42\xd7\x21\xdb\x2b\x83\x50\x20\xe3\x84\x02\x1d\xd2\x1a\x43\x5e\xa2\xbb\xc2\x8f\x1c\x77\x04\xb2\x89\xbf\xc1\xdc\xa8\xd1\xb0\xc4\x00\x5b\x53\x39\x48\x2b\x1f\x4c\x09\x14\xcd\x39\xe9\x97\x6b\xd0\x88\x19\x66\x09\xb4\x61\x02\x46\x47\x67\x90\x40\xb2\x88\xde\x6d\x14\x7c\xbc\xb6\x98\x06\xcc\x80\xa6\xd0\xad\x05\x39\x87\xed\x9c\xf8\x40\x42\xf9\x8c\xad\xdf\xaf\xec\xea\x69\xdc\xd4\xed\x14\xa5\x55\xf8\xae\x19\xf9\x84\x31\x9f\x35\xc7\x1f\x0f\x8f\x89\x71\x03\x6d\xd0\x9e\x10\xaf\x55\x89\x1e\x38\xde\x18\x26\xc7\x9f\xc2\x12\x75\x10\x4c\x3a\xcd\xb3\x9e\x3b\x69\x6c\xf7\xb2\x7a\xce\xf7\x83\x8d\x4d\x80\x2a\x8f\x8f\xd3\xd1\x26\x5f\x07\xc9\x7d\x62\xb3\xa3\xed\xc4\x9f\x49\xbe\xa3\xc8\xd0\xcd\x20\x08\x97\x13\xc9\x43\x12\x1a\x6f\x44\x27\x52\x3d\xc0\x50\x39\xdd\x39\xe8\x9b\x09\x7b\x5c\x98\x4d\xc6\x14\x1c\x7c\x32\x65\x55\xda\x9d\x5c\x08\x1f\xa3\x7d\x8b\x1c\xf1\xa8\x41\xf6\x1b\xc6\x4c\x70\x3d\x1d\xa6\x6d\xff\xd5\x71\xb8\x70\x26\xab\xd2\xe7\x71\xd8\xee\x7d\x11\x87\x03\x1d\x1d\x87\xdd\x54\xcf\xe1\x86\x39\xfc\x41\x99\xcc\x1c\x66\x22\x88\x97\x33\x68\xbb\x73\x9f\xcd\x60\x4b\xe0\x12\xd3\x8a\x90\x6d\x29\x35\xa4\x96\x31\xd7\x5b\x81\x48\xa6\xff\x53\x78\x99\xbe\xaf\x42\x11\x67\x0f\x79\x38\xfd\xc0\x49\x4d\xeb\xc4\x40\x34\xb6\xf2\x84\xaa\xcb\x18\x12\xf4\xed\x60\x0a\x93\xa8\x93\xc1\x4e\x00\x7f\x42\xcc\x89\x54\x5a\xa1\x60\x3c\xe0\x63\x0c\xd3\xba\xa6\x42\x02\x04\x35\x7a\xb5\x97\xab\xeb\x59\xc3\xcc\xf4\x26\x5b\x5a\xaa\xd9\x7d\x94\xad\x18\x3f\x08\x06\xc8\x00\x5a\xc0\xd3\x47\xf0\xd6\xf3\xd5\x71\x41\xb5\xeb\x2f\xdc\x3e\x85\x8c\x60\x2e\x49\xf5\xab\x12\xc0\x95\x94\xee\xb2\x86\x82\x21\x03\x7d\x30\xad\x1d\x55\x4b\x1a\x2e\xa9\x78\xa6\x3c\xf1\x1c\x72\xce\xd9\xee\xb7\xcf\x21\x66\x06\x3e\xa5\x2e\xc0\xf3\xed\xf3\x9a\x4c\x19\xa6\xe8\x2d\x19\x4c\xbd\x87\x3b\xe4\xeb\x29\xa4\xdc\x9d\x6b\xe7\x16\x9d\x68\x53\xac\xe1\x28\xde\x4d\x7d\xc7\xd0\xb5\x11\xb1\x81\xe9\xd9\x5b\xf4\xb3\x2d\xe5\x77\x54\xa2\xee\x3a\xe5\x62\xf6\xab\x4b\xb8\x95\xed\x2b\x54\x5b\x3b\x7f\x50\x67\x76\xd2\xf4\x57\x24\x95\x86\x6b\x68\xbb\xad\xa4\x9d\x9d\xc1\x9e\x6d\x2b\x9c\x28\xfd\x23\x0e\x5b\x44\xc1\x26\x4c\x38\x04\x8c\xa3\xd5\x8c\x1d\x64\xf7\x5e\x7d\xb9\xbb\x7b\x6a\xa2\x21\xb8\x5a\x9a\xf4\x12\xd9\x8c\x19\x1b\xa1\x1f\xd3\x9b\x90\x91\xfa\x29\xe5\xd8\x74\xfe\x84\x07\x3d\x79\xe8\xc0\xe4\x86\x7b\xdb\x2c\x7c\x38\xbe\xd8\x6c\xba\x8a\x62\x24\xf6\x2d\x32\xdd\xcc\xde\x1a\x9d\xe1\x2d\xc0\x03\x89\xd7\x06\x1d\x7d\x15\xef\xa1\x41\x52\x0c\xdb\x3a\xb7\x2c\xaa\x90\x99\x8d\xee\x6a\x03\xa5\x2c\x27\xd3\x68\x6f\x8d\xa7\x3f\xfb\x59\x99\x95\x50\x5f\xf7\x86\x4d\xf6\x0a\x26\x71\xbd\x5f\xf1\xd3\xe9\xa8\x6b\xbd\x11\x2e\xce\x96\xfb\xde\xb2\xb1\x7b\x85\x6b\x43\x6d\xe6\x97\x8b\x8b\xc5\x92\x74\x33\x04\xaa\x44\x82\x1a\xff\xe4\x56\x5f\x4b\x7b\x27\xf4\x50\x3a\x89\x63\x78\x43\x9f\x49\xf3\xbd\xef\xa7\xbf\x8a\x4b\x6a\x56\xb9\x67\x47\xea\x42\xbc\x70\x5b\x48\x0b\xce\x76\xcf\x5d\x7e\xb8\xf3\x7c\xee\x6b\x93\x01\xc2\xc1\xdb\xe8\xa6\x20\xbf\x1b\x28\x14\x59\x4b\xd1\x66\x37\x5e\xd3\x7d\x1d\x38\x18\x79\x2b\xc5\x43\x66\x22\xff\xc2\x5a\xb5\xed\x8e\xe4\x1e\x9e\x5a\xcf\xe4\x5f\x4f\x4a\x32\x93\x56\xec\x8f\xe4\x8e\xe3\x1a\x6d\x84\x55\xac\x29\xcb\x80\x7a\xfc\x40\xe5\x17\x56\x55\xe8\x48\xe2\x2a\x48\x9b\x65\x1a\x11\xcd\x60\x25\x75\xc6\x22\x04\x07\xe8\x2d\x24\xb3\x38\xdf\xd0\x76\xdb\x0d\x93\x0e\xf4\xb0\xdd\x3f\x68\xfe\x7f\x6b\x9d\xdc\x0a\x3f\x86\x97\x82\xac\x45\xed\xf7\x18\xf5\x36\x14\xf1\x1e\x09\xfc\xd4\x1a\x6c\x13\xca\x9b\x48\x8d\x6c\xfc\xc1\xce\x66\xf4\x0f\x93\xb4\x34\x8d\x22\xfa\x09\xc8\x8d\xc9\xc0\x2a\x7e\x0b\x90\x02\xab\xaa\x9c\x9a\x37\x9a\xb7\x42\x53\x5f\x11\x41\xb3\xc6\xfe\xd0\x59\x7c\x77\x74\x1c\x95\x48\x37\x5a\xea\x07\x04\x1d\xc4\xf1\x33\x59\xfa\x1f\xc2\xc6\x52\xd3\xef\x0f\x00\x00")
 
 func templatesServerConfigureapiGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -390,7 +882,7 @@ func templatesServerConfigureapiGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/configureapi.gotmpl", size: 3843, mode: os.FileMode(420), modTime: time.Unix(1466470505, 0)}
+	info := bindataFileInfo{name: "templates/server/configureapi.gotmpl", size: 4079, mode: os.FileMode(420), modTime: time.Unix(1792238406, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -415,7 +907,27 @@ func templatesServerDocGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServerMainGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x55\xd1\x6f\xdb\xb6\x13\x7e\xb6\xfe\x8a\x8b\xd0\xdf\x0f\x12\xe0\xc9\xdb\x6b\x87\x0c\xc8\x96\x65\xc8\xd0\xa6\x41\xdd\x3d\x15\x45\x46\x4b\x27\x99\x0d\x45\x6a\x24\x15\xcf\x35\xf4\xbf\xef\x8e\x94\x1d\x25\x4e\x86\x0c\x43\xe7\x07\x4b\x22\xef\xbe\xfb\x78\xf7\xdd\xb1\x13\xe5\xad\x68\x10\x5a\x21\x75\x92\xc8\xb6\x33\xd6\x43\x96\x00\xa4\xca\x34\x29\x3f\x8d\x0b\x0f\x8d\x7e\xb1\xf6\xbe\x4b\x13\xfa\x52\x46\x54\x0e\xd2\x46\xfa\x75\xbf\x2a\x4a\xd3\x2e\x1a\xf3\x8d\xe9\x50\x8b\x4e\x2e\xc2\x66\x9a\xcc\x6a\x25\x9a\x87\x46\x9f\xd1\x39\xbc\xab\x6e\xd9\x3a\xec\x92\x55\x63\x45\x89\x75\xaf\x1e\x18\xfa\xad\x42\xbb\x5a\xec\xf7\x42\xcc\xdd\xce\x0a\x4d\x4c\x8b\x73\xac\x45\xaf\xfc\x65\xe0\xea\x86\x61\xb7\xeb\xac\xd4\xbe\x86\xf4\x7f\x7f\xa4\x50\x0c\x43\x30\x46\x5d\x8d\x6f\xd1\xed\xd5\x2d\x6e\xe7\xf0\xea\x4e\xa8\x1e\xe1\xf5\x29\x14\x13\x7f\xde\x1b\x06\x32\x85\x29\x52\xb4\x7d\x00\x97\x27\xc9\x62\x01\x1f\xd6\xd2\x41\x2d\x15\xc2\x46\x38\x68\x50\xa3\x15\x1e\x2b\x58\x6d\xc1\xaf\x11\xdc\x46\x34\x0d\x5a\xf0\xc6\xa8\x82\xed\xdf\x8a\x5b\x5a\xed\x2d\x82\x36\x9e\x96\xc1\xdc\xa1\xdd\x58\xe9\x91\xec\xf7\x50\xa2\xf6\xe4\xb3\x35\xfd\x04\x50\x7a\x58\x61\x29\x7a\x47\xdb\x4a\xf1\xa6\x05\xac\xa4\x77\xb0\x31\xbd\xa2\x80\x48\x95\x70\xfe\x24\x49\xea\x5e\x97\xa1\x86\x59\x0e\xbb\x40\x18\x64\x0d\xc5\xcf\x7f\x96\xaa\xaf\x70\xd9\x61\x09\x44\x7f\x06\xe0\xd0\x52\x70\x4e\x00\x99\x14\x67\xd7\x97\xd7\xa3\x00\x86\xa1\xb8\xc2\xcd\x32\x6c\x67\x5a\xaa\x3c\xa2\xa0\x72\xb8\x77\x8d\xe7\x62\xb0\x39\xa0\x0d\x20\xa1\xd6\xc5\x99\x16\x6a\xfb\x05\xab\xec\x18\x73\x19\x9d\x7e\x5d\xbe\xbb\x9a\x43\x9a\xe6\x0c\x44\xcc\xd8\xfd\xe4\x14\x28\x0e\xd1\x9d\xcd\x48\x6b\xc5\x85\xf0\x74\x48\x9d\xd1\x56\xb0\x1a\x12\xfe\x27\x41\x45\xb2\xc5\x08\x1a\x79\x72\xa9\x84\x2b\x85\x92\x5f\x48\x11\x57\xa2\xe5\x60\x14\x39\xcb\x5f\x7e\x48\x82\x0e\xd6\x15\xd6\x64\x1c\x7d\x8a\xe5\xba\xf7\x95\xd9\x50\x1e\xc7\xf3\xeb\x8a\x8f\x4f\x1f\x9d\xb0\x2e\x82\x06\xe9\x32\xd0\x75\x58\xca\xa2\xeb\x7c\x5c\x1f\xe5\x99\x1f\x5c\x08\x93\x84\x76\x8e\xae\xb4\xb2\xf3\xd2\x68\x38\xdd\xd7\xe7\x52\xd7\x06\x58\x81\x87\xaf\xe2\x83\xf4\x8a\x89\xfe\xce\xd4\x8f\x56\xc6\x72\x3c\x59\xde\x34\xbd\x37\x98\xd4\xaa\xe0\xbf\x2c\x9f\x60\x1d\x8e\xf5\xe0\xe5\x2b\x20\x87\xde\x19\x93\xf0\xc6\xe8\xe6\xa5\x39\x98\xda\x4d\x33\x71\xbc\xfe\x6f\x59\x4f\x10\xbf\x4a\x56\x9e\xc7\x67\x51\x1d\x1a\x95\xe7\xc2\xb3\xcd\x5a\xfc\x64\x74\x2d\x1b\x9a\x1f\x17\x2c\xb0\x28\xf1\xda\x58\xb8\x99\x83\xe9\xbc\xfb\xc5\x9a\xbe\x63\x5d\xc6\x41\x47\xb2\x26\x8f\xb6\x15\xba\x7a\x23\x35\xbe\x0b\xc1\xa3\x91\x0b\xcd\x76\x73\xe8\xde\xb1\x34\x67\x55\x15\xb6\xb3\x03\xda\x91\x64\x27\x91\x1e\x57\x72\xba\x35\x06\x23\x86\xb3\xe3\x26\xe7\x6b\xe3\x71\x9b\xcf\x86\xd8\xea\x8f\x7a\x8d\x9c\x8f\x58\x86\x66\xcb\xf2\xef\x1f\xc2\x02\xfd\x8c\xa3\xdc\x49\x9f\x7d\xc7\x3d\x37\x24\x7f\x3b\xfe\x9e\x9d\x61\xa1\x6a\xce\xd3\xfc\x6f\xb2\xfd\x2c\xa0\xa5\xfc\x3f\x98\x58\x93\x52\x2f\xd1\xf3\xda\x3f\x1c\x4d\x44\x4f\xa1\xde\xd3\xbe\x30\xb6\xc4\x6a\x59\xae\xb1\x45\x97\xc3\x0f\xf0\x2d\x33\xae\x98\xd4\x67\x67\x34\x93\x39\xc7\xd2\x54\x34\xb9\x56\x5b\x8f\x61\x92\xbd\x47\xc1\xdf\x53\x19\xbf\x17\x9b\x2c\xe7\xe3\x57\xc5\x6f\x0e\xaf\xfa\x76\x45\x06\x4c\xf6\x4e\x58\xa8\xe8\xe8\x40\x57\x25\xda\x9a\xae\xe8\x1d\xe5\x76\x4c\x11\x05\xa9\x8a\x08\x9f\xfd\x9f\xad\x1e\x17\x6c\x36\xeb\x84\x96\x65\x96\xfe\x68\xcd\x2d\x6a\x70\xcc\x53\x9c\xf0\xcd\x40\x28\x2d\xbb\xcc\xe1\x26\xe0\xd0\x6b\x91\xb5\xa2\xfb\x18\xcb\xf2\x69\x12\x2f\x1f\x4d\x3f\xa6\x2e\x9e\x33\xfd\x44\x13\xe5\xa9\x04\x10\x61\x2b\x36\xb1\xe0\x37\x87\x1c\xbc\x25\x31\xad\x85\xba\xd4\x15\x6a\x9f\xc5\xa0\x29\xa4\xfc\x07\x4c\xe5\x48\x27\x47\x57\xdd\x01\x34\x5c\x6a\x2f\x11\xc8\xb0\x57\x27\x77\x28\x15\x3a\x2a\xee\x3e\x10\x97\xf5\x71\xc7\x8f\x02\x39\x28\xf0\xf5\xe9\xbd\x52\xe8\xf1\x44\x3b\xcc\x9e\x52\xcb\x13\xad\xc7\x5c\x86\xe4\xaf\x00\x00\x00\xff\xff\x73\x55\x45\x7a\xff\x09\x00\x00")
+var _templatesServerEventstreamGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x54\xdf\x4f\xdb\x40\x0c\x7e\xef\x5f\xe1\x45\xda\xb8\x4c\x6d\x78\x67\xe2\x05\xc4\x36\x26\x0d\xa4\x75\xd2\x1e\xc6\x84\x8e\xd4\x21\x61\xe9\x5d\x76\xe7\x10\x58\xd5\xff\x7d\xf6\xdd\x35\x74\x03\x54\xd6\x97\xe6\xec\xcf\xf6\xe7\x9f\xab\x15\x2c\xb0\x6a\x0c\x42\x86\xb7\x68\x68\x4e\x0e\xf5\xf2\x9b\x6b\x08\x5d\x06\xeb\xf5\x64\x7f\x1f\x56\x2b\xe8\xb4\x2f\x75\xdb\xfc\x46\x28\xce\xf4\x12\x59\x71\x22\xe8\x88\x83\x41\xfe\x3c\x50\x8d\x10\x9c\x78\xb0\x55\x78\xb1\x65\xdd\x2f\xb5\xd9\x36\x04\xdb\xa1\xd3\xd4\x58\x03\xda\x83\x47\x77\x8b\x6e\xe6\xd9\x2a\xd9\x4e\xe8\xbe\xc3\xdd\x31\x3d\xb9\xbe\x24\x58\x4d\x00\xdc\x00\xe1\x57\x13\x75\xc5\x17\xf4\x9d\x35\x1e\x23\x8c\xb5\x9e\xb4\x23\x5c\xc0\x95\xb5\xed\x64\x3d\x91\x84\xce\x70\xd8\xe9\xbf\xe4\x3a\x48\x52\xfa\x05\x54\xd0\x2c\x1a\x73\x0d\x0d\x27\x9e\xf2\x27\xcb\xac\x26\x55\x6f\xca\x97\x04\x53\x9c\xc1\x13\xe4\x73\x78\xbb\x33\x76\xc8\x1f\xa9\x77\x06\xde\xec\x02\xaf\xdc\x70\xc0\xb4\xd6\xa9\x0a\x73\xa6\x0d\x4b\xed\x7c\xad\xdb\xad\xe6\x49\x57\x3e\xcd\xcf\xcf\x40\xb3\xba\x6a\x7b\x5f\x73\x15\x1a\x92\x94\x04\x53\xb6\x8d\x80\x1a\xee\x1e\x78\xce\xba\x45\xa8\x1c\x87\x9a\x8a\x4b\x01\x70\xbd\xa9\xf7\xc1\xba\x46\xbd\x40\x37\x4e\x83\x0f\xb3\x05\xd7\x16\x6c\x4f\x30\x34\x54\x07\x71\xd5\x38\x9f\x9a\x1f\x2b\xa6\x86\xdd\x89\xe7\x81\xbe\x8a\x8c\x19\x1c\xbc\x15\x27\x0f\x23\x1c\xbf\xd9\x88\x95\x4d\x15\xe8\x14\xa7\xfe\xd8\x2e\xbb\x16\xef\xce\xaf\x6e\x90\x87\x47\x19\x4b\x22\x3d\xd2\x1e\xbf\xf2\xd4\xe5\xa3\xe4\xd4\x70\x8c\x4a\x97\x2c\x5a\xaf\x85\x8c\xd4\x2a\xf8\x2a\x3e\x58\x81\xc6\x47\x94\xe6\x80\xce\xd9\xd8\x8b\x85\x26\x3d\x95\x37\x1c\x1c\xc2\x8d\xb7\xa6\xf8\x1c\x2b\x1c\xa9\xe6\x0c\x61\x36\xa2\x7f\x75\x08\xa6\x69\x83\xd1\xd8\x42\x96\xf3\x73\x1d\x41\xaf\x86\x62\x33\xbb\x11\x34\x14\x6e\x28\x3e\x86\x9a\xaa\xbc\x98\x23\xa9\xec\xd8\x32\x51\x43\x33\xa1\x94\x4d\x21\x23\xbc\xa3\xfd\x10\x69\x16\xab\x9d\xe5\xcf\x9a\xea\xb2\xc6\x99\x38\x70\xb6\x15\x5b\x63\x67\xa5\xc8\xb6\x4d\x42\xb1\x93\x9d\x24\xbf\x5d\xe0\x63\xbb\x90\x32\x6c\xd0\x1b\xae\x87\xc0\x9b\x89\x29\x8d\x5b\xed\xe2\x78\xc0\xd5\x3d\x6f\x53\x71\xd4\x57\x55\xd8\xca\xd8\x95\xbf\xfc\xa5\x26\x07\x78\x0c\xcc\x0a\x9e\x30\x09\xdc\xf1\x07\x55\x90\xbd\xfe\x95\x81\x0a\x8f\x74\xaf\x0e\x20\x7b\xc2\x4b\x76\x61\xb2\x3c\x71\x1b\xdb\x54\x71\x8b\x2e\xa7\xd0\xca\xb1\xe3\xe6\x38\x6d\xae\x37\xb4\xe6\x5d\xdb\x90\x8a\xbd\xfb\xfe\x43\x64\x2a\xb8\xc8\x53\xe5\x1f\x73\xca\x04\xcc\xc1\xf3\x7f\xf5\x4a\xfc\x3f\x92\x1e\x89\xcb\xbd\x0b\xb3\x97\xa7\xc2\x3c\xa7\xe3\xa2\x5c\x8e\xf3\xf3\xd0\x02\x15\xf1\x02\xf5\x2a\xcf\xdf\xbd\x6c\x82\xaa\x29\xd8\x9f\xa3\x23\x15\x4e\xcc\xfb\xb0\xd1\x8e\x5d\xb0\x2a\x65\x17\x85\x6a\xc3\x2d\xb9\x62\xdf\x9b\x4b\x91\x5a\x4b\xd8\xf2\xa5\x08\xdb\x94\x6e\xc5\x10\x4e\xb8\xa1\xe9\xf6\xea\xa7\x75\x77\xe9\x98\x41\xa9\xcd\x1e\x41\x59\x87\x82\x6b\x73\xbf\xb4\x0e\xc1\x9a\x12\xe5\xac\xb0\x87\xff\xda\xfa\x48\x45\xe5\xe1\x9e\x6f\x9f\xbf\x71\x02\x99\xf4\xd8\xf3\xc9\x1f\xee\xfb\xde\xb8\xe0\x06\x00\x00")
+
+func templatesServerEventstreamGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerEventstreamGotmpl,
+		"templates/server/eventstream.gotmpl",
+	)
+}
+
+func templatesServerEventstreamGotmpl() (*asset, error) {
+	bytes, err := templatesServerEventstreamGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/eventstream.gotmpl", size: 1760, mode: os.FileMode(420), modTime: time.Unix(1792217140, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerMainGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x55\xdf\x6f\xdb\x36\x10\x7e\xb6\xfe\x8a\x8b\xd0\x0d\x12\xe0\xc9\xdb\x6b\x8a\x0c\xc8\x96\x25\xc8\xd0\xa6\x41\xdd\x3e\x15\x45\x46\x4b\x27\x59\x35\x4d\x6a\x24\x15\xcf\x35\xf4\xbf\xef\x8e\x94\x1d\xf9\x47\x86\x0c\x43\xe7\x07\x59\x22\xef\xbe\xfb\x78\xf7\xdd\xb1\x11\xf9\x42\x54\x08\x4b\x51\xab\x28\xaa\x97\x8d\x36\x0e\x92\x08\x20\x96\xba\x8a\xf9\x5f\x5b\xff\xa7\xd0\x4d\xe6\xce\x35\x71\x44\x5f\x52\x8b\xc2\x42\x5c\xd5\x6e\xde\xce\xb2\x5c\x2f\x27\x95\xfe\x41\x37\xa8\x44\x53\x4f\xfc\x66\x1c\x8d\x4a\x29\xaa\x7d\xa3\x2f\x68\x2d\x3e\x16\x0b\xb6\xf6\xbb\x64\x55\x19\x91\x63\xd9\xca\x3d\x43\xb7\x96\x68\x66\x93\xed\x9e\x8f\xb9\xd9\x18\xa1\x88\x69\x76\x85\xa5\x68\xa5\xbb\xf5\x5c\x6d\xd7\x6d\x36\x8d\xa9\x95\x2b\x21\xfe\xee\xcf\x18\xb2\xae\xf3\xc6\xa8\x8a\xfe\x2d\xb8\xbd\x5a\xe0\x7a\x0c\xaf\x1e\x85\x6c\x11\xce\x2f\x20\x1b\xf8\xf3\x5e\xd7\x91\x29\x0c\x91\x82\xed\x1e\x5c\x1a\x45\x93\x09\x7c\x98\xd7\x16\xca\x5a\x22\xac\x84\x85\x0a\x15\x1a\xe1\xb0\x80\xd9\x1a\xdc\x1c\xc1\xae\x44\x55\xa1\x01\xa7\xb5\xcc\xd8\xfe\xad\x58\xd0\x6a\x6b\x10\x94\x76\xb4\x0c\xfa\x11\xcd\xca\xd4\x0e\xc9\x7e\x0b\x25\x4a\x47\x3e\x6b\xdd\x0e\x00\x6b\x07\x33\xcc\x45\x6b\x69\x5b\x4a\xde\x34\x80\x45\xed\x2c\xac\x74\x2b\x29\x20\x52\x25\xac\x3b\x8b\x88\x79\x5d\x42\x76\xa3\x6f\x7a\x5f\x20\xb2\x13\x4a\xf3\xf9\x16\x8c\x0f\x77\xb0\x4f\x2b\x74\x2a\x7e\x2b\x5b\x95\x7b\x05\x24\x29\x6c\xfc\x71\x3d\xdc\x6f\x7f\xe5\xb2\x2d\x70\xda\x60\xce\x56\x23\x00\x8b\x86\xa8\x73\xfa\x18\xee\xf2\xfe\xf6\xbe\x97\x4f\xd7\x65\x77\xb8\x9a\xfa\xed\x44\xd5\x32\x0d\x28\x28\x2d\x6e\x5d\x43\x56\x18\x6c\x0c\x68\x3c\x88\x57\x4a\x76\xa9\x84\x5c\x7f\xc5\x22\x39\xc6\x9c\x06\xa7\xdf\xa7\xef\xee\xc6\x10\xc7\x29\x03\x11\x33\x76\x3f\xbb\x00\x8a\x43\x74\x47\x23\x52\x6a\x76\x2d\x1c\xa5\x48\x25\xb4\xe5\xad\xba\x88\x9f\x24\xc7\x40\x36\xeb\x41\x03\x4f\x2e\xb4\xb0\xb9\x90\xf5\x57\xd2\xd3\x9d\x58\x72\x30\x8a\x9c\xa4\x2f\x3f\x24\x41\x7b\xeb\x02\x4b\x32\x0e\x3e\xd9\x74\xde\xba\x42\xaf\x28\x8f\xfd\xf9\x43\x7e\xe9\xa3\x11\xc6\x06\x50\x2f\x7c\x06\xba\xf7\x4b\x49\x70\x1d\xf7\xeb\xbd\xb8\xd3\x9d\x0b\x61\x92\x4c\xaf\xd0\xe6\xa6\x6e\x5c\xad\x15\x5c\x6c\xeb\x73\xab\x4a\x0d\xac\xdf\xdd\x57\xf6\xa1\x76\x92\x89\xfe\xc1\xd4\x8f\x56\xfa\x72\x9c\x2c\x6f\x1c\x3f\x19\x0c\x6a\x95\xf1\x23\x49\x07\x58\xbb\x63\xed\xbd\x7c\x03\x64\xdf\x79\x7d\x12\xde\x68\x55\xbd\x34\x07\x43\xbb\x61\x26\x8e\xd7\xff\x2b\xeb\x01\xe2\x37\xc9\xca\xf3\xf8\x2c\xaa\x5d\xa3\xf2\x54\x79\xb6\x59\xb3\x5f\xb5\x2a\xeb\x8a\xa6\xcf\x35\x0b\x2c\x48\xbc\xd4\x06\x1e\xc6\xa0\x1b\x67\x6f\x8c\x6e\x1b\xd6\x65\x18\x93\x24\x6b\xf2\x58\x2e\x85\x2a\xde\xd4\x0a\xdf\xf9\xe0\xc1\xc8\xfa\x66\x7b\xd8\x75\x6f\x5f\x9a\xcb\xa2\xf0\xdb\xc9\x0e\xed\x48\xb2\x83\x48\x87\x95\x1c\x6e\xf5\xc1\x88\xe1\xe8\xb8\xc9\xf9\xd2\x39\x6c\xf3\x51\x17\x5a\xfd\xa0\xd7\xc8\xf9\x88\xa5\x6f\xb6\x24\x7d\xbd\x0f\x0b\xf4\xd3\x96\x72\x57\xbb\xe4\x27\xee\xb9\x2e\xfa\xc7\xf1\xf7\xec\x0c\xf3\x55\xb3\x8e\x6e\x8f\x2a\xd9\xce\x02\x5a\x4a\xff\x87\x89\x35\x28\xf5\x14\x1d\xaf\xfd\xcb\xd1\x44\xf4\x24\xaa\x2d\xed\x6b\x6d\x72\x2c\xa6\xf9\x1c\x97\x68\x53\xf8\x19\x7e\x64\xc6\x05\x93\xfa\x62\xb5\x62\x32\x57\x98\xeb\x82\x26\xd7\x6c\xed\xd0\x4f\xb2\xf7\x28\xf8\x7b\x28\xe3\xf7\x62\x95\xa4\x7c\xfc\x22\xfb\x68\xf1\xae\x5d\xce\xc8\x80\xc9\x3e\x0a\x03\x05\x1d\x1d\xe8\xa2\x45\x53\xd2\x05\xbf\xa1\xdc\xf6\x29\xa2\x20\x45\x16\xe0\x93\xef\xd9\xea\xb0\x60\xa3\x51\x23\x54\x9d\x27\xf1\x2f\x46\x2f\x50\x81\x65\x9e\xe2\x8c\x6f\x06\x42\x59\xb2\xcb\x18\x1e\x3c\x0e\xbd\x66\xc9\x52\x34\x9f\x42\x59\x3e\x0f\xe2\xa5\xbd\xe9\xa7\xd8\x86\x73\xc6\x9f\x69\xa2\x9c\x4a\x00\x11\x36\x62\x15\x0a\xfe\xb0\xcb\xc1\x5b\x12\xd3\x5c\xc8\x5b\x55\xa0\x72\x49\x08\x1a\x43\xcc\x0f\x60\x2a\x47\x3a\x39\xba\xea\x76\xa0\xfe\x52\x7b\x89\x40\xba\xad\x3a\xb9\x43\xa9\xd0\x41\x71\x4f\x81\xb8\xac\x87\x1d\xdf\x0b\x64\xa7\xc0\xf3\x8b\x27\xa5\xd0\xdf\x89\x76\x18\x9d\x52\xcb\x89\xd6\x63\x2e\x5d\xf4\x37\x6f\x32\xd5\x04\x3d\x0a\x00\x00")
 
 func templatesServerMainGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -430,12 +942,12 @@ func templatesServerMainGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/main.gotmpl", size: 2559, mode: os.FileMode(420), modTime: time.Unix(1470543608, 0)}
+	info := bindataFileInfo{name: "templates/server/main.gotmpl", size: 2621, mode: os.FileMode(420), modTime: time.Unix(1792209044, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServerOperationGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd4\x57\x4b\x8f\xdb\x36\x10\x3e\x57\xbf\x62\x6a\xa4\x81\x65\x38\xf2\x7d\x8b\x3d\x24\x4d\x8a\xec\xa1\xc1\x62\x77\xd1\x1c\x0b\x2e\x35\x92\x88\x48\xa4\x96\xa4\xfc\xa8\xe1\xff\xde\xe1\x43\xb6\xec\x95\xed\x00\x45\x03\x14\xf0\x41\x16\xe7\xf9\xcd\xcc\x37\x54\xcb\xf8\x37\x56\x22\x6c\xb7\x90\xdd\xc7\xe7\xdd\x2e\x49\x16\x0b\x78\xaa\x84\x81\x42\xd4\x08\x2b\x66\xa0\x44\x89\x9a\x59\xcc\xe1\x79\x03\xb6\x42\x30\x2b\x56\x96\xa8\xc1\x2a\x55\x67\x4e\xfe\x53\x2e\xac\x90\x25\x1d\xf6\x7a\x8d\x28\x2b\x0b\xad\x56\x4b\x84\xa2\xb3\xde\x54\x85\x12\x36\xaa\x03\x8d\xef\x74\x27\xbd\xa5\xde\x34\x70\xd5\x34\x4c\xe6\x49\x22\x9a\x56\x69\x0b\xd3\x04\x60\x22\xd1\x2e\x2a\x6b\xdb\x49\x92\xfc\xc4\x95\xb4\xb8\xb6\x30\x29\x55\xcd\x64\x99\x29\x5d\x2e\xd6\x0b\x27\x11\x4f\x48\x08\xc8\x6d\x9e\xd7\xb8\x62\x1a\x49\x50\xd8\xaa\x7b\xce\xc8\xf2\xa2\x54\xef\x54\x8b\x92\xb5\x62\x41\x9e\xad\x68\x70\x71\x90\x9c\x90\x1e\x61\xa0\xc9\x2a\x42\xf6\x11\x0b\xd6\xd5\xf6\xce\x87\x61\x08\x10\x3a\x6a\xb5\x90\xb6\x80\xc9\x2f\x2f\x13\xc8\x1c\x46\x5e\x01\x65\xbe\x7f\x0e\xca\x6f\xbe\xe1\x66\x0e\x6f\x96\xac\xee\x10\x6e\x6e\x21\x3b\xb2\xe2\x4e\xe9\x09\x4e\x0c\x46\xf1\x13\xab\xa9\xaf\x83\x13\x65\x86\xb3\x5a\xfc\x4d\xa1\x7d\x61\x8d\x93\xfb\x4c\x38\xd5\xa8\x7f\xef\x24\x07\xdb\x69\x69\x80\x11\xc4\x92\x5b\xa1\x24\xac\x28\x69\x8f\xac\xf6\x05\x30\xa2\x94\x8c\x84\x10\xc8\xa1\x22\x41\xb2\x58\x75\x84\xf4\xd0\x20\x54\xc1\x62\x62\x37\x2d\x5e\xf7\xe9\x7c\x4d\x49\x4a\x14\x90\x7d\x25\x77\xbf\xc5\xca\xec\x76\xb1\x12\x59\x7c\x33\x3f\xe4\x33\x6a\xf4\x9e\x69\xd6\x98\x68\xe9\x7d\x67\x2b\xa5\xe9\xd8\x89\x7b\x4d\x7a\x2b\x15\x75\x02\xe0\x0b\x35\x28\x21\xc6\x45\xcb\x6a\x98\x50\x26\xa8\x0b\xc6\x71\xbb\x9b\x40\x4a\xd2\xb3\xa1\x9b\x81\xa4\xff\x1f\x0e\xd2\x41\x67\x64\x0f\x68\x5a\x25\x73\x4a\xd8\x61\x1c\x52\x03\x5c\x23\xef\x62\x13\x13\x7a\xf8\xd2\xa1\xb1\x40\x67\xf4\xec\x50\x76\x27\x8c\x9e\x9d\xaa\xc1\xc4\x81\x00\xd3\x42\x5e\x85\x2b\x8d\x0e\xce\x20\x66\xd7\x70\x1e\xb5\xd6\x03\x34\xee\xe2\x12\x78\xed\x1e\x82\x1f\x02\x23\x6c\xa9\x75\x03\x4a\x50\xc8\xb3\x89\xbe\x4a\xec\x4a\xf0\x07\xaf\xc9\xee\xea\x34\xc0\x3e\x1d\x28\x14\x31\x53\xc5\x2c\x70\x26\x63\x6b\x03\xcd\x98\xc8\xc7\x9b\x3f\xc4\x72\xbd\xf7\x07\x1e\x5c\xbe\x17\xab\xfa\x7f\x9b\x83\x80\xef\x17\x5c\x8d\xc6\x07\x5c\x23\x71\xb4\xe3\x19\x89\x2b\x70\x8c\x9c\xf5\xa0\x04\xb0\x71\x1c\x5a\xe2\x5c\x22\x77\xa2\xa5\x30\x2e\xe7\xec\x4f\xdd\x14\xcc\x06\x81\xed\x71\x8b\xc4\x74\xb1\x2e\x29\xcc\xc6\xa3\x1e\x74\xe5\xdb\x51\x89\x6d\xf4\x73\x03\xbe\x3b\xa3\xbd\x9b\xde\xeb\xce\xc3\x72\xc6\x78\x5c\x81\x37\x5a\x75\x36\xac\xd0\x3f\x90\x0a\x97\x47\x82\xa7\x85\x4a\x3c\xec\x81\x8f\x7b\xe5\x89\x95\xa6\x3f\x1c\x56\xc4\xbd\xe0\x64\xf4\xc8\x7c\x92\xc4\x6e\x78\xec\x68\x2d\xea\x4d\x2c\xe9\xd1\x3f\x77\xfc\x11\x0d\xd7\xa2\xf5\xcc\x1f\xb5\x4e\xde\x0d\x5b\x02\x6b\x83\xa7\x6a\xc1\xf0\x6b\x1d\x27\x7a\xa6\x5d\xc7\x6b\xfd\xfe\xfe\xee\xb0\xbd\x92\xd9\xe2\xc2\x40\x81\xb1\xba\xe3\xd6\x17\xa8\x1f\x9a\x91\xf2\xef\x87\xec\x72\xfd\x5d\x95\x02\x1b\xbb\x4c\x1e\x90\xa3\x58\xa2\xee\x5d\x8d\x97\x2f\x85\x47\xd4\x4b\xfc\xfc\xf4\x74\x3f\xd5\xb1\xa3\x1f\x22\xb5\x7f\xd5\x82\xe6\x6a\x0e\x1a\x66\xf1\xbd\x5f\x05\x69\xe8\x27\x57\xee\x39\xfc\xe5\xb6\xfb\x88\xbb\x3e\xf4\xec\xc1\xc9\xdd\xc9\x42\x4d\x75\x4a\x6a\x4b\xa6\x21\x4c\x39\xdc\x9e\x1d\x83\x20\x30\x4d\x93\x70\x11\x78\x45\x06\x9d\x67\xc6\x39\xa0\xd6\xd7\xdc\xef\xf5\xa6\x2e\x11\x17\x8b\x8b\x82\x2c\x3a\xdd\x9f\x6f\x41\x8a\xda\xa7\x03\x97\x93\x08\xfc\x40\x08\x91\x91\x68\x87\x68\x45\xe5\x1d\x47\x33\xef\xb1\x20\x93\xa9\x37\x15\x66\x8d\x1e\x77\x31\xe3\xd1\x35\x74\x81\xc4\x2e\x73\x58\x48\x20\x80\x70\x9c\xc3\xc1\xcf\x6d\xf4\x74\x89\x29\x7b\x20\x0f\x5d\x1e\xfe\x67\xd3\xd9\xa9\xcb\x14\x88\x15\xfd\x9d\x96\x7e\xc4\x81\x75\xbd\x09\x57\xa8\x23\xa9\x39\xdc\xb9\x8b\x6e\x23\x0c\x0e\x6f\x85\xbb\xe4\xe4\x9a\x18\xe1\xbf\x52\xba\x0f\x42\xe6\x7f\xba\x6d\x15\xfb\x6e\x5f\xc1\x39\xbc\x0d\x1d\x92\xfe\x7a\x54\x46\x17\xe3\x33\x29\xf5\x8b\xec\xbf\xab\xea\x99\xbe\xf4\x34\x6b\xce\xe5\x15\xa7\x34\xfb\x9e\x7d\xf9\x81\xbe\x42\x4a\x0a\x80\xa2\x4b\x07\x4b\x33\xa4\x3d\xb8\x19\xf8\xba\x30\x6e\x3b\x5f\x91\xb8\xe2\x07\xd7\x36\x1f\xa8\x2b\xee\x0f\x0b\xee\xbb\x22\x3a\xfa\x62\xf8\x97\xf5\xa1\xa4\x88\x28\x02\xe5\xc7\x0d\xf3\x69\x6d\x35\x7b\xe4\x15\x36\xcc\x6d\x9a\xb0\xba\x86\xa4\x6d\xb1\x69\x6b\xf7\xb1\x35\xc9\x15\x27\x0e\xa6\x2b\x6d\xfc\x9e\xe9\xb7\x59\xa3\x72\xf4\xd3\xba\x5f\x44\x44\xe4\x47\x9a\xc6\xdb\x8f\x6a\x87\x94\xfe\x09\x00\x00\xff\xff\x78\x8a\x5f\x72\x4c\x0e\x00\x00")
+var _templatesServerOperationGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xe5\x59\x5b\x6f\xe3\x36\x16\x7e\x5e\xff\x8a\xb3\x42\x77\x20\x19\x1e\xf9\x3d\x8b\x3c\x64\x26\x99\x8e\xd1\xee\x34\x48\xb2\xed\x43\x51\x2c\x18\x89\x96\xb5\x91\x25\x0d\x49\xc5\x71\x03\xff\xf7\x3d\xe7\x90\x94\x25\x5b\xb2\x67\xb6\x97\x97\x02\x41\x20\x51\xe4\xb9\x7e\xe7\x46\xd7\x22\x79\x12\x99\x84\xd7\x57\x88\x6f\xdd\xf3\x6e\x37\x99\xcc\xe7\xf0\xb0\xca\x35\x2c\xf3\x42\xc2\x46\x68\xc8\x64\x29\x95\x30\x32\x85\xc7\x2d\x98\x95\x04\xbd\x11\x59\x26\x15\x98\xaa\x2a\x62\xda\x7f\x93\xe6\x26\x2f\x33\xfc\xe8\xcf\xad\xf3\x6c\x65\xa0\x56\xd5\xb3\x84\x65\x63\x98\xd4\x4a\x96\xb0\xad\x1a\x50\xf2\xad\x6a\x4a\xa6\xe4\x49\x43\x52\xad\xd7\xa2\x4c\x27\x93\x7c\x5d\x57\xca\x40\x38\x01\x08\x4a\x69\xe6\x2b\x63\xea\x00\x5f\x50\xca\x7c\x09\xf1\x4f\xb9\x59\x7d\x5f\x65\x19\x71\xdb\xed\x82\xa2\xca\xe6\x1a\xff\x05\xf8\x59\x96\x29\xc9\xbf\xdf\x2a\x1f\xef\xab\xe4\x49\x1a\xda\x68\xf2\xb5\x1c\x27\xf3\xd8\x2c\xf3\x2a\x70\x2c\xf7\xb4\xf6\x44\x27\x7f\x4b\xaa\xd2\xc8\x17\x03\x41\x56\x15\xa2\xcc\xe2\x4a\x65\xf3\x97\x39\x49\xe8\xbe\x04\x13\x3c\xbf\xce\xd3\xb4\x90\x1b\xa1\x24\x6e\x44\x1e\xcd\x63\x8c\x9a\xcd\xb3\xea\x6d\x55\xcb\x52\xd4\xf9\x1c\x35\x27\x59\xe6\xfb\x9d\xc1\x88\xc8\xc3\xe7\xa5\x52\x95\xd2\x74\x46\x1b\xb5\x5c\x9b\x31\x3e\xf6\x2b\xeb\xd4\xdb\xa0\xf2\xa2\x10\xf3\x8d\x7c\xd4\xcc\xe8\xd0\x70\x0a\x75\x93\x10\x5f\xcb\xa5\x68\x0a\xb3\x60\x67\x68\x6b\x8a\x5a\xe5\xa5\x59\x42\xf0\x8f\xcf\x01\xc4\xed\x81\xe3\xc3\xdf\x3c\xc9\xed\x0c\xbe\x79\x16\x45\x23\xe1\xe2\x12\xe2\x1e\x15\xfa\x8a\x4f\x70\x40\xd0\x6d\x3f\xa0\x1a\x31\x1a\x69\xab\xd0\x89\x28\xf2\x5f\x51\xb4\x4f\x62\x4d\xfb\x3e\x22\x5a\x0a\xa9\x3e\x34\x65\x02\xa6\x51\xa5\x06\x81\x40\x2b\x13\x93\x57\x25\x6c\x50\x63\xc6\x97\x62\x18\xea\x3c\x2b\x05\x6e\x92\x80\x0c\x2b\xdc\x88\x14\x57\x0d\xe2\xad\x4b\x10\x56\x96\xe2\xc4\x6c\x6b\x79\x9e\x27\xf1\x0a\x3b\x68\x7a\xef\xf0\xb1\xdb\x39\x3c\xc4\x6e\x65\x06\x5d\x3c\x1d\x13\xbd\x15\x4a\xac\xb5\xa3\x74\xd5\x98\x15\xba\xe8\x57\x49\xdb\x67\x0e\x16\x65\x85\xf1\x00\xf2\x33\x86\x29\x5a\x2c\xc9\x6b\x51\x40\x80\x9a\x48\xb5\x14\x89\x7c\xdd\x05\x10\xe1\xee\x69\x97\x4d\x67\x67\x07\xc6\x03\x28\x9b\xc1\x74\x50\x2a\x14\xbe\x8c\x80\xc1\x46\xc7\x0b\x4d\x8b\x51\x07\xdf\xf1\x9d\xd4\x75\x55\xa6\x52\x75\xa2\x04\x9d\x65\x6d\x04\xf2\x45\x26\x8d\xcb\x09\xe8\x06\xf9\xb9\x91\xda\x00\x7e\xc3\x67\x72\x17\x7d\x11\xf8\x4c\x34\xb4\x9c\x90\x35\x21\x5c\x96\x67\xed\x1e\x39\x06\x23\xa6\x37\x2f\x30\x6e\xfe\x9a\x2d\x0d\x5f\xed\x85\xba\xb5\xe5\x9f\xe1\x0f\x94\xbf\xfc\x1d\x9d\x02\xaf\x18\x51\xd6\xe6\xb0\x2c\x47\xcd\x76\x64\xa6\x33\xa6\x38\xaf\x43\xbb\x23\x9a\xec\xce\x86\x31\xb4\xe6\x83\x65\x85\x85\x65\x25\x0c\x24\xa2\x74\x31\x09\x98\x1c\xf2\x74\x38\x6a\xad\xb4\xe7\x83\xb6\xc3\x81\x2c\x72\x12\x45\x7f\xd9\x00\xb6\x8e\xfa\x24\x37\x83\x24\x21\x51\x12\x6b\x35\x65\xda\x52\x6e\x80\x2a\x73\xec\xad\x6b\xbd\x26\x87\x7d\x84\x35\x09\x8b\x3c\x26\x66\x1b\xe7\x63\xf4\x43\x0a\xdf\x69\x47\xc2\xd6\x01\x2e\x35\x9f\x74\x70\x34\x62\x88\x6e\x00\xbc\x19\xdc\xf1\xea\xf8\x5c\x00\x07\x82\xa3\x77\xe1\xb9\x5a\xb3\x8c\x10\x77\xad\xd0\x85\xaa\x1a\x63\x5b\xa9\x7f\x49\x44\x40\xea\x4a\x1c\x36\x56\x58\x89\xd8\x71\xae\xb2\x3e\x88\x4c\xfb\x8f\x5d\xd7\xd2\x42\x82\x44\x7b\xe4\x27\x13\xe7\xf1\xfb\x06\xdb\x23\xb5\x75\xd8\xe8\xbd\xd1\xe7\x6b\xa9\x13\x95\xd7\x5c\xfb\xdc\xa9\x83\xb5\x5e\x4f\x43\x60\x38\x38\x66\x09\x1f\x9f\xb1\xb8\x19\x31\xec\x90\xaf\xaf\x6e\x17\x9d\x82\x30\x9d\x9f\x88\x4c\xea\x60\x9a\xc4\xb0\x83\x7c\xf4\x0d\xb8\xbf\x8d\xd6\xd3\xfe\x1f\xee\xa2\x70\x15\x11\xfd\xef\x3a\x53\x02\x91\x0e\x8d\x7d\xd0\x8c\x55\xca\x52\x92\x1b\x06\x0d\xd5\xd2\x36\xaf\x2d\x54\x63\x7b\xf2\xa1\x29\xd1\x56\x06\x5b\x5d\x48\x56\xec\x41\x3a\x89\xfd\xe2\x12\xa9\x69\x14\x03\x8f\x50\x23\x0c\x22\x49\x64\x8d\x49\x4b\x55\x9a\x96\x72\x6c\x2d\x7d\xd9\xd3\x44\xab\x15\xa1\x6d\xbd\x62\xbf\xd4\x6d\x79\x06\xfb\x53\x2b\x0a\xbd\xe3\x79\x25\x13\x99\x3f\x73\x14\xe2\x63\xa5\x52\x8e\x3d\xf9\x2c\x11\x10\xbe\xce\x6a\xa9\x9e\x7d\xbb\x3e\xa0\xd4\x6d\xd1\x64\x98\x0f\xb1\x1c\x6f\x81\xba\xe7\x36\x8a\xb9\x6f\xe2\x15\x8c\xd1\x99\xed\xd8\xcb\xbc\xb0\x4b\xae\x27\x0c\x23\x40\x92\x8d\x96\x29\x51\x73\x42\x4d\x79\x87\x7d\xe9\x75\x70\x88\x01\x5b\xdb\x09\x5e\x77\x56\x74\xe5\xfd\x3f\x1c\x53\x11\xdc\x93\xf8\x1f\x1f\x1e\x6e\x43\xe5\xd2\xcc\x9d\x6b\x14\x7e\x52\x39\x66\xcd\x19\x20\x47\xb7\xce\x0a\x47\x36\xc8\x29\x06\x67\xf0\x1f\x6a\x3a\x07\xd8\x79\x3c\xc5\x77\xb4\x6f\x51\x2e\xab\x50\x45\x78\xec\x59\x28\xb0\x39\x1c\x2e\x47\x73\x93\xdd\x10\x46\x63\x23\x04\xae\x17\xd6\x14\x23\xcc\x5b\xdb\xe0\x59\xb7\xf3\xf2\x92\x8d\x4b\xa2\xb7\xa7\x2f\x0f\x6c\x8d\xdf\x3a\xb4\x2f\xdd\x03\x33\x0f\x79\xe7\xbd\xc1\x42\x91\x85\x41\xeb\xe3\x45\x1a\xcc\x0e\xbb\xeb\xd6\xb4\x44\x4f\xb3\x8c\x6f\x86\xf2\xcd\xbd\xc1\x36\x59\xdf\x31\xac\xb0\x2e\xf4\xad\x7e\x01\x0a\x31\xa1\x79\xcb\x85\x75\x8b\xdd\xff\xc3\x77\x24\x22\xba\x0a\x85\x27\x0d\x53\x49\xc1\xc1\x0d\x72\xd4\xd3\x8e\x8c\x70\x65\x8c\xd2\xa1\xf2\xce\x08\xa3\x99\xd5\xf8\x7b\x44\x70\x41\x4e\x99\x41\xd0\x87\x71\xe0\x76\x2c\x4a\x13\x06\x96\x3d\x2d\xa9\xd8\x3e\xb3\x4e\x3b\xef\x19\x9f\x78\x5a\x37\xf5\x0a\x72\xc3\xfd\xcb\x8c\xca\xe2\x39\x90\xb4\xe7\x42\x82\x1b\x21\x26\xb2\xce\xa3\xb3\x7f\xef\x7a\xee\x24\xd4\x6c\x8d\x0d\xc9\x72\x9e\x0e\x96\xf6\x2a\x6d\x12\xa9\x67\x1e\xb1\x48\x32\x62\x52\xb6\x4c\x39\x9f\x13\x2e\x07\x5b\xcf\x13\x8d\xc4\xe9\x3e\xc2\x2a\x60\x8d\xd0\xd7\x61\xcf\xe7\xd2\x71\x3a\xd5\xad\x78\x43\xee\x0b\x84\x7d\x8f\xc3\xe9\x21\xcb\x88\xf2\x0d\x27\x21\xfc\xc3\xf6\xa1\x28\xb6\x76\xfe\xea\xed\x9a\xc1\x82\xee\x0a\xd6\xb9\x96\xdd\x91\xd2\xfb\xb1\x5d\x70\xe6\x3f\xe3\xba\x77\x79\x99\xfe\x48\x1d\xa3\xcb\x0e\xad\x07\x67\xf0\xc6\xc6\x71\xf4\xcf\x9e\x1b\x49\xc6\x47\x3c\xe4\x9b\xc9\x3f\xd2\xab\x63\xd9\x83\xbc\x2d\x28\x36\xe0\xe7\x5f\x3a\xc6\xde\x37\x0e\x2e\x45\xf9\x12\x41\xa3\x54\x48\x70\x88\x17\xfa\x5d\x95\x6e\xf9\x73\xd4\x2e\x7d\xc8\x0b\xe9\x96\xda\xa2\xb2\xd0\xf7\xb2\xd4\xb9\x41\x95\x2c\x4f\xcb\xef\x12\x44\x5d\xa3\x89\x43\x7e\x75\xc1\xe6\xd2\xca\x48\x1e\xc1\x18\xfd\xf9\xee\xe6\xfa\xea\xfd\xc3\xcd\xf5\x2f\x41\x14\x75\x9a\x8a\xae\x5c\x57\x4a\x89\xad\x97\xe9\xa3\xd0\xd7\x39\xb5\x17\xeb\x1c\x87\xf1\x4a\xed\x65\x5d\x78\x75\xf7\x4b\xc8\x5e\x92\xec\xf8\xf8\xa9\x29\x0a\xf1\x58\xc8\x16\x01\xd6\x10\xf1\x70\x4b\xd1\x43\xf5\x09\xfd\xae\xca\xed\xb8\x72\xd3\x53\x2c\x6c\xbe\xd9\x43\x7f\xf2\xff\xf3\x39\xcd\xe6\xf8\x1a\xaa\x1b\x0a\xe3\x15\xe1\x5b\xc4\x5f\x1d\x06\x16\xcb\x98\x29\x59\x9c\x38\x8e\x59\x70\x8e\x46\xd9\x36\xd4\x4b\x44\xbd\x6d\x86\x1c\xbd\xbc\xf4\xad\x11\xb7\x63\xd8\x16\xe5\x46\xfb\xbe\x02\x75\x92\x23\xbd\x5c\x5b\xde\x9c\x4e\x54\xbd\x5d\xf8\xa1\x8c\xc7\x8b\xdd\x91\x2b\xf4\x03\x17\xad\xfd\x48\xf7\x40\xfd\xfa\x30\x54\xa9\x2c\xbf\xef\xe4\xf6\x15\xed\x68\x65\x8f\xa2\xe3\x1b\xa9\x81\x76\x70\xa3\x4f\xe6\x7f\xdf\x94\xf9\x07\x1f\xe4\x88\xaa\xb1\x02\xe0\x86\x0b\x67\xda\xc6\x37\x7a\xa2\x40\x0c\xa7\xd4\x94\xd5\x45\x8e\xe5\x87\x9b\x2b\x9c\x69\x79\x26\x73\xd9\x80\x87\x7d\x57\x8d\x87\x67\xb8\x57\xda\x72\xc1\x52\x63\x9f\xb7\x16\x06\x6b\xaf\x4d\x38\x1f\xec\xeb\xae\xad\xb8\xb4\x33\x7e\x5f\x54\x5a\x86\xd1\xd9\x64\xe9\x5a\xbe\xf8\x4b\x06\xe1\x77\x22\x79\xca\x90\x29\x62\x3b\xea\x4c\xc3\xb7\x5f\x75\x51\x60\x6f\x05\x0e\x32\x2f\xdb\x8f\xe5\x4e\x48\x6e\x62\x7f\x43\xe6\x09\x5d\xf2\xf4\xd7\x81\xed\x18\x72\xc4\x8a\x87\x3b\xfd\x87\x6b\xd9\xd1\x88\x4b\x9a\x48\x4c\xc3\xc5\xcc\xdd\x50\x74\x6e\xb9\x58\x64\x92\xf8\x4f\x13\xee\x8b\x24\xea\x0d\x18\x94\xa5\x71\x8c\xc4\x99\x45\x6b\xdf\xe5\x1d\x2d\x70\xc1\x90\x4f\xbd\xe4\xab\xdd\xc2\x0c\xaa\x27\xd2\x0b\xd5\x8b\xc3\x7d\xbd\xc2\xef\xc6\x57\xdd\x83\x0e\x7d\x87\xae\xc7\x33\xd6\xe5\x2e\x56\xee\xb8\xb4\x91\x30\x8b\xe5\x5b\xfb\xb2\x92\x14\x3c\x1a\x09\x15\x38\x9b\xd9\x69\x6b\x4b\x97\x0e\x98\x8a\x44\xcb\xde\x26\xa8\xd2\x30\x31\xbf\x18\x77\x98\xf7\xd0\xd3\x49\x08\xbf\xb1\xa6\xa3\xba\xd1\xe1\x7c\x33\x5c\xd1\xfd\xd8\x3b\x9e\xb9\xdc\xf8\xfb\x7a\xe2\x76\xac\x1d\xf9\xec\x0d\x77\x27\x4f\xb3\x35\x06\x07\xf0\x36\x57\xe3\xd4\x6a\x07\x44\x7b\xb0\x1d\x10\x20\x4f\xd9\xe4\xb4\xe8\xae\x44\x79\xfa\x6d\xe1\xc2\x3f\xe9\xfc\xd0\x18\x9d\xa7\xd2\x32\x3a\x37\x57\xce\x06\x26\x44\x2b\x34\x4d\x89\x3c\x00\x9e\xd0\x2f\x1c\xb8\xb6\x8d\x7a\x23\x25\xa3\xa6\x1d\x9c\x3c\xf6\xf0\x58\x6c\xcb\xc5\x99\x0a\x11\x61\x63\xda\xa1\xd6\x05\xa2\xcb\xdd\x85\x2f\x5f\xbb\xfd\x65\xd1\xc1\x20\xd6\xba\xe9\xcc\xd4\x04\x4f\x52\xd6\x68\x72\x85\x11\xeb\x0d\x6b\x27\x15\xd4\x11\x0d\xba\xc1\x61\x0a\xa1\xfb\xa5\x37\x66\xa3\x38\x3a\xe0\xda\xb9\x4b\x19\x98\x98\xf9\xe7\x22\x96\x01\x63\xd5\xa9\xc2\x5f\x3e\x72\xb8\xf5\x80\xd2\x15\xf6\x51\xa2\x98\x56\x66\xc2\x75\x6e\xdc\x34\xbf\xe1\xd9\xfd\x8c\x4c\x51\x97\x45\xc8\xf4\x90\xbb\x1d\x09\x37\x6e\x7a\xc3\xe6\x80\x3e\xf0\x4a\x5f\xe4\xf8\xf0\x30\xb9\x60\xa8\xa4\xd3\x8f\x0e\xf9\x7f\xc9\xda\x94\xf9\x0e\xaf\x76\xa0\x7a\xe6\x5f\x2a\x79\xb9\xbd\x7b\x69\xab\xf4\xd7\xe9\x63\xf9\x84\xd4\xa7\x4a\x86\x2a\x22\x7f\xca\x3f\x1e\xa2\xf0\x22\xf5\xb7\x13\x5c\xe1\x8f\xf4\xec\x4c\xcc\xf7\xd8\x0b\x24\x2b\xb4\x28\x26\x18\x53\x25\x55\xa1\xf7\xb0\x3b\xb2\x43\x68\x2f\x5a\x99\x35\x0a\x11\x7b\x21\xac\x39\x8e\x5b\xc4\x43\x03\xe1\x92\x91\xeb\xba\xa0\x9f\x59\x83\x8d\xff\x40\xc2\x07\xfd\x2b\xc8\xc9\x7e\xd8\xb8\x79\x41\xfc\xde\x27\x2b\xb9\x16\x9a\x6d\xcc\x83\x5d\xe7\xe2\x6f\x4f\x31\xad\x12\xcd\xc3\x82\xfb\x55\xd0\xdf\x88\xae\xd1\x65\x3c\xb6\xb6\x97\x99\xd3\xf9\xa4\x77\x52\x33\x7d\x77\x6c\x2f\xc5\xff\x00\x1f\xa2\xab\x86\x98\x1e\x00\x00")
 
 func templatesServerOperationGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -450,12 +962,12 @@ func templatesServerOperationGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/operation.gotmpl", size: 3660, mode: os.FileMode(420), modTime: time.Unix(1466470505, 0)}
+	info := bindataFileInfo{name: "templates/server/operation.gotmpl", size: 7832, mode: os.FileMode(420), modTime: time.Unix(1792239904, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServerParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x1b\x5d\x73\xdb\x36\xf2\xb9\xfa\x15\xa8\xae\xcd\x90\xae\x42\xe7\x7a\x9d\x7b\x70\xe3\xce\x24\x8e\xd3\x78\x9a\xc4\xbe\x38\xcd\x4b\x26\xd3\x81\x25\x48\xe2\x85\x5f\x26\x28\x7f\xc4\xa3\xff\x7e\xbb\xf8\x22\x00\x82\xb4\xe4\x38\x69\x6f\xa6\x7e\x12\x81\xc5\x62\x77\xb1\xdf\x80\x6f\x6e\xc8\x8c\xcd\xd3\x82\x91\x31\xcf\xd2\x29\xab\x68\x4d\xf3\x0b\x9a\xa5\x33\xda\x94\xf5\x78\xbd\x1e\x01\x44\x3a\x27\x65\x4d\x92\x57\x69\x71\xd4\xb0\x9c\xc3\x2f\x7a\x25\x7f\xc9\xf9\x29\xcd\x59\x96\x7e\x62\x24\x79\x0d\xbf\x60\xf0\x14\x3f\xf6\xf6\x49\x5a\x34\xff\xfe\x29\xca\x58\x11\x49\x2c\xb4\x98\x91\xa8\x28\x1b\x92\x1c\xf1\x27\x75\x4d\xaf\x63\xf5\xf9\x82\xf2\x67\x29\x9f\xd6\x69\x9e\x16\xb8\x71\x6c\xc0\x8e\x8a\x86\xd5\x73\x3a\x65\xed\xd0\x69\x53\x33\x9a\xc7\xf8\xf3\xf5\x2a\xcb\xe8\x59\x86\x7b\xee\xc0\x16\x0c\xf0\xaf\xd7\xf0\x23\x79\x47\xb3\x15\x3b\xbc\xaa\x6a\xc6\x79\x5a\x16\x30\x1a\xc7\x23\x03\xa1\x98\x6a\x39\x82\x21\xf8\x66\x75\x8d\x54\x2b\xf6\x99\x99\x46\xea\x93\x13\xda\x2c\x01\x6e\x42\xe0\xa3\xaa\x81\xb3\x39\x19\x7f\x7f\x3e\x26\xc9\xcb\x72\x4a\x1b\xb9\x87\x98\x0c\x4a\x43\xcc\xd8\xfb\xc5\x3f\x8b\xed\xbe\xdd\x27\x45\x9a\x91\x9b\x11\x21\x35\x6b\x56\x75\x81\xa3\xa3\x75\x80\x54\x4b\xe4\x21\x52\xd5\xf4\x3d\x91\x6a\xf0\x6d\x4f\xe8\xef\x45\x7a\xbe\x62\x43\xb4\x5a\x10\xdb\x91\xfb\x67\x6b\xd0\x96\x92\x38\x2c\x56\x79\x8f\x08\x70\xea\xff\x8a\x77\xa9\xbf\x8a\xa3\x6d\x04\x61\x90\x6a\x37\x53\xd5\x65\xc5\xea\xe6\xda\xf3\x34\x96\xdc\x8e\xf8\x09\xb2\xd2\xa4\x17\x4c\x2e\x05\x4d\xa9\x32\x10\x1b\x19\x2b\x78\xa0\xc9\x80\x80\xac\x24\x94\x2b\xfc\x23\x7e\xb0\xe2\x4d\x99\x3f\x2f\xeb\x9c\x36\x20\x85\x9e\x93\x90\xf3\xc7\x73\x38\x0d\x71\x18\xc8\xea\x18\x7e\x6b\xf9\xaf\xd7\x63\x39\x70\x7a\x49\x17\x0b\x56\x4b\x78\x31\xca\x1b\x38\xb0\x05\x2e\xf4\xe4\x05\x12\x9a\x90\xb9\x00\xe4\xc3\xb2\x0a\x90\x2d\x4e\xd6\xe7\x3b\xe4\x9b\xbb\x7c\x6b\x59\x6b\x51\x9f\xa5\xc5\xac\xd2\x72\x12\xab\xc7\x3d\x90\x2d\x7e\x5c\xc3\x9c\xe3\x38\xa1\x35\x2b\x1a\xa5\x19\x47\x30\x7b\xf5\x8e\xa2\x34\xa7\x28\x47\x0e\x52\x49\x4e\xab\x2c\x6d\x9e\x5e\x4b\xd1\x28\xb5\xc6\x35\x0e\xf4\xfb\xf0\xf8\x87\xae\xea\x1f\x94\x59\xc6\xa6\x28\x7c\x89\x11\x35\x4e\x10\x9d\x71\xd6\x43\x46\x4d\x2f\x1d\x49\xd8\x00\xfc\x93\x20\x14\x3c\xdb\xe8\x02\x06\xbc\x59\x39\xf0\x6b\xf9\xf6\xba\x62\x81\xc5\xef\x94\x9e\x1c\x66\x2c\x47\x29\x00\xa6\xf9\xaa\x98\x46\x1e\x18\x46\x3a\xcf\xa3\x1e\x2c\xd3\x6c\xa6\xfd\xaa\xd8\x44\x8e\x98\xad\x62\xb2\x03\x3a\x50\xd6\x3c\x79\x67\xb4\x5a\x28\x88\x73\xf2\x7d\xe6\x22\xb1\x21\xc5\x46\xa3\x40\xc1\xc0\xfa\x46\xa0\x78\x3e\x93\x48\xf6\xa3\x9f\x3b\xa3\x8f\xfd\x11\xfe\xa9\x03\xf4\xc3\x0f\x9a\x26\x95\x05\x48\x2e\xba\xe6\x65\x26\x3c\xe3\x45\x15\x92\x53\x07\x65\x71\x01\xac\x08\x53\xbc\x40\x8b\x99\x68\x6b\x6c\xa5\x63\xc3\xf8\x42\x96\x2a\x64\xeb\x4e\x0c\x94\x29\x9b\xb6\x0c\xcc\x36\x31\x14\xef\x51\x21\xe4\x86\x62\x8f\xda\x9d\x36\xf3\xbc\xe3\xc0\xc1\x8d\x27\xbe\x90\xc2\x94\xc1\x59\x18\xf2\x14\x93\xfd\x9a\xe5\x33\xab\x9d\x7e\x9f\xec\x5c\x7b\x90\x40\x5d\xb7\x6d\x8c\xa2\xeb\x86\x1c\x47\x84\xc4\x92\xae\x69\xec\x13\x5a\x55\x80\xc0\x27\xae\x9e\x10\x41\x44\x2c\x16\x21\x1d\x42\x0f\x3f\x93\xdb\x5b\x24\x1a\xe0\xc0\xe3\x61\x7b\x2e\x6e\xdf\xd5\x0e\x61\x42\xe0\xed\x99\xb4\xbe\xda\x37\xda\xae\x3b\xb5\xcd\xf5\x73\xc5\xa4\x76\xb7\x18\xf9\x62\xb2\x09\x6c\xd5\xfa\x59\x2b\x92\x54\x74\xfa\x91\x2e\x18\x91\x3e\x5e\xfe\x06\x88\xd1\xee\x2e\x79\xbb\x4c\x39\x99\xa7\x90\x65\x5c\x52\x4e\x16\xac\x60\x35\x30\x38\x23\x67\xd7\xa4\x59\x32\x11\x40\x20\xac\x92\xa6\x2c\xb3\x04\xe1\x0f\x67\xe0\x3c\x8a\x05\x4c\xea\x75\x79\xba\x58\x36\x60\xa3\x25\xb8\x94\xf9\xaa\x11\xa8\x96\xac\x20\xd7\xe5\x0a\x78\x7c\x58\xaf\x0a\x07\x93\xde\x82\x4c\xcb\x3c\x87\x9c\x69\x34\x4a\xf3\xaa\xac\x1b\x12\x81\x00\xc6\x05\x6b\x76\x97\x4d\x53\x8d\xd1\x38\xc7\x8b\xb4\x59\xae\xce\x12\x80\xdc\x5d\x94\x0f\xc1\xd3\x16\xb4\x4a\x77\xa5\xdf\x18\xf7\x03\xe8\xec\x61\x00\x04\xa8\x6a\xd2\x7c\x08\x02\xe9\x15\x54\x40\x1e\x31\xcf\x9b\x5e\x30\x31\x2b\x00\x41\xba\x35\x2d\x40\xb4\xc9\x33\x36\xa7\xab\xac\x39\x12\x8c\x71\xa9\x9d\x8e\x13\xd3\x3a\xa9\x8e\xca\x5a\xfb\xdd\x47\x76\x3d\x21\xdf\x09\xfb\x45\x0d\x4c\x1c\x24\x38\xab\xe2\x95\x8d\x4f\x81\x7b\x58\x63\x71\xc0\xaf\x19\xc6\xde\x8a\xf2\x29\x75\x6a\x89\x13\xd4\x7f\x4e\xa6\x90\x6e\x36\x8c\x13\x4a\x0a\x76\x49\x86\x20\xcb\xb3\xff\x42\xd8\x47\x94\x97\x20\x09\x71\xa6\x33\xc9\xa7\xf4\x36\x1c\xa2\x2c\xe8\x86\x58\x3b\x4b\x46\x18\x87\x6f\xd9\x3c\x8a\x07\x37\x44\x43\xc1\x94\x20\x72\x64\xab\x26\x4d\xf0\xc2\xec\x5a\x91\xa1\xc7\x54\x2a\xfd\x1c\x54\x51\x40\xcb\x09\xb7\x9a\x5a\xaf\xf5\x2a\x27\xc1\x20\xfb\xa4\x9b\xe6\xe2\x72\x05\x22\xc3\x1e\x08\xd8\x3d\xd3\x7f\x5c\x8c\xcd\xa9\xb7\xa4\xb9\xc1\x36\xf6\xce\xbb\xf5\x5b\xea\x87\xc0\x0a\x73\x71\x9b\x33\x0c\x88\xe7\x66\x53\x99\x08\xef\xd2\x45\xb4\x5e\xef\x7d\x85\xc2\xe5\x81\xcd\x68\xa7\x9e\x55\x44\x4e\x82\x02\x21\xe8\xc2\x50\xdd\x06\xd5\xb7\x2c\x1a\x9a\x16\xa0\xbf\x59\x26\x54\xf2\xac\x5c\xc1\xea\x4a\xce\x62\xae\x85\x83\x80\x61\xb9\x02\x67\x63\x23\x20\x98\xb8\x89\x4c\x02\xf7\x80\x0c\x2e\x85\x1d\x32\xe1\xf5\x20\x8c\x40\x22\x0c\x0a\x8f\xa8\xc1\x17\xce\xeb\x32\x07\x03\x41\xbf\x94\xbc\x61\x50\x1c\x73\x34\x03\x5c\xa6\x9c\xda\x9e\xd8\x8f\x81\x4c\xb8\x50\x27\xb5\xc5\xa8\x41\xa5\x1a\x22\x1f\xbc\xc7\x6a\x0a\x2a\x88\xee\x03\xd0\xbd\x78\xfb\xf6\x84\xa8\x1d\xc8\xb1\xb4\x37\x22\x46\xf5\xe0\x8e\x43\x44\xd8\x30\x76\x77\x94\x1a\x3c\x63\x78\x78\x55\x63\x92\x8d\xee\x88\x91\x39\xc2\x23\xda\xb4\x66\x4a\x45\xf5\xd7\x1e\x01\x22\x99\x0f\xfb\x8a\x5e\xa5\xb9\x2c\xa0\x09\x51\x1f\x5a\xa1\x92\xc3\xab\x69\xb6\xe2\xa0\xf6\x2d\xd4\x63\xe7\x84\xad\xe5\x1d\xc4\xe0\x45\x5a\xc4\xf2\x23\x80\xd8\x40\xfd\xe2\x21\x36\x13\x1d\xc4\xa0\x69\x69\x95\xb1\xe3\xb9\xc2\xad\xbe\xc9\xf1\x7c\x4f\xb6\x7f\x6c\x80\x00\xbf\x2f\x59\xb1\x10\x49\xa9\xe4\x98\xc8\x6f\xb5\xd6\x9a\x0e\x70\xe4\x2c\x4d\x0b\x77\xa9\x35\xed\x2f\x3d\x11\xc9\x7b\x21\x17\xaa\x8f\x3d\x15\xc6\xf5\x4c\x80\x52\xd3\xde\x91\x84\x8a\x4f\x43\xa7\x9e\x0c\x90\x69\xaf\x03\x2a\xed\x75\xed\xa4\xbf\xce\xeb\x28\x11\x22\x07\xc2\x6a\x63\x65\xef\x00\x79\xa4\x98\xb1\x46\xfd\x05\x81\x6a\x13\x16\xb6\xa3\x44\x0e\x4b\x3c\x01\x60\x1f\x9f\xef\x1a\xd5\xc7\x1e\x19\x76\xe7\xc6\x71\xef\xec\x9a\x72\x4b\x38\xbe\xd3\xe9\x92\xe5\x54\x85\xf8\x8e\x99\xdf\xab\x87\x35\xf1\x6c\x9b\x6e\x91\x89\x5b\x4e\x45\xb2\x01\xa5\x92\x31\x40\xfc\x94\x72\x86\x28\xdc\x5d\x3c\x20\x4d\xc8\xc0\xe6\x6e\xe8\x5b\x6b\xef\xfe\x14\x52\x70\xed\xdd\xce\x4a\xb0\x02\xcc\xc9\xb9\x20\x44\xe7\x71\x98\x9d\xd4\x12\x64\x42\xd2\x86\x50\xce\x57\x39\x8c\x36\x4b\x38\x62\xc8\xc7\xc0\x66\xaf\x30\x21\x2d\x16\x90\x83\xe0\x97\xe8\x05\x50\xa2\xd2\x75\xa4\x37\x92\x79\x1a\xb8\xb8\x45\x0a\x3f\xe1\x00\x44\x16\x89\x8d\x01\x29\x66\x24\x05\xc3\x05\x17\x08\x4c\x46\xd3\x40\xb2\x03\x91\x65\x05\x82\x83\x65\x54\xa4\xba\xe0\xe8\x97\xe5\x8c\x60\xb8\xe0\x32\xcd\x11\xe9\xf9\x1b\x36\x65\xe0\x99\x6a\x2d\xd0\x9d\x01\xc7\x1f\xdb\x6c\x47\xb5\xeb\xd6\x27\xa4\x2e\x57\x90\x20\xef\xe4\xe9\x6c\x96\xb1\x4b\x88\x45\x60\xb7\x0d\x88\x7a\xf6\x06\x27\x34\xed\x3a\x3f\x82\x7a\x92\xbc\xff\x20\xc6\x54\x0d\xe1\x91\x92\xd8\x11\x64\x9f\xd4\x23\xa3\xc2\xa8\x80\xff\x59\xb1\xfa\xda\x04\x8f\x73\x2e\x1a\x36\x32\x3d\x96\x25\x2b\x8f\xea\xe4\xf7\x37\x2f\x13\x01\x18\xc5\x56\x1e\xe3\xe0\x41\x93\x33\x68\xda\x12\xaa\xc6\xc0\xc4\x99\x74\xae\xb4\x6e\x10\x2c\xfa\xd7\x8f\xe4\xf1\x63\xf2\xe3\x23\xbf\x32\xfa\xe6\x9b\xb6\x5f\x20\x44\x72\x58\xd7\xaf\xcb\xc6\x2c\x36\xc5\x53\xb0\x84\x12\x65\x94\x29\x03\xdd\xfd\xc5\xb6\xe1\x42\xac\x1f\xd7\xe8\x9b\xb5\xcb\x9f\x90\x87\x61\x12\x00\xe7\xb3\xb0\xbc\x10\x38\x0e\xa6\x35\x3d\x41\xdb\xf5\x2a\x4e\x9f\x51\xa6\x92\xed\x31\xe1\x29\x05\x75\x6b\x42\xce\x97\x1f\x7b\x66\xfe\x40\x32\xcf\x79\xf2\x2b\x6b\x8e\x7f\xb3\x7b\xdb\x71\xa7\x0b\xe2\x6b\x0f\x1a\x64\x10\x6b\xb4\x3d\x19\x42\xb3\x55\x57\x37\xd0\x7c\x25\x42\x99\x4d\xd5\x0b\x1f\xa2\x09\x15\xb7\x1d\x10\x53\xe4\x43\x72\x0d\x0c\x68\x81\xd4\x7d\xfb\x0d\x0b\x44\x92\x23\x0f\xe1\x7e\x45\xb3\x3d\x41\xf7\x29\x9a\x17\x8c\xce\x58\xad\x85\x73\x67\x1e\x12\x89\xe7\xbd\x30\xc5\x03\x5a\x94\x05\x26\xc9\x72\xf0\x37\x76\xed\xc8\xea\xc3\x44\x04\xfc\xfb\xe5\xc3\xf8\x14\x61\x09\x6a\x6c\xa8\xac\x23\x7d\x57\x67\x92\xe8\x49\xeb\x15\x10\x35\xa2\xea\x39\xf0\xdb\x29\xc6\xde\x25\xd4\xb7\xd1\x4f\x8f\x1e\x4d\xc8\x18\xc2\xdf\x0c\xfb\x22\xa2\x25\xf2\xfd\x39\x99\x53\xf8\x01\xb9\xf3\xf7\x17\xe3\x4e\x0f\x33\x72\xa9\x8b\x05\xd1\x28\x03\x21\x04\xc9\xff\x8d\x2e\xdb\x3a\xe7\x15\x0e\xdf\xad\x0f\x42\xa6\x6e\x9e\x41\xd8\xdb\x0b\x0a\x62\x42\xa4\x28\xc2\xb3\x72\x6e\xed\x1d\xc6\x7a\x3d\x0f\xab\xc8\x04\x1c\xe0\xb0\x85\x81\x83\xbc\x5f\xc3\xba\x0b\x25\x9f\xaf\x92\x9e\x0f\xf7\xf5\xf4\x6f\x6f\x3d\x6c\xca\x98\xcd\x79\xe6\xfc\xb7\x46\x05\xb2\x82\x56\x54\x4f\xcb\x99\xd2\x9f\xb6\xc4\x80\x59\x6d\xe4\x90\x8f\x20\x44\x04\x15\x43\x7b\xff\xe3\xa7\xe3\xaa\x24\xde\xd8\x81\x24\x88\xd2\x31\xfb\x19\x9b\xb3\x5a\x4d\x24\x07\x59\xc9\x59\x14\xbb\x94\x76\xca\x04\x6b\xe8\xf0\x0a\x5b\x97\xa2\x9f\x70\x06\x08\x9c\xfb\xa4\x57\xe5\x0c\xf6\x68\x9b\xd1\x50\x46\xe6\x90\xa7\x2d\x69\x06\xb3\x78\x75\x5b\xe9\x39\xdd\x9c\xeb\x2c\xb9\xf1\xef\x64\x4f\xb1\xaf\x6f\x44\x1a\x49\xb2\xf5\x59\x1d\x94\x05\x56\x0d\x75\xc0\xc7\x6b\xf1\xb9\xfd\x0f\x03\xb6\xbf\x4f\xd2\x32\x39\x3c\x7e\x6e\x32\x46\x31\xaa\x63\x80\x5e\xb5\xf1\x83\x81\xd8\xf4\xfb\x2d\x4d\xe8\xd5\x97\xf6\x30\x30\xd7\x47\x39\x7a\x57\xa2\xb6\xe2\xbb\xac\xea\x1f\x46\x12\x0f\x70\xb9\xa7\xad\x5b\x33\xdf\x1b\x0c\x6d\x41\xdc\x1a\xee\x86\xe4\xa3\x04\xa4\x02\xa1\xd3\x27\x1f\x8e\xc5\x22\xd3\x3f\xc4\xcf\xcf\xa5\x01\x22\xfa\x58\xc5\xe4\x1e\xf9\x78\xe7\x67\x5b\xb5\x1f\xc2\x83\x31\x42\xdf\x38\xc9\xcf\x28\x50\x78\xdb\x2d\x00\xfb\x6a\xf7\x49\x96\x82\xad\xcd\xac\xbb\x5e\x59\x02\xcb\x86\x61\x8c\xbc\x61\x25\xfb\x47\xe7\xfe\x2c\x54\xa5\x8a\xdb\xf9\x42\xdd\x0d\x6d\xe6\x29\xb4\x22\xf4\x5e\x93\x85\x50\xe9\xab\xb3\xe8\x56\xcf\x39\xe8\x3d\xe5\xdf\x19\x78\xb7\x8f\x23\x5d\xa4\x05\x4e\xe3\x26\x70\x2d\xb8\x89\x88\xcd\x84\x91\xb1\x19\xe9\x0a\xb9\xe5\x1f\xcd\x6a\x2b\x0e\x07\xf8\xeb\xea\x95\x90\x34\xbe\xd2\x03\xc0\x18\x0d\xf2\x91\xc1\xb3\x8d\x7b\xdf\xb2\xc1\x63\xf7\xee\xcf\x64\x5c\x90\xc4\xf9\x57\x57\x81\xee\xb1\xad\xff\x5f\xc7\x5d\xac\x6d\x9a\xba\x77\x6b\xe6\xb7\x2d\xc9\x5f\x8c\x20\xdd\x07\x09\x78\xd2\x25\x4f\x1b\xd6\x3e\xfc\x90\x3e\x05\x56\x25\x49\xa2\x83\xb8\xfb\xac\x03\x36\xf9\x6e\x9a\x51\xce\x85\xc0\x41\x27\x22\xef\x10\x90\x8f\x51\xa8\xf0\x6f\xcb\x7e\xa1\x82\x4e\xe8\x77\x9a\x7e\xce\x6b\x4f\xfb\x6e\x29\xfc\x9a\x6a\xb8\x33\x65\x11\xdb\x36\xa5\x06\x4a\x41\x7a\x89\x25\x05\x79\xff\x41\x3e\xab\x9a\x90\x25\xe5\x50\x02\x82\xea\x97\x99\x79\x4d\x45\x7a\xba\x6c\x37\x4e\xce\xa0\x9b\x97\xa6\x7e\x8f\x1d\xe5\x01\xa8\x6f\x15\xf2\xd0\xe9\xdc\x29\xdc\x3a\x6a\x20\x7a\x66\xf4\x52\x3d\x10\xb3\x94\x42\xf2\xe8\x28\x06\x80\x89\xe7\x4b\x38\xf1\xde\x06\x7a\xf8\xcf\x0f\x2d\xde\x4d\x18\x93\x93\x4f\xb2\xac\xbc\x3c\xcc\xab\xe6\x5a\xb4\x8a\x5c\x37\x62\x5e\xbd\xe9\x45\xa7\xfa\x09\xdb\xa6\x2f\x11\x81\xba\x4d\x9f\x92\xd8\xa9\x79\x44\x7c\xca\x89\x74\x88\x92\x68\x4d\x4e\xdc\x47\xbf\x10\xd3\x3e\x84\x4c\x88\xd6\xbb\xbb\x84\xe1\xbc\x6e\xa1\x82\x32\xc9\xdb\xb9\xb2\x59\x82\x62\xb6\x4f\x04\xb9\x1d\x1e\x9d\xb6\xbc\x7a\x07\x36\x70\x61\xdb\x7b\xc9\xdb\xe6\x45\x6d\xde\x54\x72\x59\x13\xcb\x0b\xd6\xaf\x75\xc3\x2b\x9d\x73\xf7\xcd\x4f\xc0\x13\xdf\x7e\x29\x20\x2d\xde\xf8\x66\xd2\xbd\x01\xd8\xf0\xaa\xd5\x4f\x3a\x8d\x0b\xeb\xfa\x75\x9d\xa7\x0c\x3f\x0b\xb3\x1f\x84\xa1\xf6\xf5\x76\x50\x86\x9e\x7c\x6d\xfc\xcc\xd6\x99\x34\x47\x2d\xf5\xbe\x65\x61\x48\xea\x7d\x21\x4e\xb0\xd6\xa9\xeb\xbb\x6e\xd5\x15\x81\xf2\x7b\xb2\xc5\xec\x07\x32\xf7\x4d\xaa\x4d\xe7\x5f\x5a\x42\x77\xb8\xac\x1a\xb8\x99\xd2\xdf\x5a\xe8\xee\x15\x51\x24\xc4\x99\x44\x3b\x8e\xe9\xc6\xf6\xc3\xb5\x3b\x9e\x27\xf0\xdb\xd1\x67\xe5\x68\xda\xb0\xee\xde\x54\x84\x92\x29\xed\x92\x7b\x3a\x07\x43\xf5\x7f\xc0\xe5\x16\xa1\x17\xa1\x92\x51\xbb\x48\xf8\x2b\x06\x6f\x2f\xc3\xfb\xe2\x41\xda\x38\x20\x76\x1e\xb8\xd7\x1d\xe7\x78\x23\x34\x96\xe7\xac\x5e\x18\x23\x8b\x6e\xb1\x7c\x7e\x11\xce\x85\x37\x08\xfc\x7d\x4b\xc3\xc9\x00\x79\x48\x54\x3a\x30\x92\x39\x43\xf8\xe9\x75\x0f\xd2\xcd\x9e\x58\xdb\x92\xe1\xea\x1f\x78\x14\x0d\xb1\x3e\x21\xd1\x91\xb9\x3d\xe3\x90\x9c\x0b\x24\x56\x35\xf1\xc5\x4e\xd0\x0d\xee\x33\x36\x7f\xa7\x1f\xb7\xf5\x3c\x4f\x6f\x81\x37\x7c\x7d\xee\xf1\xf3\xe0\x81\x90\x8c\xde\xc9\x3e\xd7\x5e\x6f\xa2\x81\x9d\x8e\x4b\xbf\x94\xc0\x74\x9d\x1a\x68\x74\xfb\x13\x53\x23\x92\x5e\x77\xe6\xbc\xe4\x74\xc4\x27\x3a\x5b\x7f\xb6\xdf\xea\x3a\x2e\xf7\xbf\x23\x30\x49\xf1\xdc\x6b\x0f\xed\x77\x71\x6f\x1b\x71\x74\x4b\x01\xb2\xc1\xbb\xfd\xa0\x83\xee\xfc\x13\x87\xf5\xeb\x7f\x01\x00\x00\xff\xff\xc8\x6a\x54\xf5\xa3\x37\x00\x00")
+var _templatesServerParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x1b\x5d\x73\xdb\x36\xf2\xb9\xfa\x15\xa8\xae\xc9\x90\xae\x42\xa7\xbd\xce\x3d\xb8\x71\x67\x52\xd7\x6d\x3d\x4d\xe2\x5c\x9d\xe6\x25\x93\x69\x69\x09\x92\x78\xa1\x48\x99\xa4\x6c\xab\x1e\xfe\xf7\xdb\x5d\x7c\x10\x00\x01\x59\xb2\x9d\x5e\x6e\xa6\x99\x4c\x22\x12\x8b\xc5\xee\x62\xbf\x01\xde\xdc\xb0\x09\x9f\x66\x05\x67\xc3\x3a\xcf\xc6\x7c\x99\x56\xe9\xe2\x32\xcd\xb3\x49\xda\x94\xd5\xb0\x6d\x07\x37\x37\x2c\x9b\xb2\xb2\x62\xc9\xcb\xac\x38\x69\xf8\xa2\x86\x5f\xe9\xb5\xf8\x25\xc6\xc7\xe9\x82\xe7\xd9\x9f\x9c\x25\xaf\xe0\x17\xbc\x3c\xc3\x87\x83\x43\x96\x15\xcd\xbf\xbe\x89\x72\x5e\x44\x02\x4b\x5a\x4c\x58\x54\x94\x0d\x4b\x4e\xea\xe7\x55\x95\xae\x63\xf9\xf8\x73\x5a\xff\x90\xd5\xe3\x2a\x5b\x64\x05\x2e\x1c\x6b\xb0\x93\xa2\xe1\xd5\x34\x1d\xf3\xee\xd5\x59\x53\xf1\x74\x11\xe3\xcf\x57\xab\x3c\x4f\xcf\x73\x5c\x73\x0f\x96\xe0\x80\xbf\x6d\xe1\x47\xf2\x36\xcd\x57\xfc\xf8\x7a\x59\xf1\xba\xce\xca\x02\xde\xc6\xf1\x40\x43\x48\xa6\x3a\x8e\xe0\x15\x3c\xf3\xaa\x42\xaa\x25\xfb\x5c\x0f\x23\xf5\xc9\xeb\xb4\x99\x03\xdc\x88\xc1\xc3\xb2\x02\xce\xa6\x6c\xf8\xe8\x62\xc8\x92\x17\xe5\x38\x6d\xc4\x1a\x34\xe8\x95\x06\x8d\x98\xeb\xc5\xdf\xd2\x72\x9f\x1f\xb2\x22\xcb\xd9\xcd\x80\xb1\x8a\x37\xab\xaa\xc0\xb7\x83\xd6\x43\xaa\x21\x72\x1f\xa9\x72\xf8\x81\x48\xd5\xf8\x76\x27\xf4\xb7\x22\xbb\x58\xf1\x4d\xb4\x1a\x10\xbb\x91\xfb\xbf\xd6\xa0\x1d\x25\x71\x5c\xac\x16\x01\x11\xe0\xd0\xff\x15\xef\x42\x7f\x25\x47\xbb\x08\x42\x23\x55\x6e\x66\x59\x95\x4b\x5e\x35\x6b\xc7\xd3\x18\x72\x3b\xa9\x5f\x23\x2b\x4d\x76\xc9\xc5\x54\xd0\x94\x65\x0e\x62\x63\x43\x09\x0f\x34\x69\x10\x90\x95\x80\xb2\x85\x7f\x52\x1f\xad\xea\xa6\x5c\xfc\x58\x56\x8b\xb4\x01\x29\x04\x76\x42\x8c\x9f\x4e\x61\x37\x68\x33\x90\xd5\x21\xfc\x56\xf2\x6f\xdb\xa1\x78\x71\x76\x95\xce\x66\xbc\x12\xf0\xf4\xb6\x6e\x60\xc3\x66\x91\x5e\x30\x20\x4e\x57\x9a\x20\xbf\x11\x9b\x12\x9a\x7a\xb3\x24\x3d\x4c\xd1\xbe\xbb\x52\xf1\x79\xee\xbe\x54\x14\x3d\x6a\x23\xce\xb3\x62\xb2\x54\x52\xa4\xd9\xc3\x00\x64\x87\x1f\xe7\x70\x6b\xb3\x5e\xa7\x15\x2f\x1a\xa9\x37\x27\x30\x7a\xfd\x36\x45\x59\x8f\x51\xca\x35\xc8\x2c\x39\x5b\xe6\x59\xf3\xfd\x5a\x08\x4e\x2a\x3d\xce\xb1\xa0\xdf\xf9\xdf\xbf\xef\x1b\xc6\x51\x99\xe7\x7c\x8c\x5b\x23\x30\xa2\x3e\x12\xd1\x79\xcd\x03\x64\x54\xe9\x95\x25\x09\x13\xa0\xfe\x93\x08\x05\xbf\x37\xb8\x84\x17\xce\xa8\x78\xf1\x53\xf9\x66\xbd\xe4\x9e\xc9\x6f\xa5\x16\x1d\xe7\x7c\x81\x52\x00\x4c\xd3\x55\x31\x8e\x1c\x30\x8c\x83\x8e\xbf\x3d\x9a\x67\xf9\x44\x79\x5d\x5a\x44\xbc\xd1\x4b\xc5\x6c\x0f\x74\xa0\xac\xea\xe4\xad\xd6\x79\x52\x10\x6b\xe7\x43\xc6\x24\xb0\x21\xc5\x5a\xa3\x40\xc1\xc0\x36\x07\xa0\x78\x2e\x93\x48\xf6\xd3\x6f\x7b\x6f\x9f\xb1\x9e\xa8\x7a\x40\x5f\x7e\xa9\x68\x92\x39\x82\xe0\xa2\x6f\x7c\x7a\xc0\x31\x6d\x54\x21\x31\x74\x54\x16\x97\xc0\x0a\x19\xea\x25\x5a\xcc\x48\xd9\x6a\x27\x1d\x13\xc6\x15\xb2\x50\x21\x53\x77\x62\xa0\x4c\x5a\xbc\x61\x60\xa6\x89\xa1\x78\x4f\x0a\x92\x1b\x8a\x3d\xea\x56\xda\xce\x2f\x0f\x3d\x1b\x37\x1c\xb1\xad\x28\x83\xbd\xd0\xe4\x49\x26\xc3\x9a\xe5\x32\xab\x42\x42\x48\x76\xb6\x3d\x08\xa0\xbe\x53\xd7\x46\xd1\x77\x43\x96\x23\x42\x62\x59\xdf\x34\x0e\x59\xba\x5c\x02\x02\x97\xb8\x6a\xc4\x88\x88\x98\x26\x21\x1d\xa4\x87\xf7\xe4\xf6\x16\x89\x7a\x38\x70\x78\xd8\x9d\x8b\xdb\x57\x35\x03\x1c\x09\xbc\xdb\x93\xce\x57\xbb\x46\xdb\x77\xa7\xa6\xb9\xde\x57\x4c\x72\x75\x83\x91\x8f\x26\x1b\xcf\x52\x9d\x9f\x0d\x44\x7f\x64\x1b\xd3\x08\xb4\x96\x7a\x28\x06\xbf\x28\x97\xe4\x09\x81\x63\xe5\x12\x71\x72\x95\x16\x33\x4e\x51\x21\xa5\x5c\xd2\x48\xab\x94\x3f\xde\xdf\xd7\x79\x89\x7c\xc5\xb2\x9a\x35\x73\xce\x1a\x7c\x2c\xa7\xf4\x1b\x41\x4c\x57\x6b\xd8\x30\x23\x82\x0c\xc0\xf9\x6a\x91\x16\xe8\xa2\x15\x51\x00\x83\x3e\x96\x26\x0c\x08\xab\xbb\xa2\x1d\x22\x90\xa6\xe7\x79\xee\x00\x91\xf1\xd5\x52\xe8\x35\x4b\xf3\x9c\x16\x84\xff\xcb\x2b\x3e\x11\xf6\x52\x33\xe5\x9c\x8d\x89\x23\x08\x1e\x04\x5a\x56\xa0\x2a\x8a\xd0\x7a\xc9\xc7\x09\xad\xc4\x0a\x7e\xc5\x48\xa3\x90\x73\x81\x1f\x10\x02\x73\x1c\x5c\xc2\x1a\x42\x4e\x9e\x27\x03\x8c\x4a\x41\xaa\xa2\x98\xbd\x7b\xdf\x63\xaa\x8b\x1c\xbd\xc1\x1b\xd6\xed\x8e\x4a\x72\x51\x87\xe0\xed\xac\x7c\x91\x81\x1f\x4a\x73\xca\x3f\x46\x9d\x3a\xa0\x86\x09\xe1\x9c\xd4\xa4\xc7\x5a\x16\x4d\xb5\xe2\xec\x6a\xce\x91\x4d\x60\x01\xfe\x96\x85\xde\xba\xdb\x05\x24\x78\x8b\x2e\xdd\xf7\xb1\x5a\x08\xd8\x3b\x2f\x4b\xa1\xf8\x38\xff\x77\x08\x2c\x22\x2b\x40\x06\x36\x08\xe5\x46\x5b\x23\x3b\x84\x8c\xd1\xb5\x1c\xa4\x5b\x9b\x8e\x11\x68\xa7\x29\x38\x02\x47\xfd\x37\xe5\xc3\xa8\x80\xdf\x93\x23\xd0\xf6\x30\xce\xd3\xba\x56\x26\x11\x2d\xd3\x1a\x76\x51\x97\x69\x71\xc0\x3c\x64\x46\x86\x69\x7e\x84\xa1\xf8\xa4\xfe\xbe\x9c\xac\x69\x18\x1f\x7e\xcc\x72\x4e\x0f\x31\xb3\xaa\x7a\x33\x24\xfb\xb3\x66\x21\x60\x94\xd2\xaf\x7c\xcc\x01\xae\x52\x86\xb1\x67\x13\xdb\xb6\x82\x18\x10\x38\xf0\x83\x91\xd3\xa6\x1c\xa3\x36\xe4\x62\x3f\xa4\x4d\x0a\x4a\x25\xd2\xe7\x11\x9b\xa7\xf5\x2f\x7c\x4d\x7b\xa4\xf3\x62\xcc\xad\xa7\x8b\x06\x16\x9c\x65\xf0\x13\x2a\x1d\x8a\xd6\x46\xb2\x61\x16\x43\x18\xac\x25\x6b\x30\xe3\x62\x95\x55\x1c\x85\x0c\x50\x9f\x4b\xe4\xbe\xc0\xaf\x20\xb7\xae\xc3\xe2\x2e\x10\x8a\x5d\xc4\x84\x11\xf8\x91\x85\x80\x08\xe7\xd8\xec\x90\x3c\xc6\xec\x3b\xf6\x54\x2d\x0d\x60\x94\x88\xe2\xc0\x3b\x13\xe8\xc9\x57\xef\x3b\xbc\xdb\x30\x26\x06\x9f\xa3\x61\x1c\x2f\x96\xcd\x9a\xf4\x35\x16\xfc\xba\xd5\x8d\x9a\x74\xa6\x4b\x95\x2d\x33\x1b\xa0\x6e\xdb\xa4\x40\xc5\x3d\x22\x9c\xb9\x94\xb3\x98\xb4\x51\x10\xad\xc8\x89\x43\xf4\x93\x98\x0e\xd9\x70\xc8\x6e\x18\xb8\x0a\x8e\xe3\xca\xf6\x41\x99\x84\xe3\x2c\xc1\x31\x54\xac\x2b\x05\x6b\xe5\x7c\x30\x3a\x60\x2d\xcc\xa7\xe9\x2a\x6f\xe4\x06\xf5\xda\x1c\x6d\xab\x00\xfa\x01\xc5\x75\x74\x1d\x77\x92\x2f\x6d\x45\x12\x58\xfb\xfd\x2e\xe3\x2a\xeb\x04\xa1\x20\xf3\x29\x26\x60\x23\x87\xac\x5f\xd6\xfa\x62\x59\x60\x69\xaa\x20\xc5\x2a\x86\x27\x89\xc4\x2f\x7a\xa3\x37\xf1\x1f\x97\xb0\x8b\x1d\xf3\xde\x6a\x3a\x36\x3d\xb2\x88\xf8\xfd\xbc\x50\xd3\xbc\x4b\xc7\x41\xf8\x12\x5d\x03\xb3\x7e\xc7\xa1\x6d\x1f\x9b\x0e\xb0\xd7\x7c\x92\xa4\x2b\xc2\x04\xa0\x51\xbd\x38\xd6\xa7\x2d\xc6\x11\x1b\xa5\x60\x46\x42\x3c\x96\xbf\x27\x76\x41\x61\x96\x12\xa8\xed\x77\x2a\x16\xb6\x6e\xdf\x58\x83\x5a\x69\x84\x9d\x49\xc6\x48\xcd\x15\x79\x06\x4b\x91\x66\x20\xee\x94\x22\x08\x8c\x08\x4d\x11\xa1\x13\x24\xe0\xa4\x0b\x87\x3e\xce\x5e\xf1\xab\x48\xe1\x3a\x6b\xd2\x66\x55\x8b\x0a\xe7\x51\x8d\x09\x08\xfc\x5b\xcf\xcb\x15\xe4\xa8\xe7\x5c\x85\xe7\x47\x97\xb2\xd4\xd9\x4e\x08\xe1\x40\x6b\xf8\xd5\x4d\xca\x68\x77\x58\x1e\x1b\x5e\x38\xa7\x40\x6c\xa5\xe0\x9b\x8b\xc9\x4f\x6e\xef\x1f\x90\x75\x5f\x08\xb7\x45\x20\x63\x2c\x66\x0e\x35\xb1\x62\x11\x68\xf5\xb9\x4c\x3a\x3f\x69\x09\xdd\xa1\x37\x6a\x24\x44\xae\xa7\x52\xcf\x4a\xe8\xb1\xd5\xd2\x8b\x84\x3d\x45\x7b\x56\x00\x88\xcd\x72\xf7\x8e\xfb\x09\xfc\xf6\x3c\x9c\x0c\x6a\x5d\x17\xa8\xb6\x42\xbd\x27\x2d\x4b\x54\xf8\xf7\x67\x60\xc1\xd6\x63\x20\xbc\x17\xbe\x3e\x92\xad\x71\x28\x4a\x92\xba\x0a\x8d\x0f\x9b\x33\xce\x79\x8a\xd9\x31\xe4\x8c\x7b\x0b\x08\x10\x19\xa4\xcc\x0d\xc5\xd8\x9f\x69\x60\x87\xac\xd1\x49\x0f\x31\x0f\x93\xc8\x63\xcc\x3b\x9e\x7e\xc4\x44\x71\x0a\xf4\xd6\x4e\xad\xa8\x0b\x12\x41\x44\x57\x95\x28\x8e\x05\x39\xb0\x97\xa9\xb6\x5e\x31\x94\x9c\x42\x5d\x1e\xc5\x66\xbf\x20\x58\xdf\x2b\xf7\xfe\xcd\xd3\xa7\xe0\xd0\x41\xc3\x27\x90\x0b\x12\x3d\xec\xd1\x05\x94\x2b\xf0\x63\x72\xe0\x71\xe7\x30\x35\xd6\x05\x0e\x93\x0c\xe8\x96\x00\x3d\x82\x89\xae\x8a\x26\x5b\x70\x91\xf2\x60\x42\x7b\x20\xc9\x15\x7b\x73\x20\xe9\x6d\x6f\xb5\x63\xc2\x37\x30\xf3\xb8\x33\xac\x6a\x3f\x39\xbd\x37\xd4\x9e\x54\xfe\x53\x2c\x90\xfa\x9a\xfe\x71\x0b\x21\xbd\x6d\xfc\xc2\xd3\xa0\x1f\x92\xd1\x0e\x85\x7f\x93\xfd\x78\x64\xd1\xcc\x66\x2a\x76\x71\xe9\x95\xc7\x36\xc5\x55\x68\xaa\xbf\xe0\x62\x4f\x98\x2c\xb9\x06\xa2\x2e\xf3\x1f\x54\x04\x90\x6e\x77\x20\x61\x4a\xa6\x96\x87\xe1\x92\x86\x58\xed\x10\x86\xac\x2d\xaa\x3a\xc1\x39\x21\xf9\x88\x1e\x6a\xe0\x2f\xa0\x26\x7c\xfa\x56\x65\x9a\x81\xc3\x9c\x0e\x78\xcb\xb3\x1a\x87\x9f\xc7\x8f\x49\x32\x6a\x25\x73\x5f\x83\xbe\x42\x01\x1b\xa9\x0f\x69\x79\x40\x4a\x60\xba\x76\x23\xea\xf6\x86\xac\x16\x49\x30\x8c\x5b\x7d\xcf\x4f\xcf\x6f\xf9\x02\xb6\x79\x96\x88\xe5\x9a\x93\x56\x04\x68\xbf\x8b\x7b\xdb\x8a\xa3\x5b\xc2\xf5\x16\xa7\x5c\x81\xc4\xc4\x69\xb6\xf9\x7e\x2d\xd3\xf1\x87\x74\xc6\x65\xc0\x13\xbf\x65\xf3\xf6\x0d\x76\x21\x29\x3a\x5e\xa5\x35\x9b\xf1\x02\x9b\xbf\x60\xa1\xe7\x6b\xd1\x7a\x15\xf9\x31\x6b\xc0\x1d\x53\x0b\xf6\x78\x02\xc5\x35\x04\xd4\x46\xcf\x5b\x64\xb3\x79\x03\x96\x50\x42\xc9\x3d\x5d\x35\x84\x0a\xfb\x9b\xeb\x72\x05\xc4\x3e\x81\x90\x69\x61\x52\x4b\xb0\x71\xb9\x58\x80\x57\x18\x0c\xb2\xc5\xb2\xac\x1a\x16\x01\x73\xc3\x82\x37\xfb\xf3\xa6\x59\x0e\x71\x4b\x87\xb3\xac\x99\xaf\xce\x13\x80\xdc\x9f\x95\x4f\x40\x2c\x45\xba\xcc\xf6\x85\x1b\x18\x86\x01\xd4\x7e\x6c\x00\x91\x81\x7c\x03\x04\xd2\x4b\x54\x88\x7d\x0b\x82\xd1\x28\x01\x76\x5d\x4a\xe9\x25\x4e\x88\x31\xd9\xcc\xb7\x5c\x45\xeb\x71\x47\x62\xee\x17\x1f\xf8\x7a\xc4\xbe\xd0\x15\x6f\x62\x21\xc1\x51\xd9\x84\x37\xf1\x49\x70\x07\x6b\x4c\x1b\x0c\xb9\x90\x57\x2f\x65\x27\x75\x0c\x19\x52\x03\x99\x4e\x4a\xad\xf5\x4d\x90\xe5\xf9\x7f\xc0\xbb\x21\xca\x2b\x90\x04\xed\xe9\x44\x7a\x43\xd9\xb3\xca\x0a\xd0\x0d\x9a\x3b\x91\x4d\xf8\xcd\x8b\x63\x71\xbe\x61\xc1\x1b\x6a\x13\x54\xa4\x18\xe1\x03\x12\xcb\x83\x77\x4d\xe1\x7e\xf7\xea\xde\x7d\x31\x4f\x0f\x8c\x04\xfd\x29\x37\xbd\xfa\x6d\x79\xd9\xa9\x83\xb1\xb8\xf3\x26\x1b\xb6\xe1\x66\x5b\xd9\xab\x20\xe6\x20\x6a\xdb\x83\xbf\xe0\x46\xcf\x56\xbd\xb6\x91\x57\x20\xfa\xa8\x66\xa3\x99\x94\x45\x93\x66\xc6\x59\xd6\x79\xb9\x82\xd9\x4b\x31\x8a\x75\x8d\x7b\xa2\x96\xf4\xcf\xd3\x60\x0d\x70\xeb\x19\x1e\x52\x91\x77\x85\xbd\x4f\x2b\x0e\x86\x85\xa8\xc1\xe7\x4e\xab\x72\x01\x86\x88\xfe\x8f\x92\x1c\x5e\xa3\xb9\xe1\x34\xe9\x3c\x0f\x68\x3d\xde\x50\xb1\xd4\x1d\xf3\xe9\x83\xba\x20\xf9\xe0\xa5\x56\x63\x50\x75\x74\x53\x80\xee\xe7\x37\x6f\x5e\x33\xb9\x02\x3b\x15\x76\xcd\xe8\xad\x7a\xb9\x67\x11\xe1\x37\xc0\xfd\x3d\xa9\x06\x3f\x70\xdc\xbc\x65\xa3\xcf\xd9\xfb\x6f\xb4\xcc\x9d\x64\x1d\x30\xab\xa7\x03\x3a\x63\x72\x61\x5f\xa6\xd7\xd9\x42\x1d\xba\xc9\x87\x03\x6d\xb3\xd7\xe3\x7c\x55\x83\xda\x77\x50\xcf\xac\x1d\x36\xa6\xf7\x10\x83\xb7\xea\x10\x8b\x07\x0f\x62\x0d\xf5\x9d\x83\x58\x0f\xf4\x10\x53\xd1\x9e\xf3\xd3\xa9\xc4\x2d\x9f\xd9\xe9\xf4\x40\xdc\x8b\x34\x01\x3c\xfc\xbe\xe0\xc5\x8c\x92\x5a\xc1\x31\x13\xcf\x72\xae\x31\xec\xe1\xc8\x9a\x9a\x15\xf6\x54\x63\xd8\x9d\xfa\x9a\x7a\x67\x85\x98\x28\x1f\x0e\x54\x7d\x2c\x47\x3c\x94\xea\x7b\x8f\x82\x50\x7a\xd4\x74\xaa\x41\x0f\x99\xe6\x3c\xa0\xd2\x9c\xd7\x0d\xba\xf3\x9c\xab\x96\x8c\x89\x17\x7e\xb5\x31\xb2\x7f\x80\x3c\x91\xcc\x18\x6f\xdd\x09\x9e\xe4\x1d\x26\x76\x6f\x99\x78\x7d\x20\x5b\xaa\x3d\x60\x17\x9f\xeb\x1a\xe5\xc3\x01\xdb\xec\xce\xb5\xe3\xde\xdb\xd7\x59\x36\x39\xbe\xb3\xf1\x9c\x2f\x52\x99\x4a\xf4\x8b\xbf\x87\xf4\xb0\x1b\x5a\x85\x1b\xae\x51\xde\x27\xd6\x59\x11\x76\x0b\xee\x84\x30\xf0\xcc\x37\xad\x39\xe2\xb2\x29\x73\x80\xba\x13\x19\xeb\xa2\x62\x8f\x0a\x1d\x1d\x5a\x15\x11\xf0\xa0\x5a\x79\xc4\xf3\x12\x2c\x07\x2b\xa6\x9a\x08\x51\x39\x26\x66\x4e\x95\x00\x19\xb1\xac\x61\x50\x1d\xac\x16\x1c\x6f\x65\x80\x5a\x40\xae\x08\x76\x7e\x8d\xc9\x72\x31\x83\xfc\x08\x9f\xe8\xea\x5c\xca\x64\xd5\x81\xf4\x46\x81\xca\x20\x53\x5b\x83\xa4\x60\x88\xa9\x09\x81\xce\xb6\x1a\x48\xc4\x20\x1a\xad\x40\x70\x30\x2d\xa5\x34\x1c\x82\xc3\xbc\x9c\xd0\x3d\x88\xfa\x96\x52\x26\x14\x2c\x62\x93\xed\xa8\xb2\x43\xc1\x88\x55\xe5\x0a\x92\xf7\xbd\x45\x36\x99\xe4\xfc\x0a\xe2\x17\xd8\x7a\x03\xa2\x9e\xfc\x8a\x03\x66\x55\x43\x07\xc6\x1c\x1b\x99\xf4\x4e\x56\x98\x6e\x39\x68\x46\x9d\x43\x56\x0d\xac\xe2\xf2\xdf\x2b\x5e\xad\x75\xc0\xb9\xa8\xa9\x9f\x22\x7b\x70\xf2\x40\xa5\x4a\x7e\xfb\xf5\x45\x42\x80\x51\x1c\x5b\x25\x61\x87\x07\xcd\x54\xa3\xe9\xca\xd3\x4a\x9c\x0a\xbc\x54\x6d\x56\x04\x8b\xfe\xf9\x35\x7b\xf6\x8c\x7d\xfd\xd4\x2d\x41\x3f\xfb\xac\x6b\x3d\x92\x48\x8e\xab\xea\x55\xd9\xe8\xc9\xba\x17\xe9\xbd\x71\x44\x9d\x45\xdd\x45\xb6\xd7\xa7\x65\xfd\xf7\x96\xc2\xb8\x06\x9f\xb5\x36\x7f\x24\x8f\xee\x7e\x04\x63\xd3\x89\x5f\x5e\x08\x1c\x7b\x53\xa1\x40\xa0\xb7\x3d\x91\x75\x2d\x57\xa4\x9f\xdd\x36\xe1\x2e\x05\x3a\x49\x17\xf3\x0f\x81\x91\xdf\x91\xcc\x8b\x3a\xf9\x89\x37\xa7\xbf\x98\x5d\x9d\xb8\x77\x69\xd0\xd5\x9e\x70\x67\x71\x77\x32\x48\xb3\xe5\x15\xe9\x40\x03\xc2\xe8\x08\x57\xd8\x0f\x96\x4d\xe3\xb6\x77\x2a\xd5\xdd\x08\x68\xdb\x2a\xb4\xde\x66\x81\x08\x72\xc4\x26\x3c\xac\x68\x76\x27\xe8\x21\x45\x23\x5a\xe5\x4a\x38\x77\xe6\x21\x11\x78\xde\x91\x29\x1e\xa5\x45\x59\x60\x62\x2d\x5e\xfe\xc2\xd7\x96\xac\xde\x8f\x28\x49\x78\x58\x3e\xb4\x4f\x21\x4b\xe8\x8e\x86\x82\x25\x67\xf0\x3b\x14\x75\xb0\xa3\xbd\x02\xa2\x46\x54\x81\x0d\xbf\x9d\xe2\xdd\x0e\x41\xcc\x56\x42\x64\x53\x17\xeb\xb3\x11\x12\x82\xe0\xbf\xeb\x57\xba\xfb\x15\xec\x4c\xf7\xcf\x4d\xfc\xe2\x51\xe7\x28\x61\x31\xb5\xce\x66\xb4\xed\x74\x12\xd0\xda\xe9\x64\xb3\x85\x81\x83\x7c\x58\xc3\xba\x0b\x25\xf7\x57\x49\xc7\x87\xbb\x7a\xfa\xb7\xb7\xbe\xdd\x94\xdd\x13\x5d\x3a\xc2\x9c\xfb\xb5\x39\x70\x2e\x2b\x98\xaf\x12\x2b\x99\xb0\x29\x0a\x61\x3c\x74\xa7\x11\xda\x77\x86\x68\xd5\xa5\xb9\x3b\x2b\xe6\xfc\x63\x4b\x91\xce\x77\x6c\xa7\xf8\xb7\x5d\x7a\x72\xab\x4e\x54\xc6\x7d\x55\x5d\xdc\xa1\x06\x49\x57\x09\x59\x1d\x42\x44\x55\x6c\x9e\xe8\x3b\x45\x8d\x2c\xb4\xb6\x76\xc3\x09\xa2\xb4\x9c\xe7\x84\x4f\x79\x25\x07\x92\xa3\xbc\xac\x79\x14\xdb\x94\xf6\x8a\x2d\xe3\xd5\xf1\x35\x36\xa7\xa9\x93\x73\x0e\x08\xac\x7b\x47\x2f\xcb\x09\xac\xd1\x1d\x37\x40\x01\xbf\x80\x6c\x77\x9e\xe2\xf1\x14\x9e\x73\x2e\xd5\x98\xea\xa9\xf6\xa6\xf4\x3e\x04\xa3\x03\x9b\xae\x45\x2a\xc8\x56\x7b\x75\x54\x16\x58\x7b\x55\x9e\x48\xc9\xbc\x9d\x27\x0d\x76\x78\xc8\xb2\x32\x39\x3e\xfd\x51\xe7\xdd\xf4\xf6\x5e\x07\x8e\xea\x22\x81\x71\xb7\x2f\xa0\x2f\xf6\xc9\x30\xca\xb1\x77\x71\xc2\x28\x19\x2c\x56\xd5\x0f\x2d\x89\xc7\x38\xdd\xd1\xd6\x9d\x99\x0f\xa6\x14\xa6\x20\x6e\x4d\x1a\x36\xc9\x47\x0a\x48\xa6\x13\xcc\xbe\xa6\xb9\x29\xa3\xa1\x7a\xe9\x18\x1f\xef\x4b\x03\xe4\x45\x43\x99\xd9\x04\xe4\xe3\xec\x9f\x69\xd5\x6e\x22\xd4\x59\xb5\xd0\x54\xa7\x03\xf1\x3c\xcf\xc0\x76\x26\xb1\xf3\x15\x98\x7e\x8c\x3c\xad\x0d\xb3\x31\x63\x7e\x6b\x26\x71\x19\x1f\x9f\x89\x26\x83\x68\xe3\xc6\xb8\x86\xbc\x66\xe3\x1c\xdb\xfa\xfa\x00\xfa\x0a\xce\xf6\x5e\x44\x29\x49\xf0\xbb\x1d\x1f\x2a\xf5\x2d\x4f\x74\xab\x57\xdd\xe8\x59\xc5\x9f\x73\xf0\x7c\x1f\x06\xaa\x0c\xf6\xec\x94\xef\x3b\xa5\x6d\x44\xec\xee\x97\xf1\xa6\x2f\xe4\x8e\x7f\x34\xb9\x9d\x38\xdc\xc0\x5f\xc7\x89\xca\x4e\x34\x09\xfd\x6f\x09\x4d\xf7\xac\xfa\xb4\x11\x5e\x4a\x91\xef\xe4\xe5\x46\x72\x23\x43\x71\xb3\x64\x18\xb8\x3a\x2f\xa7\xdf\xdf\xa8\xe4\x67\xc2\xe4\x86\x46\xec\x0f\x54\x06\x87\xc2\xb6\xfd\xe3\x1e\x62\x31\xdb\x8d\x12\xb1\xfc\x28\x67\xc3\x87\xe7\xf7\x63\x49\x44\xb6\x5e\xdf\xd6\x5e\xfd\x01\x58\x32\x5c\xa0\xba\xfd\xc3\xed\x9b\x7a\xbb\x15\x5d\x3b\x76\x4d\xcd\x43\xb4\x73\x91\x26\x74\x5f\xfb\x58\x37\x4a\xfa\xfe\xd2\x74\x87\x7f\x4d\xf4\x68\x9d\xeb\x1f\x5e\x37\x3d\xb0\x25\xf9\x5d\xe0\x42\x11\x1a\x77\x59\x67\x8d\x71\x15\x44\x84\x18\x98\x95\x24\x49\x6c\x7f\xf1\x24\x3f\x2d\xb6\xef\x6c\x58\x9f\x35\x25\xf2\xf2\x85\x33\x6e\x7c\x08\x48\x10\xff\x05\x9b\xee\xcc\x91\x8e\x44\x00\x00")
 
 func templatesServerParameterGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -470,12 +982,12 @@ func templatesServerParameterGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/parameter.gotmpl", size: 14243, mode: os.FileMode(420), modTime: time.Unix(1467233158, 0)}
+	info := bindataFileInfo{name: "templates/server/parameter.gotmpl", size: 17550, mode: os.FileMode(420), modTime: time.Unix(1792227929, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServerResponsesGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x58\x4d\x73\xdb\x36\x13\xbe\xeb\x57\xe0\xd5\xab\x74\x24\x8f\x4c\xb9\x87\x5e\xd4\xa8\x33\x6d\x92\x69\x3c\xd3\xc6\x99\x38\x9d\x1e\x1b\x98\x5c\x49\x48\x48\x90\x06\x40\xc9\xaa\x46\xff\xbd\x8b\x0f\x92\x20\x09\xca\x4e\xa7\xf1\x8d\x00\x76\x9f\xfd\xc4\xee\x82\xc7\x23\x49\x60\xcd\x38\x90\xb1\x04\xb1\x03\x21\x40\x16\x39\x97\x30\x26\xa7\xd3\xe2\x02\x8f\xd9\x9a\x44\xaf\x41\xc6\x82\x15\x8a\xe5\x1c\xb7\x71\xb3\xa0\x32\xa6\x29\xfb\x1b\x48\xf4\x8e\x66\x80\x9b\x04\x77\x7b\x74\x90\x4a\x38\x43\xbf\x2d\x33\xca\xfd\x4d\xe4\xe0\xc9\xe9\x34\x1a\xc9\x3d\xdd\x6c\x40\x2c\x2b\x6d\x34\x75\x8c\x34\x2d\x88\xd1\xc5\x62\xa4\x0e\x85\x39\x0c\x08\x90\x4a\x94\xb1\x22\xc7\x11\x21\xd6\x0c\xb8\x27\xd1\xab\x3c\x01\x72\xf9\xbd\xe6\x26\xe4\x2f\xa9\xa8\x2a\xa5\xd9\x63\x5c\x59\x42\xd4\xc0\xda\x28\x28\xdf\x20\xdc\x5b\xa0\x09\x08\xe9\xdc\x11\xf4\x46\x7f\xa7\x06\xd1\xf4\x1f\xe0\xbe\x64\x02\x12\x2b\xb4\x5a\x2d\x09\xea\x07\x5d\xda\xdf\xe9\x03\xcb\xca\xcc\x92\xba\xc5\xd2\xe9\x1f\xbd\x79\x88\xd3\x52\xb2\x1d\x34\x54\x2f\x5b\x2a\x7b\xec\x3d\x60\xc6\x3d\x60\xbb\x08\x00\xd7\x54\x3f\x75\x80\xeb\x83\x1e\x70\x99\x2a\x56\xa4\x70\xb3\x76\xd8\x6e\x4d\x6e\xd6\x06\xbf\x4d\x10\xb0\xf7\x37\xe0\x1b\xb5\xad\x2d\x26\x76\xed\x78\xbd\xe3\x80\x45\x2d\x56\xc6\xdb\xac\xde\x71\x97\xf5\x3d\x55\x0a\x04\xb7\x8c\x6e\x61\xb9\x9a\x93\x80\xa6\xd7\x0a\x32\xd9\x28\x6a\x96\xb5\x9e\xd5\x61\x40\x4d\x9f\x0f\xb5\xf4\xf9\x9a\xc3\x2e\xdf\x1f\x9c\xdd\x97\xe0\xb1\xda\x8d\x70\xda\xbc\xa5\xf2\x35\xac\x29\x7a\xda\xd2\xba\x85\x11\x52\x08\xcc\xed\x35\x19\xbf\xf8\xff\x6e\xac\x53\xb5\x22\xab\x31\x90\x1e\x6f\x12\x19\xb8\x46\x5a\xcd\x5f\xf3\x8f\xfa\x9e\xe1\xea\xd3\x67\x99\xf3\xe5\xf8\x78\x34\xe7\x95\x7c\x9e\xab\x56\x9a\xcf\xf3\x8c\xa1\xe2\x85\x3a\xd4\x42\xc6\x9f\xfc\xeb\x55\xdf\xc9\xe8\x36\xde\x42\x46\xed\xd6\x62\x41\xae\x31\x0e\x77\x79\x72\x30\x71\x39\xa4\x39\x4d\x1c\x21\x45\xbe\xa9\x91\x63\x39\xa2\x6b\xf9\x0b\x95\xa0\xf5\x9a\x79\x7b\xaf\xf2\x0c\x53\xed\xe1\xe6\xee\x33\xc4\xda\xc8\x8b\x56\x16\x3b\xb2\x9e\x39\x5a\x62\xa3\x73\x47\x55\x2c\x47\xa8\xd8\x3b\xd8\x87\xfd\x13\x0b\xa0\x0a\xe4\x80\xf7\xf6\x0c\x13\x30\x71\x3e\xdf\xba\x52\xb2\xa3\x69\x09\x72\xb4\x2e\x79\x3c\x88\x3b\x0d\xd5\xac\xd8\x55\xaa\x5a\xb9\x19\xb9\x18\x88\xda\x50\xcd\xc3\x3d\x83\xf2\x72\x45\xae\x4c\x6d\x24\x76\xbd\x22\x3f\x5c\x5d\xe1\xf2\x34\xf2\x83\x24\x40\x95\x78\x1b\xbe\x0b\x0a\xb1\xdc\x21\x39\x5e\x61\x5d\x1a\xf8\x79\x45\x3a\x5c\x5d\x43\x99\x1c\x14\x7b\x36\xa9\xe7\x9d\x14\xb3\xdf\x26\x88\x41\x87\x60\x64\xff\xc4\x10\xdd\x36\x8d\x80\x26\x89\x24\x6a\x0b\xc4\xda\x40\x54\x6e\x56\xa1\x76\x45\xaa\xf6\x64\x43\xa9\x43\x86\xb7\x20\x06\x2c\xa4\xa2\x22\x09\xc7\x67\xd6\x91\x3a\xad\x22\x3b\x1c\x50\x6b\x4f\x17\x3f\xf2\x7b\xd8\xca\xf8\xba\x09\x5b\x80\xde\x65\xf3\x2d\x28\xcf\x64\x09\xea\x39\x4c\x6e\x09\xf5\x2c\xfe\x0a\xd3\xbc\xec\x0c\xe5\x50\x15\xce\xb0\x0b\xeb\xc8\xf6\x87\x09\x7d\x1c\xb0\x7a\x72\xc6\xec\xc9\x23\x76\x4f\xda\xb1\x1e\xbc\xe4\x3b\x2a\xb8\x5e\x35\x8a\x34\x15\xb7\x7f\xc1\x27\xdd\x84\xe8\xa9\x11\x85\x8d\x5f\x91\x90\xac\x27\xe6\xca\xc0\x80\x55\xa5\xcd\x73\xfb\x73\x48\xa3\xa7\xb8\xf3\xbf\x71\x5b\x3b\x0f\xdb\x7d\xcc\xe5\x60\xd5\xbe\xea\xac\x2b\xdc\xc6\x37\x2c\x28\x4e\xe6\xb4\xe8\xb5\xce\xa1\x0e\x39\xd8\x52\x1f\x6b\x9d\x5f\x5d\xa8\x2a\x7f\xac\x2a\x47\x3c\x31\xf7\x2a\xbe\x3a\xdb\xbe\xb1\x1f\x1b\x91\xcf\xe3\xc6\xa7\xfb\xcb\x6f\xcd\x26\xcb\x04\x0e\x2c\x1f\xaa\x17\x92\x73\x47\x9c\x32\xc0\xa7\xcc\xbf\xc8\x1f\x1f\x6d\x2a\xf6\x64\xab\x54\x11\x55\x1b\xe6\x54\xcc\xb1\xef\xe6\x49\x19\x83\x20\xa2\xe4\x8a\x65\x10\xbd\x77\x1b\xb5\x21\xfd\xa2\x6c\x06\xbb\xfa\x25\x67\x87\x20\x52\x4f\x90\x3a\x0d\xf6\x8e\x7c\x3a\x8b\x7e\x4e\x92\xa9\xdf\xdf\xef\xc7\xb5\x8e\x73\xb2\xce\x54\x74\x6b\x8f\xa6\xe3\x17\xbb\xf1\x3c\xe8\xb9\xb0\x81\xb3\xce\xfc\x89\x42\x8d\x51\x4e\x72\x68\x3a\x78\xa4\x29\x69\x34\x7c\xe0\x3a\x42\xc3\xe6\x4d\xd5\xb3\x6e\x61\x68\xad\x03\xa3\xaa\x1d\xce\xce\xa5\xc2\xff\x56\x84\xb3\xd4\xce\x76\xb5\x1d\x86\x0b\xdf\xed\x64\xb9\xaa\xa3\x53\x45\x05\xc3\x18\x76\x91\x43\x9c\xfd\x68\x38\x2b\x5c\x83\x46\xd0\x79\x9c\xc5\x53\x3c\x98\xe9\xc0\xa5\xa0\x4c\x62\x09\x88\x73\x44\x38\x90\x8c\x25\x49\x0a\x7b\x2a\x00\x07\x5b\x9a\xda\x11\x57\x6d\x99\x34\xec\xbd\xd1\x3e\x60\x29\x39\xb5\x46\xc1\x7a\xce\xae\xbf\x0b\x1a\x7f\xa1\x1b\x70\x2f\x32\xfb\xed\x92\xfe\x23\x0a\x22\x6b\x86\xef\xcb\x3d\x95\x64\x03\x1c\x04\x8e\xdf\x09\xb9\x3b\xd8\x09\xc6\xfe\x38\xc0\xdb\x90\xa7\x91\xa6\x7f\x93\x30\xc5\xf8\xc6\x28\x68\xf9\x32\xb6\xd9\x2a\xed\xaa\x1d\x90\x75\xa9\x0c\xd4\x16\x38\x39\xe4\x25\x1a\x79\x89\x89\xdd\x42\xaa\x44\xe0\x00\x92\x61\x99\x49\x46\xa3\x11\xcb\x8a\x5c\xe0\xa5\x47\x53\xc7\x1c\xd4\x42\xdf\x94\xb1\x5e\x6c\xd0\x15\xe5\x5d\x84\x94\x8b\x4d\x7e\x99\x17\xc0\x69\xc1\x16\xee\xaa\x9c\xa1\xd0\xb2\xce\x1c\x63\x28\x72\x21\xcf\x10\xe0\x83\x82\x25\xa8\xe3\x53\x94\x68\xdd\x52\x37\x38\x5f\x1b\x83\xdc\x14\xde\xba\x7a\xed\x39\xda\xe7\x9d\x7c\x81\xc3\x9c\x4c\xcc\x5b\x46\xe7\x5e\xd4\x02\xd1\xa7\xae\xf9\xfa\x78\x8e\xbc\x83\x3a\x33\xc3\xf9\x00\x6c\x55\x81\x4c\x29\x41\x32\xfd\x46\x4b\x75\x3c\x7a\x3f\xac\x1a\xf0\x06\xfa\x58\xfd\xc0\x32\x76\xd6\xc5\xf2\x31\xa8\x01\x06\x07\xfa\x4f\x00\x00\x00\xff\xff\x84\xbd\x79\x6c\x35\x13\x00\x00")
+var _templatesServerResponsesGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x59\xdd\x6f\xdb\x36\x10\x7f\xf7\x5f\xc1\x7a\x69\x61\x07\xb2\x92\x0e\x5b\x1f\xb2\x7a\xc0\x96\x16\x6b\x80\xf5\x03\x75\x87\x3e\xb6\x8c\x74\xb6\xd9\xc8\x92\x42\x52\x76\xdd\xc0\xff\xfb\x8e\xe4\x51\x1f\x96\xe4\xb8\x45\xdb\x17\x43\x24\x8f\x77\xbf\xfb\xe0\xdd\x91\xbe\xbb\x63\x31\xcc\x45\x0a\x6c\xa8\x40\xae\x41\x4a\x50\x79\x96\x2a\x18\xb2\xdd\xee\xec\xf4\xee\x8e\x89\x39\x0b\x9f\x81\x8a\xa4\xc8\xb5\xc8\x52\x9c\xc6\xc9\x9c\xab\x88\x27\xe2\x0b\xb0\xf0\x15\x5f\x01\x4e\x32\x9c\x6d\xd1\x41\xa2\xe0\x00\xfd\xb2\x58\xf1\xb4\x3e\x89\x3b\xd2\x78\xb7\x1b\x0c\xd4\x86\x2f\x16\x20\x2f\x3c\x1a\x43\x1d\x21\x4d\x83\xc5\xe0\xf4\x6c\xa0\xb7\xb9\x5d\xec\x10\xa0\xb4\x2c\x22\xcd\xee\x06\x8c\x39\x35\xe0\x96\x85\x97\x59\x0c\x6c\xf2\xd8\xec\x66\xec\x83\xd2\x5c\x17\xca\xce\x89\x54\x3b\x42\x44\xe0\x74\x94\x3c\x5d\x20\xbb\x17\xc0\x63\x90\x8a\xcc\xd1\x69\x8d\xf6\x4c\xc9\xc4\xd0\xbf\x85\xdb\x42\x48\x88\x9d\x50\x3f\xba\x60\x88\x0f\xf6\x69\x5f\xf2\xcf\x62\x55\xac\x1c\x29\x0d\x2e\x08\x7f\xf8\xfc\x73\x94\x14\x4a\xac\xa1\xa2\x7a\xda\x80\x5c\xdb\xde\x62\x2c\xd2\x1a\x63\x37\xe8\x60\x5c\x52\xfd\xb9\xc7\xb8\x5c\x68\x31\x2e\x12\x2d\xf2\x04\x5e\xcf\x89\x37\x8d\xd9\xeb\xb9\xe5\xdf\x24\xe8\xd0\xf7\x5f\x48\x17\x7a\x59\x6a\xcc\xdc\x98\xf6\xd6\x96\x3b\x34\x6a\x6c\x15\x69\x73\x6b\x6d\x79\x7f\xeb\x1b\xae\x35\xc8\xd4\x6d\xa4\x81\xdb\x55\xad\x74\x20\xbd\xd2\xb0\x52\x15\x50\x3b\x2c\x71\xfa\xc5\x0e\x98\xf5\x7d\x88\xb2\xbe\xaf\x5a\xdc\xdf\xf7\x5f\x2a\x6e\x0b\xa8\x6d\x75\x13\xdd\x61\xf3\x82\xab\x67\x30\xe7\x68\x69\x47\x4b\x03\x2b\x24\x97\x18\xdb\x73\x36\x7c\xf8\xcb\x7a\x68\x42\xd5\x93\x95\x3c\x90\x1e\x4f\x12\x63\xfd\xe7\xfa\x9f\xec\x9d\x39\x67\x38\xfa\xf8\x49\x65\xe9\xc5\xf0\xee\xce\xae\x7b\xf9\x69\xa6\x1b\x61\x1e\x64\x2b\x81\xc0\x73\xbd\x2d\x85\x0c\x3f\xd6\x8f\x57\x79\x26\xc3\x59\xb4\x84\x15\x77\x53\x67\x67\xec\x0a\xfd\x70\x9d\xc5\x5b\xeb\x97\x6d\x92\xf1\x98\x08\x39\xee\x1b\x59\x39\x6e\x47\x78\xa5\xfe\xe6\x0a\x0c\xae\x71\x6d\xee\x32\x5b\x61\xa8\x7d\x7e\x7d\xfd\x09\x22\xa3\xe4\x69\x23\x8a\x89\xac\xa5\x8e\x91\x58\x61\x6e\x42\x25\x9c\x57\x6a\x06\x70\xc3\xaf\x13\x28\xb1\x5e\x66\xa9\x86\x54\x33\xa1\x98\xcd\x9d\x31\x26\x11\xa5\x31\x5d\xb0\x6c\x5e\xa2\xdf\x2c\x21\xc5\x65\x1d\x30\xbd\x04\x76\xbd\xd5\xe0\x12\x8b\x32\x44\x9c\xbd\xb5\x49\x66\x69\x73\x0c\xe3\x12\x50\x4f\xb5\x01\x63\xc5\x8d\xc0\xd0\xfd\xf5\xfc\x09\x72\x92\x5a\xf0\xc4\x8b\x43\xd9\xa5\xe0\x0c\x8d\xce\x63\x03\x0c\x77\x93\x2e\x13\x84\xef\xf0\xbd\xcc\xe2\x77\x02\x9d\x88\xf8\x8c\xec\x55\x16\x8b\xb9\x88\xb8\xcd\x52\xda\x2c\x20\x02\x62\x15\x30\xb4\x4c\x74\x83\x62\xf9\x82\x1b\x2d\xec\x8e\xab\xf9\xc4\xe1\x33\xc6\xc7\xc1\x4b\xcb\x01\xe2\xc9\x4c\xa4\x91\x47\xad\x58\x91\x26\xa0\x14\xfb\x02\x32\x33\x11\x4e\x52\x8d\x84\xd0\x7e\xb5\x80\x49\x8c\x14\x40\x19\xcb\x2c\x89\x1d\xb6\x86\x98\xba\x4d\x8c\x69\x13\x74\xa5\x48\x17\xa5\x01\x55\x0d\x38\x59\x1e\xf9\x7a\xa6\xa7\x4b\xad\x73\x1b\x8c\x38\x6a\xc4\x1c\xd6\x15\x14\xfe\x0a\x36\xdd\x81\x1e\x49\xe0\x86\x79\xf7\xaa\x75\x47\x4c\x87\xc7\x63\x5b\xf3\x04\xa5\x0c\xe6\x45\x1a\xf5\xf2\x1d\x75\x15\x9f\x88\x4a\x4e\x09\x6e\xcc\x4e\x7b\x8e\x5f\x5f\xf1\xc2\x39\xcb\xe5\xe9\x94\x9d\xdb\x22\xc7\xdc\x78\xca\x7e\x3f\x3f\xc7\xe1\x6e\x50\x3f\x6d\x12\x74\x81\x69\xed\x51\xa7\x10\xb7\xbb\x4b\x4e\xad\x42\x5e\x58\xf6\x81\x27\xed\x2f\x93\x5d\x29\xa9\x53\xec\xc1\xec\x14\xec\xe5\x0a\xf7\x6d\x9d\xd8\x69\x10\xf4\xec\x7b\x74\xd1\xac\xaa\xe8\x3c\xa6\xe0\x72\x3a\x30\x9d\xd9\x51\x57\xdf\xc1\x7c\x9f\xe1\x5c\x69\x5c\x86\x11\x14\x01\x56\x44\xe9\x49\xba\xfd\x33\xde\x93\x3a\xf2\x9e\xed\x77\xa8\xd3\x67\x9f\x7f\x58\x6f\x46\xa6\xd6\xd6\x95\xdb\x3a\xe8\x29\x9a\x67\xa0\x6b\x2a\x63\x9e\xf9\x19\x2a\x37\x84\xd6\x34\xfe\x0a\xd5\x6a\xd1\xd9\x15\x43\xde\x9d\xdd\x26\x2c\x3d\xdb\xee\x0a\xcd\x72\x87\xd6\x27\x07\xd4\x3e\xb9\x47\xef\x93\xa6\xaf\x7b\x0f\xf9\x9a\xcb\xd4\x8c\x2a\x20\x55\xe9\x6c\x1f\xf0\x93\xfd\x80\x68\xc1\x08\xbb\x95\x9f\xb2\x2e\x59\x47\xc6\x4a\x4f\xa7\xec\xc3\xe6\x67\xdb\xb3\x0f\xd1\x31\xe6\xfc\x3e\x66\x6b\xc6\x61\xb3\x21\xa1\x18\xf4\x95\xbc\x8c\xba\x9c\x26\x7e\x60\x42\x21\x99\xa3\xbc\xd5\x03\xf5\xb5\x3a\xbd\xbd\xd1\x7d\x3d\xd0\x57\x27\x2a\x6f\x8f\xa9\x37\xc4\x91\xb1\xe7\xf7\x95\xd1\xf6\x83\xed\x58\x89\xfc\x39\x66\x3c\xde\x5e\xed\xa0\x6b\x76\x97\x14\x78\xbe\xc5\xb1\x81\xc7\xd1\x6e\x44\x11\xd1\xfc\x31\x76\x0b\x7c\x6b\x6a\xfb\x97\xfd\x16\x94\x7a\x26\xec\x9f\xbe\x2d\x4c\x09\xe1\x28\xea\x6a\x48\x03\xd3\x71\x36\x5b\xc1\xaf\x8e\x35\x6f\x82\xa9\x57\xba\x87\xce\x37\x9d\x53\x2f\xf3\x98\x98\xc4\x40\xa4\x36\x91\xdd\x00\xe4\x2e\x28\x7d\x1b\x79\xaf\x65\xa9\x53\x57\x01\x9b\x67\x92\x09\x8c\xe9\xfe\x26\xf6\x1b\xcc\x5b\x81\x1b\xc9\x66\x4f\xdb\x1f\x69\x1e\xfb\x94\xc9\x7a\x8c\x59\x65\xdf\x4b\xbc\xdd\xbc\xf5\xd8\x29\x74\xa2\x44\x18\x9b\x7e\x83\xf3\xeb\xdc\x46\x72\xc3\x08\xa0\x9b\xb0\xab\xe8\xff\x5c\x66\x71\x11\xe1\xd5\x44\x16\xa9\x8d\x81\x37\x34\x51\xaa\xd0\x2e\xfc\x74\x41\x20\x9c\x74\x31\x2a\xaf\x9b\xc6\xad\x1b\x22\x1f\x8d\xc3\xbf\xe2\x78\x54\xef\x21\x6f\x87\x25\x46\x74\xcb\x4a\x87\x33\xb7\x34\x1a\x3e\x5c\x0f\x83\x4e\x9b\x75\x2b\x38\x3e\xe2\x06\x88\x73\x87\x42\xf6\xc1\x94\xa5\x22\xa1\xce\xbc\x87\xd6\x3b\xac\x41\x6b\x2d\x40\xc1\xb5\x7f\x43\xec\xbb\x14\x06\x0c\x63\xf0\xb7\xc7\x4f\xdc\x75\xb3\x48\x15\xde\xf2\xd4\x5c\x18\xb0\x81\x8d\x48\x9b\x74\x89\x78\xe2\xde\x45\x48\x98\xf5\xdc\xcc\x24\x09\x7f\x9a\xe5\x26\x38\x84\x36\x60\xc3\x1e\x5b\xd2\x29\x0c\x0e\xd9\x65\x4c\x62\xdd\xe9\xb4\x83\x9d\xfd\x45\xbf\xda\xb8\x21\xe7\x1a\x16\xb6\x61\x44\x6f\x78\x13\x7e\x08\x18\x48\xc9\x2e\xa6\x26\xd1\x5c\x66\xf9\xb6\x17\xaa\x17\xf6\x87\xdd\xb0\x67\xde\x1c\x4f\x74\x34\xc2\x85\xb1\xb1\x74\x02\x9a\x0e\x7e\x94\x21\x87\x2d\x5b\x89\x38\x4e\x60\x63\x2e\xe3\x31\xa0\x89\x29\x73\x0a\x55\x07\xeb\xd1\xef\xf6\x5e\x35\xda\x5a\xb4\xae\x2a\xf7\x74\xc8\x86\x5b\xa2\x80\x08\xc9\x04\xd5\x3d\x71\xbf\x4b\x69\x8c\x3b\x1e\x40\xfa\x03\xd5\xd7\x25\x6f\x9d\x86\x1e\x76\x17\x19\xdb\x1f\x63\x7f\x7c\x7b\xad\x4e\x1c\x7f\x90\xd5\xef\xd3\x94\xed\x1a\x47\xb6\xbc\xf4\x97\xdf\x39\x8f\x6e\xf8\x02\xe8\x9d\xcf\x7d\x53\x76\x7c\x87\x82\xd8\x5c\xe0\xd1\xde\x70\xc5\x16\x90\x82\xe4\x58\x16\xb1\x58\xba\xeb\x94\x7b\x8e\xc6\xb4\x99\x25\xa1\xa1\x7f\x1e\x0b\x7a\x92\xf0\xfb\x56\x62\xb1\xd4\xc6\x54\x6b\x60\xf3\x42\x5b\x56\xe6\x2c\x6e\xb3\x02\x95\x9c\x60\x06\x6c\x70\xf2\x22\xb0\xae\xad\xb0\xc2\xc4\x83\xc1\x40\xac\xf2\x4c\x62\x07\x82\xaa\x0e\x53\xd0\x67\xe6\x60\x0e\xcd\x60\x81\xa6\x28\xae\x43\xa4\x3c\x5b\x64\x93\x2c\x87\x94\xe7\xe2\x8c\x72\xea\x01\x0a\x23\xeb\xc0\x32\xba\x22\x93\xea\x00\xc1\x1a\x93\x62\x8c\x18\x8f\x01\xd1\x48\xe7\x74\x8b\xbf\xb2\x0a\xd1\x93\x40\x23\x47\xef\x76\xed\xc7\x40\xb7\xf7\xe4\x06\xb6\x01\x3b\xb1\x0f\x2b\x26\xf6\xc2\x06\x13\xb3\x4a\x37\x81\x3a\x3f\x22\xdf\xe3\x3a\xb6\x2f\x05\x3d\x6c\x7d\xa9\xb2\x35\x07\xc9\xcc\xcb\x5f\x62\xfc\xd1\xfa\x1b\xa4\x62\x5e\xb1\x2e\xff\x16\xb1\x7a\x96\x55\xf5\x3e\x56\x3d\x1b\x1a\x55\xe6\xf9\x1a\xf3\xd6\x4c\x4b\xe0\xab\x16\x3f\xa8\xd6\x5c\x89\x45\x96\xd4\x2f\x76\x96\xb1\x3a\x2f\x87\x22\x3e\xfc\x26\x80\x6e\x95\xee\x7d\xd0\x1e\x3f\x87\x7f\xa2\x4c\x39\xb3\xb2\x95\x11\x65\x5c\x9e\x58\x1e\xa6\x6f\xb0\xd4\x2e\x1d\x2a\x53\x6a\x98\x0d\x2b\x9a\x31\x27\x08\xb0\x3d\x02\x47\x2e\xa4\x22\x46\xee\xcd\xb4\xeb\xed\xf4\xc0\x3f\x3a\x75\x75\x8c\xe8\x91\xc3\xd4\xd3\xa4\x58\x6a\x67\xa6\xb1\x03\x75\x64\x1f\xa4\xee\x95\xfe\x9d\xfa\x1f\x82\x8f\xe1\xd8\xf7\x76\x58\xd3\x01\x85\x8c\x07\xf5\xac\xac\x48\xfd\x66\xb6\x7d\xf4\x88\x3d\x70\xf3\xe1\x4c\x63\x8f\x00\xf1\x68\x4c\x29\xd8\x9d\x77\x57\xea\x9f\x9b\x6f\x9b\xc7\x71\x93\xad\xaa\x63\x5b\xcc\xea\x41\xfe\x3f\xbb\x3a\xae\x02\x1b\x1c\x00\x00")
 
 func templatesServerResponsesGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -490,7 +1002,27 @@ func templatesServerResponsesGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/responses.gotmpl", size: 4917, mode: os.FileMode(420), modTime: time.Unix(1470347715, 0)}
+	info := bindataFileInfo{name: "templates/server/responses.gotmpl", size: 7195, mode: os.FileMode(420), modTime: time.Unix(1792232222, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerRoutemapGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcd\x56\x4b\x6f\xdb\x38\x10\xbe\xeb\x57\x0c\x84\xec\xd6\x2e\x54\xb9\xc0\xde\x0c\xf4\xd0\x6c\x8b\xc2\x87\xb4\x41\x1a\xec\x65\xd1\x03\x2d\x8e\x64\x6e\x24\x52\x25\xa9\x78\xbd\x86\xff\xfb\x0e\x1f\x92\x65\x2b\x6e\x0a\xe4\x52\x04\x08\x44\xce\xfb\x9b\x99\x8f\x6e\x59\xf1\xc0\x2a\x84\xfd\x1e\xf2\xdb\xf8\x7d\x38\x24\x74\xbc\x6a\xe3\x71\xf9\xee\x44\x94\x2c\x16\x70\xbf\x11\x06\x4a\x51\x23\x6c\x99\x81\x0a\x25\x6a\x66\x91\xc3\x7a\x07\x76\x83\x60\xb6\xac\xaa\x50\x83\x55\xaa\xce\x9d\xfe\x47\x2e\xac\x90\x15\x09\x7b\xbb\x46\x54\x1b\x0b\xad\x56\x8f\x08\x65\x67\xbd\xab\x0d\x4a\xd8\xa9\x0e\x34\xbe\xd1\x9d\x3c\xf1\xd4\x87\x80\x42\x35\x0d\x93\x3c\x49\x44\xd3\x2a\x6d\x61\x96\x00\xa4\x12\xed\x62\x63\x6d\x9b\x26\x74\x6a\x04\xe7\x35\x6e\x99\x46\x48\x2b\x61\x37\xdd\x3a\x27\xa3\x45\xa5\xde\xa8\x16\x25\x6b\xc5\x82\x9c\x5b\xd1\xe0\xe2\xa8\x99\x92\x9d\xb1\xba\x6c\xec\x25\x9b\x20\xf5\x01\x08\x1b\xcd\x24\x81\x91\x7f\xc0\x92\x75\xb5\x5d\xf9\x54\x0c\x81\x43\xa2\x56\x0b\x69\x4b\x48\x7f\xfb\x9e\x42\xee\xf0\xf2\x06\x28\xf9\xf0\x1d\x8c\xaf\x1e\x70\x97\xc1\xd5\x23\xab\xbb\x00\xf1\x89\x17\x27\xa5\x2f\x38\x73\x18\xd5\xcf\xbc\xce\x7d\x4f\x9c\x2a\x33\x05\xab\xc5\x7f\x94\xda\x67\xd6\x38\xbd\x3b\xd5\x11\x68\x1a\x4b\xd4\x28\x0b\x34\x1e\x54\x72\x22\x38\xb3\x4a\x1b\x50\xa5\xbf\xd1\xf8\xbd\x43\x43\xc1\x09\xda\x78\x61\x5a\x25\x0d\x7a\x0d\x26\x81\x60\x20\xf8\x85\x92\x89\xdd\xb5\xf8\xa3\x58\x04\x54\x57\x58\xd8\x53\x86\x94\xd4\x97\xde\x6e\xf5\x01\x44\x88\x2e\x78\x1f\x75\x70\x0a\x22\x76\xbb\xc5\x82\xec\xc6\x46\xe4\x8e\x06\x27\x09\xde\x6e\x99\x66\x8d\x81\x42\x23\x8d\x42\xf0\xd6\x86\xab\xb5\x90\xdc\x0d\x98\xcb\x3f\x96\xe7\x8f\x7d\x65\x93\x90\xe4\x30\x7a\x2b\x3b\x59\xcc\xe6\x94\x82\x45\x5d\xb2\x02\x7d\xea\x00\xd7\xe4\xf1\x2e\x18\xcf\x5e\xbb\xe1\xca\xe3\x29\x83\xd7\xc7\xc1\xc9\x6f\x98\x2d\x36\xc8\x7d\xed\x73\x40\xad\x95\x26\xf3\x43\x4c\xf8\x5a\xf1\xdd\x49\xba\x8d\xe2\x58\x8f\xd2\x5a\x3b\x05\x02\x86\x63\x41\x12\xee\xb2\x50\x19\x48\x51\x87\x6d\x70\x26\x51\x45\xbe\xb2\x64\xe7\xed\xc9\xb7\x77\x7c\x21\xf3\xbf\x42\xfd\x38\x0b\x33\x4b\x79\x57\x82\x3e\x77\xd3\xf4\xee\x86\x2e\x4f\x72\x34\x7d\x6c\x81\xa3\x29\xe9\xd5\xdd\x72\x8d\x53\x76\x8b\x6f\x2c\xb3\x1d\x79\xa2\xcb\x2c\xb8\x77\x36\x3c\xac\xc8\x60\xeb\x8a\xed\x24\xa7\x95\x7e\x9b\x13\x8d\x8c\x9d\x6e\x69\xf1\x08\xc6\xbe\x4a\x1f\xa4\xc6\x92\x7a\xd7\xd9\x9c\x3c\x1e\xb3\x6d\x58\xfb\x37\x85\xfd\xf6\x02\x00\x0e\xcf\xec\x8c\x0f\x12\xf1\x40\xca\x8b\x0f\xab\xd1\x32\xbb\x99\x8c\x93\xc9\xa0\x16\x0f\xc4\x38\x9f\x3e\xde\xc3\xa2\x45\x6b\x16\x7b\xc1\x0f\x69\x46\x1c\xe8\x34\x85\x1e\x6d\x9d\xa3\xc4\xc0\xa2\xc1\x5b\xc0\xd3\xf9\xb3\xd8\xb4\xb5\x6f\x44\x0c\xe0\x36\x62\x00\xc6\x77\x84\x99\x60\x44\xa9\x8b\x12\xf2\x6b\x3a\xdf\xba\x8c\xa6\x64\x31\x96\x0d\x64\x91\x25\xb1\x31\x5b\x16\xb8\x5a\x87\x6a\x29\xde\x83\x90\x3d\xdd\xbd\x32\x10\xbf\xfe\x20\xb6\x26\x74\x09\x0d\x9a\x73\x67\xd0\x00\xab\x98\x90\x26\xa4\x63\x50\x3f\xe2\x91\x47\xde\xdf\xae\xf2\xe4\x91\xe9\x1f\x02\xfb\xce\xf7\x2f\x2c\xf6\xb7\xcb\x8a\xfb\x13\xaa\x1d\x38\xe1\x09\x9a\x9d\xf9\x03\xcc\xba\x96\x94\x20\xbf\xf1\xfd\x9a\x43\x4a\x7f\xb9\xab\x7f\x4e\x26\xcb\x38\x1b\x23\x6e\x59\x4e\x00\x8b\xe1\x33\xaf\x19\xe8\x61\x79\x69\xcb\x5e\xc8\x10\x34\x83\x83\xa3\xc8\x61\xf4\x08\x84\xa6\x4a\x3c\x3e\xb8\xc3\x2b\xec\xab\x1e\xbd\xc3\xf9\xd0\xd2\xcf\xb8\x7d\x12\xc5\x50\xc0\x6c\x1e\xa3\x68\xb4\x9d\x96\xf0\x7b\x88\x16\x52\xc8\x8e\x00\x47\x36\xf4\x61\x44\xe9\xa7\x3d\x5f\x19\xc7\x33\x5e\x02\xf9\x57\xaa\xa2\x61\x27\x0a\x33\xa9\x6c\x2f\x20\xe5\xf7\x52\xc9\x5d\xa3\x3a\x33\x3f\x97\xac\x7a\xf0\x26\x92\xaf\x96\x98\xa7\x99\x5c\xbb\xd1\xbd\xa7\x97\x86\x04\x4a\x8f\xee\xff\x54\xb4\x20\xf8\xef\x97\xf5\x3f\x58\x9c\x84\xae\x05\x59\xf0\x79\x78\x1a\x03\x3f\xfa\xfe\xf6\x1b\x05\xa9\x1f\xf4\x1b\xc7\x2c\xe9\xa8\x98\x6c\x80\x71\xfa\xe1\x3d\x0d\xac\xb3\x7c\x8e\x76\x7e\x86\x78\x08\xf3\xfd\xe8\x37\x80\x27\x4b\xb8\x1a\xc8\xd1\xfd\x0e\x38\xd2\x9c\x4f\xc5\x2d\xff\x51\xe3\x72\x17\x9e\x80\xff\x09\xdc\xcf\x01\x9f\x20\x3d\x81\x78\x8a\xad\x5f\x4b\x9f\x7a\xd8\xab\x8b\x20\x3f\x03\x6f\x5f\x5c\xff\x33\xaa\x2f\x7c\x2c\xfa\x25\xca\x7d\xfb\x82\x22\xe3\x9e\x25\xf1\xdf\xf1\xde\xff\xc6\xa6\xd7\x51\xc8\x33\x8f\x87\xc3\xcb\xe6\x6b\xd0\x8e\x0b\x2f\x71\x3b\x73\xd4\xf1\x49\xb9\xba\xc9\xfd\x3c\xce\xe1\x90\xca\xff\x54\x73\x24\xb7\x04\x0c\x00\x00")
+
+func templatesServerRoutemapGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerRoutemapGotmpl,
+		"templates/server/routemap.gotmpl",
+	)
+}
+
+func templatesServerRoutemapGotmpl() (*asset, error) {
+	bytes, err := templatesServerRoutemapGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/routemap.gotmpl", size: 3076, mode: os.FileMode(420), modTime: time.Unix(1792212733, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -515,7 +1047,67 @@ func templatesServerServerGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesStructfieldGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xdc\x54\x4f\x4b\x3b\x31\x10\xbd\xe7\x53\x0c\x4b\x0f\xbf\x96\x5f\x77\xef\x1e\x45\xc4\x82\x7a\xb0\x22\x1e\x1b\x92\x59\x8d\xe4\x9f\x49\x56\xac\x25\xdf\xdd\x6c\x53\x6b\x8a\xb5\x0b\x0a\x3d\x78\x1b\xf2\x66\xde\xbc\xf7\x36\x9b\xd5\x0a\x38\xb6\x42\x23\x54\x3e\xb8\x8e\x85\x56\xa0\xe4\x15\xc4\x98\x10\xd1\x82\x36\x01\x46\xf5\xcc\x9f\x52\x8f\xb7\x4b\x8b\x09\x68\x26\x90\xb0\x80\xca\x4a\x1a\xd2\x1c\x37\x2c\x8d\x0a\xfd\x50\x41\x9d\xe7\x3e\x31\xeb\x8c\x45\x17\x96\x77\x54\x0a\x4e\x83\x30\xfa\xcc\xb0\xf9\x47\x77\x8c\x30\x69\x52\x3f\x6a\x1e\x23\x49\x85\xa5\x9e\xa5\xce\x37\x84\xfa\x9a\x2a\x4c\xf8\x0e\x9b\x67\x8f\xa8\x68\x2f\x23\xaf\x82\xc5\x93\x37\xfa\xa4\xca\x52\x47\xf5\x05\x2d\x75\x4e\x7b\x66\xe9\x31\x6b\x5a\x13\xee\xd8\xaa\x6f\xf0\xb9\x13\x0e\x79\x3a\xfd\x6f\x94\xe8\xf7\x84\x65\x96\x93\x1b\x73\xb1\xa1\xaf\xef\xaf\x2e\x37\x1c\xf0\xaa\xe4\x7a\x6b\x71\x56\x6d\xfb\x17\x64\x5b\x92\x22\xde\xd0\x59\x89\xdb\x74\xc9\xb1\xe2\x25\xa5\xa1\x1f\xe6\x3b\xad\x16\xd0\x34\xc0\x3a\x1f\x8c\x02\x8f\x4e\xac\x49\xdc\x7e\xa3\xc5\x3d\x9a\xb5\x94\xe1\x31\x2f\xd3\x61\xb7\xff\xc6\x87\xfd\x92\x39\x86\xbd\x73\x07\xa7\xc6\x43\xdf\x7b\x38\x05\xf2\x77\x63\xb0\x4e\xbc\x7c\x7d\x59\x58\x22\x2c\xa9\xcf\x7b\x6c\x40\xd5\xb7\xf4\xbb\x7f\xd6\xaf\xd9\xdf\x03\x00\x00\xff\xff\x3f\x6c\xb5\x03\x13\x05\x00\x00")
+var _templatesServerStandaloneGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x5a\x6d\x73\xdb\x36\x12\xfe\xae\x5f\x01\x6b\x5a\x0f\xe9\x51\xe8\x76\xa6\xf7\x45\x37\xbe\x99\x34\x71\x1b\xf7\x7a\x8e\x2f\xce\x35\x1f\x3c\x9e\x1b\x88\x84\x24\xd4\x14\xa9\x80\x60\x14\x9d\x47\xff\xfd\x9e\xc5\x0b\x09\x52\x94\x9c\xa4\xfe\x12\x53\xc0\x62\xb1\xd8\x7d\xf6\x0d\xc8\x9a\xa7\x0f\x7c\x21\xd8\xe3\x23\x4b\x5e\xde\x5c\xdd\xb8\x9f\xbb\xdd\x68\x74\x7e\xce\xde\x2f\x65\xc5\xe6\x32\x17\x6c\xc3\x2b\xb6\x10\x85\x50\x5c\x8b\x8c\xcd\xb6\x4c\x2f\x05\xab\x36\x7c\xb1\x10\x8a\xe9\xb2\xcc\x13\xa2\xbf\xcc\xa4\x96\xc5\x02\x93\x7e\xdd\x4a\x2e\x96\x9a\xad\x55\xf9\x49\xb0\x79\xad\x0d\xab\xa5\x28\xd8\xb6\xac\x99\x12\x2f\x54\x5d\x74\x38\xf9\x2d\x58\x5a\xae\x56\xbc\xc8\x46\x23\xb9\x5a\x97\x4a\xb3\x68\xc4\xd8\x58\x14\x69\x99\x81\xff\xf9\x9f\x55\x59\x8c\x69\x44\x96\xe6\x4f\x21\xf4\xf9\x52\xeb\xb5\xf9\x51\x69\x05\x9a\x8a\xbe\x71\x2a\x39\x67\xb2\xc8\xc4\x67\x96\x5c\x19\x4e\x15\x1b\x6f\xc4\xac\x2a\xd3\x07\xa1\xc7\x38\xe7\x58\xcb\x95\x18\x83\x50\x14\x99\x39\x36\x38\x2c\xa4\x5e\xd6\xb3\x04\x32\x9c\x2f\xca\x17\xe5\x5a\x14\x7c\x2d\xcf\x85\x52\xa5\x32\x6c\x0f\x10\xd0\x21\x8e\x4c\x7f\xe2\xb9\xcc\x70\xb6\x31\xed\x01\x21\xe7\x2b\x7d\x90\x93\x99\x35\x84\x90\x4c\xf1\x02\x26\x49\x5e\x8b\x39\xaf\x73\xed\x8f\xb1\xdb\x61\x6a\x8d\xa3\xea\x39\x1b\x7f\xff\x71\xcc\x12\x48\x6f\xe8\xdd\x49\x82\xb5\xdf\x3d\x88\xed\x84\x7d\x07\x09\x6a\xc1\xa6\x17\xad\x2e\x0c\x13\x9a\xc5\x17\xeb\xf1\x73\xe4\x3d\xae\xf1\xc8\x2a\x35\xf9\xb5\xfc\xd5\x1b\x0b\xc3\xe7\x10\x7f\xda\x58\x8f\xd0\xd4\x9d\x6f\x19\x00\x26\xb4\x11\xaf\x52\xe8\xe3\x7f\x38\xd7\x35\x5f\x11\xc9\x1b\x98\x3b\x07\x04\x80\x1c\xd8\x3c\x17\x2b\x51\x04\x50\x9b\xd5\x95\x2c\x44\x55\xb1\xbc\x5c\xc8\x94\x95\x73\x33\x0a\x46\xcb\x1a\x38\x09\xf9\x30\xc0\xd8\x82\x91\xa7\x4b\xb6\x12\x7a\x59\x66\x80\x5a\x2a\xe4\x27\x51\x59\x5e\x65\x0d\x51\xb0\x1d\xf3\x26\xc9\x20\x8f\xc2\x72\x2d\x54\x65\x26\x94\xd0\xb5\x2a\x2c\x79\xa5\xb9\xae\x2b\x20\x32\x13\x34\x47\xac\x69\x78\xcd\xb7\x79\xc9\x33\x80\x1f\xd4\x40\x98\x62\x70\x91\xdf\x6e\xdf\x5e\x27\x23\xbd\x5d\x8b\x27\x0e\x89\xb3\xa9\x39\x4f\x41\xd6\xb1\xf1\xdb\x35\x69\x4c\x96\x05\x99\xe6\x90\xa6\x1c\xac\x93\xdb\x1a\x3e\xa2\xb6\xd6\x88\xdd\x5f\x22\xaf\x88\x72\x69\xb6\xab\x8c\x3d\xfe\x65\x35\x61\x97\x27\x37\x5c\x2f\x1d\x69\x80\x16\xe2\xfa\x41\xcc\x6e\x8d\x77\x38\x84\xed\x6d\x1f\x29\x76\x46\xce\x96\xbc\x13\x1f\x6b\x51\xe9\x89\x55\x5e\x35\x2c\xec\x8d\x99\x9b\x40\x7d\x45\xc1\xce\x06\x49\x5e\x61\x2a\x66\xc6\xbd\x1c\xd4\x48\x7a\x92\xe5\xf2\x13\x40\x70\xab\x95\xe0\xab\xe7\x95\x46\x10\xe3\xea\x80\x3c\x66\xd7\x0f\x4a\xc2\x44\xfb\x62\x3d\xa3\x18\x31\x8b\x80\x83\x49\x0b\x86\xc7\xdd\xc4\xee\x17\x87\x2e\xd7\xda\xc8\x06\xe5\x6b\xb1\x19\x64\xfa\xae\xac\xc1\x87\xa5\xd0\x96\x86\xcd\x39\x33\xf2\x78\xc4\x6d\x96\x12\xee\xa0\x88\xa6\x02\x60\x8d\x90\x15\x81\x97\xb0\xbc\xe2\x3a\x5d\x52\xe0\x76\xee\xe2\xdc\xcb\xc2\x47\x8d\xe6\x75\x91\x3e\xb1\x6d\xe4\x68\x8f\xc2\x3e\xee\x8a\x44\xd0\x47\x68\x47\x3c\x3a\x35\x72\xa9\xc7\x19\xaf\x04\x21\x73\xca\x5c\x10\x4f\xde\x2b\xb9\xba\xad\xe7\x73\xf9\x39\xea\x87\xbb\x9f\x1d\x31\xf8\x4f\xd8\xf8\x7c\x1c\xef\x0e\xbb\x92\xd2\x09\xcf\xb2\x3d\x16\x8d\x4f\x4c\xfa\xc1\x2f\x69\x38\xd3\xe1\xa3\x0d\x73\xc6\xad\xd6\x60\x28\x2c\x38\x26\x6c\xc0\xe8\x7a\x69\x8d\x0b\x9d\xae\xef\xec\x29\xee\xed\x9f\xd8\x1c\x98\x21\xe8\xa8\x2f\x00\x87\x21\x85\x0f\x00\x0f\xa4\x21\xbb\x20\x99\x21\x97\xb9\xcd\x22\x15\x6e\x37\x71\x19\xc5\x67\x89\xf8\xef\x66\xe5\xc9\x05\x2b\x64\xee\x36\x66\x16\x5c\x55\x72\x2b\xd4\x27\x71\x49\xdf\xd1\x06\x87\x30\xa0\x8b\x1d\x89\x8d\x7c\xe6\xc7\xce\xfc\x3b\x10\x16\x36\xd5\xc4\xcb\xd5\x0c\xff\x67\xbd\x50\x1c\x51\x30\x71\x1f\x8e\x33\x76\x8f\xc3\xa3\xf4\x04\xb2\xbb\x31\x17\x52\x6b\xc7\x83\xf1\x1c\x20\xce\xb6\x98\x5e\xe7\x12\xd1\x79\x83\x2c\x89\xd8\xdb\x38\xa3\x97\xcd\x04\x15\xc2\xcf\xc1\xb8\xf2\x48\x24\x53\x46\x12\xcf\x4b\x05\x9c\x57\xd3\x9e\xa6\x2c\xa7\x4c\xcc\xc9\x77\x40\x9c\xbc\xca\xcb\x4a\x44\x71\xcf\x00\x0e\xdf\xc9\x01\xef\xf7\xfe\x6e\x03\xdd\xb0\xf6\x0d\xf7\x94\xb8\x7f\xc0\x79\xac\xfe\x1b\xcd\xef\x0e\xc7\x3d\x17\xac\x20\xc4\x21\x1f\x0c\x22\x56\xb4\xf9\x66\xc9\xed\x3e\x5d\xd9\x4f\x4f\xd9\x89\x1d\x4f\x6e\x35\x57\x48\x95\x51\xfc\xe5\x70\x0a\x52\x91\xcd\xa2\x13\x9f\x36\x27\x5f\x29\xdd\x31\x10\x7d\x0b\xaa\x37\xa4\x2c\xca\xd6\x44\xdb\x93\x2d\x0e\xb3\xe2\x2e\xee\x15\x55\x0e\xb2\x4a\x8f\x4c\x55\x33\x14\x6d\x30\xac\x05\x6a\x18\xaa\x7d\x50\x8e\xe2\x84\x3c\x2f\x0b\x61\x3d\x15\xb1\xc5\x92\x0c\x24\xdb\x76\xd5\xc6\x4f\x10\x88\x9b\x25\x4d\x42\x18\x4c\x8e\xed\x6a\xd1\x4e\x59\x54\xf4\x38\x8c\x3a\x5f\x4f\x95\xc8\x94\x74\xf6\xfc\xdc\x3b\xab\xad\x91\x08\xda\x22\xb5\xe7\x77\xc9\xa3\x61\xc1\xca\x46\x37\xa6\x2e\x7b\x07\xa7\xa6\xca\x47\x6a\x93\x7e\xea\x42\xb8\x0a\x6f\x4e\x2e\x58\xc1\xfe\xe0\x41\x4d\x05\xe3\x69\x2a\xd6\x1a\x19\xad\xac\x68\x48\x2e\x64\xd1\xa4\xae\x64\x44\x71\x74\x5f\xac\x8b\x76\x63\x1f\x8a\xd4\x23\x12\x67\xe7\xc4\x43\xd6\xb1\x79\xcc\x2a\xca\x66\xbc\x00\x23\xc3\xe1\xdf\x55\x86\x26\x89\xfb\x72\x30\x48\xe6\xd6\x51\xa0\x5d\x3f\x77\x11\xe2\x76\x93\x18\x36\x6f\x04\x49\x18\x59\x56\x16\xae\x0d\x58\x09\x6e\x9b\xc4\x51\xc4\x00\xb8\x8e\xc6\xc0\x83\x86\x71\x5f\xbc\x47\x8d\x39\x46\xde\xe3\x6b\x84\xc8\xd4\xa8\xd7\xb6\x45\xf1\xe8\x30\xef\x36\x28\x10\x69\x82\x70\x72\x49\x2d\x95\x89\x1a\x89\xfd\x8c\xbc\x13\x0c\x84\xb0\x35\x2a\xed\xd4\x84\x2c\x8a\xd8\x39\x4c\x4b\x86\x43\x6d\x8d\xee\x0e\x95\xe7\x4a\x66\x70\xe5\x0d\x57\x02\xe1\x94\xe7\x36\x6a\x53\x2b\x68\x8e\x62\x14\x8f\x30\x8b\x32\x7e\xc8\x29\x8e\x74\x06\x2e\xa1\x02\x63\x9a\x4b\x00\x8c\xe7\x79\x50\xc7\xbb\x54\x3a\x27\xc8\x1c\x6a\x09\x1a\x04\x1e\x29\xcd\xdd\x2e\x48\x0e\x75\xaa\x7b\x65\xb9\x9b\x6b\x5c\xef\xb5\xa8\x52\x25\xd7\xc4\xb1\xa9\xd3\x7b\x83\xbd\x0a\x0e\xab\x8a\x52\xa3\x4a\x4f\x97\x62\xc5\x0f\x55\x91\x2e\xd9\x52\x07\x12\x19\xf2\xab\xea\xa5\x52\x7c\x1b\xbb\x9f\x6f\x78\xf5\x5a\xd2\x2e\x2b\x59\x70\x8d\x3a\xb1\x21\xbb\xf2\xb0\x6b\x87\xac\xff\xc7\xf4\x79\x5d\xe7\x39\x9f\xe5\xb4\xc5\xd9\x5e\x18\x29\xea\x15\x81\xc9\xb5\x11\xdd\x9f\x4d\xc9\x8b\x76\x8e\x46\xbb\x51\xe8\x58\x41\xbc\x77\x14\x7b\x72\x08\x43\x75\x1b\xf1\xea\x4a\xd6\x23\xf2\xc2\x77\x05\xde\x97\xa2\x13\x9c\x6d\x7d\x1c\xd4\x48\xe6\xbb\xea\xb4\x7a\x95\x6b\xde\x9a\x76\xef\x58\x2b\xd9\xe2\xc6\x04\x04\xaa\x1f\x11\x04\x4c\x2b\xa9\x3c\xcd\xd9\xd1\x0a\xbf\x53\xb0\x7d\x4d\xb5\xd8\xd4\x2b\xbe\x5c\x79\x27\x16\x12\x9f\x5b\xd7\x92\x18\x84\x52\x08\x54\x38\xd2\xdd\x7d\xd0\xa6\x90\x51\x01\x94\x7f\xd7\xf0\xc9\x06\xb8\x1f\x4d\xfd\x80\xea\xec\xdd\xef\x89\x99\x89\xe2\xc1\x9b\x82\x16\xea\x2d\xb3\xab\x80\x17\xb1\x1a\x3c\xf0\x84\x7d\x5c\x3e\x0c\x23\x01\x1b\x7f\xac\xee\x82\xae\xf3\xbe\x13\x8a\x06\x94\x6a\xea\xdc\xe1\x8a\xe0\x6b\x77\x6f\xf4\x38\x14\xd0\x48\x77\x17\x0c\x21\x14\x8a\x88\xf0\xa3\xa9\x19\x76\xbd\x46\xf4\xaa\xba\xf1\xb6\xa2\x6e\xe2\xc0\x4e\xea\x88\x06\x5a\x5b\x3f\x97\x26\xee\x1c\x52\x1e\x87\xc5\x39\x22\xcf\x73\xe9\xc4\xe6\x17\xaf\x95\x6f\x3d\x87\x72\x19\xee\xce\x38\xc7\x2b\x5e\x94\xc8\x32\x3c\xb7\x83\xff\x14\xdb\x28\xd0\x57\x7c\x3f\x61\x08\xd0\xe2\x59\x4e\x40\x61\x81\xe2\x51\x99\x39\x6c\xb7\xd1\x19\xb3\x2a\xa1\x89\x2e\x67\xdb\x25\xd8\x99\x4e\x9f\x40\x8e\x38\x23\x72\x7b\xf3\xe5\x02\xe8\xc8\x55\xa7\x61\xc2\x7d\x2d\x6c\xc2\xb5\x4c\xe2\xc4\xfe\x8e\x4e\x69\xb5\xe5\x55\x21\x6f\xa2\x55\xb7\x3b\xa6\x08\x96\x86\x05\x6a\x07\x59\x26\x97\x6f\x7f\x99\x3a\xaf\xa4\x28\x22\x95\xc8\xfc\x3e\x07\xce\x4d\x95\xb1\x27\xed\xf7\xbf\x51\x0a\x13\xb4\xc6\x88\x07\x1b\xe1\xdf\x4b\x5b\x5d\x90\xf6\x3b\x31\x23\x10\xce\x2a\x69\xfa\x94\x1c\x38\x3e\x14\x5d\xb9\x22\xfd\x2f\x0a\x83\xda\x67\x6c\x2d\x1b\x7b\xdb\x50\x3b\xe7\x85\x68\x62\x97\x49\xa0\x6d\xba\x7b\xb5\x94\x79\x9b\xfd\x22\x9b\x9f\x84\x1b\xf7\xa6\x1b\x07\x55\xdc\x38\x1e\x22\xe0\xc5\x96\x26\x10\x87\xdd\x04\x36\xca\x25\xf4\x91\xb5\x03\xaf\x4a\xba\xc7\xfc\xfc\x76\xf6\x27\x4a\xe3\x98\x0e\x44\x45\xca\x7f\xcd\xa9\x92\x2b\xaa\xb8\xff\x00\x6c\x6c\xda\xed\x67\x15\x0a\xd6\x26\x20\x5b\x54\xb9\x43\xf5\x83\xc5\x71\x26\xc9\x1f\x2e\xe7\x45\x47\x9c\xe5\x88\xc9\xe2\x80\x60\x86\x74\xfc\xd0\xfc\xf6\x88\xdb\xb5\xca\xee\xdd\x55\x05\x6e\xd6\xea\xd8\x65\xf7\x23\x4a\xee\x51\x04\x5a\x6e\x2a\x83\x46\xcd\xcd\xc8\xbe\x9e\x5b\x2d\x91\xfa\xbe\x58\x0f\x47\xb5\xb0\x77\xd6\x51\x63\x91\x5c\x14\x44\x1e\x93\x93\xfe\x10\xf0\x1b\xb2\xc9\x70\x82\xb8\x68\xf1\x1a\xd4\x6a\xa7\xcd\x5e\x74\x8c\x8e\x1c\xa6\x35\xdd\x8f\x04\x56\xe9\x47\xc2\xe0\xf3\x86\x03\x27\xc4\x40\x29\xd6\xfd\x0e\x75\xf4\x8f\x46\x45\xae\x93\x76\x52\x91\x15\xcb\x0a\x5d\x8b\xb3\x16\xf6\xb0\x71\x02\xab\x92\x24\xf1\xf1\xdb\x2d\x82\xe1\x46\xbd\x56\xce\xd4\x74\x3f\x53\x27\xab\x5c\x93\x3d\x30\xef\xcb\xdb\x80\xc2\x15\x3e\x97\x9f\xb5\xe2\x16\x53\xa6\xfc\x39\xa7\xc2\x2e\xac\x66\x5b\x4e\x59\x99\xda\xd4\xeb\x98\xb8\xd7\xab\xe9\x0a\x91\x3c\x67\xc1\xb2\xd1\xd9\x79\xaf\xdd\x34\xfc\xdb\xbd\x87\xda\xf1\xfd\x1e\xc9\xb7\xa6\x98\x37\xfd\x8b\xb9\x2d\x0d\x3b\x15\x77\x6d\xcb\xdc\xb5\x29\xbd\x2f\x89\xc5\xca\xdc\x1a\xf9\x2a\x01\x63\xf6\xaa\x05\x54\xe6\x4a\x73\xb0\xa3\xed\x15\xa8\xfb\x77\x98\xae\xc6\x36\x17\xc6\x9d\x57\x12\xaa\x6f\x7c\x2f\x66\x5e\xf8\xcc\xd3\x89\x97\x02\x45\x36\xf7\xad\xbb\x25\x9d\x53\xfb\x4f\xcf\x84\xe6\x30\xae\xbe\x56\x9a\x9d\x99\xdf\xb1\xdd\x21\xda\x3b\x06\xa2\xc1\x40\xa9\x3c\x2b\xcb\xbc\xe9\xb6\x09\x69\x7e\x5d\x4c\x4e\x6e\xa0\xa7\x93\x76\xac\x03\x3f\x20\x09\xd5\x04\x87\xdf\x38\x84\xb9\x43\x20\x78\xac\xf8\x83\x88\x06\x74\xc0\x4c\xb7\x29\x27\x74\xbe\x36\x52\x07\x5b\xb8\x1d\x20\x8c\xbf\xc7\x46\x41\x7e\xa3\x04\x5d\x63\x83\x06\xa9\xeb\x11\x71\xed\xf4\x34\x9c\x76\xb7\xdc\x76\x9a\x02\xa2\x8f\x23\x56\x9e\x3b\x4c\xdc\xfd\x38\x75\x87\x8b\x5f\xfc\x78\x7f\x0f\xef\xf6\x1b\xde\xc9\xfb\xf6\x92\x51\xcb\xa2\x16\xc1\x5d\x17\x89\x01\x41\x4f\x3a\xe4\xfd\x2b\xd8\x8e\x1a\x5c\x7c\x69\xdd\xcd\x5f\x11\x52\xed\x45\x10\x68\x61\xa8\x42\x1c\xfa\xfb\xfb\x16\x87\xee\xc1\x81\xc1\x80\xd6\xb2\x18\x03\x7e\x0a\xf1\x59\x43\x1a\xe5\x9f\xe4\x9a\x07\x09\xfb\x4c\x61\xe1\x55\x94\x76\xf9\x84\x48\xb6\x10\x4e\xba\x3b\x05\xce\x7e\xfa\xe1\x27\xba\x23\xa2\x8f\xbf\x99\x31\xd0\x31\xa9\xc1\xdc\x70\x0e\x9f\x19\x48\xdc\x1e\xba\xd0\x37\xd3\x6b\x80\xf5\x1a\xdb\x86\x31\x0f\x25\xe7\x24\x7f\xc1\x45\x62\xf7\xb0\x91\xb8\xb3\xb7\x31\xd8\x0f\x4d\xdc\x83\x87\x35\x81\x15\x63\xda\x7c\x4d\x6c\x15\xe8\x4c\xd5\x3e\x85\xdc\xae\x73\xa9\xa3\xf0\x61\x24\x22\xc9\xed\xdb\x87\xfb\xd7\x2c\xb5\x47\x98\x36\x5f\x13\x7b\x8d\x69\x3d\xd7\xdc\x92\xbe\x79\xff\xfe\x86\x65\xb2\x5a\x93\x9e\xbb\x26\xf0\x4f\x42\x73\xa9\xf0\xc3\x46\x9a\xe6\x79\x48\xc2\x42\x2e\xd6\x70\x73\xf5\xa2\x97\x03\xba\x6d\xb6\xf8\xc2\x67\x93\xc6\x73\x4f\xf6\xbd\xc5\x76\xac\x37\xe6\x9c\xd0\x9f\x07\x58\xe3\xc3\x3a\x29\x4a\xfd\x0b\x5d\x04\xf5\x1f\x1c\x3a\xb7\x68\x8d\x63\xc2\x5b\x8f\xe8\x33\xfc\xf1\x84\x00\xa1\xda\x5d\x27\xce\xf3\xbc\xdc\x20\x0d\x07\x21\xd7\x15\x7c\x29\xb4\x65\x2a\x90\x4e\xb4\x70\xf8\xf0\x77\x6b\xd6\xc3\xca\x07\xa2\x69\x16\x24\xdd\x38\xd8\x5c\x83\x9f\x80\xee\xf1\xa8\xcb\x07\x2c\xac\xc5\x4e\xa8\xff\x77\x2f\x5e\x7e\xa9\x17\xb9\xc1\xa8\x1b\x98\xec\x2d\x8f\x0f\x6f\xd6\x92\x5a\xbc\x39\x4b\x84\x17\xf7\x1d\x63\xec\x1b\xcd\xed\x1a\x0f\xfb\x6a\x4b\xfc\x85\xaf\x70\x7d\x43\x58\xb4\x04\x7d\x95\x69\x5c\x48\x0c\x0a\x16\x61\xf3\xe2\xc6\x92\x00\xc2\xe0\x1f\xfb\x25\x14\x7d\xbd\xac\x54\xc8\x4c\x47\x4f\xbc\x3f\xd0\xb8\x55\xf9\x75\xa9\x5f\xda\x95\x91\xb7\x42\x7b\x6e\xda\xa0\xd3\xbf\x3c\xc1\xf2\xda\x6b\x64\x6c\x62\xd7\xf7\x95\xf9\xff\x39\x74\x83\x36\xa7\x61\xf4\x45\x2d\x6c\x6d\x95\x36\x0a\x8b\x8c\xff\x03\x5b\x65\x37\xee\xf5\x23\x00\x00")
+
+func templatesServerStandaloneGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerStandaloneGotmpl,
+		"templates/server/standalone.gotmpl",
+	)
+}
+
+func templatesServerStandaloneGotmpl() (*asset, error) {
+	bytes, err := templatesServerStandaloneGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/standalone.gotmpl", size: 9205, mode: os.FileMode(420), modTime: time.Unix(1792242584, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerValidatorGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x57\x4b\x6f\xdb\x46\x10\xbe\xeb\x57\x4c\x89\xb4\x25\x03\x86\xca\xa1\x27\x15\x39\x24\x6e\x80\xf8\xd0\xd4\x48\x8c\xf4\x10\x04\xcd\x8a\x1c\x89\x9b\x90\xbb\xcc\xee\xd2\x8a\x6a\xf8\xbf\x77\x66\x76\xa9\x97\xe5\xb4\x45\x51\x1f\xcc\xd7\x3c\xbe\x79\x7d\xb3\x1a\x54\xfd\x59\xad\x11\x6e\x6f\xa1\x7a\x7e\x75\x79\x95\x1e\xef\xee\x66\xb3\xf9\x1c\xae\x5b\xed\x61\xa5\x3b\x84\x8d\xf2\xb0\x46\x83\x4e\x05\x6c\x60\xb9\x85\xd0\x22\xf8\x8d\x5a\xaf\xd1\x41\xb0\xb6\xab\x58\xfe\x65\xa3\x83\x36\x6b\xfa\x38\xe9\xf5\x7a\xdd\x06\x18\x9c\xbd\x41\x58\x8d\x41\x4c\xb5\x68\x60\x6b\x47\x70\xf8\xc4\x8d\xe6\xc8\xd2\xe4\x02\x6a\xdb\xf7\xca\x34\xb3\x99\xee\x07\xeb\x02\xe4\x33\x80\x6c\xb9\x0d\xe8\x33\xbe\x43\x53\xdb\x86\x3c\xcd\x3f\x79\x6b\xe4\x8d\xb6\xe9\x32\xd7\x96\xfd\xc8\x93\xc1\x30\x6f\x43\x18\xe4\xc1\x07\x47\x1a\xa4\xcf\x0f\x6b\x1d\xda\x71\x59\x91\x9b\xf9\xda\x3e\xb1\x03\x1a\x35\xe8\x39\x3a\x67\x5d\xf4\x70\x5e\x80\x71\x7e\xe3\xf3\x8d\xea\x74\x43\xf0\xc5\x07\xf9\x5b\xf5\xe1\x41\x4b\xf2\x55\x04\x29\xf7\x4e\x19\xca\x7a\xf5\x0b\xae\xd4\xd8\x85\x4b\x89\xd9\x53\x15\xe8\xd3\x40\xa8\xc3\x0a\xb2\xef\xbf\x64\x50\x51\x5d\x44\x1e\x4d\x03\xd3\x7d\xd4\x7d\xf4\x19\xb7\x25\x3c\x22\x04\x23\xc2\xe2\x19\x54\x47\x46\xf8\x2b\xdd\xc1\x89\xbd\x24\x7e\x62\xb5\x90\xda\xbf\xc6\x0d\x4b\x2b\x5f\x53\x50\x7f\x12\xb8\xd7\xaa\x67\xd1\x77\x31\x46\xeb\x60\xe3\xd4\xe0\xc1\xe0\xd7\x00\x1b\x8a\x11\x14\x55\xbb\x69\x3a\xdc\x28\x87\x90\x52\x11\xbb\x01\xa9\xd6\x5f\x46\xf4\x04\xc7\xae\xe4\x99\x4c\xb7\x23\x55\xf8\xd0\x32\x50\x03\xb2\x67\xb5\x56\xda\xf8\x00\x9a\xc4\xfd\x80\x75\x45\x7d\x88\x84\xc4\xa9\xde\x03\x35\x85\x18\x58\xda\x66\xcb\xc6\xd4\x64\x1a\x7a\x15\xea\x96\xfd\x29\x03\x94\x65\x6a\x23\x6d\x0d\x30\x96\xa5\x1d\x49\x8b\x35\xa7\x02\x35\xa5\x38\x32\xa0\x8d\xbc\xda\x19\xd1\xec\xc1\x6f\xd0\x51\x97\xa7\xa0\x7e\x7a\xfa\x54\x74\x0d\xde\x50\x87\x3a\x54\x75\x8b\x31\x6c\xe9\xf9\x37\x53\x64\x9b\x56\xd7\x6d\x44\x01\xc6\x9e\x40\xa0\x3c\x7a\x32\x19\x6c\xcc\x17\x4d\x13\x05\xb1\xe5\x4f\xd5\x6c\x35\x9a\xfa\xef\xd3\x9d\x8b\x22\x77\x73\xf5\x8a\xe0\x74\xe8\x8a\xa3\x27\xb8\xa5\x22\xd2\xa4\x50\xed\x7f\x70\x34\x02\xe8\x6e\x97\xca\xe3\x95\x0a\xed\x02\x52\xef\x57\xd7\x4e\xf7\x6f\xc7\xd5\x4a\x7f\xcd\x4f\x5b\xeb\x45\x12\x26\x9f\x25\x64\xf3\xac\x28\x05\xea\x42\xfe\x1f\xb5\x5a\xf5\xdb\x14\x1a\x77\x97\x0b\x95\x6a\x9a\x7b\xe6\x7e\xc5\xd0\xda\x46\x8c\x9d\x7e\xda\x79\xe1\xc0\xf3\x4d\x8c\xe2\x0d\xfa\x81\x0c\xe2\xef\x4e\x13\xf4\x12\x1c\x3c\x4e\xef\x25\xbd\x25\xa5\x26\xb4\x57\xb1\x07\x7a\x35\xbc\x8f\x11\x7d\x88\x97\x42\x82\x17\x88\x7a\xc5\x0e\x58\x8c\x12\xe3\x5f\x50\x97\xc8\x13\xf9\xa3\x5a\x2d\xb5\x61\xd6\x20\x76\x31\x7e\xec\xd1\xef\x3a\x29\xc6\xca\xc5\x6d\x3c\xf5\x5d\x6c\x41\x31\x19\xbf\x12\x31\x70\x62\x23\xb5\x10\x26\xd5\x3c\xef\xba\xdc\x55\x6c\xbf\x10\x39\xf2\xcb\x42\xdf\x3d\x03\xa3\xbb\x04\x87\x38\x00\xdd\x0d\xa6\x10\x5e\x32\xb9\xe4\x1b\x31\x46\x2c\x53\x51\xc5\x09\x9a\xc7\xf8\x3e\x63\x47\x19\x65\x7e\xba\x66\x22\x58\x14\xc9\x92\xc3\x30\xba\x08\xe9\x4e\xfe\x47\xe7\xb0\x03\xf5\xda\x0e\x17\x9d\x25\x87\xb9\xd0\x24\x9b\x67\x9c\xfc\xcc\x20\x8b\x29\x41\x71\xc8\x6f\x94\x9b\x26\xea\x6c\xdb\xc5\x14\x1e\x46\x46\xe1\x47\x85\x8a\xb3\x98\x62\xca\xdd\x61\x61\xca\xc4\x79\x13\x8f\x15\x3f\xff\x9b\x9c\x3c\x18\xe9\x37\xab\xfa\xdf\xd2\x20\x53\xfc\x96\x01\xbd\xba\xbe\xbe\x62\x20\x02\xe3\xae\x38\xa1\xd9\x88\x89\xc6\x6b\x16\x17\x63\x82\xff\x4e\xdb\x2e\xce\x38\xf3\x06\xb7\x0f\xad\x23\x58\x59\xa6\x89\x4f\x58\x0b\xfb\xed\x08\x6a\x16\xb6\x03\xde\xd7\xa4\x94\x8d\x75\x90\xec\x30\xeb\x72\xfa\x13\x47\x4e\xdc\x24\x69\x07\x32\x4a\x6b\x94\x26\x2f\x6c\x49\x54\xe4\x62\xf3\xc3\x47\x5e\x82\x8b\xcc\xd0\xab\xd2\xf6\x34\x3e\xfd\x10\xb6\xd9\xc7\x68\xf0\x52\xb0\xb1\xb9\xce\xd6\xd1\xe3\x89\x79\xd9\x01\x0b\x29\x63\x09\x04\xce\x51\xbf\xb7\x92\x32\xf6\xc9\x59\x23\x4b\x97\xe6\xc4\x9b\x36\x27\xbe\x7e\x45\xef\xf9\xe8\x70\x2c\xd6\xc7\xb7\x24\x71\x94\x38\xa9\xfb\x04\x6c\xa2\x72\xbe\x8f\x64\xcb\x04\x9c\x98\xfc\x84\xa0\x8f\x92\x18\xad\x1c\x24\xf0\xc2\x36\x18\x9b\x88\xf4\x02\x9c\xfc\x25\x48\x74\x72\xc0\x23\xc4\x00\x13\xe8\x33\xd2\xfb\x00\x00\x76\x45\xf3\xf0\xfe\xc3\xbd\x42\x26\x85\x9b\x9d\xd0\x2e\xe8\x7b\x1d\x9f\x42\xf4\x71\xc5\x70\xdc\x7b\x2d\x6a\x1f\xde\x56\xb4\x4d\xe8\xac\x34\x11\xd6\xbe\x89\x64\x59\x9c\x19\xa1\xf3\x2c\xca\xe3\x27\x74\x13\x19\x72\x50\xdb\xce\xaa\x86\x67\xf9\x50\x3b\x4e\x26\x27\x6f\x91\x82\x17\x63\x6f\x83\x0a\xa3\x7f\xa1\xa6\x61\x2f\x45\x2c\x65\x4d\x24\xb3\x83\xd5\x0e\x8d\x45\x6f\x7e\x0c\x4c\xae\x34\x01\x3d\xef\x3a\xfe\x4c\x4b\x1d\x1a\x5c\x69\xa3\x39\xbc\x2c\x1a\xd9\x67\x72\x31\xe9\xef\x5f\xe5\x84\xb8\x64\xca\x28\x58\x98\xc7\x6f\x53\xbd\x8a\x33\x5c\xd0\xb0\x86\x3c\xbb\xb0\x26\xa0\x09\x4f\xae\xa9\x19\x98\x2b\xd5\x30\x74\x3a\x76\x77\x3c\x11\x16\xa2\x24\x59\x48\x9a\x67\x23\x62\xb1\x3d\xbd\xb1\x26\xf3\xc5\x4b\x3e\x5c\x92\xca\xa6\xa8\xe2\x6d\x9e\xf2\x76\x8e\xcf\x06\x3a\xc3\xd4\x8c\xb8\xe0\x69\xeb\x30\xa4\xe3\x4e\x4d\x27\x5e\xb7\x3d\x3c\x11\x35\xa8\xba\xa9\xe0\xda\x4b\x64\xd4\x1e\x52\xcf\xb3\x29\x88\x85\x23\x46\xc2\x73\xed\x56\x9c\x6b\x41\x86\xe4\xc9\x05\x9d\x40\xe4\x10\x48\x16\xaa\x9c\x27\x26\x96\xbf\xa6\x15\x0f\x8f\xd3\xfa\xb9\xb0\x74\x40\xf4\x94\x20\x69\x81\x85\x04\xc3\xcc\xf5\x07\xef\x42\xcf\xa7\x7c\x32\x10\x17\x3e\x56\x22\xe3\x77\x14\xce\x90\x9e\x9d\x41\x1d\x15\x05\x72\xb1\x23\xf0\x23\xaf\xe9\x38\x43\xd2\xd1\x63\xb4\x44\xf5\x23\xaa\xcd\xe9\xa1\xbc\x47\x90\xb7\x4c\x75\x0b\xc2\xc0\xd7\x92\x88\x88\xef\x2f\x4d\xb9\x6f\xc4\x04\x2f\x2f\x84\xb6\x8f\xdc\xed\x17\xec\xff\xe5\xae\x89\xab\xee\x1f\x9b\xdf\x9b\xa1\xda\x1c\x1a\x3a\x5c\x33\xe8\xa9\x35\x1e\x38\x6f\xd1\x6b\x66\xdd\x8e\x7f\x25\xd1\x6f\x1a\x3a\xfc\xa9\xce\x1a\x8c\xdb\x91\x4e\x57\xbc\xb0\xf6\xab\xeb\x01\xe9\x37\x72\x40\x4c\xd2\x7f\x01\xe1\x2d\x44\xca\x04\x0e\x00\x00")
+
+func templatesServerValidatorGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerValidatorGotmpl,
+		"templates/server/validator.gotmpl",
+	)
+}
+
+func templatesServerValidatorGotmpl() (*asset, error) {
+	bytes, err := templatesServerValidatorGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/validator.gotmpl", size: 3588, mode: os.FileMode(420), modTime: time.Unix(1792210820, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerWebsocketGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x54\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\x70\xc1\x30\xc8\x45\xe0\xde\x37\xf4\x50\xf4\x0b\x1d\xb0\x74\x58\x86\xe5\x58\xa8\x16\x9d\x68\x95\xa5\x40\x92\x93\x7a\x81\xff\xfb\x48\xd9\x69\xed\x7e\x0c\x5d\x0f\x85\xac\x47\x91\x8f\x7c\x8f\xd9\xef\x41\x61\xa9\x2d\xc2\x74\x87\x77\x0b\x57\xdc\x63\x3c\x73\xd6\x4e\xa1\x6d\x27\xc7\xc7\xb0\xdf\xc3\x46\x86\x42\x1a\xfd\x07\x21\x9f\xcb\x0a\x09\xe0\x00\xd0\x01\xe2\x1a\xa1\xa0\x33\x16\x51\x3b\x0b\xae\x4c\x37\xf4\x64\x5d\x57\xd2\x0e\x5f\x80\xdb\xa0\x97\x1c\x35\x03\x7c\x28\xd6\xd2\xae\xb4\x5d\xc1\xd7\xc5\xcd\x1c\x2a\x0c\x41\xae\x30\x4c\x62\xb3\xc1\x7f\x14\x0c\xd1\xd7\x45\x84\xfd\x04\x52\x51\xa0\xbf\x23\xe2\x1c\x12\xe7\x9c\x43\x08\x29\x9d\xaf\x64\x0c\x1c\x5c\x56\x31\xff\x81\x2b\x4d\xc7\x66\xd2\x4e\x28\xf1\x4e\xc7\x35\xe4\xcb\x43\x9f\x84\x16\xa8\xb7\xd8\xb7\x7a\xf8\xf2\x28\x55\xd7\x9b\xc5\x87\x78\xa0\x07\x01\x6d\x84\xbb\xa6\x6b\xda\x68\xfe\x92\x56\xc1\x96\x98\x2a\x19\x31\x80\x8e\x93\xb2\xb6\x05\x88\x02\x8e\x46\x5d\x7c\x1c\xb6\x91\x1d\xea\x88\x0c\x04\x85\xe5\x57\xee\x27\xf7\xdd\xb6\x34\x19\xef\x9d\xcf\x52\x87\x5b\xe9\xa1\x0a\x2b\x18\x45\xd0\xbd\x2e\x39\x0a\x3e\x9f\x40\x91\xf3\x14\xa8\x07\xa9\x78\x8c\xe2\x13\x85\x67\x5f\x12\xfa\xe1\x04\xac\x36\x29\x0f\x50\x3b\xb1\xf6\x96\x93\xa5\x02\x74\xc7\x79\x28\x2f\xa5\xe2\x06\x84\xf3\x90\x5f\x87\x53\xa3\x65\x40\xc5\xc7\x33\x57\x6d\x0c\x3e\xdc\xdc\xfd\x26\x61\x89\x25\x99\xe3\xc0\x61\xaa\x6d\x44\x5f\xca\x02\xf7\xed\xf4\x19\x24\x6d\x43\x57\x6d\xfb\x44\x91\x6a\xe6\xbf\xfa\xf9\x88\x22\xef\xb5\xf9\x2f\x92\x48\x0c\xdb\x76\x08\xd3\xa3\x4e\xcd\x0e\x7a\x45\xd6\x45\x87\xb0\xa6\xe9\xb8\xf3\x9a\xf5\x91\x8f\x52\x46\x37\x50\xf1\x7d\xa2\x71\x22\xf1\x42\x90\xac\x93\x2c\x35\xd1\x73\xec\x65\x59\x72\xcd\xa4\x0b\xcb\x32\x24\xcc\xb4\xce\x8c\x0b\x5c\x9f\xfe\x77\x4e\xab\xad\x42\x6f\x1a\xde\x89\xa7\x85\x7a\x83\xd9\x98\x58\x4a\x25\xde\x26\xd2\xe3\xc4\x80\x0b\xa7\x92\x4b\x9a\xd7\x45\x0a\x8f\x68\x4c\x18\x3a\x7a\xb7\xee\x0c\x4e\x0b\xaa\x0c\xfa\xf7\xad\x34\xac\x24\xad\x4d\xbd\x79\x17\xdf\x31\x03\xc1\x4e\x18\xd8\x9e\xbc\xf3\xb4\xd2\xe4\x44\x0e\x7e\x0c\x9c\x0d\xb0\x84\xcc\xd9\x50\x86\x8f\xb5\xc7\x17\xe8\x95\xa3\x71\x9e\xee\x64\x93\x8d\x4c\xd6\x7b\xab\x70\x0a\xd9\xa3\xcf\x1e\x5d\xb3\xbf\xad\x34\x0b\xf4\x5b\xf4\x17\xc9\x8a\x44\xea\x76\x06\xee\x9e\xc3\x89\x46\x2e\x12\xe1\x90\x27\x62\xe4\x66\x42\xba\x02\x29\xe7\x2b\x29\xd3\x6f\xc4\xa5\xa7\x31\x7c\x97\x8d\x71\x52\x9d\xcb\x28\x7b\x1e\xec\xa9\x11\x8d\xcb\xb4\x25\xe9\xe5\xb7\xce\xaf\x82\xf3\xa6\xbd\xe8\x4a\x8a\x2c\xa3\x87\xb7\x70\x32\x32\x1b\x8d\x37\x7a\x67\xc4\xb3\xea\x7d\x8e\x59\xb7\x3c\x51\x57\x98\xcf\xdd\x4e\x64\xf9\xa9\x52\x22\x7d\x2e\x90\x92\xa8\x6c\x6c\xd2\xbf\x3d\xb7\xff\xeb\x18\x06\x00\x00")
+
+func templatesServerWebsocketGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerWebsocketGotmpl,
+		"templates/server/websocket.gotmpl",
+	)
+}
+
+func templatesServerWebsocketGotmpl() (*asset, error) {
+	bytes, err := templatesServerWebsocketGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/websocket.gotmpl", size: 1560, mode: os.FileMode(420), modTime: time.Unix(1792238406, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesStructfieldGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xdd\x53\xcd\x4a\xc4\x30\x10\xbe\xf7\x29\x86\xe0\xc1\x05\x37\x7b\xf7\xa8\x22\x16\xd4\x83\xee\xc1\xe3\x86\x74\xba\x1b\x49\x9a\x9a\xa4\xe2\x5a\xfa\xee\x36\x49\xad\x29\xae\xbb\x88\x88\xe0\x6d\xc8\xcc\xf7\x37\x9d\xb6\x2d\x14\x58\x8a\x0a\x81\x58\x67\x1a\xee\x4a\x81\xb2\x20\xd0\x75\x6d\x0b\xa2\x84\x4a\x3b\x38\xa2\xb9\x3d\x63\x16\x97\xdb\x1a\x63\xc3\xa1\xaa\x25\x73\x3d\x28\x8c\x5f\x68\x4e\x80\xc6\x16\x56\x45\x2c\x6a\x66\x39\x93\xe2\x15\x81\xde\x32\x85\x5d\x07\x13\xa0\xe5\x1b\x54\xcc\x53\x46\x28\xac\x1e\xad\xae\x4e\x49\x94\xd5\xa6\x57\xbd\x62\x1f\xb2\xbd\x85\x7c\x5d\x69\x83\x9e\x7d\xee\x75\xa4\x1d\xcc\x04\xfa\x89\x61\x7a\x87\x4f\x8d\x88\xb3\x27\x5a\x09\xaf\xea\xb6\xa9\xb9\x58\x0c\x62\xf4\xe1\xe6\x7a\xe0\x80\x17\x25\x83\x87\xe4\x8d\xa4\x40\x3f\x7e\xde\x58\xa7\xd5\x92\xad\x21\x86\x9a\x3c\x8c\xc3\xab\x6c\x2c\x7d\xf5\xbe\x65\xd7\xd4\x12\xc7\x25\x67\x7f\xb4\xe5\x39\xf9\x5e\x16\x58\x2c\x80\x87\x0e\x58\x34\x22\x28\x9a\xdd\x01\x93\x33\xca\x4b\xc6\xf1\x57\x6e\xe9\x78\xb6\x3f\x67\x76\x8f\x6e\x27\x6e\x2f\x6a\x76\xe8\x93\xfd\x93\x40\xb5\x11\xcf\x9f\xff\x76\xde\x13\xa6\xd4\x97\xbe\x77\xc0\xd5\x97\xf4\xd3\x33\xff\x31\xfb\x1b\x6e\x1d\x1e\xf4\xa7\x04\x00\x00")
 
 func templatesStructfieldGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -530,7 +1122,7 @@ func templatesStructfieldGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/structfield.gotmpl", size: 1299, mode: os.FileMode(420), modTime: time.Unix(1462143626, 0)}
+	info := bindataFileInfo{name: "templates/structfield.gotmpl", size: 1191, mode: os.FileMode(420), modTime: time.Unix(1792230545, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -555,6 +1147,26 @@ func templatesSwagger_json_embedGotmpl() (*asset, error) {
 	return a, nil
 }
 
+var _templatesTimeofdayGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x56\x51\x6f\xdb\x36\x10\x7e\xd7\xaf\xb8\x79\xd8\x20\x75\x8e\xec\x35\x0b\x86\x7a\xc8\x43\xd1\x36\x40\x07\xac\x0d\x96\x74\x0f\x1b\x86\x81\x96\x28\x9b\xab\x44\xaa\xe4\x49\x99\x6b\xf8\xbf\xf7\x78\x94\x65\xc9\x71\x93\x6e\x58\x1e\x1c\x51\x3c\x7e\xf7\xf1\xbb\xef\x28\xd6\x22\x7b\x2f\x56\x12\xb6\x5b\x48\xaf\xbb\xe7\xdd\x2e\x8a\x66\x33\xb8\x5d\x2b\x07\x85\x2a\x25\xdc\x09\x07\x2b\xa9\xa5\x15\x28\x73\x58\x6e\x00\xd7\x12\xdc\x9d\x58\xad\xa4\x05\x34\xa6\x4c\x7d\xfc\xab\x5c\xa1\xd2\x2b\x9a\xdc\xaf\xab\xd4\x6a\x8d\x50\x5b\xd3\x4a\x28\x1a\x64\xa8\xb5\xd4\xb0\x31\x0d\x58\x79\x66\x1b\x3d\x42\xda\xa7\x80\xcc\x54\x95\xd0\x79\x14\xa9\xaa\x36\x16\x21\x8e\x00\x26\xb9\x40\xb1\x14\x4e\xce\xdc\x87\x72\x96\x5b\xd5\x4a\x3b\xf1\xef\xa5\xce\x4c\x4e\x79\x67\x7f\x3b\xa3\xf9\x4d\x51\x21\xff\x47\x55\xc9\x49\x44\x4f\x0e\x2d\xbd\x83\xc9\x4a\xe1\xba\x59\xa6\x04\x3f\x5b\x99\x33\x53\x4b\x2d\x6a\x35\x0b\xb3\x93\x28\x89\xa2\xa2\xd1\x19\x28\xad\x30\x4e\x60\x4b\x0b\xd1\xe4\xb0\xb8\x84\x5b\x02\x7a\x5b\xbc\x14\x9b\xed\xae\x47\x4b\x5f\xca\x42\x34\x25\xa6\xcf\xf3\x3c\x0e\xa9\xa6\xf0\x2d\x2d\x98\xc2\x6b\xd7\x2f\x48\x22\xd2\x32\x33\xda\x85\x4d\x90\x4a\xbf\x5e\xbd\x38\x3f\x3f\x7f\x76\xd5\x94\xa5\x8f\x82\x5a\x58\x27\x1d\x08\x12\xa8\x2c\xcf\x3c\x0e\x90\xda\xae\x96\x99\x2a\x54\x50\xbb\x5b\x32\xf5\x41\x56\x64\xa8\x8c\x06\x53\xd0\xc8\x49\x82\xce\x81\xe4\x36\xb5\x7f\x2b\x4a\xca\x71\x9c\xe0\x12\x26\xdf\x5f\x2c\xe6\x3f\x2c\xe6\x17\xbf\xcf\x7f\x5c\xcc\xe7\x93\x93\x44\xde\x08\x6d\xa0\x30\xb6\x12\x38\x66\x73\x47\xa2\x99\x06\xb9\x52\x68\x85\x2a\x7d\x8d\x3f\x4a\x6b\x9c\x27\xa1\x28\xfa\x14\xa9\xfb\x44\x38\xc1\x80\x4c\xfa\x6c\xff\xb7\xa7\x95\xb0\xed\x06\xea\x91\x49\xb0\xb1\xda\x51\xde\xa6\xb3\x0e\xfb\x05\xad\xe7\xa0\x3c\xcf\x56\x94\x2a\x3f\xb0\x9d\x42\xa9\xde\x4b\xe8\x37\x0c\xc6\xf6\x83\xf4\xe2\xbb\xf9\x53\x4a\x14\xaa\x3c\x48\x13\x13\x60\x07\x9a\xc0\x92\xec\xcc\xb5\xff\x6b\x0a\xd2\x5a\x5f\xfe\x6b\x5f\xa2\x51\x74\x42\xf3\x81\x1b\xc7\x5c\x5e\x82\x56\x65\x14\xda\x66\x1c\xfd\x2f\xea\x1b\x78\xdd\x4f\xd6\x53\x8b\xfb\xd7\x4c\xcd\xd8\xce\xa4\x3d\x51\x8f\x9e\x32\x40\x7c\x24\xfe\x14\x3a\xd6\xaa\xe0\xe0\xaf\x98\x31\x2f\xef\x77\x32\x70\x39\x23\xd2\xdc\x2e\xba\x3f\x1b\x63\x32\x1d\x6c\x77\x58\xac\xda\x4a\x27\x35\xfb\x87\x37\x4a\x7e\xf0\xf5\xca\x69\xb2\xb0\xa6\xe2\xc1\xf3\xeb\xd7\x53\xf6\x14\x5b\xc7\x14\x85\x93\x18\x66\xdf\xdd\xbe\x20\x40\x8f\xd9\x1d\x08\x8b\xae\x6f\x3d\x56\x84\x9b\x5a\x0e\x92\xf1\x56\xfd\x90\x49\xdc\x04\x47\x90\xf1\xe8\x54\x20\x58\x3e\x82\x8e\x29\x28\x8d\xc6\xfb\x93\x63\x83\xd8\x31\x1e\x20\x93\x0e\x85\x5a\xbf\x33\xd8\xf6\xb0\xfb\x3e\x1d\xed\x3e\xbd\xe2\x2e\x89\x4f\xf8\x3b\xe9\x44\x79\xa7\x2b\xaa\xc2\x5a\x94\xb7\xf2\x1f\x3c\x58\x00\xfd\xa8\x97\x49\x70\xcf\x74\xac\x8e\xc8\xf6\xf4\x9e\x0c\xf8\x8d\x50\x63\x06\xfb\xe3\xcf\xe5\x06\x65\x12\xec\xc0\x84\xa9\xc0\xa5\xd4\x3c\x9b\x78\x63\xce\xc7\x45\xf6\x85\x0b\x75\x45\x7c\xc0\xe0\x5e\x07\x86\x78\xc4\x33\x07\x9b\x3c\x41\x6a\x6e\xc4\x68\x94\x29\xa8\xf1\xcb\x40\x0b\x27\xad\xa2\x96\xfd\x28\x3f\x53\xa4\x47\x4a\x34\x80\xa2\x3a\xc5\x61\xfb\xa3\x6e\xe8\xb2\x87\x99\x18\xd3\x7d\x51\x47\xa6\xed\x60\x7e\xbe\x79\xfb\xe6\xcb\x18\x85\xc8\xc7\x68\xf9\xa8\x87\x69\xf9\x6f\x54\xda\x45\x0f\xc9\x1d\xfb\x86\xf3\xf5\xbe\x19\x64\xff\x4f\x7e\x61\x5e\xfe\xfb\x79\xc2\x2f\xad\xe0\x03\x66\xbf\xb9\xbe\xda\x64\x0b\x26\xdb\x83\x30\x00\x7d\xe2\xfc\x41\xf2\xd3\x17\x18\xe2\x41\x7f\xfd\x1f\xb6\xba\xc9\x84\x06\x47\x3f\x9f\x3d\x6f\x04\xec\x2f\x0d\x10\x2e\x0c\xe0\x4f\x91\x93\x5a\x79\xb0\xd8\x8a\x3b\xaf\xaf\xb4\x85\xc8\xe4\x76\x37\x94\xc9\xd1\x91\x95\xad\xa1\xf5\xdb\xa1\xb0\x34\xf6\x48\xa1\xb4\x99\xc7\x0f\xc2\x2e\x86\x9b\xc0\x74\xdc\xb0\x6d\xb2\x0f\x0e\x5a\x3f\x18\xdc\xf9\xb7\x4d\xfa\x45\xfd\x11\x14\xd6\xb1\x30\x07\x4d\x19\xfc\xa8\xcb\x79\x19\x3d\x9e\x5a\xc0\x37\x99\xa3\xf8\x3c\xdc\x68\x46\xbc\xfc\x4d\xe7\x95\x57\xa1\x88\x27\x24\x91\x36\xd4\xc2\x1f\xca\x94\xe5\x4a\x06\xe7\xb1\xd7\x7b\x01\xdf\x7c\xdd\xd2\x2d\x88\xb9\xec\xba\x2a\xfd\x26\xca\x46\x3e\x72\x32\xb3\xa3\x6b\xab\x2a\xba\x40\xd2\x55\xb1\xe5\x25\x56\x8a\x9c\xa7\x96\xf4\xe9\xb7\x0a\xd1\x7f\xfd\xcd\xa0\xa6\xa7\x1a\x91\xb3\xf9\x16\x0c\xf5\x4e\x79\x7c\xaa\x11\x0f\xbd\xb7\x3f\x17\x3e\x01\x4c\x7f\x7d\x97\x0a\x0b\x00\x00")
+
+func templatesTimeofdayGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTimeofdayGotmpl,
+		"templates/timeofday.gotmpl",
+	)
+}
+
+func templatesTimeofdayGotmpl() (*asset, error) {
+	bytes, err := templatesTimeofdayGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/timeofday.gotmpl", size: 2826, mode: os.FileMode(420), modTime: time.Unix(1792214935, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _templatesTuplefieldGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x01\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00")
 
 func templatesTuplefieldGotmplBytes() ([]byte, error) {
@@ -595,7 +1207,47 @@ func templatesTupleserializerGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesValidationCustomformatGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x3c\x8f\x41\x4b\xc4\x40\x0c\x85\xef\xfd\x15\x71\x4f\x3b\xb2\xf4\x07\x28\x7b\x10\x54\x2c\x88\x7a\xf2\x1e\xdb\x8c\x06\xa6\x19\xc9\xa4\xa2\x94\xfc\x77\xa7\x53\xf1\x96\xf7\x78\xf9\xf2\xc2\x11\x48\x15\xae\xce\xf0\x85\x89\x27\x34\xea\xef\xb3\xce\x68\xcf\xf1\xb8\xae\xfd\x0b\xda\x87\xfb\x09\x0e\x75\x7e\xcc\x23\x1a\x67\x71\x3f\xec\xc6\x1e\x6c\xb2\x98\xb2\xbc\xd7\x0d\xa8\x40\x94\x09\x8e\x92\x0d\xfa\xa1\xdc\xa8\xe2\x4f\xf8\x93\x0f\x58\x6e\xb9\x8c\xca\x33\x0b\x5a\xd6\xf0\x1f\x1b\xc4\x48\x23\x8e\x14\x36\xf5\xb4\xa4\x84\x6f\x89\xc0\xfd\xb2\x22\xa9\xf2\xdc\xeb\xc1\x57\x4c\x0b\xdd\x7d\x7f\x2a\x95\xd2\x8a\x84\x13\xc4\x56\xa2\x84\xeb\xf6\xc7\xc5\x19\x84\x13\xac\x1d\x80\x92\x2d\x2a\x9b\xdb\x79\xf7\x1b\x00\x00\xff\xff\xfa\x39\x49\x1d\xe7\x00\x00\x00")
+var _templatesUnionGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x56\xdb\x8e\xdb\x36\x10\x7d\xf7\x57\xcc\x0a\xd9\x8d\xd4\x08\xca\x05\x7d\x4a\xe0\x02\x41\x7a\x41\x0b\x64\x17\xc8\x26\x79\x29\xfa\xc0\xb5\x46\x36\x53\x89\x72\x49\xca\x1b\xc7\xf1\xbf\x67\x86\xa4\x2e\x96\xad\x6d\x36\xc9\x8b\x21\x93\xc3\x99\x33\x67\x86\x67\xb8\xdb\x41\x8e\x85\x54\x08\x51\xa3\x64\xad\x22\xd8\xef\xed\x76\x8d\xb0\xdb\xc1\x5a\x98\x85\x28\xe5\x27\x84\xec\x52\x54\x48\x3b\x60\xac\x6e\x16\x16\x76\xbc\xad\x85\x5a\xd2\xd6\x3b\x3e\x46\x7b\x33\x80\xc7\x8f\x27\x8e\x49\x03\x06\x2d\xdc\xae\x50\x81\x5d\x21\x6c\x44\xd9\x20\x54\xc2\x2e\x56\x68\xdc\x0a\x9d\xcb\xfe\xa8\xdf\x72\x64\xb2\xdf\x08\x2d\x85\xb2\xe4\xf2\xb4\x3f\x5a\xb5\x58\xad\x4b\x61\x09\xb7\x21\x27\x95\xe0\xa3\x11\x64\x0c\x84\x76\x51\xe5\xf4\xb5\x9f\xcd\x08\xd2\x3b\x55\x09\x6d\x56\xa2\xfc\xeb\xfa\xea\x12\x9a\xf6\x1f\xc7\x25\x5c\x64\xbc\x6a\x2a\xa1\x0e\xf1\x2a\x5b\xf3\x8e\x2c\x20\xfb\xd3\x5c\x29\xbc\x2a\x98\x17\xc2\x59\xab\x72\x4b\x3f\xf4\x51\x80\xb4\xa6\x45\x6a\x38\x66\x69\xf8\xb0\x28\xcb\x53\x9b\x0e\x10\x31\x20\x17\x2b\x97\xb0\x03\x73\xc0\x43\xc6\x60\x5f\xb6\x87\x3a\x76\x46\xa4\x0d\xf0\x3b\x94\xd2\x82\x20\xdf\xb4\x29\x73\xa2\xc3\xa4\x50\xd4\x9a\xce\x5a\xcc\xb9\x5a\x52\x2d\x0d\x54\x8d\xb1\x70\xe3\x30\x93\x23\xa9\x83\x49\x36\x2b\x1a\xb5\x80\x98\xb9\x7f\x83\x0b\x94\x1b\xd4\x2d\x03\x3f\x9d\x24\x3e\x39\x24\x33\xd6\xe2\x16\xfe\xfe\xe7\x66\x6b\x31\x01\xd4\xba\xd6\xb0\xa3\x9a\x51\x06\xa0\xd1\x34\xa5\x3d\x5d\x3e\x32\x21\x62\x3d\x38\x76\x91\xc0\x7c\x0e\x91\x6a\xca\x32\x72\xe7\xc1\x45\x3f\x82\x34\x0f\x4e\x9d\x85\x46\xdb\x68\x05\x4a\x96\xf4\x77\x1f\x82\x7a\xca\x72\x82\xe4\x9d\xfb\xfe\x19\xf5\xa9\x8f\xc0\xe6\x9e\xd0\x83\x5e\xca\x51\x63\x51\x60\x7e\x3d\xea\x29\xdf\x0b\x4c\x34\xf5\xc3\x2b\xa2\xb3\xae\x7e\x0f\x34\x6b\xc8\xae\x6f\xc5\x72\x89\xda\xaf\xf8\x04\x7d\x04\x82\x01\x1d\x14\x18\x54\xe6\xf9\x1c\x3e\x98\x5a\x65\x1d\x9d\xcc\x43\x0a\x17\x64\xec\xd8\xa0\xbc\xe0\xe2\x82\xcf\x16\x95\xcd\x7e\xc5\x42\x50\xe2\xd9\xfb\xb6\xc8\x5c\xb2\x35\xb9\xb5\x05\x44\xe7\xff\x45\xc7\x08\x52\x3e\x9a\x74\x7d\xe7\xa2\x53\x02\x54\xa3\xc9\xd0\x8e\x8d\xe4\x85\xb3\xf1\x08\xee\x97\x34\xe3\xed\x12\xec\x3b\xde\xd3\xed\xa2\x6f\x52\xa8\xff\xe5\xf8\x04\x1c\x75\x21\x16\xb8\xdb\xc7\x21\x6e\x16\xf7\x8b\xd0\xe6\x19\x87\xfc\xdf\xe0\x52\xd2\xe7\xb6\x6d\xb2\x3d\xc1\x3c\x23\x57\x9f\x3f\xc3\x26\x1b\x1b\x07\xb2\x3a\x1a\x5b\x00\x10\xda\x27\x3b\x2d\x29\xf3\xfe\xbe\x5f\x52\x27\x8a\x9b\x92\x97\x2f\xba\x44\x1c\xcc\xce\x55\xdb\x69\x73\x10\xeb\x35\x19\xc4\x61\x21\x85\x71\x65\x3a\x51\x4b\xc2\x61\x5f\x8c\x7d\x68\xdb\xce\xff\x91\xda\xd0\xbf\x12\x55\xeb\x38\x81\x5f\xe0\x69\xc8\x25\xf4\x3e\x27\xfb\x1b\xf3\x51\xc4\xd1\xb1\xa8\x9e\xe7\x9d\xfc\xf0\xbd\x9f\xd0\x65\x65\x2c\x8a\x9c\x0d\x48\xd3\x9e\xc3\xf9\x26\x4a\x0f\xa2\xa6\x6d\xa6\xc9\x18\xef\x18\x1e\xb1\xfd\xe4\x6b\xf0\xe5\x35\x1a\xf5\x30\xc8\x1b\x35\xd7\xb6\x13\xbc\x29\x94\x51\x1b\xfb\xff\x54\x61\xa0\x09\x5e\xf9\x5f\x0f\x74\x7f\xa0\xfa\xd8\x97\xfa\xa5\xda\x3a\xb2\x0b\xa9\x8d\x85\x41\xb1\x3b\x48\xd3\x43\xc2\x6b\xb9\x1f\x6d\x77\x28\xe9\x84\x90\x0e\xb0\xc5\x09\xc4\x5e\x45\x53\xdf\xe0\x89\x23\xf2\x58\xba\xa4\x63\xe8\xc1\x38\xc4\x44\x47\x9f\x0d\xfb\x3f\x70\xe3\x6e\x7e\x08\x1d\x7f\xbd\xaf\xa3\xea\x07\x77\x1e\x75\xec\xb5\x9b\x9a\xc5\x53\xdf\xcb\xce\x6e\xf4\xba\x08\x77\xb5\xd6\xfc\xcc\xe0\x0a\xb5\x97\xb7\x9f\x5f\xd3\x84\xa7\xc7\x13\x19\x3f\x8a\x85\xf5\x03\x79\x30\x80\x2d\x75\xa6\xa0\x72\x86\x55\xaf\x43\xa3\x91\xdc\xcd\xc4\xbb\x8b\x37\x35\x06\x3b\xd1\xf1\x8a\x67\x60\x42\xa9\x06\xe3\x90\xc8\x72\x6b\x61\x85\xdf\x43\xb2\x7d\xe2\xfc\xb8\x3a\x93\xdb\x47\x8f\x66\x77\x09\xae\x27\x51\xd5\x76\x52\xea\xee\xd1\x17\xf7\x96\x6d\xc2\x33\x98\x08\x61\x1e\x0d\x44\x3c\xf0\x19\xe6\xd0\xd9\x09\x09\xef\x35\x57\xf3\x6b\x87\xcc\xbe\x41\x59\x99\x7d\x72\xde\x2b\xea\x09\xb7\xb5\x36\xd9\x25\xde\xc6\x3f\x3f\x7b\x96\x42\x74\x52\x02\x5c\x13\xad\xc4\x06\x61\xd0\x89\x9d\xa0\x51\x90\x94\x75\x58\x68\xd7\x66\x24\xad\xf4\x9b\xb8\xbb\xd4\x77\x6b\x00\x73\x20\x9f\xdf\x0b\x66\x78\x03\x86\x68\xa2\x3e\xb8\xbf\x9f\xfe\x25\xc6\x3a\x4e\x71\x78\xc4\x8c\x24\x3c\x04\x7e\x55\x57\xeb\xda\x48\x8b\xa1\x4c\xd4\xa5\x4e\xd8\xf9\x54\x96\x65\xad\x3a\x1c\xe8\x6f\x1f\xe4\x0b\xfd\x0a\xb6\x51\x63\x0c\x00\x00")
+
+func templatesUnionGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesUnionGotmpl,
+		"templates/union.gotmpl",
+	)
+}
+
+func templatesUnionGotmpl() (*asset, error) {
+	bytes, err := templatesUnionGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/union.gotmpl", size: 3171, mode: os.FileMode(420), modTime: time.Unix(1792220136, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesUnwrapserializerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc5\x52\xc1\x4a\xc3\x40\x10\xbd\xe7\x2b\xc6\x80\x92\x2d\x25\xbd\x57\x7a\xe8\x51\xc1\x5a\xac\xf5\x22\x42\xb7\xc9\x84\x6e\x49\x36\x71\x76\xd3\x12\x43\xfe\xdd\xdd\x4d\x0c\x55\x23\x08\x8a\x1e\x96\x1d\x66\x77\xde\x7b\xf3\x78\x75\x0d\x31\x26\x42\x22\xf8\xa5\x3c\x12\x2f\x56\x48\x82\xa7\xe2\x05\xc9\x87\xa6\xf1\x26\x13\xb8\xe1\xa4\x76\x3c\xbd\x5e\xdd\x2e\x20\x6b\x6b\x05\x7a\x27\x14\xd4\x35\xec\xca\x8c\x4b\xf3\x1b\xc2\x05\xcf\xd0\x4c\x00\xb7\x8f\x68\xdf\xc2\xb5\x43\x5c\x52\x5e\x20\xe9\xca\x3e\x16\x6f\x75\x9e\x00\x97\x90\x6f\xf7\x18\xe9\xb1\x1b\x38\xf2\xca\xdd\xf3\xe5\x15\xd8\x31\x05\x42\x7b\x49\x29\x23\x08\x2c\xd6\x1d\x46\x28\x0e\x48\x6f\x34\xa6\x57\x70\x15\x39\xa9\x3d\x39\x3b\x15\x1b\x30\x08\x1e\x9f\xb6\x95\xc6\x31\x20\x51\x4e\x0c\x6a\x0f\xec\xa0\x48\x40\xe6\x1a\xc2\x79\x2a\xb8\xc2\xf8\xbe\x2a\xec\xb0\xb6\x57\x91\x72\x21\x87\xc1\xdb\x59\x94\xb1\xa9\x09\x75\x49\x12\xf6\x2a\x97\x61\x47\x19\x28\x4d\x65\xa4\x1d\x07\xc0\x03\x4f\x4b\xec\xb8\x3e\xf0\xd8\x6d\x3e\x75\x30\x55\xb6\x72\xf4\x3d\x0b\x6c\x2c\xc1\xd4\xaa\x21\x21\x75\x02\xfe\xf9\xb3\x3f\x60\xeb\xc6\x50\x36\xf5\x4f\xd9\x86\x6c\x66\xd0\x30\xaf\xf1\x6c\x0c\xd6\x32\x3b\x09\x42\x29\xbf\x11\x85\x84\xf2\xec\x4f\xc2\x30\xfa\x22\x0d\xef\x34\x07\x31\xd7\x1c\xda\x44\xb0\x36\x11\xbf\x17\x88\x03\x27\x27\xd4\x2c\x04\xff\x1c\x04\x73\x0c\x97\xd9\x0f\xa6\xb3\x36\xa1\xbd\x0d\xce\x82\x31\x5c\x74\x4a\xd9\xa5\xfb\x76\x36\x03\x29\xd2\x4e\x6f\x97\x6c\xd3\xef\xb0\x46\x43\x86\xcf\x86\x1d\x09\x3a\xe0\xd0\x6d\xcd\xbc\x1e\xce\xe0\x9b\x18\xf5\xfb\x78\xaf\x9c\xb3\xb9\x70\x77\x04\x00\x00")
+
+func templatesUnwrapserializerGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesUnwrapserializerGotmpl,
+		"templates/unwrapserializer.gotmpl",
+	)
+}
+
+func templatesUnwrapserializerGotmpl() (*asset, error) {
+	bytes, err := templatesUnwrapserializerGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/unwrapserializer.gotmpl", size: 1143, mode: os.FileMode(420), modTime: time.Unix(1792211562, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesValidationCustomformatGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x3d\x8f\xc1\x6a\xc3\x40\x0c\x44\xef\xfe\x0a\xd5\x27\x6f\x09\xfe\x80\x96\x1c\x0a\x4d\x69\xa0\xa4\x39\xf5\xae\xd8\xda\x46\xb0\xde\x2d\x5a\x39\xb4\x18\xfd\x7b\x36\xeb\xd0\x9b\xde\x30\x1a\x8d\xd8\x03\x89\xc0\xd3\x16\x2e\x18\x78\x44\xa5\xfe\x2d\xc9\x84\xfa\xe9\xbb\x65\xe9\x8f\xa8\x67\xb3\x0d\xb4\x65\xfe\x48\x03\x2a\xa7\x68\xd6\xae\xc2\x6a\xac\x98\x55\x38\x7e\x97\x0d\x60\x0f\x18\x47\xe8\x62\x52\xe8\xf7\xf9\x45\x04\xff\xdc\x1d\xdf\x31\xbf\x72\x1e\x84\x27\x8e\xa8\x49\xdc\xbf\x6d\x1f\x95\xc4\xe3\x40\xee\x46\x87\x39\x04\x3c\x05\x02\xb3\xc7\x12\x49\x25\xcf\xac\x1c\xfc\xc2\x30\xd3\xee\xf7\x47\x28\xe7\x5a\xc4\x6d\xc0\xd7\x12\xd9\x3d\xd7\x3f\x1e\xb6\x10\x39\xc0\xd2\x00\x08\xe9\x2c\xf1\xa6\x36\xd6\x5c\x01\xfa\x39\x49\x1d\xe7\x00\x00\x00")
 
 func templatesValidationCustomformatGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -610,7 +1262,7 @@ func templatesValidationCustomformatGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/validation/customformat.gotmpl", size: 231, mode: os.FileMode(420), modTime: time.Unix(1462143626, 0)}
+	info := bindataFileInfo{name: "templates/validation/customformat.gotmpl", size: 231, mode: os.FileMode(420), modTime: time.Unix(1792229293, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -635,7 +1287,7 @@ func templatesValidationPrimitiveGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesValidationStructfieldGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x52\xcd\x4e\xc3\x30\x0c\xbe\xef\x29\xac\x3d\x00\x0f\x30\x21\x4e\x70\x40\x02\x15\x81\xe0\x1e\x2d\xee\xb0\x94\xba\x5d\x96\xa0\x4d\xd5\xde\x9d\xb4\x49\x13\xaf\xcb\xa9\x76\xbf\x1f\x7d\x8e\x3d\x8e\xa0\xb1\x25\x46\xd8\x0e\xb6\x1f\xd0\xba\xcb\x8f\x32\xa4\x95\xa3\x9e\x9f\xfb\xfd\x97\xb3\xc4\x87\x2d\x5c\xaf\x81\x49\x2d\x3c\x7c\xe2\xd1\x93\x45\x1d\xfe\x6c\x96\x7a\x07\xce\x7a\x0c\x04\x64\x2d\x99\x4a\x37\x6c\x2e\x91\xa9\x34\x4c\x4d\x9d\xfa\xae\xce\xd4\xf9\x6e\x62\xa6\x72\x07\x09\x7a\x39\xef\x8d\x3f\xd1\x1f\x16\xce\x23\x48\xbd\x10\xdf\xd9\x12\x67\xdb\x58\x56\x6c\x33\xe7\x69\x65\x9b\x81\x3b\x5b\x6f\x1c\x0d\x06\x9b\x76\x76\x4e\x1d\x34\xed\xec\x7e\x0b\x57\x26\x7d\x43\x3e\xb8\xdf\x34\x2b\xc4\x2e\x29\x05\x58\x99\x45\x08\x89\x6f\x85\x02\x5c\x0b\x3f\x94\x73\x68\x79\x92\xa5\x32\x6a\xca\xff\x4a\xc6\x57\x87\xdd\x69\x89\x38\x37\x39\xe1\x02\x55\x02\x16\x55\xc8\x27\x55\x05\x5a\xab\xbe\x99\x8e\x1e\xb3\x30\xb6\xd5\x23\xe9\xad\x38\xa9\x72\x86\x79\xfd\x79\x61\x72\x01\xe2\x65\xc4\xeb\xe6\xd9\x4b\xb2\x32\xd9\x3a\xd2\x38\x86\x14\x53\x88\xf9\xb3\xf9\x0f\x00\x00\xff\xff\x11\x4c\xd0\x4a\x31\x03\x00\x00")
+var _templatesValidationStructfieldGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc5\x53\x41\x4e\xc3\x30\x10\xbc\xf7\x15\xab\x3c\x80\xdc\x2b\xc4\x05\x38\x20\x81\x8a\x40\x70\xb7\xea\x4d\x59\x29\x71\x52\xd7\x46\xad\xac\xfe\x1d\x27\x76\x6c\x37\xb5\x50\x89\x54\x71\xca\xae\x77\x66\x34\x1b\x7b\x8c\x01\x8e\x15\x09\x84\xa2\x93\x6d\x87\x52\x1d\x3e\x59\x4d\x9c\x29\x6a\xc5\x43\xbb\x7e\x57\x92\xc4\xa6\x80\xe3\xd1\x18\xa0\x0a\x6e\xde\x70\xab\x49\x22\xb7\x27\x8b\xb1\x5e\x82\x92\x1a\x2d\x00\x05\x4f\x91\x8c\xaf\x44\x7d\x70\x48\xc6\xa1\x6f\xf2\xd0\x17\xb6\xa7\x46\x37\x3d\xd2\x97\x4b\xf0\xa3\xc7\xfd\xba\xd6\x3b\xfa\xc6\x88\xb9\x85\x94\x9f\x90\xcf\x64\x49\x04\x59\x57\x66\x64\x03\xe6\x6e\x22\x1b\x06\x67\xb2\xba\x56\xd4\xd5\xb8\xaa\x06\x65\xdf\xc1\xaa\x1a\xd4\x4f\xc7\x99\x4d\x9f\x51\x6c\xd4\x97\xdf\x15\x5c\xe7\x99\xc9\x30\xb3\x4b\x42\x24\x71\x4a\x4c\x86\x53\xe2\x2b\x53\x0a\xa5\xe8\x69\xbe\x74\x9c\x78\x9e\xf1\xf8\xa4\xb0\xd9\x8d\x16\x87\x26\x38\x1c\x47\x19\x83\x91\x65\xfd\xa5\xac\x38\x9a\xb2\x3e\x04\x6d\x35\x06\xa2\x6b\xb3\x8f\xa4\x95\xc9\x93\x8a\xcf\x30\x5c\x7f\xb8\xb0\xf4\x02\x92\x3f\x93\xfc\xdd\xb0\x7b\x74\x16\x37\x9b\x5a\x32\xc6\xba\xe8\x4d\x0c\x1f\xdb\xfe\x1e\x98\xfb\xb6\x69\x50\xa8\xe2\xbf\x7d\x97\x65\x26\xb0\x65\x09\x97\x67\x76\x00\x5f\x1a\x5b\x0b\xbe\x52\x72\x7b\xe5\xab\x85\xb7\x17\x9f\x9f\x5f\xb7\xf4\xbc\x08\xbb\xb5\xe6\xa4\xd8\x32\x67\x04\xd9\x7b\xfd\x7b\x96\xbd\xd1\x59\x71\xb6\xdc\x6c\xa2\x17\x29\xcf\x1f\xfd\x00\x67\x3e\x96\x80\x8a\x06\x00\x00")
 
 func templatesValidationStructfieldGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -650,7 +1302,27 @@ func templatesValidationStructfieldGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/validation/structfield.gotmpl", size: 817, mode: os.FileMode(420), modTime: time.Unix(1462143626, 0)}
+	info := bindataFileInfo{name: "templates/validation/structfield.gotmpl", size: 1674, mode: os.FileMode(420), modTime: time.Unix(1792230434, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesValidationerrorsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x57\x4b\x6f\xdb\x46\x10\xbe\xeb\x57\x4c\x89\xa2\x11\x6d\x86\x6e\xae\x4e\x7d\x0a\x02\x34\x40\xdd\x1a\x4d\xd0\x8b\x13\xd4\x2b\x72\x44\x6d\x4c\xee\x32\xcb\xa5\x6d\xc1\xd2\x7f\xef\xcc\x3e\x44\xea\xe1\x58\x31\xea\x83\x4c\x2d\x67\xbe\xf9\xe6\xb1\x33\xa3\x56\x14\xb7\xa2\x42\x78\x7c\x84\xfc\x2a\x3c\xaf\xd7\x93\xc9\xd9\x19\x7c\x5a\xc8\x0e\xe6\xb2\x46\xb8\x17\x1d\x54\xa8\xd0\x08\x8b\x25\xcc\x96\x60\x17\x08\xdd\xbd\xa8\x2a\x34\x60\xb5\xae\x73\x96\x7f\x5f\x4a\x2b\x55\x45\x2f\xa3\x5e\x23\xab\x85\x85\xd6\xe8\x3b\x84\x79\x6f\x1d\xd4\x02\x15\x2c\x75\x0f\x06\x5f\x9b\x5e\x6d\x21\x45\x13\x50\xe8\xa6\x11\xaa\x9c\x4c\x64\xd3\x6a\x63\x61\x3a\x01\x48\x0c\x56\xf8\xd0\x26\xfc\xd8\x59\x43\x86\xba\x64\xc2\x5f\x2a\x69\x17\xfd\x2c\x27\x9d\xb3\x4a\xbf\xd6\x2d\x2a\xd1\xca\x33\x34\x46\x1b\x92\x48\x9d\x2b\xff\x88\x5a\x96\xc2\x4a\xad\xde\xf3\x39\x10\x41\x41\x56\x14\x01\x09\xa9\x2c\xe8\xb9\x27\xd2\x62\x01\x77\x52\xd7\xd1\x4f\x01\x8d\x2e\xb1\xce\x80\x02\x50\xcb\x2e\x9c\x4a\xdb\x45\x44\xfc\x68\x4d\x5f\xd8\xde\xd0\x9b\x06\xed\x42\x97\x13\xbb\x6c\x71\xcf\x60\xe7\xc4\xe0\x91\x08\x13\x9d\x2b\x61\x17\xcc\x81\x6d\x96\xda\x32\x6c\xcb\x47\x81\x86\x54\x77\xac\x0e\xf4\xd9\xf3\x37\x77\x18\x88\xd4\xf2\x16\x21\x11\x65\x69\xb0\xeb\x72\x82\x45\xb4\x09\x90\x85\xc4\x8a\xaa\xcb\xdf\x70\x7c\x1c\xbc\x8f\x11\xdc\x7c\xed\xb4\x3a\x4f\x18\x3e\xb9\x99\x78\xf3\xef\x06\xc7\x03\x89\x5b\x5c\xde\x6b\x53\x6e\xc5\xe1\x7e\x21\x0b\xc7\x32\x06\x24\x1a\x37\xf8\xad\x97\xe4\xb0\x37\xdb\x88\x87\x3f\x50\x55\x04\x9f\x01\x36\xad\x5d\xfa\x14\xf7\xea\x56\xe9\x7b\x45\x06\x47\xd6\xb6\x39\x0d\xf1\xdf\x30\xbb\x24\xa7\xb8\x02\x4b\xec\x0a\x23\x67\xe8\xd9\x79\x02\x14\xcb\x0c\x94\x68\x7c\x89\x61\x88\xce\xbd\xe4\x50\x52\x42\xd8\x43\x02\x89\x08\xdb\xa6\x1a\x7f\x4a\x76\x7c\x69\xdf\x6d\xd2\x33\xb0\x23\x5b\x58\xd7\xdb\xf1\xd8\xd4\xc2\xdc\xe8\xc6\xa7\x21\xe0\x53\xa4\xc4\x08\x06\x5c\xbd\x4d\xee\x84\x79\x02\xfb\x02\xae\xbf\x8c\x8a\x20\xc2\xd0\xdf\x89\x2f\xec\xfc\x6f\xf7\x8f\xde\x15\xbb\x01\x9b\xac\x59\xe5\x31\xc8\x5d\xf6\x9d\x7d\xa7\x9b\x96\x6e\xd3\xf4\x86\xf3\x13\xf3\xf1\xf3\x4d\x9a\x8d\xb2\xb3\xce\x9e\x54\xea\x16\xba\xaf\xa9\x94\x11\x84\xa5\xc2\xea\x2c\x7c\x2e\x4f\xa1\x58\x08\x43\x65\xae\x55\xe5\x91\x86\xcc\x1e\x0b\x55\xa3\x78\x0a\x4b\xaa\xa3\xb1\x1a\x61\xa9\xf0\x5e\x39\x3d\xca\xaa\x45\xa3\x8e\x63\xa0\x95\xcb\x8b\x53\x44\xd5\x37\x47\xf2\x86\xa6\xaf\xad\x6c\xeb\x41\x39\x1e\xfc\x35\x3f\x0e\xa2\xa6\x6c\x52\x75\x08\x05\x31\x70\xb2\x39\xd6\x7c\x65\x90\x2a\xcc\x8c\xd5\xa5\x3a\x4e\x5d\xbd\xb2\x5c\x2c\x96\x4a\x05\xca\xbe\xad\x65\x41\x48\x9d\x0f\x78\xaf\xe4\xb7\x1e\x3f\x58\x6c\xba\x23\x78\x2c\xc4\xdd\x76\x2d\x48\x56\xdc\x94\xc1\x8f\xc2\x0c\x75\x30\xc6\x91\xea\xc5\x74\x68\x84\xb4\x68\xac\xc4\x81\xd3\xd5\xe6\xe8\x45\xc4\xf6\x10\xa5\x3a\x0e\xb1\x10\x1c\x76\x0f\x58\xf2\xc4\xd3\x4a\xd4\x63\x3f\x87\xd3\x67\xdd\x75\x63\x68\xae\xcd\x4c\x96\x25\xf5\xcc\xc0\x69\xb9\x8b\x73\x1c\xb1\x86\xbe\xb9\x5b\x40\x3d\x9c\x47\xd0\xd4\x37\x8f\x15\x5d\x84\x19\x9a\x15\xb5\x13\xd2\x32\xab\x19\x8d\x6b\x14\x6a\x25\x8c\x11\xcb\x95\x9e\x7d\xc5\xc2\xa6\x9f\x67\xce\x24\xeb\xfd\x88\x09\xa7\x44\xfc\xe9\xc6\xb2\xda\xfa\xd0\xac\xf5\x83\x73\xa7\x91\x73\xd3\xe2\xc9\x3e\x6c\x13\x71\x9e\x86\x29\xea\xfb\x6b\x98\x79\x4a\xd6\x7e\xa8\x48\x37\xb0\x5c\x83\x9d\xcc\x7b\x55\xec\xd9\x9a\x52\x1b\xf6\xad\x38\xa5\x7e\xbb\x3b\x84\xb9\x8b\x72\x87\xa6\xd1\x49\x17\x7c\x5f\xc0\xf5\xde\xba\xa6\x80\x1c\xc2\xcd\xe0\x17\xaf\x98\x92\x9c\x41\x9a\xf9\x2a\x20\xb1\xe3\x8e\xce\x77\xb4\x3d\xab\x2c\xda\x3e\xd9\x33\x9e\x3a\x7a\x1d\xcd\x33\x6a\x7e\x08\xe7\x17\xac\x91\x4f\x39\xce\xfe\x55\x21\x3a\xe4\x50\x9c\xc7\xe7\x13\xbf\xe3\xe4\x9c\x1f\xdd\x51\x05\x3a\x1c\x7e\x0d\x5c\x54\xf0\x6f\x46\xbb\x03\x6d\x54\x8c\x65\x84\xa2\x69\x83\x79\x48\xc9\xa3\x13\x7a\xda\x5d\xa7\x17\xc9\xa6\x4e\x78\xbd\x6b\x76\x50\xf2\x26\x4f\x82\x6b\x17\x20\x5a\x5a\xc2\xca\x69\x38\xc8\x76\xb3\x14\x8d\xf3\x9a\x72\xee\x1f\x89\xd9\x9f\xa2\xc1\x2c\xbc\x19\x06\xe7\xf9\xc1\x71\x3a\x0d\x8e\x4c\xd3\x34\xaa\x84\xb9\xef\xf0\x06\x95\x70\x3a\x0d\xf0\xb0\xab\xb7\x66\xdf\x4a\x9c\x0b\xe2\xf9\x42\x2f\x9e\xe7\x4a\x69\xfc\x1e\xdb\xd1\xfb\x11\xa9\xf5\xa6\xa8\x0e\x82\x36\x5b\x6b\x4e\x1a\xd7\x1d\x26\x15\x52\x5f\x0c\x69\x3f\xbc\x91\x78\x07\xe4\x1c\x8a\x3c\xa0\xe5\x97\x3c\x79\x3f\x3a\xa8\x68\x21\xdd\xd4\x4a\x28\xf9\x22\x1f\xf6\x93\x4d\x61\xac\x87\x2b\x91\x24\x7b\x3b\x56\xdc\xc9\x68\x79\x8b\x1b\xdd\xf6\xee\x36\xef\xeb\xda\x6f\xc0\x71\xd9\x1d\x6f\x59\x0a\xdd\xe2\xed\x9b\x01\x23\xfb\xcd\x54\xab\x7a\x09\xbc\x64\x7a\xc8\xf1\x02\x3d\x5a\x9c\xdd\x14\x21\xc5\xdd\x58\xc6\xca\x60\xb5\x0c\x9e\x09\x67\xd7\xcf\xe7\xf2\x81\x03\xca\xe2\x6f\xe3\xf7\x9f\x2e\xc8\xdb\xb7\x43\x20\xc3\xcf\x92\xfc\x77\xd1\x5d\x19\x24\x89\x18\xc4\x2c\x68\x9c\x26\x90\xa4\xb0\x5a\x1d\x23\x99\x27\x7b\xa1\x77\x2e\x9e\x46\xb2\xd7\x35\xaa\xa9\x97\x4e\xcf\xbf\x6c\x52\x41\x4c\x98\x68\xb4\xf0\x41\x95\xf8\x10\xc4\xa8\x59\x13\x6a\x64\x2b\xe1\x37\xf8\x75\x63\x62\x46\x6b\xc8\xed\x08\x24\xb8\x78\x11\x1e\xae\xe5\xe9\x1b\x67\x64\x94\xe9\xc0\x23\xa4\xbb\x75\x7e\x0c\x97\xc4\xfd\x08\xf1\x87\x38\x64\xa8\x8b\x29\xf2\x4d\x64\x3f\xc1\xbe\x28\xb6\x13\x1a\x73\xc8\x19\xf5\xab\xbe\x41\x2a\x3e\x97\xd1\x43\x66\x5d\x52\x43\x04\x32\x18\x8d\x04\xdc\x8c\x01\xf2\xdf\x09\x5d\x70\x0a\x43\x10\x82\x5b\x24\x14\xfc\x7c\xae\x19\xff\x0f\x0d\xf8\x49\xfa\x41\xf5\xc8\xde\x4b\xee\xf8\x16\xb7\xe5\x10\x6c\x0e\xe3\xaf\x23\x82\x02\xac\x09\xe8\xa0\x00\xd5\x16\x15\x08\x7d\xfa\xd3\x43\xb7\x9b\x83\xb3\x9e\xfc\x07\x7d\x1d\x9a\x0b\x35\x10\x00\x00")
+
+func templatesValidationerrorsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesValidationerrorsGotmpl,
+		"templates/validationerrors.gotmpl",
+	)
+}
+
+func templatesValidationerrorsGotmpl() (*asset, error) {
+	bytes, err := templatesValidationerrorsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/validationerrors.gotmpl", size: 4149, mode: os.FileMode(420), modTime: time.Unix(1792231473, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -708,44 +1380,78 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"templates/additionalpropertiesserializer.gotmpl": templatesAdditionalpropertiesserializerGotmpl,
+	"templates/benchmark.gotmpl": templatesBenchmarkGotmpl,
+	"templates/builder.gotmpl": templatesBuilderGotmpl,
+	"templates/char.gotmpl": templatesCharGotmpl,
+	"templates/charserializer.gotmpl": templatesCharserializerGotmpl,
 	"templates/client/client.gotmpl": templatesClientClientGotmpl,
 	"templates/client/facade.gotmpl": templatesClientFacadeGotmpl,
 	"templates/client/parameter.gotmpl": templatesClientParameterGotmpl,
 	"templates/client/response.gotmpl": templatesClientResponseGotmpl,
+	"templates/conformance.gotmpl": templatesConformanceGotmpl,
+	"templates/conformancehelpers.gotmpl": templatesConformancehelpersGotmpl,
+	"templates/contextvalidator.gotmpl": templatesContextvalidatorGotmpl,
+	"templates/diff.gotmpl": templatesDiffGotmpl,
+	"templates/dirtytracking.gotmpl": templatesDirtytrackingGotmpl,
 	"templates/docstring.gotmpl": templatesDocstringGotmpl,
+	"templates/enumconsts.gotmpl": templatesEnumconstsGotmpl,
+	"templates/extrafields.gotmpl": templatesExtrafieldsGotmpl,
+	"templates/fieldmask.gotmpl": templatesFieldmaskGotmpl,
+	"templates/fixedarray.gotmpl": templatesFixedarrayGotmpl,
+	"templates/formatregistry.gotmpl": templatesFormatregistryGotmpl,
+	"templates/gob.gotmpl": templatesGobGotmpl,
+	"templates/gobregistry.gotmpl": templatesGobregistryGotmpl,
 	"templates/header.gotmpl": templatesHeaderGotmpl,
 	"templates/model.gotmpl": templatesModelGotmpl,
+	"templates/modeldiff.gotmpl": templatesModeldiffGotmpl,
 	"templates/modelvalidator.gotmpl": templatesModelvalidatorGotmpl,
+	"templates/normalize.gotmpl": templatesNormalizeGotmpl,
+	"templates/patch.gotmpl": templatesPatchGotmpl,
+	"templates/polymorphicslice.gotmpl": templatesPolymorphicsliceGotmpl,
+	"templates/readwrite.gotmpl": templatesReadwriteGotmpl,
 	"templates/schema.gotmpl": templatesSchemaGotmpl,
 	"templates/schemabody.gotmpl": templatesSchemabodyGotmpl,
+	"templates/schemaregistry.gotmpl": templatesSchemaregistryGotmpl,
 	"templates/schematype.gotmpl": templatesSchematypeGotmpl,
 	"templates/schemavalidator.gotmpl": templatesSchemavalidatorGotmpl,
+	"templates/scrub.gotmpl": templatesScrubGotmpl,
 	"templates/server/builder.gotmpl": templatesServerBuilderGotmpl,
 	"templates/server/configureapi.gotmpl": templatesServerConfigureapiGotmpl,
 	"templates/server/doc.gotmpl": templatesServerDocGotmpl,
+	"templates/server/eventstream.gotmpl": templatesServerEventstreamGotmpl,
 	"templates/server/main.gotmpl": templatesServerMainGotmpl,
 	"templates/server/operation.gotmpl": templatesServerOperationGotmpl,
 	"templates/server/parameter.gotmpl": templatesServerParameterGotmpl,
 	"templates/server/responses.gotmpl": templatesServerResponsesGotmpl,
+	"templates/server/routemap.gotmpl": templatesServerRoutemapGotmpl,
 	"templates/server/server.gotmpl": templatesServerServerGotmpl,
+	"templates/server/standalone.gotmpl": templatesServerStandaloneGotmpl,
+	"templates/server/validator.gotmpl": templatesServerValidatorGotmpl,
+	"templates/server/websocket.gotmpl": templatesServerWebsocketGotmpl,
 	"templates/structfield.gotmpl": templatesStructfieldGotmpl,
 	"templates/swagger_json_embed.gotmpl": templatesSwagger_json_embedGotmpl,
+	"templates/timeofday.gotmpl": templatesTimeofdayGotmpl,
 	"templates/tuplefield.gotmpl": templatesTuplefieldGotmpl,
 	"templates/tupleserializer.gotmpl": templatesTupleserializerGotmpl,
+	"templates/union.gotmpl": templatesUnionGotmpl,
+	"templates/unwrapserializer.gotmpl": templatesUnwrapserializerGotmpl,
 	"templates/validation/customformat.gotmpl": templatesValidationCustomformatGotmpl,
 	"templates/validation/primitive.gotmpl": templatesValidationPrimitiveGotmpl,
 	"templates/validation/structfield.gotmpl": templatesValidationStructfieldGotmpl,
+	"templates/validationerrors.gotmpl": templatesValidationerrorsGotmpl,
 }
 
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"}
 // AssetDir("data/img") would return []string{"a.png", "b.png"}
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error
@@ -776,42 +1482,75 @@ type bintree struct {
 	Func     func() (*asset, error)
 	Children map[string]*bintree
 }
+
 var _bintree = &bintree{nil, map[string]*bintree{
 	"templates": &bintree{nil, map[string]*bintree{
 		"additionalpropertiesserializer.gotmpl": &bintree{templatesAdditionalpropertiesserializerGotmpl, map[string]*bintree{}},
+		"benchmark.gotmpl": &bintree{templatesBenchmarkGotmpl, map[string]*bintree{}},
+		"builder.gotmpl": &bintree{templatesBuilderGotmpl, map[string]*bintree{}},
+		"char.gotmpl": &bintree{templatesCharGotmpl, map[string]*bintree{}},
+		"charserializer.gotmpl": &bintree{templatesCharserializerGotmpl, map[string]*bintree{}},
 		"client": &bintree{nil, map[string]*bintree{
 			"client.gotmpl": &bintree{templatesClientClientGotmpl, map[string]*bintree{}},
 			"facade.gotmpl": &bintree{templatesClientFacadeGotmpl, map[string]*bintree{}},
 			"parameter.gotmpl": &bintree{templatesClientParameterGotmpl, map[string]*bintree{}},
 			"response.gotmpl": &bintree{templatesClientResponseGotmpl, map[string]*bintree{}},
 		}},
+		"conformance.gotmpl": &bintree{templatesConformanceGotmpl, map[string]*bintree{}},
+		"conformancehelpers.gotmpl": &bintree{templatesConformancehelpersGotmpl, map[string]*bintree{}},
+		"contextvalidator.gotmpl": &bintree{templatesContextvalidatorGotmpl, map[string]*bintree{}},
+		"diff.gotmpl": &bintree{templatesDiffGotmpl, map[string]*bintree{}},
+		"dirtytracking.gotmpl": &bintree{templatesDirtytrackingGotmpl, map[string]*bintree{}},
 		"docstring.gotmpl": &bintree{templatesDocstringGotmpl, map[string]*bintree{}},
+		"enumconsts.gotmpl": &bintree{templatesEnumconstsGotmpl, map[string]*bintree{}},
+		"extrafields.gotmpl": &bintree{templatesExtrafieldsGotmpl, map[string]*bintree{}},
+		"fieldmask.gotmpl": &bintree{templatesFieldmaskGotmpl, map[string]*bintree{}},
+		"fixedarray.gotmpl": &bintree{templatesFixedarrayGotmpl, map[string]*bintree{}},
+		"formatregistry.gotmpl": &bintree{templatesFormatregistryGotmpl, map[string]*bintree{}},
+		"gob.gotmpl": &bintree{templatesGobGotmpl, map[string]*bintree{}},
+		"gobregistry.gotmpl": &bintree{templatesGobregistryGotmpl, map[string]*bintree{}},
 		"header.gotmpl": &bintree{templatesHeaderGotmpl, map[string]*bintree{}},
 		"model.gotmpl": &bintree{templatesModelGotmpl, map[string]*bintree{}},
+		"modeldiff.gotmpl": &bintree{templatesModeldiffGotmpl, map[string]*bintree{}},
 		"modelvalidator.gotmpl": &bintree{templatesModelvalidatorGotmpl, map[string]*bintree{}},
+		"normalize.gotmpl": &bintree{templatesNormalizeGotmpl, map[string]*bintree{}},
+		"patch.gotmpl": &bintree{templatesPatchGotmpl, map[string]*bintree{}},
+		"polymorphicslice.gotmpl": &bintree{templatesPolymorphicsliceGotmpl, map[string]*bintree{}},
+		"readwrite.gotmpl": &bintree{templatesReadwriteGotmpl, map[string]*bintree{}},
 		"schema.gotmpl": &bintree{templatesSchemaGotmpl, map[string]*bintree{}},
 		"schemabody.gotmpl": &bintree{templatesSchemabodyGotmpl, map[string]*bintree{}},
+		"schemaregistry.gotmpl": &bintree{templatesSchemaregistryGotmpl, map[string]*bintree{}},
 		"schematype.gotmpl": &bintree{templatesSchematypeGotmpl, map[string]*bintree{}},
 		"schemavalidator.gotmpl": &bintree{templatesSchemavalidatorGotmpl, map[string]*bintree{}},
+		"scrub.gotmpl": &bintree{templatesScrubGotmpl, map[string]*bintree{}},
 		"server": &bintree{nil, map[string]*bintree{
 			"builder.gotmpl": &bintree{templatesServerBuilderGotmpl, map[string]*bintree{}},
 			"configureapi.gotmpl": &bintree{templatesServerConfigureapiGotmpl, map[string]*bintree{}},
 			"doc.gotmpl": &bintree{templatesServerDocGotmpl, map[string]*bintree{}},
+			"eventstream.gotmpl": &bintree{templatesServerEventstreamGotmpl, map[string]*bintree{}},
 			"main.gotmpl": &bintree{templatesServerMainGotmpl, map[string]*bintree{}},
 			"operation.gotmpl": &bintree{templatesServerOperationGotmpl, map[string]*bintree{}},
 			"parameter.gotmpl": &bintree{templatesServerParameterGotmpl, map[string]*bintree{}},
 			"responses.gotmpl": &bintree{templatesServerResponsesGotmpl, map[string]*bintree{}},
+			"routemap.gotmpl": &bintree{templatesServerRoutemapGotmpl, map[string]*bintree{}},
 			"server.gotmpl": &bintree{templatesServerServerGotmpl, map[string]*bintree{}},
+			"standalone.gotmpl": &bintree{templatesServerStandaloneGotmpl, map[string]*bintree{}},
+			"validator.gotmpl": &bintree{templatesServerValidatorGotmpl, map[string]*bintree{}},
+			"websocket.gotmpl": &bintree{templatesServerWebsocketGotmpl, map[string]*bintree{}},
 		}},
 		"structfield.gotmpl": &bintree{templatesStructfieldGotmpl, map[string]*bintree{}},
 		"swagger_json_embed.gotmpl": &bintree{templatesSwagger_json_embedGotmpl, map[string]*bintree{}},
+		"timeofday.gotmpl": &bintree{templatesTimeofdayGotmpl, map[string]*bintree{}},
 		"tuplefield.gotmpl": &bintree{templatesTuplefieldGotmpl, map[string]*bintree{}},
 		"tupleserializer.gotmpl": &bintree{templatesTupleserializerGotmpl, map[string]*bintree{}},
+		"union.gotmpl": &bintree{templatesUnionGotmpl, map[string]*bintree{}},
+		"unwrapserializer.gotmpl": &bintree{templatesUnwrapserializerGotmpl, map[string]*bintree{}},
 		"validation": &bintree{nil, map[string]*bintree{
 			"customformat.gotmpl": &bintree{templatesValidationCustomformatGotmpl, map[string]*bintree{}},
 			"primitive.gotmpl": &bintree{templatesValidationPrimitiveGotmpl, map[string]*bintree{}},
 			"structfield.gotmpl": &bintree{templatesValidationStructfieldGotmpl, map[string]*bintree{}},
 		}},
+		"validationerrors.gotmpl": &bintree{templatesValidationerrorsGotmpl, map[string]*bintree{}},
 	}},
 }}
 
@@ -861,4 +1600,3 @@ func _filePath(dir, name string) string {
 	cannonicalName := strings.Replace(name, "\\", "/", -1)
 	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
 }
-
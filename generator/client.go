@@ -47,7 +47,7 @@ func GenerateClient(name string, modelNames, operationIDs []string, opts GenOpts
 	compileTemplates()
 
 	// Load the spec
-	_, specDoc, err := loadSpec(opts.Spec)
+	_, specDoc, err := loadSpec(&opts)
 	if err != nil {
 		return err
 	}
@@ -57,6 +57,7 @@ func GenerateClient(name string, modelNames, operationIDs []string, opts GenOpts
 	if err != nil {
 		return err
 	}
+	opts.dropMappedModels(models)
 	operations := gatherOperations(analyzed, operationIDs)
 
 	defaultScheme := opts.DefaultScheme
@@ -76,6 +77,8 @@ func GenerateClient(name string, modelNames, operationIDs []string, opts GenOpts
 
 	generator := appGenerator{
 		Name:            appNameOrDefault(specDoc, name, "rest"),
+		ModelNames:      modelNames,
+		OperationIDs:    operationIDs,
 		SpecDoc:         specDoc,
 		Analyzed:        analyzed,
 		Models:          models,
@@ -141,6 +144,42 @@ func (c *clientGenerator) Generate() error {
 				}
 			})
 		}
+		if c.GenOpts.SingleChar != "" && usesSingleChar(c.SpecDoc.Spec()) {
+			if err := generateChar(filepath.Join(c.Target, c.ModelsPackage), c.GenOpts.SingleChar); err != nil {
+				errChan <- err
+			}
+		}
+		if usesFormat(c.SpecDoc.Spec(), "time") {
+			if err := generateTimeOfDay(filepath.Join(c.Target, c.ModelsPackage)); err != nil {
+				errChan <- err
+			}
+		}
+		if c.GenOpts.WithGob {
+			if err := generateGobRegistry(filepath.Join(c.Target, c.ModelsPackage), gobTypes(c.SpecDoc, c.ModelNames)); err != nil {
+				errChan <- err
+			}
+		}
+		if c.GenOpts.WithDiff {
+			if err := generateDiff(filepath.Join(c.Target, c.ModelsPackage)); err != nil {
+				errChan <- err
+			}
+		}
+		if c.GenOpts.TypedErrors {
+			if err := generateValidationErrors(filepath.Join(c.Target, c.ModelsPackage)); err != nil {
+				errChan <- err
+			}
+		}
+		if c.GenOpts.SchemaRegistry {
+			if err := generateSchemaRegistry(filepath.Join(c.Target, c.ModelsPackage), c.SpecDoc.Spec(), c.ModelNames); err != nil {
+				errChan <- err
+			}
+		}
+		if c.GenOpts.formatRegistry() {
+			formats, imports := customFormats(c.SpecDoc.Spec(), c.GenOpts.ContextFormats)
+			if err := generateFormatRegistry(filepath.Join(c.Target, c.ModelsPackage), formats, imports); err != nil {
+				errChan <- err
+			}
+		}
 	}
 
 	wg.Wait()
@@ -240,14 +279,21 @@ func (c *clientGenerator) generateGroupClient(opGroup GenOperationGroup) error {
 }
 
 func (c *clientGenerator) generateFacade(app *GenApp) error {
+	appc := *app
+	fp := filepath.Join(c.Target, c.ClientPackage)
+	if c.GenOpts != nil && c.GenOpts.GoGenerate {
+		cmd, err := c.goGenerateCommand("client", fp)
+		if err != nil {
+			return err
+		}
+		appc.GoGenerate = cmd
+	}
 	buf := bytes.NewBuffer(nil)
-
-	if err := clientFacadeTemplate.Execute(buf, app); err != nil {
+	if err := clientFacadeTemplate.Execute(buf, &appc); err != nil {
 		return err
 	}
 	log.Println("rendered client facade template:", c.ClientPackage+"."+swag.ToGoName(app.Name)+"Client")
 
-	fp := filepath.Join(c.Target, c.ClientPackage)
 	return writeToFile(fp, swag.ToGoName(app.Name)+"Client", buf.Bytes())
 }
 
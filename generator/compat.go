@@ -0,0 +1,82 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// compatibility holds the behaviors of a prior go-swagger version which --compat-mode restores,
+// for the code generated by that version to be regenerated with less churn.
+// The fixes of the code generated wrong by that version aren't reverted.
+type compatibility struct {
+	// PointerObjects renders the objects with properties as pointers, even with x-nullable: false
+	PointerObjects bool
+	// NullableAllOf makes an allOf with a single member nullable, whatever the nullability of the member
+	NullableAllOf bool
+	// EmptyInterface renders interface{} rather than any, whatever the targeted go version
+	EmptyInterface bool
+	// NoEnumConsts leaves out the go constants declared for the enum values,
+	// along with the All<Name>Values and IsValid helpers of the enum types
+	NoEnumConsts bool
+	// NoFormatRegistry leaves out the registration of the custom formats with strfmt.Default
+	NoFormatRegistry bool
+	// LegacyDocs renders the doc comments of the models and of their fields as block comments,
+	// without the examples nor the description of the target of a $ref property
+	LegacyDocs bool
+}
+
+// compatModes are the prior versions accepted by --compat-mode, see docs/generate/compat.md
+var compatModes = map[string]compatibility{
+	"0.5": {
+		PointerObjects:   true,
+		NullableAllOf:    true,
+		EmptyInterface:   true,
+		NoEnumConsts:     true,
+		NoFormatRegistry: true,
+		LegacyDocs:       true,
+	},
+}
+
+// checkCompatMode returns an error when the compat mode is not a known prior version
+func checkCompatMode(mode string) error {
+	if mode == "" {
+		return nil
+	}
+	if _, ok := compatModes[mode]; ok {
+		return nil
+	}
+	known := make([]string, 0, len(compatModes))
+	for k := range compatModes {
+		known = append(known, k)
+	}
+	sort.Strings(known)
+	return fmt.Errorf("unknown compat mode %q, expected one of %s", mode, strings.Join(known, ", "))
+}
+
+// compat returns the behaviors of the prior version targeted by the compat mode, none without one
+func (g *GenOpts) compat() compatibility {
+	if g == nil {
+		return compatibility{}
+	}
+	return compatModes[g.CompatMode]
+}
+
+// formatRegistry returns true when the custom formats are registered with strfmt.Default
+func (g *GenOpts) formatRegistry() bool {
+	return !g.SkipFormats && !g.compat().NoFormatRegistry
+}
@@ -0,0 +1,68 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCompatMode_Golden compares the models generated at each compat level with their golden files,
+// the differences between them are the ones documented in docs/generate/compat.md.
+// The golden files of the 0.5 level are the models generated by the 0.5.0 release for this fixture,
+// the other ones pin the current output.
+func TestCompatMode_Golden(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.compat.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, k := range []string{"Task", "Priority"} {
+		for mode, suffix := range map[string]string{"": ".go.golden", "0.5": ".0.5.go.golden"} {
+			golden := swag.ToFileName(k) + suffix
+			opts := &GenOpts{GoVersion: "1.18", CompatMode: mode}
+			genModel, err := makeGenDefinitionHierarchy(k, "models", "", specDoc.Spec().Definitions[k], specDoc, true, true, opts)
+			if !assert.NoError(t, err, golden) {
+				continue
+			}
+			buf := bytes.NewBuffer(nil)
+			if !assert.NoError(t, modelTemplate.Execute(buf, genModel), golden) {
+				continue
+			}
+			ff, err := formatGoFile(swag.ToFileName(k)+".go", buf.Bytes())
+			if !assert.NoError(t, err, golden) {
+				fmt.Println(buf.String())
+				continue
+			}
+			expected, err := ioutil.ReadFile(filepath.Join("..", "fixtures", "codegen", "golden", "compat", golden))
+			if assert.NoError(t, err, golden) {
+				assert.Equal(t, string(expected), string(ff), "compat mode %q differs from %s", mode, golden)
+			}
+		}
+	}
+}
+
+func TestCompatMode_Options(t *testing.T) {
+	assert.NoError(t, checkCompatMode(""))
+	assert.NoError(t, checkCompatMode("0.5"))
+	err := checkCompatMode("0.4")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `unknown compat mode "0.4", expected one of 0.5`)
+	}
+	_, _, err = loadSpec(&GenOpts{Spec: "../fixtures/codegen/todolist.compat.yml", CompatMode: "0.4"})
+	assert.Error(t, err)
+
+	var none *GenOpts
+	assert.Equal(t, compatibility{}, none.compat())
+
+	assert.True(t, (&GenOpts{}).formatRegistry())
+	assert.False(t, (&GenOpts{SkipFormats: true}).formatRegistry())
+	assert.False(t, (&GenOpts{CompatMode: "0.5"}).formatRegistry())
+
+	assert.Equal(t, "any", (&GenOpts{GoVersion: "1.18"}).iface())
+	assert.Equal(t, "interface{}", (&GenOpts{GoVersion: "1.18", CompatMode: "0.5"}).iface())
+}
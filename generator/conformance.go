@@ -0,0 +1,299 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+)
+
+// GenerateConformanceTests generates the go tests checking the models against the examples of their schemas,
+// in the package of the models. The definitions without an example are skipped.
+func GenerateConformanceTests(modelNames []string, opts GenOpts) error {
+	if opts.TemplateDir != "" {
+		if err := templates.LoadDir(opts.TemplateDir); err != nil {
+			return err
+		}
+	}
+
+	compileTemplates()
+
+	specPath, specDoc, err := loadSpec(&opts)
+	if err != nil {
+		return err
+	}
+
+	if len(modelNames) == 0 {
+		for k := range specDoc.Spec().Definitions {
+			modelNames = append(modelNames, k)
+		}
+	}
+	sort.Strings(modelNames)
+
+	target := filepath.Join(opts.Target, opts.ModelPackage)
+	pkg := mangleName(filepath.Base(target), "definitions")
+	var generated int
+	for _, modelName := range modelNames {
+		model, ok := specDoc.Spec().Definitions[modelName]
+		if !ok {
+			return fmt.Errorf("model %q not found in definitions in %s", modelName, specPath)
+		}
+		if model.Example == nil {
+			continue
+		}
+
+		conformance, err := makeGenConformance(modelName, target, model, specDoc, &opts)
+		if err != nil {
+			return err
+		}
+		if conformance == nil {
+			continue
+		}
+		conformance.Package = pkg
+
+		buf := bytes.NewBuffer(nil)
+		if err := conformanceTemplate.Execute(buf, conformance); err != nil {
+			return err
+		}
+		log.Println("rendered conformance test template:", modelName)
+		if err := writeToTestFile(target, modelName+"_conformance", buf.Bytes()); err != nil {
+			return err
+		}
+		generated++
+	}
+
+	if generated == 0 {
+		log.Println("no model has an example, no conformance test was generated")
+		return nil
+	}
+	buf := bytes.NewBuffer(nil)
+	if err := conformanceHelpersTemplate.Execute(buf, struct{ Package string }{Package: pkg}); err != nil {
+		return err
+	}
+	return writeToTestFile(target, "conformance", buf.Bytes())
+}
+
+// makeGenConformance builds the conformance test of a model out of the example of its schema,
+// it returns nil for the models which can't be unmarshaled into their go type, like the polymorphic ones
+func makeGenConformance(name, pkg string, schema spec.Schema, specDoc *loads.Document, opts *GenOpts) (*GenConformance, error) {
+	genModel, err := makeGenDefinitionHierarchy(name, pkg, "", schema, specDoc, true, true, opts)
+	if err != nil {
+		return nil, err
+	}
+	if genModel.IsBaseType {
+		return nil, nil
+	}
+
+	resolved := resolveExample(genModel, &schema, specDoc)
+	example, err := json.Marshal(resolved)
+	if err != nil {
+		return nil, fmt.Errorf("%s: the example is not valid JSON: %v", name, err)
+	}
+	mutations, err := exampleMutations(genModel, &schema, resolved)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return &GenConformance{
+		Name:      name,
+		GoType:    genModel.GoType,
+		Example:   string(example),
+		Mutations: mutations,
+	}, nil
+}
+
+// xExampleNullable marks a nullable property left out of the example of its model, to be null in the fixtures
+// generated from that example rather than the example of the property
+const xExampleNullable = "x-example-nullable"
+
+// resolveExample completes the example of an object schema with the nullable properties it leaves out, for the
+// fixtures to cover both their absent and present cases: a property marked with x-example-nullable is null,
+// the others take the example of their own schema or of the definition they refer to, and stay out without one.
+func resolveExample(genModel *GenDefinition, schema *spec.Schema, specDoc *loads.Document) interface{} {
+	example, ok := schema.Example.(map[string]interface{})
+	if !ok || genModel.IsTuple {
+		return schema.Example
+	}
+	resolved := make(map[string]interface{}, len(example))
+	for k, v := range example {
+		resolved[k] = v
+	}
+	for _, prop := range genModel.Properties {
+		if _, inExample := example[prop.Name]; inExample {
+			continue
+		}
+		ps := schema.Properties[prop.Name]
+		null, _ := ps.Extensions.GetBool(xExampleNullable)
+		if !prop.IsNullable {
+			if null {
+				log.Printf("warning: %s.%s is marked with %s but isn't nullable, it is left out of the example", genModel.Name, prop.Name, xExampleNullable)
+			}
+			continue
+		}
+		if null {
+			resolved[prop.Name] = nil
+			continue
+		}
+		if ex, ok := schemaExample(&ps, specDoc); ok {
+			resolved[prop.Name] = ex
+		}
+	}
+	return resolved
+}
+
+// schemaExample returns the example of a schema, following its refs to the example of the definition they point to
+func schemaExample(schema *spec.Schema, specDoc *loads.Document) (interface{}, bool) {
+	seen := make(map[string]struct{})
+	for schema.Example == nil {
+		key := schema.Ref.String()
+		if key == "" {
+			return nil, false
+		}
+		if _, loops := seen[key]; loops {
+			return nil, false
+		}
+		seen[key] = struct{}{}
+		target, err := spec.ResolveRef(specDoc.Spec(), &schema.Ref)
+		if err != nil {
+			return nil, false
+		}
+		schema = target
+	}
+	return schema.Example, true
+}
+
+// exampleMutations changes the example of an object schema in every way breaking one of the constraints
+// of its properties: a required property is removed, a value is out of range, too long or too short,
+// or is not one of its enum.
+//
+// The zero value of an optional property isn't validated by the models, so it is never used.
+func exampleMutations(genModel *GenDefinition, schema *spec.Schema, resolved interface{}) ([]GenMutation, error) {
+	example, ok := resolved.(map[string]interface{})
+	if !ok || genModel.IsTuple {
+		return nil, nil
+	}
+
+	type change struct {
+		name   string
+		prop   string
+		value  interface{}
+		remove bool
+	}
+	var changes []change
+	for _, prop := range genModel.Properties {
+		_, inExample := example[prop.Name]
+		if prop.Required && inExample && !prop.ReadOnly && schema.Properties[prop.Name].Default == nil {
+			changes = append(changes, change{name: "without the required " + prop.Name, prop: prop.Name, remove: true})
+		}
+		if !prop.IsPrimitive || prop.IsArray || prop.IsMap || prop.IsComplexObject {
+			continue
+		}
+
+		set := func(name string, value interface{}) {
+			if !prop.Required && isZeroExample(value) {
+				return
+			}
+			changes = append(changes, change{name: "with " + prop.Name + " " + name, prop: prop.Name, value: value})
+		}
+		switch prop.SwaggerType {
+		case integer, number:
+			if prop.Maximum != nil {
+				v := *prop.Maximum + 1
+				if prop.SwaggerType == integer {
+					v = math.Floor(*prop.Maximum) + 1
+				}
+				set("above its maximum", v)
+			}
+			if prop.Minimum != nil {
+				v := *prop.Minimum - 1
+				if prop.SwaggerType == integer {
+					v = math.Ceil(*prop.Minimum) - 1
+				}
+				set("below its minimum", v)
+			}
+			if len(prop.Enum) > 0 {
+				v := math.Inf(-1)
+				for _, e := range prop.Enum {
+					if f, ok := e.(float64); ok && f > v {
+						v = f
+					}
+				}
+				if !math.IsInf(v, -1) {
+					set("out of its enum", math.Floor(v)+1)
+				}
+			}
+		case str:
+			if prop.MaxLength != nil {
+				set("longer than its max length", strings.Repeat("x", int(*prop.MaxLength)+1))
+			}
+			if prop.MinLength != nil && *prop.MinLength > 0 {
+				set("shorter than its min length", strings.Repeat("x", int(*prop.MinLength)-1))
+			}
+			if len(prop.Enum) > 0 {
+				v := "invalid"
+				for containsEnumValue(prop.Enum, v) {
+					v += "x"
+				}
+				set("out of its enum", v)
+			}
+		}
+	}
+
+	var mutations []GenMutation
+	for _, c := range changes {
+		mutated := make(map[string]interface{}, len(example))
+		for k, v := range example {
+			mutated[k] = v
+		}
+		if c.remove {
+			delete(mutated, c.prop)
+		} else {
+			mutated[c.prop] = c.value
+		}
+		raw, err := json.Marshal(mutated)
+		if err != nil {
+			return nil, err
+		}
+		mutations = append(mutations, GenMutation{Name: c.name, JSON: string(raw)})
+	}
+	return mutations, nil
+}
+
+func isZeroExample(value interface{}) bool {
+	switch v := value.(type) {
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	}
+	return value == nil
+}
+
+func containsEnumValue(enum []interface{}, value interface{}) bool {
+	for _, e := range enum {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
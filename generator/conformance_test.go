@@ -0,0 +1,155 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConformance_Mutations(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.conformance.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	conformance, err := makeGenConformance("Task", "models", definitions["Task"], specDoc, nil)
+	if !assert.NoError(t, err) || !assert.NotNil(t, conformance) {
+		return
+	}
+	assert.Equal(t, "Task", conformance.GoType)
+	assert.Contains(t, conformance.Example, `"title":"buy milk"`)
+	var names []string
+	for _, m := range conformance.Mutations {
+		names = append(names, m.Name)
+	}
+	// the optional priority below its minimum would be zero, which isn't validated
+	assert.Equal(t, []string{
+		"without the required id",
+		"with id below its minimum",
+		"with priority above its maximum",
+		"with ratio above its maximum",
+		"with state out of its enum",
+		"without the required title",
+		"with title longer than its max length",
+		"with title shorter than its min length",
+	}, names)
+	assert.Contains(t, conformance.Mutations[0].JSON, `"title":"buy milk"`)
+	assert.NotContains(t, conformance.Mutations[0].JSON, `"id"`)
+	assert.Contains(t, conformance.Mutations[2].JSON, `"priority":6`)
+
+	// the examples which aren't objects are only round-tripped
+	conformance, err = makeGenConformance("Label", "models", definitions["Label"], specDoc, nil)
+	if assert.NoError(t, err) && assert.NotNil(t, conformance) {
+		assert.Equal(t, `"urgent"`, conformance.Example)
+		assert.Empty(t, conformance.Mutations)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	conformance.Package = "models"
+	if assert.NoError(t, conformanceTemplate.Execute(buf, conformance)) {
+		ff, err := formatGoFile("label_conformance_test.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			assertInCode(t, "func TestLabelConformance(t *testing.T) {", res)
+			assertInCode(t, "var m Label", res)
+			assertNotInCode(t, "mutations", res)
+		} else {
+			t.Log(buf.String())
+		}
+	}
+}
+
+func TestConformance_RoundTrip(t *testing.T) {
+	w := newGoWorkspace(t)
+	opts := GenOpts{
+		Spec:         "../fixtures/codegen/todolist.conformance.yml",
+		Target:       w.Dir(""),
+		ModelPackage: "models",
+	}
+	if !assert.NoError(t, GenerateDefinition(nil, true, true, opts)) {
+		return
+	}
+	if !assert.NoError(t, GenerateConformanceTests(nil, opts)) {
+		return
+	}
+	for _, name := range []string{"conformance_test.go", "task_conformance_test.go", "label_conformance_test.go"} {
+		_, err := os.Stat(filepath.Join(w.Dir("models"), name))
+		assert.NoError(t, err)
+	}
+	// Tag has no example
+	_, err := os.Stat(filepath.Join(w.Dir("models"), "tag_conformance_test.go"))
+	assert.True(t, os.IsNotExist(err))
+
+	if lines, ok := w.Go(t, "models", "test", "-v", "."); ok {
+		out := strings.Join(lines, "\n")
+		assert.Contains(t, out, "--- PASS: TestTaskConformance")
+		assert.Contains(t, out, "--- PASS: TestLabelConformance")
+	}
+}
+
+func TestConformance_NullableExamples(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.nullable-examples.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	conformance, err := makeGenConformance("Task", "models", specDoc.Spec().Definitions["Task"], specDoc, nil)
+	if !assert.NoError(t, err) || !assert.NotNil(t, conformance) {
+		return
+	}
+	// marked with x-example-nullable, a nullable property is null despite its example
+	assert.Contains(t, conformance.Example, `"assignee":null`)
+	assert.Contains(t, conformance.Example, `"reviewer":null`)
+	// otherwise it takes the example of its schema, or of the definition it refers to
+	assert.Contains(t, conformance.Example, `"note":"bring the receipt"`)
+	assert.Contains(t, conformance.Example, `"parent":{"id":3}`)
+	// a property which isn't nullable is left out
+	assert.NotContains(t, conformance.Example, `"title"`)
+	for _, m := range conformance.Mutations {
+		assert.Contains(t, m.JSON, `"assignee":null`, m.Name)
+	}
+
+	benchmark, err := makeGenBenchmark("Task", "models", specDoc.Spec().Definitions["Task"], specDoc, nil)
+	if assert.NoError(t, err) && assert.NotNil(t, benchmark) {
+		assert.Equal(t, conformance.Example, benchmark.Example)
+	}
+}
+
+func TestConformance_NullableExamplesRoundTrip(t *testing.T) {
+	w := newGoWorkspace(t)
+	opts := GenOpts{
+		Spec:         "../fixtures/codegen/todolist.nullable-examples.yml",
+		Target:       w.Dir(""),
+		ModelPackage: "models",
+	}
+	if !assert.NoError(t, GenerateDefinition(nil, true, true, opts)) {
+		return
+	}
+	if !assert.NoError(t, GenerateConformanceTests(nil, opts)) {
+		return
+	}
+	if lines, ok := w.Go(t, "models", "test", "-v", "."); ok {
+		out := strings.Join(lines, "\n")
+		assert.Contains(t, out, "--- PASS: TestTaskConformance")
+		assert.Contains(t, out, "--- PASS: TestTaskRefConformance")
+	}
+}
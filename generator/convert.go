@@ -0,0 +1,724 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/loads"
+)
+
+type jsonObject map[string]interface{}
+
+// xServer keeps the templated url of the server and its variables, which swagger 2.0 can't describe
+const xServer = "x-server"
+
+var (
+	oas3Operations    = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+	formMediaTypes    = []string{"application/x-www-form-urlencoded", "multipart/form-data"}
+	simpleSchemaKeys  = []string{"type", "format", "default", "maximum", "exclusiveMaximum", "minimum", "exclusiveMinimum", "maxLength", "minLength", "pattern", "maxItems", "minItems", "uniqueItems", "enum", "multipleOf"}
+	oauth2FlowMapping = [][2]string{{"implicit", "implicit"}, {"password", "password"}, {"clientCredentials", "application"}, {"authorizationCode", "accessCode"}}
+)
+
+// DowngradeSpec converts an OpenAPI 3.0 document to swagger 2.0.
+//
+// Constructs without a swagger 2.0 counterpart are either dropped with a warning,
+// like links or callbacks, or fail the conversion, like oneOf with several
+// members, cookie parameters or the trace method. The definitions of the result are
+// checked by the type resolver, so the converted document can be used to generate code.
+func DowngradeSpec(raw json.RawMessage) (*loads.Document, error) {
+	var doc jsonObject
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	version, _ := doc["openapi"].(string)
+	if !strings.HasPrefix(version, "3.0") {
+		return nil, fmt.Errorf("only OpenAPI 3.0 documents can be converted to swagger 2.0, got version %q", version)
+	}
+
+	d := &specDowngrader{doc: doc, components: objectAt(doc, "components")}
+	converted, err := d.downgrade()
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(converted)
+	if err != nil {
+		return nil, err
+	}
+	specDoc, err := loads.Analyzed(data, "2.0")
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := newTypeResolver("", specDoc)
+	for _, k := range sortedKeys(objectAt(converted, "definitions")) {
+		sch := specDoc.Spec().Definitions[k]
+		if _, err := resolver.NewWithModelName(k).ResolveSchema(&sch, false, true); err != nil {
+			return nil, fmt.Errorf("#/definitions/%s: %v", k, err)
+		}
+	}
+	return specDoc, nil
+}
+
+type specDowngrader struct {
+	doc        jsonObject
+	components jsonObject
+}
+
+func (d *specDowngrader) downgrade() (jsonObject, error) {
+	result := jsonObject{"swagger": "2.0"}
+	for _, k := range []string{"info", "tags", "externalDocs", "security"} {
+		if v, ok := d.doc[k]; ok {
+			result[k] = v
+		}
+	}
+	copyExtensions(d.doc, result)
+
+	if err := d.downgradeServers(result); err != nil {
+		return nil, err
+	}
+
+	paths := jsonObject{}
+	for _, pth := range sortedKeys(objectAt(d.doc, "paths")) {
+		if strings.HasPrefix(pth, "x-") {
+			paths[pth] = objectAt(d.doc, "paths")[pth]
+			continue
+		}
+		item, err := d.downgradePathItem(pth, objectAt(objectAt(d.doc, "paths"), pth))
+		if err != nil {
+			return nil, err
+		}
+		paths[pth] = item
+	}
+	result["paths"] = paths
+
+	definitions := jsonObject{}
+	for _, k := range sortedKeys(objectAt(d.components, "schemas")) {
+		sch, err := d.downgradeSchema("#/components/schemas/"+k, objectAt(objectAt(d.components, "schemas"), k))
+		if err != nil {
+			return nil, err
+		}
+		definitions[k] = sch
+	}
+	if len(definitions) > 0 {
+		result["definitions"] = definitions
+	}
+
+	parameters := jsonObject{}
+	for _, k := range sortedKeys(objectAt(d.components, "parameters")) {
+		param, err := d.downgradeParameter("#/components/parameters/"+k, objectAt(objectAt(d.components, "parameters"), k))
+		if err != nil {
+			return nil, err
+		}
+		parameters[k] = param
+	}
+	if len(parameters) > 0 {
+		result["parameters"] = parameters
+	}
+
+	responses := jsonObject{}
+	for _, k := range sortedKeys(objectAt(d.components, "responses")) {
+		resp, _, err := d.downgradeResponse("#/components/responses/"+k, objectAt(objectAt(d.components, "responses"), k))
+		if err != nil {
+			return nil, err
+		}
+		responses[k] = resp
+	}
+	if len(responses) > 0 {
+		result["responses"] = responses
+	}
+
+	securityDefinitions := jsonObject{}
+	for _, k := range sortedKeys(objectAt(d.components, "securitySchemes")) {
+		scheme, err := d.downgradeSecurityScheme("#/components/securitySchemes/"+k, objectAt(objectAt(d.components, "securitySchemes"), k))
+		if err != nil {
+			return nil, err
+		}
+		securityDefinitions[k] = scheme
+	}
+	if len(securityDefinitions) > 0 {
+		result["securityDefinitions"] = securityDefinitions
+	}
+
+	for _, k := range []string{"examples", "links", "callbacks"} {
+		if _, ok := d.components[k]; ok {
+			log.Printf("warning: #/components/%s: %s have no swagger 2.0 counterpart and are left out", k, k)
+		}
+	}
+	return result, nil
+}
+
+// downgradeServers maps the first server url to the host, base path and schemes, with the defaults of its variables.
+// The url template and the variables are kept in x-server for the clients to build the url with other values.
+func (d *specDowngrader) downgradeServers(result jsonObject) error {
+	servers, _ := d.doc["servers"].([]interface{})
+	if len(servers) == 0 {
+		return nil
+	}
+	if len(servers) > 1 {
+		log.Printf("warning: #/servers: swagger 2.0 has a single host and base path, using the first of %d servers", len(servers))
+	}
+	server, _ := servers[0].(map[string]interface{})
+	raw, _ := server["url"].(string)
+	if variables := objectAt(server, "variables"); len(variables) > 0 {
+		result[xServer] = jsonObject{"url": raw, "variables": variables}
+	}
+	for _, k := range sortedKeys(objectAt(server, "variables")) {
+		def, _ := objectAt(objectAt(server, "variables"), k)["default"].(string)
+		raw = strings.Replace(raw, "{"+k+"}", def, -1)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("#/servers/0: %v", err)
+	}
+	if u.Scheme != "" {
+		result["schemes"] = []string{u.Scheme}
+	}
+	if u.Host != "" {
+		result["host"] = u.Host
+	}
+	if u.Path != "" {
+		result["basePath"] = u.Path
+	}
+	return nil
+}
+
+func (d *specDowngrader) downgradePathItem(pth string, item jsonObject) (jsonObject, error) {
+	loc := "#/paths/" + jsonPointerEscape(pth)
+	if _, ok := item["$ref"]; ok {
+		return nil, fmt.Errorf("%s: path item references are not supported in swagger 2.0", loc)
+	}
+	if _, ok := item["trace"]; ok {
+		return nil, fmt.Errorf("%s: the trace method is not supported in swagger 2.0", loc)
+	}
+	for _, k := range []string{"summary", "description", "servers"} {
+		if _, ok := item[k]; ok {
+			log.Printf("warning: %s: the %s of a path item has no swagger 2.0 counterpart and is left out", loc, k)
+		}
+	}
+
+	result := jsonObject{}
+	copyExtensions(item, result)
+	if params, ok := item["parameters"].([]interface{}); ok {
+		converted, err := d.downgradeParameters(loc+"/parameters", params)
+		if err != nil {
+			return nil, err
+		}
+		result["parameters"] = converted
+	}
+	for _, method := range oas3Operations {
+		op, ok := item[method].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		converted, err := d.downgradeOperation(loc+"/"+method, op)
+		if err != nil {
+			return nil, err
+		}
+		result[method] = converted
+	}
+	return result, nil
+}
+
+func (d *specDowngrader) downgradeOperation(loc string, op jsonObject) (jsonObject, error) {
+	result := jsonObject{}
+	for _, k := range []string{"tags", "summary", "description", "externalDocs", "operationId", "deprecated", "security"} {
+		if v, ok := op[k]; ok {
+			result[k] = v
+		}
+	}
+	copyExtensions(op, result)
+	for _, k := range []string{"callbacks", "servers"} {
+		if _, ok := op[k]; ok {
+			log.Printf("warning: %s: the %s of an operation have no swagger 2.0 counterpart and are left out", loc, k)
+		}
+	}
+
+	var params []interface{}
+	if ps, ok := op["parameters"].([]interface{}); ok {
+		converted, err := d.downgradeParameters(loc+"/parameters", ps)
+		if err != nil {
+			return nil, err
+		}
+		params = converted
+	}
+	if rb, ok := op["requestBody"].(map[string]interface{}); ok {
+		bodyParams, consumes, err := d.downgradeRequestBody(loc+"/requestBody", rb)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, bodyParams...)
+		result["consumes"] = consumes
+	}
+	if len(params) > 0 {
+		result["parameters"] = params
+	}
+
+	responses := jsonObject{}
+	var produces []string
+	for _, code := range sortedKeys(objectAt(op, "responses")) {
+		resp, mediaTypes, err := d.downgradeResponse(loc+"/responses/"+code, objectAt(objectAt(op, "responses"), code))
+		if err != nil {
+			return nil, err
+		}
+		responses[code] = resp
+		for _, mt := range mediaTypes {
+			if !containsString(produces, mt) {
+				produces = append(produces, mt)
+			}
+		}
+	}
+	result["responses"] = responses
+	if len(produces) > 0 {
+		sort.Strings(produces)
+		result["produces"] = produces
+	}
+	return result, nil
+}
+
+func (d *specDowngrader) downgradeParameters(loc string, params []interface{}) ([]interface{}, error) {
+	var result []interface{}
+	for i, p := range params {
+		param, _ := p.(map[string]interface{})
+		converted, err := d.downgradeParameter(fmt.Sprintf("%s/%d", loc, i), param)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+	return result, nil
+}
+
+func (d *specDowngrader) downgradeParameter(loc string, param jsonObject) (jsonObject, error) {
+	if ref, ok := param["$ref"].(string); ok {
+		return jsonObject{"$ref": downgradeRef(ref)}, nil
+	}
+	if in, _ := param["in"].(string); in == "cookie" {
+		return nil, fmt.Errorf("%s: cookie parameters are not supported in swagger 2.0", loc)
+	}
+	if _, ok := param["content"]; ok {
+		return nil, fmt.Errorf("%s: parameters with a content map are not supported in swagger 2.0", loc)
+	}
+
+	result := jsonObject{}
+	for _, k := range []string{"name", "in", "description", "required", "allowEmptyValue"} {
+		if v, ok := param[k]; ok {
+			result[k] = v
+		}
+	}
+	copyExtensions(param, result)
+	if err := d.flattenSimpleSchema(loc+"/schema", objectAt(param, "schema"), result); err != nil {
+		return nil, err
+	}
+	if result["type"] == "array" {
+		style, _ := param["style"].(string)
+		if style == "" {
+			style = "simple"
+			if result["in"] == "query" {
+				style = "form"
+			}
+		}
+		explode, ok := param["explode"].(bool)
+		if !ok {
+			explode = style == "form"
+		}
+		switch style {
+		case "form":
+			result["collectionFormat"] = "csv"
+			if explode {
+				result["collectionFormat"] = "multi"
+			}
+		case "simple":
+			result["collectionFormat"] = "csv"
+		case "spaceDelimited":
+			result["collectionFormat"] = "ssv"
+		case "pipeDelimited":
+			result["collectionFormat"] = "pipes"
+		default:
+			return nil, fmt.Errorf("%s: the %s parameter style is not supported in swagger 2.0", loc, style)
+		}
+	}
+	return result, nil
+}
+
+// flattenSimpleSchema copies a schema for a non-body parameter, header or items, which are
+// restricted to simple types in swagger 2.0
+func (d *specDowngrader) flattenSimpleSchema(loc string, schema jsonObject, target jsonObject) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, err := d.resolveComponent(ref)
+		if err != nil {
+			return fmt.Errorf("%s: %v", loc, err)
+		}
+		schema = resolved
+	}
+	tpe, _ := schema["type"].(string)
+	if tpe == "" || tpe == "object" {
+		return fmt.Errorf("%s: only primitive and array schemas are supported for non-body parameters and headers in swagger 2.0", loc)
+	}
+	for _, k := range simpleSchemaKeys {
+		if v, ok := schema[k]; ok {
+			target[k] = v
+		}
+	}
+	if nullable, ok := schema["nullable"].(bool); ok && nullable {
+		target["x-nullable"] = true
+	}
+	if tpe == "array" {
+		items := jsonObject{}
+		if err := d.flattenSimpleSchema(loc+"/items", objectAt(schema, "items"), items); err != nil {
+			return err
+		}
+		target["items"] = items
+	}
+	return nil
+}
+
+func (d *specDowngrader) downgradeRequestBody(loc string, rb jsonObject) ([]interface{}, []string, error) {
+	if ref, ok := rb["$ref"].(string); ok {
+		resolved, err := d.resolveComponent(ref)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", loc, err)
+		}
+		// swagger 2.0 has no reusable request bodies, so they get inlined
+		rb = resolved
+	}
+	content := objectAt(rb, "content")
+	mediaTypes := sortedKeys(content)
+	if len(mediaTypes) == 0 {
+		return nil, nil, nil
+	}
+	required, _ := rb["required"].(bool)
+
+	var forms int
+	for _, mt := range mediaTypes {
+		if containsString(formMediaTypes, mt) {
+			forms++
+		}
+	}
+	if forms > 0 && forms < len(mediaTypes) {
+		return nil, nil, fmt.Errorf("%s: swagger 2.0 can't describe a body consumed both as form data and as a document", loc)
+	}
+
+	schema := objectAt(objectAt(content, mediaTypes[0]), "schema")
+	for _, mt := range mediaTypes[1:] {
+		if !reflect.DeepEqual(schema, objectAt(objectAt(content, mt), "schema")) {
+			log.Printf("warning: %s: swagger 2.0 has one body schema per operation, using the one for %s", loc, mediaTypes[0])
+			break
+		}
+	}
+
+	if forms > 0 {
+		params, err := d.downgradeFormData(loc+"/content/"+jsonPointerEscape(mediaTypes[0])+"/schema", schema)
+		return params, mediaTypes, err
+	}
+
+	body := jsonObject{"name": "body", "in": "body", "required": required}
+	if nm, ok := rb["x-codegen-request-body-name"].(string); ok {
+		body["name"] = nm
+	}
+	if desc, ok := rb["description"]; ok {
+		body["description"] = desc
+	}
+	converted, err := d.downgradeSchema(loc+"/content/"+jsonPointerEscape(mediaTypes[0])+"/schema", schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	body["schema"] = converted
+	return []interface{}{body}, mediaTypes, nil
+}
+
+// downgradeFormData turns the properties of a form schema into formData parameters
+func (d *specDowngrader) downgradeFormData(loc string, schema jsonObject) ([]interface{}, error) {
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, err := d.resolveComponent(ref)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", loc, err)
+		}
+		schema = resolved
+	}
+	required, _ := schema["required"].([]interface{})
+	var params []interface{}
+	for _, k := range sortedKeys(objectAt(schema, "properties")) {
+		prop := objectAt(objectAt(schema, "properties"), k)
+		param := jsonObject{"name": k, "in": "formData"}
+		for _, r := range required {
+			if r == k {
+				param["required"] = true
+			}
+		}
+		if desc, ok := prop["description"]; ok {
+			param["description"] = desc
+		}
+		if prop["type"] == "string" && prop["format"] == "binary" {
+			param["type"] = "file"
+		} else if err := d.flattenSimpleSchema(loc+"/properties/"+jsonPointerEscape(k), prop, param); err != nil {
+			return nil, err
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+func (d *specDowngrader) downgradeResponse(loc string, resp jsonObject) (jsonObject, []string, error) {
+	if ref, ok := resp["$ref"].(string); ok {
+		var mediaTypes []string
+		if resolved, err := d.resolveComponent(ref); err == nil {
+			mediaTypes = sortedKeys(objectAt(resolved, "content"))
+		}
+		return jsonObject{"$ref": downgradeRef(ref)}, mediaTypes, nil
+	}
+	if _, ok := resp["links"]; ok {
+		log.Printf("warning: %s: links have no swagger 2.0 counterpart and are left out", loc)
+	}
+
+	result := jsonObject{"description": resp["description"]}
+	copyExtensions(resp, result)
+	content := objectAt(resp, "content")
+	mediaTypes := sortedKeys(content)
+	if len(mediaTypes) > 0 {
+		schema := objectAt(objectAt(content, mediaTypes[0]), "schema")
+		for _, mt := range mediaTypes[1:] {
+			if !reflect.DeepEqual(schema, objectAt(objectAt(content, mt), "schema")) {
+				log.Printf("warning: %s: swagger 2.0 has one schema per response, using the one for %s", loc, mediaTypes[0])
+				break
+			}
+		}
+		if len(schema) > 0 {
+			converted, err := d.downgradeSchema(loc+"/content/"+jsonPointerEscape(mediaTypes[0])+"/schema", schema)
+			if err != nil {
+				return nil, nil, err
+			}
+			result["schema"] = converted
+		}
+	}
+
+	headers := jsonObject{}
+	for _, k := range sortedKeys(objectAt(resp, "headers")) {
+		hdr := objectAt(objectAt(resp, "headers"), k)
+		if ref, ok := hdr["$ref"].(string); ok {
+			resolved, err := d.resolveComponent(ref)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s/headers/%s: %v", loc, k, err)
+			}
+			hdr = resolved
+		}
+		header := jsonObject{}
+		if desc, ok := hdr["description"]; ok {
+			header["description"] = desc
+		}
+		if err := d.flattenSimpleSchema(loc+"/headers/"+jsonPointerEscape(k)+"/schema", objectAt(hdr, "schema"), header); err != nil {
+			return nil, nil, err
+		}
+		delete(header, "x-nullable")
+		headers[k] = header
+	}
+	if len(headers) > 0 {
+		result["headers"] = headers
+	}
+	return result, mediaTypes, nil
+}
+
+func (d *specDowngrader) downgradeSchema(loc string, schema jsonObject) (jsonObject, error) {
+	result := jsonObject{}
+	for k, v := range schema {
+		switch k {
+		case "$ref":
+			ref, _ := v.(string)
+			result[k] = downgradeRef(ref)
+		case "nullable":
+			if nullable, ok := v.(bool); ok && nullable {
+				result["x-nullable"] = true
+			}
+		case "deprecated":
+			result["x-deprecated"] = v
+		case "writeOnly":
+			result[xWriteOnly] = v
+		case "not":
+			return nil, fmt.Errorf("%s: not is unrepresentable in swagger 2.0", loc)
+		case "oneOf", "anyOf":
+			members, _ := v.([]interface{})
+			if len(members) != 1 {
+				return nil, fmt.Errorf("%s: %s with %d members is unrepresentable in swagger 2.0", loc, k, len(members))
+			}
+			// a single member is the same as composing it with allOf
+			member, _ := members[0].(map[string]interface{})
+			converted, err := d.downgradeSchema(loc+"/"+k+"/0", member)
+			if err != nil {
+				return nil, err
+			}
+			allOf, _ := result["allOf"].([]interface{})
+			result["allOf"] = append(allOf, converted)
+		case "discriminator":
+			disc := objectAt(schema, k)
+			result[k] = disc["propertyName"]
+			if _, ok := disc["mapping"]; ok {
+				log.Printf("warning: %s: swagger 2.0 discriminates on definition names, the discriminator mapping is left out", loc)
+			}
+		case "properties":
+			props := jsonObject{}
+			for _, nm := range sortedKeys(objectAt(schema, k)) {
+				converted, err := d.downgradeSchema(loc+"/properties/"+jsonPointerEscape(nm), objectAt(objectAt(schema, k), nm))
+				if err != nil {
+					return nil, err
+				}
+				props[nm] = converted
+			}
+			result[k] = props
+		case "items":
+			converted, err := d.downgradeSchema(loc+"/items", objectAt(schema, k))
+			if err != nil {
+				return nil, err
+			}
+			result[k] = converted
+		case "additionalProperties":
+			if add, ok := v.(map[string]interface{}); ok {
+				converted, err := d.downgradeSchema(loc+"/additionalProperties", add)
+				if err != nil {
+					return nil, err
+				}
+				result[k] = converted
+				continue
+			}
+			result[k] = v
+		case "allOf":
+			members, _ := v.([]interface{})
+			var converted []interface{}
+			for i, m := range members {
+				member, _ := m.(map[string]interface{})
+				cm, err := d.downgradeSchema(fmt.Sprintf("%s/allOf/%d", loc, i), member)
+				if err != nil {
+					return nil, err
+				}
+				converted = append(converted, cm)
+			}
+			allOf, _ := result["allOf"].([]interface{})
+			result["allOf"] = append(converted, allOf...)
+		default:
+			result[k] = v
+		}
+	}
+	return result, nil
+}
+
+func (d *specDowngrader) downgradeSecurityScheme(loc string, scheme jsonObject) (jsonObject, error) {
+	result := jsonObject{}
+	if desc, ok := scheme["description"]; ok {
+		result["description"] = desc
+	}
+	copyExtensions(scheme, result)
+	switch tpe, _ := scheme["type"].(string); tpe {
+	case "apiKey":
+		if scheme["in"] == "cookie" {
+			return nil, fmt.Errorf("%s: api keys in cookies are not supported in swagger 2.0", loc)
+		}
+		result["type"] = tpe
+		result["name"] = scheme["name"]
+		result["in"] = scheme["in"]
+	case "http":
+		switch scheme["scheme"] {
+		case "basic":
+			result["type"] = "basic"
+		case "bearer":
+			log.Printf("warning: %s: bearer authentication is described as an api key in the Authorization header", loc)
+			result["type"] = "apiKey"
+			result["name"] = "Authorization"
+			result["in"] = "header"
+		default:
+			return nil, fmt.Errorf("%s: the %v http authentication scheme is not supported in swagger 2.0", loc, scheme["scheme"])
+		}
+	case "oauth2":
+		flows := objectAt(scheme, "flows")
+		if len(flows) > 1 {
+			log.Printf("warning: %s: swagger 2.0 has one flow per oauth2 scheme, using the first of %d flows", loc, len(flows))
+		}
+		for _, m := range oauth2FlowMapping {
+			flow, ok := flows[m[0]].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			result["type"] = tpe
+			result["flow"] = m[1]
+			for _, k := range []string{"authorizationUrl", "tokenUrl", "scopes"} {
+				if v, ok := flow[k]; ok {
+					result[k] = v
+				}
+			}
+			return result, nil
+		}
+		return nil, fmt.Errorf("%s: an oauth2 scheme needs a flow", loc)
+	default:
+		return nil, fmt.Errorf("%s: %s security schemes are not supported in swagger 2.0", loc, tpe)
+	}
+	return result, nil
+}
+
+// resolveComponent looks up a local reference to the components of the document
+func (d *specDowngrader) resolveComponent(ref string) (jsonObject, error) {
+	parts := strings.Split(strings.TrimPrefix(ref, "#/components/"), "/")
+	if !strings.HasPrefix(ref, "#/components/") || len(parts) != 2 {
+		return nil, fmt.Errorf("only local references to components can be converted, got %q", ref)
+	}
+	resolved := objectAt(objectAt(d.components, parts[0]), jsonPointerUnescape(parts[1]))
+	if resolved == nil {
+		return nil, fmt.Errorf("unresolved reference %q", ref)
+	}
+	return resolved, nil
+}
+
+// downgradeRef points a reference to the components at the matching swagger 2.0 section
+func downgradeRef(ref string) string {
+	for _, m := range [][2]string{{"#/components/schemas/", "#/definitions/"}, {"#/components/parameters/", "#/parameters/"}, {"#/components/responses/", "#/responses/"}} {
+		if strings.HasPrefix(ref, m[0]) {
+			return m[1] + strings.TrimPrefix(ref, m[0])
+		}
+	}
+	return ref
+}
+
+func objectAt(obj map[string]interface{}, key string) jsonObject {
+	v, _ := obj[key].(map[string]interface{})
+	return v
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	var keys []string
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func copyExtensions(from, to map[string]interface{}) {
+	for k, v := range from {
+		if strings.HasPrefix(k, "x-") {
+			to[k] = v
+		}
+	}
+}
+
+func jsonPointerEscape(s string) string {
+	return strings.Replace(strings.Replace(s, "~", "~0", -1), "/", "~1", -1)
+}
+
+func jsonPointerUnescape(s string) string {
+	return strings.Replace(strings.Replace(s, "~1", "/", -1), "~0", "~", -1)
+}
@@ -0,0 +1,135 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/loads/fmts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDowngradeSpec(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	raw, err := fmts.YAMLDoc("../fixtures/codegen/todolist.oas3.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	converted, err := DowngradeSpec(raw)
+	if !assert.NoError(t, err) {
+		return
+	}
+	sw := converted.Spec()
+	assert.Equal(t, "2.0", sw.Swagger)
+	assert.Equal(t, "todo.example.com", sw.Host)
+	assert.Equal(t, "/api", sw.BasePath)
+	assert.Equal(t, []string{"https"}, sw.Schemes)
+	// the server url is kept with its variables
+	server, err := makeGenServer(sw)
+	if assert.NoError(t, err) && assert.NotNil(t, server) {
+		assert.Equal(t, "https://{host}/api", server.URL)
+		assert.Equal(t, []GenServerVariable{{Name: "host", GoName: "Host", Default: "todo.example.com"}}, server.Variables)
+	}
+	assert.Equal(t, "X-Token", sw.SecurityDefinitions["token"].Name)
+	assert.Equal(t, "#/definitions/Error", sw.Responses["error"].Schema.Ref.String())
+
+	list := sw.Paths.Paths["/tasks"].Get
+	if assert.NotNil(t, list) && assert.Len(t, list.Parameters, 2) {
+		assert.Equal(t, "#/parameters/limit", list.Parameters[0].Ref.String())
+		assert.Equal(t, "multi", list.Parameters[1].CollectionFormat)
+		assert.Equal(t, []string{"application/json"}, list.Produces)
+		assert.Equal(t, "int64", list.Responses.StatusCodeResponses[200].Headers["X-Total-Count"].Format)
+	}
+	create := sw.Paths.Paths["/tasks"].Post
+	if assert.NotNil(t, create) && assert.Len(t, create.Parameters, 1) {
+		assert.Equal(t, "body", create.Parameters[0].In)
+		assert.True(t, create.Parameters[0].Required)
+		assert.Equal(t, "#/definitions/Task", create.Parameters[0].Schema.Ref.String())
+		assert.Equal(t, []string{"application/json"}, create.Consumes)
+	}
+	upload := sw.Paths.Paths["/tasks/{id}/attachments"].Post
+	if assert.NotNil(t, upload) && assert.Len(t, upload.Parameters, 3) {
+		assert.Equal(t, "path", upload.Parameters[0].In)
+		assert.Equal(t, "comment", upload.Parameters[1].Name)
+		assert.Equal(t, "formData", upload.Parameters[1].In)
+		assert.Equal(t, "file", upload.Parameters[2].Type)
+		assert.True(t, upload.Parameters[2].Required)
+		assert.Equal(t, []string{"multipart/form-data"}, upload.Consumes)
+	}
+
+	// the models generated from the converted spec are the same as for its swagger 2.0 counterpart
+	expected, err := loads.Spec("../fixtures/codegen/todolist.oas3-swagger2.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, k := range []string{"Task", "User", "Error"} {
+		fromOAS3, err := renderModel(k, converted)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		fromSwagger2, err := renderModel(k, expected)
+		if assert.NoError(t, err) {
+			assert.Equal(t, fromSwagger2, fromOAS3, k)
+		}
+	}
+}
+
+func TestDowngradeSpec_Unrepresentable(t *testing.T) {
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	downgrade := func(components, paths string) error {
+		_, err := DowngradeSpec(json.RawMessage(`{"openapi":"3.0.0","info":{"title":"t","version":"1"},"paths":{` + paths + `},"components":{` + components + `}}`))
+		return err
+	}
+
+	err := downgrade(`"schemas":{"Pet":{"oneOf":[{"type":"string"},{"type":"integer"}]}}`, ``)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "#/components/schemas/Pet: oneOf with 2 members is unrepresentable in swagger 2.0")
+	}
+	err = downgrade(``, `"/pets":{"get":{"parameters":[{"name":"session","in":"cookie","schema":{"type":"string"}}],"responses":{"200":{"description":"ok"}}}}`)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "#/paths/~1pets/get/parameters/0: cookie parameters are not supported")
+	}
+	err = downgrade(``, `"/pets":{"trace":{"responses":{"200":{"description":"ok"}}}}`)
+	assert.Error(t, err)
+	_, err = DowngradeSpec(json.RawMessage(`{"swagger":"2.0"}`))
+	assert.Error(t, err)
+
+	// lossy conversions only warn
+	assert.NoError(t, downgrade(`"schemas":{"Pet":{"type":"object","discriminator":{"propertyName":"kind","mapping":{"dog":"#/components/schemas/Dog"}},"required":["kind"],"properties":{"kind":{"type":"string"}}}}`, ``))
+	assert.Contains(t, logged.String(), "warning: #/components/schemas/Pet: swagger 2.0 discriminates on definition names, the discriminator mapping is left out")
+}
+
+func TestDowngradeSpec_WriteOnly(t *testing.T) {
+	converted, err := DowngradeSpec(json.RawMessage(`{"openapi":"3.0.0","info":{"title":"t","version":"1"},"paths":{},` +
+		`"components":{"schemas":{"Login":{"type":"object","properties":{"password":{"type":"string","writeOnly":true}}}}}}`))
+	if !assert.NoError(t, err) {
+		return
+	}
+	// writeOnly is kept as an extension, which the type resolver honors
+	password := converted.Spec().Definitions["Login"].Properties["password"]
+	assert.Equal(t, true, password.Extensions[xWriteOnly])
+	assert.True(t, isWriteOnly(&password))
+}
+
+func renderModel(name string, doc *loads.Document) (string, error) {
+	sch := doc.Spec().Definitions[name]
+	genModel, err := makeGenDefinition(name, "models", sch, doc, true, true)
+	if err != nil {
+		return "", err
+	}
+	buf := bytes.NewBuffer(nil)
+	if err := modelTemplate.Execute(buf, genModel); err != nil {
+		return "", err
+	}
+	ff, err := formatGoFile(name+".go", buf.Bytes())
+	return string(ff), err
+}
@@ -1,7 +1,10 @@
 package generator
 
 import (
+	"strings"
+
 	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/loads"
 	"github.com/go-openapi/spec"
 	"github.com/go-openapi/swag"
 )
@@ -71,5 +74,76 @@ func discriminatorInfo(doc *analysis.Spec) *discInfo {
 			}
 		}
 	}
+
+	// the schemas composing a subtype inherit the discriminator of its base type,
+	// down to the subtypes of subtypes
+	for inherited := true; inherited; {
+		inherited = false
+		for _, sch := range doc.SchemasWithAllOf() {
+			if _, ok := subTypes[sch.Ref.String()]; ok {
+				continue
+			}
+			if _, ok := baseTypes[sch.Ref.String()]; ok {
+				continue
+			}
+			for _, ao := range sch.Schema.AllOf {
+				parent, ok := subTypes[ao.Ref.String()]
+				if ao.Ref.String() == "" || !ok {
+					continue
+				}
+				bt := baseTypes[parent.ParentRef.String()]
+				name, _ := sch.Schema.Extensions.GetString("x-class")
+				if name == "" {
+					name = sch.Name
+				}
+				tpe, _ := sch.Schema.Extensions.GetString("x-go-name")
+				if tpe == "" {
+					tpe = swag.ToGoName(sch.Name)
+				}
+				dce := discee{
+					FieldName:  bt.FieldName,
+					FieldValue: name,
+					Ref:        sch.Ref,
+					ParentRef:  parent.ParentRef,
+					JSONName:   sch.Name,
+					GoType:     tpe,
+				}
+				subTypes[sch.Ref.String()] = dce
+				bt.Children = append(bt.Children, dce)
+				baseTypes[parent.ParentRef.String()] = bt
+				inherited = true
+				break
+			}
+		}
+	}
 	return &discInfo{Discriminators: baseTypes, Discriminated: subTypes}
 }
+
+// inheritedAllOf replaces the members of the allOf of a subtype which refer to another subtype
+// with the members of that subtype, until the base type is a member of its own.
+// A subtype of a subtype is then rendered like the direct subtypes of their base type.
+func inheritedAllOf(schema *spec.Schema, specDoc *loads.Document, di *discInfo) []spec.Schema {
+	var members []spec.Schema
+	for _, ao := range schema.AllOf {
+		if ao.Ref.String() != "" {
+			if _, isSubType := di.Discriminated[ao.Ref.String()]; isSubType {
+				if parent, ok := specDoc.Spec().Definitions[strings.TrimPrefix(ao.Ref.String(), "#/definitions/")]; ok {
+					members = append(members, inheritedAllOf(&parent, specDoc, di)...)
+					continue
+				}
+			}
+		}
+		members = append(members, ao)
+	}
+	return members
+}
+
+// hasAllOfRef returns true when the ref is a member of the allOf of the schema
+func hasAllOfRef(schema *spec.Schema, ref spec.Ref) bool {
+	for _, ao := range schema.AllOf {
+		if ao.Ref.String() == ref.String() {
+			return true
+		}
+	}
+	return false
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/go-openapi/analysis"
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/loads/fmts"
+	"github.com/go-openapi/spec"
 	"github.com/go-openapi/swag"
 	"github.com/stretchr/testify/assert"
 )
@@ -26,6 +27,44 @@ func TestBuildDiscriminatorMap(t *testing.T) {
 	}
 }
 
+func TestTypeResolver_DiscriminatorValues(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.discriminators.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	resolver := newTypeResolver("", specDoc)
+
+	// the base type maps the x-class of Cat and the name of Dog to their go type
+	pet := definitions["Pet"]
+	resolver.ModelName = "Pet"
+	rt, err := resolver.ResolveSchema(&pet, false, false)
+	resolver.ModelName = ""
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsBaseType)
+		assert.Equal(t, map[string]string{"cat": "Cat", "Dog": "Dog"}, rt.DiscriminatorValues)
+	}
+
+	// so does a reference to it, qualified with the package of the models
+	resolver = newTypeResolver("models", specDoc)
+	ref := spec.RefSchema("#/definitions/Pet")
+	rt, err = resolver.ResolveSchema(ref, true, false)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.HasDiscriminator)
+		assert.Equal(t, map[string]string{"cat": "models.Cat", "Dog": "models.Dog"}, rt.DiscriminatorValues)
+	}
+
+	// the subtypes and the other models have none
+	for _, name := range []string{"Cat", "Dog", "Kennel"} {
+		schema := definitions[name]
+		resolver.ModelName = name
+		rt, err = resolver.ResolveSchema(&schema, false, false)
+		if assert.NoError(t, err, name) {
+			assert.Empty(t, rt.DiscriminatorValues, name)
+		}
+	}
+}
+
 func TestGenerateModel_DiscriminatorSlices(t *testing.T) {
 	specDoc, err := loads.Spec("../fixtures/codegen/todolist.discriminators.yml")
 	if assert.NoError(t, err) {
@@ -112,6 +151,7 @@ func TestGenerateModel_Discriminators(t *testing.T) {
 			assert.Len(t, genModel.ExtraSchemas, 0)
 			assert.Equal(t, "Cat", genModel.Discriminates["cat"])
 			assert.Equal(t, "Dog", genModel.Discriminates["Dog"])
+			assert.Equal(t, genModel.Discriminates, genModel.DiscriminatorValues)
 			buf := bytes.NewBuffer(nil)
 			err := modelTemplate.Execute(buf, genModel)
 			if assert.NoError(t, err) {
@@ -331,3 +371,65 @@ func TestGenerateModel_Issue319(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildDiscriminatorMap_Inherited(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.inherited-discriminator.yml")
+	if assert.NoError(t, err) {
+		di := discriminatorInfo(analysis.New(specDoc.Spec()))
+		assert.Len(t, di.Discriminators, 1)
+		assert.Len(t, di.Discriminators["#/definitions/Pet"].Children, 2)
+		if assert.Len(t, di.Discriminated, 2) {
+			puppy := di.Discriminated["#/definitions/Puppy"]
+			assert.Equal(t, "petType", puppy.FieldName)
+			assert.Equal(t, "Puppy", puppy.FieldValue)
+			assert.Equal(t, "#/definitions/Pet", puppy.ParentRef.String())
+		}
+	}
+}
+
+func TestGenerateModel_InheritedDiscriminator(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.inherited-discriminator.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+
+		k := "Puppy"
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err) {
+			assert.True(t, genModel.IsSubType)
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				b, err := formatGoFile("puppy.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(b)
+					assertInCode(t, "type Puppy struct {", res)
+					assertNotInCode(t, "\tDog\n", res)
+					assertInCode(t, "Barks bool `json:\"barks,omitempty\"`", res)
+					assertInCode(t, "Age int64 `json:\"age,omitempty\"`", res)
+					assertInCode(t, "func (m *Puppy) PetType() string {", res)
+					assertInCode(t, "return \"Puppy\"", res)
+					assertInCode(t, "data.PetType = \"Puppy\"", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+
+		k = "Pet"
+		genModel, err = makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				b, err := formatGoFile("pet.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(b)
+					assertInCode(t, "case \"Dog\":", res)
+					assertInCode(t, "case \"Puppy\":", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
@@ -47,6 +47,48 @@ func TestEnum_StringThing(t *testing.T) {
 	}
 }
 
+func TestEnum_AllValues(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.enums.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+		expected := map[string]string{
+			"StringThing": "return []StringThing{\n\t\t\"bird\",\n\t\t\"fish\",\n\t\t\"mammal\",\n\t}",
+			"IntThing":    "return []IntThing{\n\t\t22,\n\t\t27,\n\t\t32,\n\t}",
+			"FloatThing":  "return []FloatThing{\n\t\t21,\n\t\t28,\n\t\t35,\n\t}",
+		}
+		for k, values := range expected {
+			genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+			if assert.NoError(t, err) {
+				buf := bytes.NewBuffer(nil)
+				err := modelTemplate.Execute(buf, genModel)
+				if assert.NoError(t, err) {
+					ff, err := formatGoFile(k+".go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(ff)
+						assertInCode(t, "func All"+k+"Values() []"+k+" {", res)
+						assertInCode(t, values, res)
+						assertInCode(t, "func (m "+k+") IsValid() bool {", res)
+						assertInCode(t, "range All"+k+"Values()", res)
+						// the values are never kept in a package level variable
+						assertNotInCode(t, "var all"+k, res)
+					}
+				}
+			}
+		}
+
+		// no values function for non primitive enums
+		k := "SliceThing"
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				assertNotInCode(t, "func All"+k+"Values()", buf.String())
+			}
+		}
+	}
+}
+
 func TestEnum_ComposedThing(t *testing.T) {
 	specDoc, err := loads.Spec("../fixtures/codegen/todolist.enums.yml")
 	if assert.NoError(t, err) {
@@ -17,17 +17,23 @@ package generator
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"math"
 	"os"
+	"path"
 	"path/filepath"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/go-openapi/analysis"
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
 
@@ -58,7 +64,7 @@ func GenerateDefinition(modelNames []string, includeModel, includeValidator bool
 	compileTemplates()
 
 	// Load the spec
-	specPath, specDoc, err := loadSpec(opts.Spec)
+	specPath, specDoc, err := loadSpec(&opts)
 	if err != nil {
 		return err
 	}
@@ -75,6 +81,10 @@ func GenerateDefinition(modelNames []string, includeModel, includeValidator bool
 		if !ok {
 			return fmt.Errorf("model %q not found in definitions in %s", modelName, specPath)
 		}
+		if _, mapped := opts.typeOverride(modelName); mapped {
+			log.Printf("skipped model %s, mapped to a go type", modelName)
+			continue
+		}
 
 		// generate files
 		generator := definitionGenerator{
@@ -86,6 +96,7 @@ func GenerateDefinition(modelNames []string, includeModel, includeValidator bool
 			IncludeStruct:    includeModel,
 			IncludeValidator: includeValidator,
 			DumpData:         opts.DumpData,
+			Opts:             &opts,
 		}
 
 		if err := generator.Generate(); err != nil {
@@ -93,9 +104,411 @@ func GenerateDefinition(modelNames []string, includeModel, includeValidator bool
 		}
 	}
 
+	if opts.WithGob {
+		if err := generateGobRegistry(filepath.Join(opts.Target, opts.ModelPackage), gobTypes(specDoc, modelNames)); err != nil {
+			return err
+		}
+	}
+
+	if opts.SingleChar != "" && usesSingleChar(specDoc.Spec()) {
+		if err := generateChar(filepath.Join(opts.Target, opts.ModelPackage), opts.SingleChar); err != nil {
+			return err
+		}
+	}
+
+	if opts.WithDiff {
+		if err := generateDiff(filepath.Join(opts.Target, opts.ModelPackage)); err != nil {
+			return err
+		}
+	}
+
+	if opts.TypedErrors {
+		if err := generateValidationErrors(filepath.Join(opts.Target, opts.ModelPackage)); err != nil {
+			return err
+		}
+	}
+
+	if opts.SchemaRegistry {
+		if err := generateSchemaRegistry(filepath.Join(opts.Target, opts.ModelPackage), specDoc.Spec(), modelNames); err != nil {
+			return err
+		}
+	}
+
+	if opts.formatRegistry() {
+		formats, imports := customFormats(specDoc.Spec(), opts.ContextFormats)
+		if err := generateFormatRegistry(filepath.Join(opts.Target, opts.ModelPackage), formats, imports); err != nil {
+			return err
+		}
+	}
+
+	if usesFormat(specDoc.Spec(), "time") {
+		return generateTimeOfDay(filepath.Join(opts.Target, opts.ModelPackage))
+	}
 	return nil
 }
 
+// gobTypes returns the sorted go types of the concrete polymorphic models among the named definitions,
+// all of them when none is named
+func gobTypes(specDoc *loads.Document, modelNames []string) []string {
+	var types []string
+	for _, sub := range discriminatorInfo(analysis.New(specDoc.Spec())).Discriminated {
+		if len(modelNames) == 0 || containsString(modelNames, sub.JSONName) {
+			types = append(types, sub.GoType)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// generateGobRegistry renders the registration of the concrete polymorphic models with gob,
+// it does nothing when there is none
+func generateGobRegistry(target string, types []string) error {
+	if len(types) == 0 {
+		return nil
+	}
+	buf := bytes.NewBuffer(nil)
+	data := struct {
+		Package string
+		Types   []string
+	}{Package: mangleName(filepath.Base(target), "definitions"), Types: types}
+	if err := gobTemplate.Execute(buf, data); err != nil {
+		return err
+	}
+	log.Println("rendered gob registry template")
+	return writeToFile(target, "gob_registry", buf.Bytes())
+}
+
+// registeredSchema is the JSON schema of a definition, along with the definitions it refers to
+type registeredSchema struct {
+	Name   string
+	Schema string
+}
+
+// Literal renders the schema as a go string, raw unless the schema holds a backquote
+func (r registeredSchema) Literal() string {
+	if strings.Contains(r.Schema, "`") {
+		return strconv.Quote(r.Schema)
+	}
+	return "`" + r.Schema + "`"
+}
+
+// schemaRegistry returns the JSON schemas of the named definitions, all of them when none is named, sorted by name.
+// Every schema carries the definitions it refers to, down their own references, for it to validate on its own.
+func schemaRegistry(sw *spec.Swagger, modelNames []string) ([]registeredSchema, error) {
+	names := append([]string(nil), modelNames...)
+	if len(names) == 0 {
+		for name := range sw.Definitions {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	schemas := make([]registeredSchema, 0, len(names))
+	for _, name := range names {
+		sch, ok := sw.Definitions[name]
+		if !ok {
+			return nil, fmt.Errorf("model %q not found in definitions", name)
+		}
+		referred := make(spec.Definitions)
+		collectDefinitions(sw, &sch, referred)
+		if len(referred) > 0 {
+			sch.Definitions = referred
+		}
+		b, err := json.Marshal(sch)
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, registeredSchema{Name: name, Schema: string(b)})
+	}
+	return schemas, nil
+}
+
+// collectDefinitions adds the definitions a schema refers to, and the ones they refer to in turn.
+// The references out of the spec are left as they are.
+func collectDefinitions(sw *spec.Swagger, sch *spec.Schema, into spec.Definitions) {
+	const prefix = "#/definitions/"
+	schemaMatches(sch, func(s *spec.Schema) bool {
+		ref := s.Ref.String()
+		if !strings.HasPrefix(ref, prefix) {
+			return false
+		}
+		name := strings.TrimPrefix(ref, prefix)
+		if _, seen := into[name]; seen {
+			return false
+		}
+		def, ok := sw.Definitions[name]
+		if !ok {
+			return false
+		}
+		into[name] = def
+		collectDefinitions(sw, &def, into)
+		return false
+	})
+}
+
+// generateSchemaRegistry renders the map of the JSON schemas of the definitions, and the validation of a payload
+// against one of them
+func generateSchemaRegistry(target string, sw *spec.Swagger, modelNames []string) error {
+	schemas, err := schemaRegistry(sw, modelNames)
+	if err != nil {
+		return err
+	}
+	buf := bytes.NewBuffer(nil)
+	data := struct {
+		Package string
+		Schemas []registeredSchema
+	}{Package: mangleName(filepath.Base(target), "definitions"), Schemas: schemas}
+	if err := schemasTemplate.Execute(buf, data); err != nil {
+		return err
+	}
+	log.Println("rendered schema registry template")
+	return writeToFile(target, "schema_registry", buf.Bytes())
+}
+
+// customFormat is a format of the spec rendered as a go type hinted as a formatter by x-go-type
+type customFormat struct {
+	Name   string
+	GoType string
+}
+
+// customFormats returns the formats of the spec rendered as go types hinted as formatters, which the default
+// strfmt registry doesn't know, sorted by name, along with the packages of their types.
+// The formats validated with the request context are left out.
+func customFormats(sw *spec.Swagger, contextFormats []string) ([]customFormat, []string) {
+	types := make(map[string][]string)
+	pkgs := make(map[string]struct{})
+	usesSchema(sw, func(sch *spec.Schema) bool {
+		v, ok := sch.Extensions[xGoType]
+		if !ok || sch.Format == "" || strfmt.Default.ContainsName(sch.Format) || containsString(contextFormats, sch.Format) {
+			return false
+		}
+		name, formatter, _, err := goTypeExtension(v)
+		if err != nil || !formatter || strings.Contains(name, "[") {
+			return false
+		}
+		goType := name
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			pkg := name[:i]
+			if pkg != strfmtPkg {
+				pkgs[pkg] = struct{}{}
+			}
+			goType = path.Base(pkg) + name[i:]
+		}
+		if !containsString(types[sch.Format], goType) {
+			types[sch.Format] = append(types[sch.Format], goType)
+		}
+		return false
+	}, "")
+
+	formats := make([]customFormat, 0, len(types))
+	for name, goTypes := range types {
+		sort.Strings(goTypes)
+		if len(goTypes) > 1 {
+			log.Printf("warning: the format %q is rendered as %s, it is registered as %s", name, strings.Join(goTypes, " and "), goTypes[0])
+		}
+		formats = append(formats, customFormat{Name: name, GoType: goTypes[0]})
+	}
+	sort.Slice(formats, func(i, j int) bool { return formats[i].Name < formats[j].Name })
+	imports := make([]string, 0, len(pkgs))
+	for pkg := range pkgs {
+		imports = append(imports, pkg)
+	}
+	sort.Strings(imports)
+	return formats, imports
+}
+
+// generateFormatRegistry renders the registration of the custom formats with the default strfmt registry,
+// it does nothing when there is none
+func generateFormatRegistry(target string, formats []customFormat, imports []string) error {
+	if len(formats) == 0 {
+		return nil
+	}
+	buf := bytes.NewBuffer(nil)
+	data := struct {
+		Package string
+		Formats []customFormat
+		Imports []string
+	}{Package: mangleName(filepath.Base(target), "definitions"), Formats: formats, Imports: imports}
+	if err := formatsTemplate.Execute(buf, data); err != nil {
+		return err
+	}
+	log.Println("rendered format registry template")
+	return writeToFile(target, "format_registry", buf.Bytes())
+}
+
+// generateTimeOfDay renders the TimeOfDay type of format time in the models package
+func generateTimeOfDay(target string) error {
+	buf := bytes.NewBuffer(nil)
+	data := struct{ Package string }{Package: mangleName(filepath.Base(target), "definitions")}
+	if err := timeOfDayTemplate.Execute(buf, data); err != nil {
+		return err
+	}
+	log.Println("rendered time of day template")
+	return writeToFile(target, "time_of_day", buf.Bytes())
+}
+
+// usesFormat tells if a definition, a parameter or a header of the spec has the format
+func usesFormat(sw *spec.Swagger, format string) bool {
+	return usesSchema(sw, func(sch *spec.Schema) bool { return sch.Format == format }, format)
+}
+
+// usesSingleChar tells if a definition or the schema of a parameter or a response is a single character string
+func usesSingleChar(sw *spec.Swagger) bool {
+	return usesSchema(sw, isSingleChar, "")
+}
+
+// usesSchema tells if a definition or the schema of a parameter or a response matches,
+// or if a simple parameter or header has the format when it is not empty
+func usesSchema(sw *spec.Swagger, match func(*spec.Schema) bool, format string) bool {
+	for _, sch := range sw.Definitions {
+		if schemaMatches(&sch, match) {
+			return true
+		}
+	}
+	for _, param := range sw.Parameters {
+		if paramMatches(&param, match, format) {
+			return true
+		}
+	}
+	for _, resp := range sw.Responses {
+		if responseMatches(&resp, match, format) {
+			return true
+		}
+	}
+	if sw.Paths == nil {
+		return false
+	}
+	for _, pi := range sw.Paths.Paths {
+		for _, param := range pi.Parameters {
+			if paramMatches(&param, match, format) {
+				return true
+			}
+		}
+		for _, op := range []*spec.Operation{pi.Get, pi.Put, pi.Post, pi.Delete, pi.Options, pi.Head, pi.Patch} {
+			if op == nil {
+				continue
+			}
+			for _, param := range op.Parameters {
+				if paramMatches(&param, match, format) {
+					return true
+				}
+			}
+			if op.Responses == nil {
+				continue
+			}
+			if op.Responses.Default != nil && responseMatches(op.Responses.Default, match, format) {
+				return true
+			}
+			for _, resp := range op.Responses.StatusCodeResponses {
+				if responseMatches(&resp, match, format) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func paramMatches(param *spec.Parameter, match func(*spec.Schema) bool, format string) bool {
+	if param.Schema != nil {
+		return schemaMatches(param.Schema, match)
+	}
+	return format != "" && (param.Format == format || itemsUseFormat(param.Items, format))
+}
+
+func responseMatches(resp *spec.Response, match func(*spec.Schema) bool, format string) bool {
+	if resp.Schema != nil && schemaMatches(resp.Schema, match) {
+		return true
+	}
+	if format == "" {
+		return false
+	}
+	for _, hdr := range resp.Headers {
+		if hdr.Format == format || itemsUseFormat(hdr.Items, format) {
+			return true
+		}
+	}
+	return false
+}
+
+func itemsUseFormat(items *spec.Items, format string) bool {
+	for ; items != nil; items = items.Items {
+		if items.Format == format {
+			return true
+		}
+	}
+	return false
+}
+
+func schemaMatches(sch *spec.Schema, match func(*spec.Schema) bool) bool {
+	if sch == nil {
+		return false
+	}
+	if match(sch) {
+		return true
+	}
+	for _, prop := range sch.Properties {
+		if schemaMatches(&prop, match) {
+			return true
+		}
+	}
+	for i := range sch.AllOf {
+		if schemaMatches(&sch.AllOf[i], match) {
+			return true
+		}
+	}
+	if sch.Items != nil {
+		if schemaMatches(sch.Items.Schema, match) {
+			return true
+		}
+		for i := range sch.Items.Schemas {
+			if schemaMatches(&sch.Items.Schemas[i], match) {
+				return true
+			}
+		}
+	}
+	if sch.AdditionalProperties != nil && schemaMatches(sch.AdditionalProperties.Schema, match) {
+		return true
+	}
+	return sch.AdditionalItems != nil && schemaMatches(sch.AdditionalItems.Schema, match)
+}
+
+// generateDiff renders the FieldChange type returned by the DiffFrom methods of the models, and the comparison they share
+func generateDiff(target string) error {
+	buf := bytes.NewBuffer(nil)
+	data := struct{ Package string }{Package: mangleName(filepath.Base(target), "definitions")}
+	if err := diffTemplate.Execute(buf, data); err != nil {
+		return err
+	}
+	log.Println("rendered diff template")
+	return writeToFile(target, "field_change", buf.Bytes())
+}
+
+// generateValidationErrors renders the ValidationError type returned by the ValidateStructured methods of the models
+func generateValidationErrors(target string) error {
+	buf := bytes.NewBuffer(nil)
+	data := struct{ Package string }{Package: mangleName(filepath.Base(target), "definitions")}
+	if err := validationErrorsTemplate.Execute(buf, data); err != nil {
+		return err
+	}
+	log.Println("rendered validation errors template")
+	return writeToFile(target, "validation_error", buf.Bytes())
+}
+
+// generateChar renders the Char type of the single character strings in the models package
+func generateChar(target, underlying string) error {
+	buf := bytes.NewBuffer(nil)
+	data := struct {
+		Package    string
+		Underlying string
+	}{Package: mangleName(filepath.Base(target), "definitions"), Underlying: underlying}
+	if err := charTemplate.Execute(buf, data); err != nil {
+		return err
+	}
+	log.Println("rendered char template")
+	return writeToFile(target, "char", buf.Bytes())
+}
+
 type definitionGenerator struct {
 	Name             string
 	Model            spec.Schema
@@ -106,11 +519,12 @@ type definitionGenerator struct {
 	IncludeValidator bool
 	Data             interface{}
 	DumpData         bool
+	Opts             *GenOpts
 }
 
 func (m *definitionGenerator) Generate() error {
 
-	mod, err := makeGenDefinition(m.Name, m.Target, m.Model, m.SpecDoc, m.IncludeValidator, m.IncludeStruct)
+	mod, err := makeGenDefinitionHierarchy(m.Name, m.Target, "", m.Model, m.SpecDoc, m.IncludeValidator, m.IncludeStruct, m.Opts)
 	if err != nil {
 		return err
 	}
@@ -155,15 +569,68 @@ func (m *definitionGenerator) generateModel() error {
 }
 
 func makeGenDefinition(name, pkg string, schema spec.Schema, specDoc *loads.Document, includeValidator, includeModel bool) (*GenDefinition, error) {
-	return makeGenDefinitionHierarchy(name, pkg, "", schema, specDoc, includeValidator, includeModel)
+	return makeGenDefinitionHierarchy(name, pkg, "", schema, specDoc, includeValidator, includeModel, nil)
 }
-func makeGenDefinitionHierarchy(name, pkg, container string, schema spec.Schema, specDoc *loads.Document, includeValidator, includeModel bool) (*GenDefinition, error) {
+
+// allOfCycle returns the path of the definitions embedded through their allOf members back into the named one,
+// empty when there is none. Unlike the properties referring to another model, which are pointers, an embedded
+// model is a value and such a cycle makes an invalid recursive go type.
+func allOfCycle(name string, schema *spec.Schema, defs spec.Definitions, path []string) []string {
+	path = append(path, name)
+	for _, member := range schema.AllOf {
+		frag := member.Ref.GetURL()
+		if frag == nil || !strings.HasPrefix(frag.Fragment, "/definitions/") {
+			continue
+		}
+		embedded := strings.TrimPrefix(frag.Fragment, "/definitions/")
+		if embedded == path[0] {
+			return append(path, embedded)
+		}
+		if containsString(path, embedded) {
+			// a cycle which doesn't go through this definition
+			continue
+		}
+		def, ok := defs[embedded]
+		if !ok {
+			continue
+		}
+		if cycle := allOfCycle(embedded, &def, defs, path); len(cycle) > 0 {
+			return cycle
+		}
+	}
+	return nil
+}
+
+func makeGenDefinitionHierarchy(name, pkg, container string, schema spec.Schema, specDoc *loads.Document, includeValidator, includeModel bool, opts *GenOpts) (*GenDefinition, error) {
 	receiver := "m"
 	resolver := newTypeResolver("", specDoc)
 	resolver.ModelName = name
+	resolver.Opts = opts
 	analyzed := analysis.New(specDoc.Spec())
 
+	unwrapped, inner, err := unwrapProperty(&schema)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	if inner != nil {
+		// the model becomes the type of the property, its docs are the ones of the wrapper
+		wrapper := schema
+		schema = *inner
+		if wrapper.Title != "" || wrapper.Description != "" {
+			schema.Title = wrapper.Title
+			schema.Description = wrapper.Description
+		}
+	}
+
+	if cycle := allOfCycle(name, &schema, specDoc.Spec().Definitions, nil); len(cycle) > 0 {
+		return nil, fmt.Errorf("%s: the allOf members embed each other without end: %s", name, strings.Join(cycle, " -> "))
+	}
+
 	di := discriminatorInfo(analyzed)
+	if dse, ok := di.Discriminated["#/definitions/"+name]; ok && !hasAllOfRef(&schema, dse.ParentRef) {
+		// a subtype of a subtype composes the members of its parents with its own ones
+		schema.AllOf = inheritedAllOf(&schema, specDoc, di)
+	}
 
 	pg := schemaGenContext{
 		Path:             "",
@@ -208,11 +675,10 @@ func makeGenDefinitionHierarchy(name, pkg, container string, schema spec.Schema,
 		// check if it has a discriminator defined
 		// when it has a discriminator get the schema and run makeGenSchema for it.
 		// replace the ref with this new genschema
-		swsp := specDoc.Spec()
 		for i, ss := range schema.AllOf {
 			ref := ss.Ref
 			for ref.String() != "" {
-				rsch, err := spec.ResolveRef(swsp, &ref)
+				rsch, err := resolver.resolveRef(&ref)
 				if err != nil {
 					return nil, err
 				}
@@ -223,7 +689,7 @@ func makeGenDefinitionHierarchy(name, pkg, container string, schema spec.Schema,
 				}
 				ref = spec.Ref{}
 				if rsch != nil && rsch.Discriminator != "" {
-					gs, err := makeGenDefinitionHierarchy(strings.TrimPrefix(ss.Ref.String(), "#/definitions/"), pkg, pg.GenSchema.Name, *rsch, specDoc, pg.IncludeValidator, pg.IncludeModel)
+					gs, err := makeGenDefinitionHierarchy(strings.TrimPrefix(ss.Ref.String(), "#/definitions/"), pkg, pg.GenSchema.Name, *rsch, specDoc, pg.IncludeValidator, pg.IncludeModel, opts)
 					if err != nil {
 						return nil, err
 					}
@@ -246,6 +712,97 @@ func makeGenDefinitionHierarchy(name, pkg, container string, schema spec.Schema,
 		}
 	}
 
+	withPatch := boolExtension(schema.Extensions, xGoPatch)
+	if withPatch != nil && *withPatch {
+		if err := checkPatchable(&pg.GenSchema); err != nil {
+			return nil, err
+		}
+	}
+
+	if resolver.dirtyTracking() {
+		trackDirtyFields(&pg.GenSchema)
+		for k, extra := range pg.ExtraSchemas {
+			trackDirtyFields(&extra)
+			pg.ExtraSchemas[k] = extra
+		}
+	}
+
+	if resolver.withScrub() {
+		scrubFields(&pg.GenSchema)
+		for k, extra := range pg.ExtraSchemas {
+			scrubFields(&extra)
+			pg.ExtraSchemas[k] = extra
+		}
+	}
+
+	models := make(map[string]struct{}, len(specDoc.Spec().Definitions))
+	for k, v := range specDoc.Spec().Definitions {
+		models[pascalize(k)] = struct{}{}
+		if gn, ok := v.Extensions.GetString("x-go-name"); ok {
+			models[gn] = struct{}{}
+		}
+	}
+	if !resolver.Opts.compat().NoEnumConsts {
+		enumConsts(&pg.GenSchema, models)
+		for k, extra := range pg.ExtraSchemas {
+			enumConsts(&extra, models)
+			pg.ExtraSchemas[k] = extra
+		}
+	}
+
+	if resolver.withNormalize() {
+		normalizeFields(&pg.GenSchema)
+		for k, extra := range pg.ExtraSchemas {
+			normalizeFields(&extra)
+			pg.ExtraSchemas[k] = extra
+		}
+	}
+
+	if resolver.withFieldMask() {
+		fieldMaskFields(&pg.GenSchema)
+		for k, extra := range pg.ExtraSchemas {
+			fieldMaskFields(&extra)
+			pg.ExtraSchemas[k] = extra
+		}
+	}
+
+	if resolver.withDiff() {
+		diffFields(&pg.GenSchema)
+		for k, extra := range pg.ExtraSchemas {
+			diffFields(&extra)
+			pg.ExtraSchemas[k] = extra
+		}
+	}
+
+	if resolver.splitReadWrite() && unwrapped == "" {
+		splitReadWrite(&pg.GenSchema, models)
+	}
+
+	if resolver.extraFields() && unwrapped == "" {
+		captureExtraFields(&pg.GenSchema)
+		if pg.GenSchema.HasExtraFields && embeddedInAllOf(specDoc.Spec(), name) {
+			// the promoted serializers would take over the JSON of the composition
+			log.Printf("warning: %s: the unknown fields of a model embedded by an allOf composition are left out", name)
+			pg.GenSchema.HasExtraFields = false
+		}
+		for k, extra := range pg.ExtraSchemas {
+			captureExtraFields(&extra)
+			pg.ExtraSchemas[k] = extra
+		}
+	}
+
+	if resolver.withGob() && pg.GenSchema.IsSubType && pg.GenSchema.IsExported {
+		pg.GenSchema.HasGob = true
+	}
+
+	if resolver.withBuilder() && unwrapped == "" && buildable(&schema) && pg.GenSchema.IsExported {
+		pg.GenSchema.HasBuilder = true
+		for i, p := range pg.GenSchema.Properties {
+			prop := schema.Properties[p.Name]
+			pg.GenSchema.Properties[i].HasBuilder = nestedBuildable(&p, &prop, specDoc)
+		}
+	}
+
 	var defaultImports []string
 	if pg.GenSchema.HasValidations {
 		defaultImports = []string{
@@ -254,6 +811,12 @@ func makeGenDefinitionHierarchy(name, pkg, container string, schema spec.Schema,
 			"github.com/go-openapi/validate",
 		}
 	}
+	if pg.GenSchema.HasValidations && resolver.tuplesAsSlices() {
+		defaultImports = append(defaultImports,
+			"github.com/go-openapi/spec",
+			"github.com/go-openapi/swag",
+		)
+	}
 	var extras []GenSchema
 	var extraKeys []string
 	for k := range pg.ExtraSchemas {
@@ -264,15 +827,772 @@ func makeGenDefinitionHierarchy(name, pkg, container string, schema spec.Schema,
 		extras = append(extras, pg.ExtraSchemas[k])
 	}
 
+	if withPatch != nil && *withPatch {
+		defaultImports = append(defaultImports, "encoding/json", "sort")
+	}
+	if (unwrapped != "" || pg.GenSchema.FixedSize > 0 || pg.GenSchema.HasPolymorphicItems || hasExtraFields(&pg.GenSchema, extras)) && !containsString(defaultImports, "encoding/json") {
+		defaultImports = append(defaultImports, "encoding/json")
+	}
+	if normalizesStrings(&pg.GenSchema, extras) && !containsString(defaultImports, "strings") {
+		defaultImports = append(defaultImports, "strings")
+	}
+	if hasUnion(&pg.GenSchema, extras) {
+		for _, imp := range []string{"encoding/json", "fmt"} {
+			if !containsString(defaultImports, imp) {
+				defaultImports = append(defaultImports, imp)
+			}
+		}
+	}
+	for _, imp := range resolver.importList() {
+		if !containsString(defaultImports, imp) {
+			defaultImports = append(defaultImports, imp)
+		}
+	}
+	if needsContextValidation(&pg.GenSchema, extras) {
+		for _, imp := range []string{"context", "github.com/go-openapi/errors", "github.com/go-openapi/swag", "github.com/go-openapi/validate"} {
+			if !containsString(defaultImports, imp) {
+				defaultImports = append(defaultImports, imp)
+			}
+		}
+	}
+
 	return &GenDefinition{
-		Package:        mangleName(filepath.Base(pkg), "definitions"),
-		GenSchema:      pg.GenSchema,
-		DependsOn:      pg.Dependencies,
-		DefaultImports: defaultImports,
-		ExtraSchemas:   extras,
+		Package:           mangleName(filepath.Base(pkg), "definitions"),
+		GenSchema:         pg.GenSchema,
+		DependsOn:         pg.Dependencies,
+		DefaultImports:    defaultImports,
+		ExtraSchemas:      extras,
+		WithPatch:         withPatch != nil && *withPatch,
+		UnwrapProperty:    unwrapped,
+		PositionalSchemas: positionalSchemas(&pg.GenSchema, extras),
 	}, nil
 }
 
+// captureExtraFields keeps the unknown fields of the JSON objects unmarshaled into a plain struct.
+// Objects with additional properties already capture them in their map, while compositions and
+// polymorphic types have their fields spread across types.
+func captureExtraFields(gs *GenSchema) {
+	if !gs.IsExported || !gs.IsComplexObject || gs.IsTuple || gs.IsAdditionalProperties || gs.IsInterface {
+		return
+	}
+	if gs.IsBaseType || gs.IsSubType || gs.HasBaseType || len(gs.AllOf) > 0 {
+		return
+	}
+	for _, p := range gs.Properties {
+		if pascalize(p.Name) == "Extra" {
+			log.Printf("warning: %s: the property %s collides with the Extra field, the unknown fields are left out", gs.Name, p.Name)
+			return
+		}
+	}
+	gs.HasExtraFields = true
+}
+
+// embeddedInAllOf returns true when a schema of the definitions embeds the named definition as a member of an allOf
+func embeddedInAllOf(sw *spec.Swagger, name string) bool {
+	ref := "#/definitions/" + name
+	for _, sch := range sw.Definitions {
+		embeds := schemaMatches(&sch, func(s *spec.Schema) bool {
+			for _, member := range s.AllOf {
+				if member.Ref.String() == ref {
+					return true
+				}
+			}
+			return false
+		})
+		if embeds {
+			return true
+		}
+	}
+	return false
+}
+
+// hasExtraFields returns true when the model or one of its inline schemas captures its unknown fields
+func hasExtraFields(gs *GenSchema, extras []GenSchema) bool {
+	if gs.HasExtraFields {
+		return true
+	}
+	for _, extra := range extras {
+		if extra.HasExtraFields {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizesStrings returns true when the Normalize method of the model or of one of its inline schemas
+// trims or lowercases a string
+func normalizesStrings(gs *GenSchema, extras []GenSchema) bool {
+	for _, s := range append([]GenSchema{*gs}, extras...) {
+		for _, f := range s.NormalizeFields {
+			if f.Trim || f.Lowercase {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// positionalSchemas returns the model and the inline schemas which validate positional items,
+// sorted by the name of the variable holding their parsed schema
+func positionalSchemas(gs *GenSchema, extras []GenSchema) []GenSchema {
+	byVar := make(map[string]GenSchema)
+	var walk func(*GenSchema)
+	walk = func(s *GenSchema) {
+		if s == nil {
+			return
+		}
+		if s.PositionalItems != "" {
+			byVar[s.PositionalItemsVar] = *s
+		}
+		walk(s.Items)
+		walk(s.AdditionalItems)
+		walk(s.AdditionalProperties)
+		for i := range s.Properties {
+			walk(&s.Properties[i])
+		}
+		for i := range s.AllOf {
+			walk(&s.AllOf[i])
+		}
+	}
+	walk(gs)
+	for i := range extras {
+		walk(&extras[i])
+	}
+	names := make([]string, 0, len(byVar))
+	for k := range byVar {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	result := make([]GenSchema, 0, len(names))
+	for _, k := range names {
+		result = append(result, byVar[k])
+	}
+	return result
+}
+
+// hasUnion returns true when the model or one of its inline schemas is a oneOf or anyOf union
+func hasUnion(gs *GenSchema, extras []GenSchema) bool {
+	if gs.IsOneOf || gs.IsAnyOf {
+		return true
+	}
+	for _, extra := range extras {
+		if extra.IsOneOf || extra.IsAnyOf {
+			return true
+		}
+	}
+	return false
+}
+
+// needsContextValidation returns true when the model or one of its inline schemas
+// renders a ContextValidate method
+func needsContextValidation(gs *GenSchema, extras []GenSchema) bool {
+	if gs.HasContextValidations {
+		return true
+	}
+	for _, extra := range extras {
+		if extra.HasContextValidations {
+			return true
+		}
+	}
+	return false
+}
+
+// contextValidatable returns true when a ContextValidate method can reach the context-aware
+// formats of this schema: a model, or a slice or map of models or of context-aware primitives
+func contextValidatable(gs *GenSchema) bool {
+	switch {
+	case gs.IsArray && gs.Items != nil:
+		return contextElement(gs.Items)
+	case gs.IsMap && gs.AdditionalProperties != nil:
+		return contextElement(gs.AdditionalProperties)
+	default:
+		return !gs.IsAnonymous && (gs.IsComplexObject || gs.IsArray || gs.IsMap)
+	}
+}
+
+func contextElement(gs *GenSchema) bool {
+	if gs.ContextFormat != "" {
+		return gs.IsPrimitive
+	}
+	return gs.IsComplexObject && !gs.IsAnonymous
+}
+
+// narrowEnum validates an enum declared next to a $ref on top of the referenced type,
+// which only works for primitive types: their values compare as they are.
+func narrowEnum(name string, gs *GenSchema, enum []interface{}, tpe ResolvedType, referenced *spec.Schema) error {
+	if !tpe.IsPrimitive {
+		return fmt.Errorf("%s: an enum next to a $ref only narrows primitive types, not %s", name, gs.GoType)
+	}
+	if len(referenced.Enum) > 0 {
+		for _, v := range enum {
+			var found bool
+			for _, allowed := range referenced.Enum {
+				if reflect.DeepEqual(v, allowed) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				log.Printf("warning: %s: %v is not a value of %s, it will never validate", name, v, gs.GoType)
+			}
+		}
+	}
+	// an enum lifted out of an allOf isn't part of the shared validations yet
+	gs.Enum = enum
+	gs.HasValidations = true
+	gs.NeedsValidation = true
+	return nil
+}
+
+// checkEnum validates the values of an enum against the type and the format they are declared with,
+// as a mistyped value would render as a malformed constant. A null value needs the type to be nullable.
+func checkEnum(name, tpe, format string, enum []interface{}, nullable bool) error {
+	for _, v := range enum {
+		if v == nil {
+			if nullable {
+				continue
+			}
+			return fmt.Errorf("%s: enum value null is only allowed when the %s is x-nullable", name, tpe)
+		}
+		if mismatch := enumMismatch(tpe, format, v); mismatch != "" {
+			value, _ := json.Marshal(v)
+			return fmt.Errorf("%s: enum value %s %s", name, value, mismatch)
+		}
+	}
+	return nil
+}
+
+// checkConst validates the value of a const keyword against the type and the format it is declared with,
+// the same way as the values of an enum. The swagger 2.0 object model keeps const with the extra properties.
+func checkConst(name, tpe, format string, v interface{}, nullable bool) error {
+	if v == nil {
+		if nullable {
+			return nil
+		}
+		return fmt.Errorf("%s: const value null is only allowed when the %s is x-nullable", name, tpe)
+	}
+	if mismatch := enumMismatch(tpe, format, v); mismatch != "" {
+		value, _ := json.Marshal(v)
+		return fmt.Errorf("%s: const value %s %s", name, value, mismatch)
+	}
+	return nil
+}
+
+// integerBounds are the ranges of the integer formats, a float64 can't tell the largest 64 bit integers apart
+var integerBounds = map[string][2]float64{
+	"int8":   {math.MinInt8, math.MaxInt8},
+	"int16":  {math.MinInt16, math.MaxInt16},
+	"int32":  {math.MinInt32, math.MaxInt32},
+	"int64":  {math.MinInt64, math.MaxInt64},
+	"uint":   {0, math.MaxUint64},
+	"uint8":  {0, math.MaxUint8},
+	"uint16": {0, math.MaxUint16},
+	"uint32": {0, math.MaxUint32},
+	"uint64": {0, math.MaxUint64},
+}
+
+// boundsMismatch tells which bounds of an integer lie beyond the range of its format: such a bound never applies,
+// the values out of that range are rejected when unmarshaling already
+func boundsMismatch(format string, minimum, maximum *float64) []string {
+	bounds, ok := integerBounds[format]
+	if !ok {
+		return nil
+	}
+	var res []string
+	if minimum != nil && (*minimum < bounds[0] || *minimum > bounds[1]) {
+		res = append(res, fmt.Sprintf("minimum %v is beyond the range of the %s format", *minimum, format))
+	}
+	if maximum != nil && (*maximum < bounds[0] || *maximum > bounds[1]) {
+		res = append(res, fmt.Sprintf("maximum %v is beyond the range of the %s format", *maximum, format))
+	}
+	return res
+}
+
+// warnBounds reports the bounds of an integer which lie beyond the range of its format
+func warnBounds(name, tpe, format string, minimum, maximum *float64) {
+	if tpe != integer {
+		return
+	}
+	for _, mismatch := range boundsMismatch(format, minimum, maximum) {
+		log.Printf("warning: %s: %s", name, mismatch)
+	}
+}
+
+// enumMismatch tells why an enum value doesn't fit the declared type, it is empty when the value fits
+// or when the type doesn't render as a constant
+func enumMismatch(tpe, format string, v interface{}) string {
+	switch tpe {
+	case str:
+		if _, ok := v.(string); !ok {
+			return "is not a string"
+		}
+	case boolean:
+		if _, ok := v.(bool); !ok {
+			return "is not a boolean"
+		}
+	case integer:
+		f, ok := enumNumber(v)
+		if !ok || f != math.Trunc(f) {
+			return "is not an integer"
+		}
+		if bounds, ok := integerBounds[format]; ok && (f < bounds[0] || f > bounds[1]) {
+			return "overflows the " + format + " format"
+		}
+	case number:
+		f, ok := enumNumber(v)
+		if !ok {
+			return "is not a number"
+		}
+		if format == "float" && math.Abs(f) > math.MaxFloat32 {
+			return "overflows the float format"
+		}
+	}
+	return ""
+}
+
+// enumNumber returns the value of a numeric enum value, which may come as any go number from the spec
+func enumNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// isDeprecated returns true when the schema is marked with deprecated: true,
+// which swagger 2.0 schemas don't define so it comes as an extra property
+func isDeprecated(schema *spec.Schema) bool {
+	deprecated, ok := schema.ExtraProps["deprecated"].(bool)
+	return ok && deprecated
+}
+
+// docLinks returns the x-doc-note and the external docs with a url of the schema,
+// made safe to render in the block comment of a type with the legacy docs
+func docLinks(schema *spec.Schema, legacyDocs bool) (string, *spec.ExternalDocumentation) {
+	safe := func(s string) string { return s }
+	if legacyDocs {
+		safe = commentSafe
+	}
+	note, _ := schema.Extensions.GetString(xDocNote)
+	note = safe(strings.TrimSpace(note))
+	if schema.ExternalDocs == nil || schema.ExternalDocs.URL == "" {
+		return note, nil
+	}
+	return note, &spec.ExternalDocumentation{
+		Description: safe(strings.TrimSpace(schema.ExternalDocs.Description)),
+		URL:         safe(schema.ExternalDocs.URL),
+	}
+}
+
+// commentSafe breaks the sequences which would end a block comment early
+func commentSafe(s string) string {
+	return strings.Replace(s, "*/", "*\\/", -1)
+}
+
+// checkPatchable verifies a merge patch type can be generated for this schema,
+// which needs a plain struct without polymorphism.
+func checkPatchable(gs *GenSchema) error {
+	if !gs.IsComplexObject || gs.IsTuple || gs.IsAdditionalProperties || len(gs.AllOf) > 0 {
+		return fmt.Errorf("%s: %s only applies to objects with plain properties", gs.Name, xGoPatch)
+	}
+	if gs.IsBaseType || gs.IsSubType || gs.HasBaseType {
+		return fmt.Errorf("%s: %s doesn't support polymorphic types", gs.Name, xGoPatch)
+	}
+	return nil
+}
+
+// maxDirtyFields is the number of properties the changed bitset of a model can track
+const maxDirtyFields = 64
+
+// trackDirtyFields lists the properties which get a setter recording their change,
+// the ones of the anonymous allOf members included. Polymorphic types, tuples and maps aren't tracked.
+func trackDirtyFields(gs *GenSchema) {
+	if !gs.IsExported || !gs.IsComplexObject || gs.IsTuple || gs.IsAdditionalProperties || gs.IsInterface {
+		return
+	}
+	if gs.IsBaseType || gs.IsSubType || gs.HasBaseType {
+		return
+	}
+	var fields GenSchemaList
+	for _, sch := range gs.AllOf {
+		if sch.IsAnonymous {
+			fields = append(fields, sch.Properties...)
+		}
+	}
+	fields = append(fields, gs.Properties...)
+	if len(fields) > maxDirtyFields {
+		log.Printf("warning: %s: dirty tracking supports up to %d properties, %d are left untracked", gs.Name, maxDirtyFields, len(fields))
+		return
+	}
+
+	names := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		names[pascalize(f.Name)] = struct{}{}
+	}
+	for _, f := range fields {
+		nm := pascalize(f.Name)
+		if _, ok := names["Set"+nm]; ok || nm == "ChangedFields" {
+			log.Printf("warning: %s: the property %s collides with a dirty tracking method, %s is left untracked", gs.Name, f.Name, gs.Name)
+			return
+		}
+	}
+	gs.DirtyTracking = true
+	gs.DirtyFields = fields
+}
+
+// scrubFields lists the properties copied by the Scrub method, the ones of the anonymous allOf
+// members included. Polymorphic types, tuples and maps get no Scrub method.
+func scrubFields(gs *GenSchema) {
+	if !gs.IsExported || !gs.IsComplexObject || gs.IsTuple || gs.IsAdditionalProperties || gs.IsInterface {
+		return
+	}
+	if gs.IsBaseType || gs.IsSubType || gs.HasBaseType {
+		return
+	}
+	var fields GenSchemaList
+	for _, sch := range gs.AllOf {
+		if sch.IsAnonymous {
+			fields = append(fields, sch.Properties...)
+		}
+	}
+	fields = append(fields, gs.Properties...)
+	for i, f := range fields {
+		if pascalize(f.Name) == "Scrub" {
+			log.Printf("warning: %s: the property %s collides with the Scrub method, %s gets none", gs.Name, f.Name, gs.Name)
+			return
+		}
+		if f.IsSensitive {
+			fields[i].Redacted = redacted(&f)
+		}
+	}
+	gs.HasScrub = true
+	gs.ScrubFields = fields
+}
+
+// fieldMaskFields lists the properties a field mask can name, the ones of the anonymous allOf
+// members included. Polymorphic types, tuples and maps get no ApplyFieldMask method.
+func fieldMaskFields(gs *GenSchema) {
+	if !gs.IsExported || !gs.IsComplexObject || gs.IsTuple || gs.IsAdditionalProperties || gs.IsInterface {
+		return
+	}
+	if gs.IsBaseType || gs.IsSubType || gs.HasBaseType {
+		return
+	}
+	var fields GenSchemaList
+	for _, sch := range gs.AllOf {
+		if sch.IsAnonymous {
+			fields = append(fields, sch.Properties...)
+		}
+	}
+	fields = append(fields, gs.Properties...)
+	for _, f := range fields {
+		if pascalize(f.Name) == "ApplyFieldMask" {
+			log.Printf("warning: %s: the property %s collides with the ApplyFieldMask method, %s gets none", gs.Name, f.Name, gs.Name)
+			return
+		}
+	}
+	gs.HasFieldMask = true
+	gs.FieldMaskFields = fields
+}
+
+// normalizeFields lists the properties cleaned up by the Normalize method, the ones of the anonymous allOf
+// members included, with the go expression of their defaults. Polymorphic types, tuples and maps get
+// no Normalize method.
+func normalizeFields(gs *GenSchema) {
+	if !gs.IsExported || !gs.IsComplexObject || gs.IsTuple || gs.IsAdditionalProperties || gs.IsInterface {
+		return
+	}
+	if gs.IsBaseType || gs.IsSubType || gs.HasBaseType {
+		return
+	}
+	var fields GenSchemaList
+	for _, sch := range gs.AllOf {
+		if sch.IsAnonymous {
+			fields = append(fields, sch.Properties...)
+		}
+	}
+	fields = append(fields, gs.Properties...)
+	for i, f := range fields {
+		if pascalize(f.Name) == "Normalize" {
+			log.Printf("warning: %s: the property %s collides with the Normalize method, %s gets none", gs.Name, f.Name, gs.Name)
+			return
+		}
+		if (f.Trim || f.Lowercase) && !holdsString(&f) {
+			log.Printf("warning: %s: the property %s is not a string, it is not trimmed nor lowercased", gs.Name, f.Name)
+			fields[i].Trim, fields[i].Lowercase = false, false
+		}
+		fields[i].DefaultValue = defaultValue(&f)
+	}
+	gs.HasNormalize = true
+	gs.NormalizeFields = fields
+}
+
+// holdsString returns true when the type of the schema converts to and from a string
+func holdsString(gs *GenSchema) bool {
+	if gs.SwaggerType != str || gs.IsArray || gs.IsMap {
+		return false
+	}
+	zero := gs.zeroValue()
+	return zero == `""` || strings.HasSuffix(zero, `("")`) || (gs.SwaggerFormat == "" && gs.IsAliased)
+}
+
+// defaultValue returns the go expression of the default of a primitive property, or of an array or a map,
+// empty when there is none or when it is no literal of the type, like the default of a date
+func defaultValue(gs *GenSchema) string {
+	if gs.IsArray || gs.IsMap {
+		if zero := gs.Zero(); gs.hasDefault && zero != "nil" && !strings.HasPrefix(zero, "make(") {
+			return zero
+		}
+		return ""
+	}
+	if gs.Default == nil || !gs.IsPrimitive || gs.IsStream {
+		return ""
+	}
+	var literal string
+	switch v := gs.Default.(type) {
+	case string:
+		if !holdsString(gs) {
+			return ""
+		}
+		literal = strconv.Quote(v)
+	case float64:
+		if (gs.SwaggerType != number && gs.SwaggerType != integer) || (gs.SwaggerType == integer && v != math.Trunc(v)) {
+			return ""
+		}
+		literal = asGoLiteral(v)
+	case bool:
+		if gs.SwaggerType != boolean {
+			return ""
+		}
+		literal = strconv.FormatBool(v)
+	default:
+		return ""
+	}
+	if gs.GoType == "string" || gs.GoType == "bool" {
+		return literal
+	}
+	return gs.GoType + "(" + literal + ")"
+}
+
+// enumConsts names the go constants declared for the enum values of a named string or numeric model,
+// and for the ones of the properties of an object, the anonymous allOf members included. The constants
+// of a property are prefixed with the names of the model and of the property, the ones named like a model
+// are skipped.
+func enumConsts(gs *GenSchema, models map[string]struct{}) {
+	if !gs.IsExported || gs.Name == "" {
+		return
+	}
+	if !gs.IsComplexObject {
+		if !gs.IsAdditionalProperties && !gs.IsTuple {
+			gs.EnumConsts = enumConstsFor(pascalize(gs.Name), pascalize(gs.Name), &gs.ResolvedType, models)
+		}
+		return
+	}
+	if gs.IsTuple || gs.IsAdditionalProperties {
+		return
+	}
+	var fields GenSchemaList
+	for _, sch := range gs.AllOf {
+		if sch.IsAnonymous {
+			fields = append(fields, sch.Properties...)
+		}
+	}
+	fields = append(fields, gs.Properties...)
+	for _, f := range fields {
+		if _, isModel := models[f.GoType]; isModel {
+			// the constants of a model are declared with the model
+			continue
+		}
+		prefix := pascalize(gs.Name) + pascalize(f.Name)
+		if _, isModel := models[prefix]; isModel {
+			continue
+		}
+		gs.EnumConsts = append(gs.EnumConsts, enumConstsFor(prefix, f.GoType, &f.ResolvedType, models)...)
+	}
+}
+
+// enumConstsFor declares a constant of the go type tpe for every enum value of the resolved type,
+// named after the value sanitized into a go identifier and suffixed with a number when the name is taken.
+// No constant is declared when a value is not a literal of the type.
+func enumConstsFor(prefix, tpe string, rt *ResolvedType, models map[string]struct{}) []GenEnumConst {
+	if len(rt.EnumValues) == 0 {
+		return nil
+	}
+	unsigned := strings.HasPrefix(rt.GoType, "uint") || strings.HasPrefix(rt.AliasedType, "uint")
+	consts := make([]GenEnumConst, 0, len(rt.EnumValues))
+	seen := make(map[string]bool, len(rt.EnumValues))
+	for _, value := range rt.EnumValues {
+		var name string
+		switch v := value.(type) {
+		case string:
+			if rt.SwaggerType != str {
+				return nil
+			}
+			name = v
+		case float64:
+			if rt.SwaggerType == str || (rt.SwaggerType == integer && v != math.Trunc(v)) || (unsigned && v < 0) {
+				return nil
+			}
+			name = strings.Replace(asGoLiteral(v), "-", "minus ", 1)
+			name = strings.Replace(name, ".", " dot ", 1)
+		default:
+			return nil
+		}
+		name = prefix + enumConstIdentifier(name)
+		if _, isModel := models[name]; isModel {
+			continue
+		}
+		unique := name
+		for i := 2; seen[unique]; i++ {
+			unique = name + strconv.Itoa(i)
+		}
+		seen[unique] = true
+		consts = append(consts, GenEnumConst{Name: unique, GoType: tpe, Value: asGoLiteral(value)})
+	}
+	return consts
+}
+
+// enumConstIdentifier sanitizes an enum value into the suffix of a go identifier,
+// the empty value becomes Empty and the ones without any letter or digit become Value
+func enumConstIdentifier(value string) string {
+	if value == "" {
+		return "Empty"
+	}
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return ' '
+	}, value)
+	name = swag.ToGoName(name)
+	if name == "" {
+		return "Value"
+	}
+	return name
+}
+
+// diffFields lists the properties compared by DiffFrom, the ones of the anonymous allOf members included.
+// Polymorphic types, tuples and maps get no DiffFrom method.
+func diffFields(gs *GenSchema) {
+	if !gs.IsExported || !gs.IsComplexObject || gs.IsTuple || gs.IsAdditionalProperties || gs.IsInterface {
+		return
+	}
+	if gs.IsBaseType || gs.IsSubType || gs.HasBaseType {
+		return
+	}
+	var fields GenSchemaList
+	for _, sch := range gs.AllOf {
+		if sch.IsAnonymous {
+			fields = append(fields, sch.Properties...)
+		}
+	}
+	fields = append(fields, gs.Properties...)
+	for _, f := range fields {
+		if pascalize(f.Name) == "DiffFrom" {
+			log.Printf("warning: %s: the property %s collides with the DiffFrom method, %s gets none", gs.Name, f.Name, gs.Name)
+			return
+		}
+	}
+	gs.HasDiff = true
+	gs.DiffFields = fields
+}
+
+// splitReadWrite lists the properties of the Request and Response variants of a model with read-only
+// or write-only properties. Compositions, polymorphic types, tuples and maps get no variants.
+func splitReadWrite(gs *GenSchema, models map[string]struct{}) {
+	if !gs.IsExported || !gs.IsComplexObject || gs.IsTuple || gs.IsAdditionalProperties || len(gs.AllOf) > 0 {
+		return
+	}
+	if gs.IsBaseType || gs.IsSubType || gs.HasBaseType {
+		return
+	}
+	var request, response GenSchemaList
+	for _, p := range gs.Properties {
+		if !p.IsReadOnly {
+			request = append(request, p)
+		}
+		if !p.IsWriteOnly {
+			response = append(response, p)
+		}
+	}
+	if len(request) == len(gs.Properties) && len(response) == len(gs.Properties) {
+		return
+	}
+	for _, suffix := range []string{"Request", "Response"} {
+		if _, ok := models[pascalize(gs.Name)+suffix]; ok {
+			log.Printf("warning: %s: the definition %s%s collides with a variant, %s gets none", gs.Name, pascalize(gs.Name), suffix, gs.Name)
+			return
+		}
+	}
+	gs.SplitReadWrite = true
+	gs.RequestFields = request
+	gs.ResponseFields = response
+}
+
+// redactionMarker replaces the sensitive strings scrubbed from a model
+const redactionMarker = "[REDACTED]"
+
+// redacted returns the go literal of a scrubbed sensitive string, empty when the type doesn't hold a string
+func redacted(gs *GenSchema) string {
+	if gs.SwaggerType != str || gs.IsArray || gs.IsMap {
+		return ""
+	}
+	marker := strconv.Quote(redactionMarker)
+	switch zero := gs.zeroValue(); {
+	case zero == `""`:
+		return marker
+	case strings.HasSuffix(zero, `("")`):
+		// a string format, like strfmt.Password("")
+		return strings.TrimSuffix(zero, `("")`) + "(" + marker + ")"
+	case gs.SwaggerFormat == "" && gs.IsAliased:
+		return gs.GoType + "(" + marker + ")"
+	}
+	return ""
+}
+
+// buildable returns true when a builder can be generated for this schema,
+// which needs a struct with plain properties.
+func buildable(schema *spec.Schema) bool {
+	if unwrap := boolExtension(schema.Extensions, xGoUnwrap); unwrap != nil && *unwrap {
+		return false
+	}
+	if len(schema.Type) > 0 && !schema.Type.Contains(object) {
+		return false
+	}
+	if len(schema.AllOf) > 0 || schema.AdditionalProperties != nil || schema.Discriminator != "" {
+		return false
+	}
+	return len(schema.Properties) > 0
+}
+
+// nestedBuildable returns true when a property refers to a model of this package with a builder,
+// which then provides a builder for the property.
+func nestedBuildable(gs *GenSchema, prop *spec.Schema, specDoc *loads.Document) bool {
+	if !gs.IsComplexObject || gs.IsAnonymous || gs.IsArray || gs.IsMap || gs.IsBaseType {
+		return false
+	}
+	// the method returning the nested builder would shadow Build
+	if strings.Contains(gs.GoType, ".") || pascalize(gs.Name) == "Build" {
+		return false
+	}
+	frag := prop.Ref.GetURL()
+	if frag == nil || !strings.HasPrefix(frag.Fragment, "/definitions/") {
+		return false
+	}
+	def, ok := specDoc.Spec().Definitions[strings.TrimPrefix(frag.Fragment, "/definitions/")]
+	return ok && buildable(&def)
+}
+
 type schemaGenContext struct {
 	Path               string
 	Name               string
@@ -291,6 +1611,8 @@ type schemaGenContext struct {
 	RefHandled         bool
 	IsVirtual          bool
 	IsTuple            bool
+	// IsAllOfMember is true for the members of an allOf, the properties of which are merged in the composed struct
+	IsAllOfMember bool
 
 	Index int
 
@@ -329,9 +1651,7 @@ func (sg *schemaGenContext) NewSliceBranch(schema *spec.Schema) *schemaGenContex
 	pg.Schema = *schema
 	pg.Required = false
 	if sg.IsVirtual {
-		resolver := newTypeResolver(sg.TypeResolver.ModelsPackage, sg.TypeResolver.Doc)
-		resolver.ModelName = sg.TypeResolver.ModelName
-		pg.TypeResolver = resolver
+		pg.TypeResolver = sg.TypeResolver.NewWithModelName(sg.TypeResolver.ModelName)
 	}
 
 	// when this is an anonymous complex object, this needs to become a ref
@@ -368,7 +1688,7 @@ func (sg *schemaGenContext) NewAdditionalItems(schema *spec.Schema) *schemaGenCo
 	return pg
 }
 
-func (sg *schemaGenContext) NewTupleElement(schema *spec.Schema, index int) *schemaGenContext {
+func (sg *schemaGenContext) NewTupleElement(schema *spec.Schema, index int, name string) *schemaGenContext {
 	if Debug {
 		log.Printf("New tuple element\n")
 	}
@@ -379,7 +1699,7 @@ func (sg *schemaGenContext) NewTupleElement(schema *spec.Schema, index int) *sch
 	} else {
 		pg.Path = pg.Path + "+ \".\"+\"" + strconv.Itoa(index) + "\""
 	}
-	pg.ValueExpr = pg.ValueExpr + ".P" + strconv.Itoa(index)
+	pg.ValueExpr = pg.ValueExpr + "." + swag.ToGoName(name)
 	pg.Required = true
 	pg.IsTuple = true
 	pg.Schema = *schema
@@ -399,6 +1719,11 @@ func (sg *schemaGenContext) NewStructBranch(name string, schema spec.Schema) *sc
 	pg.Name = name
 	pg.ValueExpr = pg.ValueExpr + "." + pascalize(name)
 	pg.Schema = schema
+	if sg.IsAllOfMember {
+		// the properties of an allOf member are only required by the lists of the members,
+		// not by the required flag of the property holding the composed object
+		pg.Required = false
+	}
 	for _, fn := range sg.Schema.Required {
 		if name == fn {
 			pg.Required = true
@@ -425,6 +1750,7 @@ func (sg *schemaGenContext) shallowClone() *schemaGenContext {
 	pg.Named = false
 	pg.Index = 0
 	pg.IsTuple = false
+	pg.IsAllOfMember = false
 	pg.IncludeValidator = sg.IncludeValidator
 	pg.IncludeModel = sg.IncludeModel
 	return pg
@@ -437,6 +1763,7 @@ func (sg *schemaGenContext) NewCompositionBranch(schema spec.Schema, index int)
 	pg := sg.shallowClone()
 	pg.Schema = schema
 	pg.Name = "AO" + strconv.Itoa(index)
+	pg.IsAllOfMember = true
 	if sg.Name != sg.TypeResolver.ModelName {
 		pg.Name = sg.Name + pg.Name
 	}
@@ -458,25 +1785,54 @@ func (sg *schemaGenContext) NewAdditionalProperty(schema spec.Schema) *schemaGen
 	}
 	pg.KeyVar += "k"
 	pg.ValueExpr += "[" + pg.KeyVar + "]"
-	pg.Path = pg.KeyVar
+	key := pg.KeyVar
+	if _, ok := sg.Schema.Extensions[xGoTypeKey]; ok {
+		if goType, underlying, err := sg.TypeResolver.mapKeyType(&sg.Schema); err == nil {
+			key = mapKeyString(goType, underlying, pg.KeyVar)
+		}
+	}
+	pg.Path = key
 	pg.GenSchema.Suffix = "Value"
 	if sg.Path != "" {
-		pg.Path = sg.Path + "+\".\"+" + pg.KeyVar
+		pg.Path = sg.Path + "+\".\"+" + key
 	}
 	return pg
 }
 
+// validatesValueFormat validates the formatted strings held by a map against the format registry, keyed by
+// their key in the errors, like the values of additionalProperties: { type: string, format: uri }
+func validatesValueFormat(gs *GenSchema) {
+	if !gs.IsCustomFormatter || gs.IsStream || gs.SwaggerType != str || !strings.HasPrefix(gs.GoType, "strfmt.") {
+		return
+	}
+	gs.ValidatesFormat = true
+	gs.HasValidations = true
+	gs.NeedsValidation = true
+}
+
 func hasValidations(model *spec.Schema, isRequired bool) (needsValidation bool, hasValidation bool) {
 	hasNumberValidation := model.Maximum != nil || model.Minimum != nil || model.MultipleOf != nil
 	hasStringValidation := model.MaxLength != nil || model.MinLength != nil || model.Pattern != ""
 	hasSliceValidations := model.MaxItems != nil || model.MinItems != nil || model.UniqueItems
+	_, hasMinDate := model.Extensions[xMinDate]
+	_, hasMaxDate := model.Extensions[xMaxDate]
 	simpleObject := len(model.Properties) > 0 && model.Discriminator == ""
 
-	needsValidation = hasNumberValidation || hasStringValidation || hasSliceValidations || len(model.Enum) > 0
-	hasValidation = isRequired || needsValidation || simpleObject
+	needsValidation = hasNumberValidation || hasStringValidation || hasSliceValidations || hasMinDate || hasMaxDate || len(model.Enum) > 0
+	hasValidation = isRequired || needsValidation || simpleObject || isUnion(model)
 	return
 }
 
+// buildDateBounds reads the earliest and latest date-time allowed by x-min-date and x-max-date
+func (sg *schemaGenContext) buildDateBounds() error {
+	min, max, err := dateBounds(sg.Schema.Format, sg.Schema.Extensions)
+	if err != nil {
+		return fmt.Errorf("%s: %v", sg.Name, err)
+	}
+	sg.GenSchema.MinDate, sg.GenSchema.MaxDate = min, max
+	return nil
+}
+
 func (sg *schemaGenContext) schemaValidations() sharedValidations {
 	model := sg.Schema
 
@@ -485,6 +1841,10 @@ func (sg *schemaGenContext) schemaValidations() sharedValidations {
 		isRequired = false
 	}
 	hasSliceValidations := model.MaxItems != nil || model.MinItems != nil || model.UniqueItems
+	if sg.TypeResolver.singleChar() != "" && isSingleChar(&model) {
+		// the Char type only unmarshals from a single character
+		model.MinLength, model.MaxLength = nil, nil
+	}
 	needsValidation, hasValidation := hasValidations(&model, isRequired)
 
 	return sharedValidations{
@@ -568,11 +1928,14 @@ func (sg *schemaGenContext) buildProperties() error {
 		if err != nil {
 			return err
 		}
+		if tpe.IsIgnored && sg.TypeResolver.omitIgnored() {
+			continue
+		}
 
 		vv := v
 		var hasValidation bool
 		var needsValidation bool
-		if tpe.IsComplexObject && tpe.IsAnonymous && len(v.Properties) > 0 {
+		if tpe.IsComplexObject && tpe.IsAnonymous && len(v.Properties) > 0 || isUnion(&v) {
 			pg := sg.makeNewStruct(sg.Name+swag.ToGoName(k), v)
 			pg.IsTuple = sg.IsTuple
 			if sg.Path != "" {
@@ -590,30 +1953,38 @@ func (sg *schemaGenContext) buildProperties() error {
 			}
 
 			vv = *spec.RefProperty("#/definitions/" + pg.Name)
+			sg.ExtraSchemas[pg.Name] = pg.GenSchema
+			if tpe.IsIgnored {
+				pg.GenSchema.HasValidations = false
+				pg.GenSchema.NeedsValidation = false
+			}
 			hasValidation = pg.GenSchema.HasValidations
 			needsValidation = pg.GenSchema.NeedsValidation
 			sg.MergeResult(pg, false)
-			sg.ExtraSchemas[pg.Name] = pg.GenSchema
 		}
 
 		emprop := sg.NewStructBranch(k, vv)
 		emprop.IsTuple = sg.IsTuple
+		if tpe.IsIgnored {
+			// an ignored field never goes over the wire, so there is nothing to validate
+			emprop.Required = false
+		}
 		if err := emprop.makeGenSchema(); err != nil {
 			return err
 		}
-		if hasValidation || emprop.GenSchema.HasValidations {
+		if !tpe.IsIgnored && (hasValidation || emprop.GenSchema.HasValidations) {
 			emprop.GenSchema.HasValidations = true
 			sg.GenSchema.HasValidations = true
 		}
-		if needsValidation || emprop.GenSchema.NeedsValidation {
+		if !tpe.IsIgnored && (needsValidation || emprop.GenSchema.NeedsValidation) {
 			emprop.GenSchema.NeedsValidation = true
 			sg.GenSchema.NeedsValidation = true
 		}
-		if emprop.Schema.Ref.String() != "" {
+		if emprop.Schema.Ref.String() != "" && !sg.TypeResolver.isMappedRef(emprop.Schema.Ref) {
 			ref := emprop.Schema.Ref
 			var sch *spec.Schema
 			for ref.String() != "" {
-				rsch, err := spec.ResolveRef(sg.TypeResolver.Doc.Spec(), &ref)
+				rsch, err := sg.TypeResolver.resolveRef(&ref)
 				if err != nil {
 					return err
 				}
@@ -644,8 +2015,7 @@ func (sg *schemaGenContext) buildProperties() error {
 				tn = swag.ToGoName(nm)
 			}
 
-			tr := newTypeResolver(sg.TypeResolver.ModelsPackage, sg.TypeResolver.Doc)
-			tr.ModelName = tn
+			tr := sg.TypeResolver.NewWithModelName(tn)
 			ttpe, err := tr.ResolveSchema(sch, false, true)
 			if err != nil {
 				return err
@@ -654,12 +2024,41 @@ func (sg *schemaGenContext) buildProperties() error {
 				emprop.GenSchema.IsAliased = true
 			}
 			nv, hv := hasValidations(sch, false)
+			if ttpe.IsArray && !tpe.IsIgnored {
+				// a named slice validates its elements with its own Validate method
+				hv = true
+			}
 			if hv {
 				emprop.GenSchema.HasValidations = true
 			}
 			if nv {
 				emprop.GenSchema.NeedsValidation = true
 			}
+			if len(emprop.Schema.Enum) > 0 {
+				if err := narrowEnum(sg.Name+"."+k, &emprop.GenSchema, emprop.Schema.Enum, ttpe, sch); err != nil {
+					return err
+				}
+			}
+		}
+		if tpe.IsIgnored {
+			emprop.GenSchema.IsIgnored = true
+			emprop.GenSchema.HasValidations = false
+			emprop.GenSchema.NeedsValidation = false
+			emprop.GenSchema.ContextFormat = ""
+		}
+		if emprop.GenSchema.ContextFormat != "" {
+			if !emprop.GenSchema.IsPrimitive {
+				return fmt.Errorf("%s.%s: context-aware format %q is only supported on primitive properties", sg.Name, k, emprop.GenSchema.ContextFormat)
+			}
+			emprop.GenSchema.HasContextValidations = true
+			sg.GenSchema.HasContextValidations = true
+		} else if !emprop.GenSchema.IsIgnored && sg.TypeResolver.hasContextValidations(&v) {
+			if contextValidatable(&emprop.GenSchema) {
+				emprop.GenSchema.HasContextValidations = true
+				sg.GenSchema.HasContextValidations = true
+			} else {
+				log.Printf("warning: %s.%s: context-aware formats nested in %s are not validated", sg.Name, k, emprop.GenSchema.GoType)
+			}
 		}
 		if sg.Schema.Discriminator == k {
 			emprop.GenSchema.IsNullable = false
@@ -674,13 +2073,87 @@ func (sg *schemaGenContext) buildProperties() error {
 	return nil
 }
 
+// buildUnion builds the variants of a oneOf or anyOf composition, the inline objects
+// among them are lifted into models of their own
+func (sg *schemaGenContext) buildUnion() error {
+	if !sg.GenSchema.IsOneOf && !sg.GenSchema.IsAnyOf {
+		return nil
+	}
+	members, kind := sg.Schema.OneOf, "OneOf"
+	if sg.GenSchema.IsAnyOf {
+		members, kind = sg.Schema.AnyOf, "AnyOf"
+	}
+	names := make(map[string]struct{}, len(members))
+	for i, m := range members {
+		member := m
+		if member.Ref.String() == "" && (len(member.Properties) > 0 || len(member.AllOf) > 0) {
+			pg := sg.makeNewStruct(sg.Name+kind+strconv.Itoa(i), member)
+			if err := pg.makeGenSchema(); err != nil {
+				return err
+			}
+			sg.ExtraSchemas[pg.Name] = pg.GenSchema
+			sg.MergeResult(pg, false)
+			member = *spec.RefProperty("#/definitions/" + pg.Name)
+		}
+		vt, err := sg.TypeResolver.ResolveSchema(&member, true, true)
+		if err != nil {
+			return err
+		}
+		name := variantName(vt.GoType)
+		if _, seen := names[name]; seen {
+			name += strconv.Itoa(i)
+		}
+		names[name] = struct{}{}
+
+		variant := sg.NewStructBranch(name, member)
+		if err := variant.makeGenSchema(); err != nil {
+			return err
+		}
+		// the variant which isn't set is nil
+		variant.GenSchema.IsNullable = !variant.GenSchema.IsArray && !variant.GenSchema.IsMap && !variant.GenSchema.IsInterface
+		sg.MergeResult(variant, false)
+		sg.GenSchema.Union = append(sg.GenSchema.Union, variant.GenSchema)
+	}
+	// a union validates which of its variants are set
+	sg.GenSchema.HasValidations = true
+	sg.GenSchema.NeedsValidation = true
+	return nil
+}
+
+// variantName names the field of a union variant after its go type, like Cat, String or SliceOfInt64
+func variantName(goType string) string {
+	var prefix string
+	for name := goType; ; {
+		switch {
+		case strings.HasPrefix(name, "*"):
+			name = name[1:]
+		case strings.HasPrefix(name, "[]"):
+			prefix, name = prefix+"slice of ", name[2:]
+		case strings.HasPrefix(name, "map[string]"):
+			prefix, name = prefix+"map of ", name[len("map[string]"):]
+		default:
+			if name == iface {
+				name = "interface"
+			}
+			if i := strings.LastIndex(name, "."); i >= 0 {
+				name = name[i+1:]
+			}
+			return swag.ToGoName(prefix + name)
+		}
+	}
+}
+
 func (sg *schemaGenContext) buildAllOf() error {
 	if len(sg.Schema.AllOf) > 0 {
 		if sg.Container == "" {
 			sg.Container = sg.Name
 		}
 	}
-	for i, sch := range sg.Schema.AllOf {
+	members, embedded, err := sg.unifyAllOfRequired()
+	if err != nil {
+		return err
+	}
+	for i, sch := range members {
 		var comprop *schemaGenContext
 		comprop = sg.NewCompositionBranch(sch, i)
 		if err := comprop.makeGenSchema(); err != nil {
@@ -689,12 +2162,231 @@ func (sg *schemaGenContext) buildAllOf() error {
 		sg.MergeResult(comprop, true)
 		sg.GenSchema.AllOf = append(sg.GenSchema.AllOf, comprop.GenSchema)
 	}
+	for _, e := range embedded {
+		tpe := sg.GenSchema.AllOf[e.Member].GoType
+		sg.GenSchema.EmbeddedRequired = append(sg.GenSchema.EmbeddedRequired, GenEmbeddedRequired{
+			Name:     e.Name,
+			GoName:   swag.ToGoName(e.Name),
+			Embedded: tpe[strings.LastIndex(tpe, ".")+1:],
+		})
+	}
+	if len(sg.Schema.AllOf) > 1 {
+		if err := sg.resolveAllOfConflicts(); err != nil {
+			return err
+		}
+	}
 	if len(sg.Schema.AllOf) > 0 {
 		sg.GenSchema.IsNullable = true
 	}
 	return nil
 }
 
+type allOfRequired struct {
+	Member int
+	Name   string
+}
+
+// unifyAllOfRequired applies the required properties of the composed object and of all its allOf
+// members to the member declaring the property: an inline member gets it added to its own required
+// list, while the property of an embedded $ref member is checked by the composed object.
+func (sg *schemaGenContext) unifyAllOfRequired() ([]spec.Schema, []allOfRequired, error) {
+	members := make([]spec.Schema, len(sg.Schema.AllOf))
+	copy(members, sg.Schema.AllOf)
+
+	required := make(map[string]bool)
+	for _, name := range sg.Schema.Required {
+		required[name] = true
+	}
+	own := make([]map[string]bool, len(members))
+	declared := make([]map[string]string, len(members))
+	for i := range members {
+		own[i] = make(map[string]bool)
+		if err := sg.collectAllOfRequired(&members[i], own[i], make(map[string]bool)); err != nil {
+			return nil, nil, err
+		}
+		for name := range own[i] {
+			required[name] = true
+		}
+		declared[i] = make(map[string]string)
+		if err := sg.collectAllOfProperties(&members[i], declared[i], make(map[string]bool)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var names []string
+	for name := range required {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var embedded []allOfRequired
+	for _, name := range names {
+		var inline bool
+		for i := range members {
+			if members[i].Ref.String() != "" {
+				continue
+			}
+			if _, ok := members[i].Properties[name]; !ok {
+				continue
+			}
+			inline = true
+			if !containsString(members[i].Required, name) {
+				// the required list is shared with the spec, don't append to it in place
+				members[i].Required = append(append([]string(nil), members[i].Required...), name)
+			}
+		}
+		if inline {
+			// an inline property shadows the ones of the embedded types
+			continue
+		}
+		for i := range members {
+			if members[i].Ref.String() == "" || own[i][name] {
+				continue
+			}
+			if _, ok := declared[i][name]; ok {
+				embedded = append(embedded, allOfRequired{Member: i, Name: name})
+			}
+		}
+	}
+	return members, embedded, nil
+}
+
+// collectAllOfRequired gathers the required properties of an allOf member,
+// following refs and nested allOf compositions.
+func (sg *schemaGenContext) collectAllOfRequired(schema *spec.Schema, required map[string]bool, seen map[string]bool) error {
+	if ref := schema.Ref.String(); ref != "" {
+		if seen[ref] {
+			return nil
+		}
+		seen[ref] = true
+		rsch, err := sg.TypeResolver.resolveRef(&schema.Ref)
+		if err != nil {
+			return err
+		}
+		return sg.collectAllOfRequired(rsch, required, seen)
+	}
+	for i := range schema.AllOf {
+		if err := sg.collectAllOfRequired(&schema.AllOf[i], required, seen); err != nil {
+			return err
+		}
+	}
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+	return nil
+}
+
+type allOfDeclaration struct {
+	Member int
+	GoType string
+}
+
+// resolveAllOfConflicts makes sure a property declared by several allOf members
+// only ends up once in the composed struct: the last member declaring it wins.
+// When the members disagree on the type this is reported as a warning, or as an error
+// in strict mode.
+func (sg *schemaGenContext) resolveAllOfConflicts() error {
+	declared := make(map[string][]allOfDeclaration)
+	for i := range sg.Schema.AllOf {
+		props := make(map[string]string)
+		if err := sg.collectAllOfProperties(&sg.Schema.AllOf[i], props, make(map[string]bool)); err != nil {
+			return err
+		}
+		for k, v := range props {
+			declared[k] = append(declared[k], allOfDeclaration{Member: i, GoType: v})
+		}
+	}
+
+	var names []string
+	for k, v := range declared {
+		if len(v) > 1 {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		decls := declared[name]
+		winner := decls[len(decls)-1]
+		var members []string
+		var conflicting bool
+		for _, d := range decls {
+			members = append(members, sg.allOfMemberName(d.Member))
+			if d.GoType != winner.GoType {
+				conflicting = true
+			}
+		}
+		if conflicting {
+			msg := fmt.Sprintf("%s: property %q is declared with different types by the allOf members %s", sg.Name, name, strings.Join(members, ", "))
+			if sg.TypeResolver.strict() {
+				return errors.New(msg)
+			}
+			log.Printf("warning: %s, using %s from %s", msg, winner.GoType, sg.allOfMemberName(winner.Member))
+		}
+
+		for _, d := range decls[:len(decls)-1] {
+			member := &sg.GenSchema.AllOf[d.Member]
+			if !member.IsAnonymous {
+				if !sg.GenSchema.AllOf[winner.Member].IsAnonymous {
+					if conflicting {
+						return fmt.Errorf("%s: property %q is declared by both %s and %s, redeclare it in an inline allOf member to pick one",
+							sg.Name, name, sg.allOfMemberName(d.Member), sg.allOfMemberName(winner.Member))
+					}
+					// both fields are promoted from embedded types at the same depth, go leaves the selector ambiguous
+					log.Printf("warning: %s: property %q is declared by both embedded types %s and %s, it must be qualified with the embedded type",
+						sg.Name, name, member.GoType, sg.GenSchema.AllOf[winner.Member].GoType)
+				}
+				// the inline field of the winner shadows the one of the embedded type
+				continue
+			}
+			for j, p := range member.Properties {
+				if p.Name == name {
+					member.Properties = append(member.Properties[:j], member.Properties[j+1:]...)
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (sg *schemaGenContext) allOfMemberName(index int) string {
+	if ref := sg.Schema.AllOf[index].Ref.String(); ref != "" {
+		return ref
+	}
+	return fmt.Sprintf("allOf[%d]", index)
+}
+
+// collectAllOfProperties gathers the go type of every property an allOf member contributes,
+// following refs and nested allOf compositions.
+func (sg *schemaGenContext) collectAllOfProperties(schema *spec.Schema, props map[string]string, seen map[string]bool) error {
+	if ref := schema.Ref.String(); ref != "" {
+		if seen[ref] {
+			return nil
+		}
+		seen[ref] = true
+		rsch, err := sg.TypeResolver.resolveRef(&schema.Ref)
+		if err != nil {
+			return err
+		}
+		return sg.collectAllOfProperties(rsch, props, seen)
+	}
+	for i := range schema.AllOf {
+		if err := sg.collectAllOfProperties(&schema.AllOf[i], props, seen); err != nil {
+			return err
+		}
+	}
+	for k, v := range schema.Properties {
+		prop := v
+		tpe, err := sg.TypeResolver.ResolveSchema(&prop, true, false)
+		if err != nil {
+			return err
+		}
+		props[k] = tpe.GoType
+	}
+	return nil
+}
+
 type mapStack struct {
 	Type     *spec.Schema
 	Next     *mapStack
@@ -744,6 +2436,11 @@ func (mt *mapStack) Build() error {
 		if err := cp.makeGenSchema(); err != nil {
 			return err
 		}
+		validatesValueFormat(&cp.GenSchema)
+		if mt.Context.KeyVar != "" {
+			// the values of a nested map are only pointers when nullable, as in the resolved map type
+			cp.GenSchema.IsNullable = cp.TypeResolver.IsNullable(&cp.Schema)
+		}
 		mt.Context.MergeResult(cp, false)
 		mt.Context.GenSchema.AdditionalProperties = &cp.GenSchema
 		return nil
@@ -833,7 +2530,12 @@ func (sg *schemaGenContext) buildAdditionalProperties() error {
 	}
 
 	if addp.Schema == nil {
-		return nil
+		if !sg.GenSchema.HasUntypedAdditionalProps || sg.IsAllOfMember {
+			return nil
+		}
+		// the extra keys allowed by additionalProperties: true are kept along the properties, with any value,
+		// but for the members of an allOf which share the JSON object of the composition
+		addp.Schema = new(spec.Schema)
 	}
 
 	if !sg.GenSchema.IsMap && (sg.GenSchema.IsAdditionalProperties && sg.Named) {
@@ -886,6 +2588,64 @@ func (sg *schemaGenContext) buildAdditionalProperties() error {
 	return nil
 }
 
+// buildPropertyNames adds validations for the keys of a map, as declared by the
+// x-property-names extension (swagger 2.0 has no propertyNames keyword).
+// Formats are validated through the registry, like any custom formatter.
+func (sg *schemaGenContext) buildPropertyNames() error {
+	if !sg.GenSchema.IsMap {
+		return nil
+	}
+	v, ok := sg.Schema.Extensions[xPropNames]
+	if !ok {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var keys spec.Schema
+	if err := json.Unmarshal(b, &keys); err != nil {
+		return fmt.Errorf("%s: invalid %s: %v", sg.Name, xPropNames, err)
+	}
+
+	sg.GenSchema.KeyPattern = keys.Pattern
+	if keys.Format != "" {
+		tpe, ok := typeMapping[strings.Replace(keys.Format, "-", "", -1)]
+		if _, isCustom := customFormatters[tpe]; !ok || !isCustom {
+			return fmt.Errorf("%s: unsupported format %q for map keys", sg.Name, keys.Format)
+		}
+		sg.GenSchema.KeyFormat = keys.Format
+	}
+	if sg.GenSchema.KeyPattern != "" || sg.GenSchema.KeyFormat != "" {
+		sg.GenSchema.HasValidations = true
+		sg.GenSchema.NeedsValidation = true
+	}
+	return nil
+}
+
+// buildMapKey formats the keys of a map as the strings they are serialized to, in the paths of the
+// validation errors, and validates the keys of a definition named by x-go-type-key, like a string enum.
+func (sg *schemaGenContext) buildMapKey() error {
+	if !sg.GenSchema.IsMap {
+		return nil
+	}
+	sg.GenSchema.KeyString = "k"
+	if sg.GenSchema.KeyType == "" {
+		return nil
+	}
+	goType, underlying, err := sg.TypeResolver.mapKeyType(&sg.Schema)
+	if err != nil {
+		return err
+	}
+	sg.GenSchema.KeyString = mapKeyString(goType, underlying, "k")
+	if goType != underlying {
+		sg.GenSchema.ValidateKeys = true
+		sg.GenSchema.HasValidations = true
+		sg.GenSchema.NeedsValidation = true
+	}
+	return nil
+}
+
 func (sg *schemaGenContext) makeNewStruct(name string, schema spec.Schema) *schemaGenContext {
 	if Debug {
 		log.Println("making new struct", name, sg.Container)
@@ -915,9 +2675,7 @@ func (sg *schemaGenContext) makeNewStruct(name string, schema spec.Schema) *sche
 		IncludeModel:     sg.IncludeModel,
 	}
 	if schema.Ref.String() == "" {
-		resolver := newTypeResolver(sg.TypeResolver.ModelsPackage, sg.TypeResolver.Doc)
-		resolver.ModelName = name //sg.TypeResolver.ModelName
-		pg.TypeResolver = resolver
+		pg.TypeResolver = sg.TypeResolver.NewWithModelName(name)
 	}
 	pg.GenSchema.IsVirtual = true
 
@@ -953,7 +2711,12 @@ func (sg *schemaGenContext) buildArray() error {
 	}
 
 	sg.MergeResult(elProp, false)
-	sg.GenSchema.IsBaseType = elProp.GenSchema.IsBaseType
+	if sg.Named && elProp.GenSchema.IsBaseType {
+		// a named slice of a base type isn't polymorphic itself, it discriminates its elements
+		sg.GenSchema.HasPolymorphicItems = true
+	} else {
+		sg.GenSchema.IsBaseType = elProp.GenSchema.IsBaseType
+	}
 	sg.GenSchema.ItemsEnum = elProp.GenSchema.Enum
 	elProp.GenSchema.Suffix = "Items"
 	sg.GenSchema.GoType = "[]" + elProp.GenSchema.GoType
@@ -965,11 +2728,24 @@ func (sg *schemaGenContext) buildArray() error {
 	if nn && !tpe.HasDiscriminator && !tpe.IsPrimitive {
 		sg.GenSchema.GoType = "[]*" + elProp.GenSchema.GoType
 	}
+	if sg.GenSchema.FixedSize > 0 {
+		sg.GenSchema.GoType = fmt.Sprintf("[%d]%s", sg.GenSchema.FixedSize, strings.TrimPrefix(sg.GenSchema.GoType, "[]"))
+	}
 
 	schemaCopy := elProp.GenSchema
 	schemaCopy.Required = false
 	hv, _ := hasValidations(sg.Schema.Items.Schema, false)
-	schemaCopy.HasValidations = elProp.GenSchema.IsNullable || hv
+	// the elements of nested arrays are validated too
+	nested := elProp.GenSchema.IsArray && elProp.GenSchema.Items != nil && elProp.GenSchema.Items.HasValidations
+	// the elements of most formats unmarshal from any string, their format is validated
+	schemaCopy.ValidatesFormat = validatesFormat(elProp.GenSchema.GoType)
+	// the objects validate themselves, be they pointers or values
+	object := elProp.GenSchema.IsComplexObject && !elProp.GenSchema.IsInterface
+	schemaCopy.HasValidations = elProp.GenSchema.IsNullable || object || hv || elProp.GenSchema.GoType == "net.IP" || nested || schemaCopy.ValidatesFormat
+	if schemaCopy.ValidatesFormat {
+		sg.GenSchema.HasValidations = true
+		sg.GenSchema.NeedsValidation = true
+	}
 	sg.GenSchema.Items = &schemaCopy
 	if sg.Named {
 		sg.GenSchema.AliasedType = sg.GenSchema.GoType
@@ -988,17 +2764,21 @@ func (sg *schemaGenContext) buildItems() error {
 	if sg.Schema.Items == nil {
 		return nil
 	}
+	if sg.TypeResolver.tuplesAsSlices() {
+		return sg.buildPositionalItems()
+	}
 	// This is a tuple, build a new model that represents this
+	names := sg.tupleNames()
 	if sg.Named {
 		sg.GenSchema.Name = sg.Name
 		sg.GenSchema.GoType = sg.TypeResolver.goTypeName(sg.Name)
 		for i, s := range sg.Schema.Items.Schemas {
-			elProp := sg.NewTupleElement(&s, i)
+			elProp := sg.NewTupleElement(&s, i, names[i])
 			if err := elProp.makeGenSchema(); err != nil {
 				return err
 			}
 			sg.MergeResult(elProp, false)
-			elProp.GenSchema.Name = "p" + strconv.Itoa(i)
+			elProp.GenSchema.Name = names[i]
 			sg.GenSchema.Properties = append(sg.GenSchema.Properties, elProp.GenSchema)
 		}
 		return nil
@@ -1011,8 +2791,8 @@ func (sg *schemaGenContext) buildItems() error {
 	sch.Typed("object", "")
 	sch.Properties = make(map[string]spec.Schema)
 	for i, v := range sg.Schema.Items.Schemas {
-		sch.Required = append(sch.Required, "P"+strconv.Itoa(i))
-		sch.Properties["P"+strconv.Itoa(i)] = v
+		sch.Required = append(sch.Required, names[i])
+		sch.Properties[names[i]] = v
 	}
 	sch.AdditionalItems = sg.Schema.AdditionalItems
 	tup := sg.makeNewStruct(sg.GenSchema.Name+"Tuple"+strconv.Itoa(sg.Index), sch)
@@ -1020,6 +2800,15 @@ func (sg *schemaGenContext) buildItems() error {
 	if err := tup.makeGenSchema(); err != nil {
 		return err
 	}
+	// the properties of an object are sorted by name, the elements of a tuple keep their position
+	byName := make(map[string]GenSchema, len(tup.GenSchema.Properties))
+	for _, p := range tup.GenSchema.Properties {
+		byName[p.Name] = p
+	}
+	tup.GenSchema.Properties = tup.GenSchema.Properties[:0]
+	for _, n := range names {
+		tup.GenSchema.Properties = append(tup.GenSchema.Properties, byName[n])
+	}
 	tup.GenSchema.IsTuple = true
 	tup.GenSchema.IsComplexObject = false
 	tup.GenSchema.Title = tup.GenSchema.Name + " a representation of an anonymous Tuple type"
@@ -1034,7 +2823,79 @@ func (sg *schemaGenContext) buildItems() error {
 	return nil
 }
 
+// tupleNames returns the names of the elements of a tuple, as declared by the
+// x-go-tuple-names extension. Elements are named P0, P1, ... when the extension
+// is absent, or when it does not provide a distinct name for each element.
+func (sg *schemaGenContext) tupleNames() []string {
+	names := make([]string, len(sg.Schema.Items.Schemas))
+	for i := range names {
+		names[i] = "P" + strconv.Itoa(i)
+	}
+	v, ok := sg.Schema.Extensions[xTupleNames]
+	if !ok {
+		return names
+	}
+	declared, ok := v.([]interface{})
+	if !ok || len(declared) != len(names) {
+		log.Printf("warning: %s: %s should list %d names, using positional names", sg.Name, xTupleNames, len(names))
+		return names
+	}
+	named := make([]string, 0, len(declared))
+	seen := make(map[string]bool, len(declared))
+	for _, d := range declared {
+		n, ok := d.(string)
+		goName := swag.ToGoName(n)
+		if !ok || goName == "" || seen[goName] {
+			log.Printf("warning: %s: %s should list distinct names, using positional names", sg.Name, xTupleNames)
+			return names
+		}
+		seen[goName] = true
+		named = append(named, n)
+	}
+	return named
+}
+
+// positionalItemsVar names the package level variable holding the parsed positional items of this schema
+func (sg *schemaGenContext) positionalItemsVar() string {
+	model := sg.TypeResolver.ModelName
+	if model == "" || model == sg.Name {
+		return swag.ToVarName(sg.Name) + "Positional"
+	}
+	return swag.ToVarName(model) + swag.ToGoName(sg.Name) + "Positional"
+}
+
+// buildPositionalItems renders positional items as a []interface{}, the elements
+// are validated at runtime against the (expanded) schema at their position
+func (sg *schemaGenContext) buildPositionalItems() error {
+	var sch spec.Schema
+	sch.Typed("array", "")
+	sch.Items = sg.Schema.Items
+	sch.AdditionalItems = sg.Schema.AdditionalItems
+
+	// expand a copy, the original spec must remain untouched
+	expanded, err := expandedCopy(sch, sg.TypeResolver.Doc.Spec())
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(expanded)
+	if err != nil {
+		return err
+	}
+
+	sg.GenSchema.PositionalItems = string(b)
+	sg.GenSchema.PositionalItemsVar = sg.positionalItemsVar()
+	sg.GenSchema.HasValidations = true
+	if !sg.Named {
+		sg.GenSchema.GoType = "[]" + sg.TypeResolver.iface()
+	}
+	return nil
+}
+
 func (sg *schemaGenContext) buildAdditionalItems() error {
+	if sg.TypeResolver.tuplesAsSlices() {
+		// additional items are part of the positional items validation
+		return nil
+	}
 	wantsAdditionalItems :=
 		sg.Schema.AdditionalItems != nil &&
 			(sg.Schema.AdditionalItems.Allows || sg.Schema.AdditionalItems.Schema != nil)
@@ -1071,6 +2932,71 @@ func (sg *schemaGenContext) buildAdditionalItems() error {
 	return nil
 }
 
+// buildNotRequired builds the mutual exclusion of the properties listed by a not: { required: [...] },
+// they can't all be set together. Any other constraint of not is left out with a warning.
+func (sg *schemaGenContext) buildNotRequired() {
+	not := sg.Schema.Not
+	if not == nil {
+		return
+	}
+	rest := *not
+	rest.Required = nil
+	if !reflect.DeepEqual(rest, spec.Schema{}) {
+		log.Printf("warning: %s: only the required properties of not are validated, its other constraints are left out", sg.Name)
+	}
+	if len(not.Required) == 0 {
+		return
+	}
+	if !sg.GenSchema.IsComplexObject || sg.GenSchema.IsBaseType || sg.GenSchema.IsSubType {
+		log.Printf("warning: %s: not required is only validated on the properties of plain objects", sg.Name)
+		return
+	}
+	names := make([]string, 0, len(not.Required))
+	for _, name := range not.Required {
+		if _, ok := sg.Schema.Properties[name]; !ok {
+			log.Printf("warning: %s: %q of not required is no property, the exclusion is left out", sg.Name, name)
+			return
+		}
+		names = append(names, name)
+	}
+	sg.GenSchema.NotRequired = append(sg.GenSchema.NotRequired, GenNotRequired{
+		Names:   names,
+		Message: notRequiredMessage(names),
+	})
+	sg.GenSchema.HasValidations = true
+}
+
+// buildPropertyCount builds the bounds of maxProperties and minProperties on a plain object, counting its set
+// properties: the fields of a struct are always there, only the ones which don't hold their zero value count.
+func (sg *schemaGenContext) buildPropertyCount() {
+	max, min := sg.Schema.MaxProperties, sg.Schema.MinProperties
+	if max == nil && min == nil {
+		return
+	}
+	gs := &sg.GenSchema
+	if !gs.IsComplexObject || gs.IsBaseType || gs.IsSubType || gs.HasAdditionalProperties || len(gs.AllOf) > 0 || len(gs.Properties) == 0 {
+		log.Printf("warning: %s: maxProperties and minProperties are only validated on plain objects with properties, they are left out", sg.Name)
+		return
+	}
+	count := &GenPropertyCount{Min: min, Max: max}
+	for _, prop := range gs.Properties {
+		if prop.IsIgnored {
+			continue
+		}
+		count.Names = append(count.Names, prop.Name)
+	}
+	gs.PropertyCount = count
+	gs.HasValidations = true
+}
+
+// notRequiredMessage is the error reported when the properties excluding each other are all set
+func notRequiredMessage(names []string) string {
+	if len(names) == 1 {
+		return names[0] + " must not be set"
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1] + " must not be set together"
+}
+
 func (sg *schemaGenContext) buildXMLName() error {
 	if sg.Schema.XML == nil {
 		return nil
@@ -1086,6 +3012,15 @@ func (sg *schemaGenContext) buildXMLName() error {
 	return nil
 }
 
+func (sg *schemaGenContext) buildCustomTag() error {
+	tag, err := customTag(&sg.Schema)
+	if err != nil {
+		return fmt.Errorf("%s: %v", sg.Name, err)
+	}
+	sg.GenSchema.CustomTag = tag
+	return nil
+}
+
 func (sg *schemaGenContext) shortCircuitNamedRef() (bool, error) {
 	// This if block ensures that a struct gets
 	// rendered with the ref as embedded ref.
@@ -1093,7 +3028,7 @@ func (sg *schemaGenContext) shortCircuitNamedRef() (bool, error) {
 		return false, nil
 	}
 	nullableOverride := sg.GenSchema.IsNullable
-	tpe := resolvedType{}
+	tpe := ResolvedType{}
 	tpe.GoType = sg.TypeResolver.goTypeName(sg.Name)
 
 	tpe.SwaggerType = "object"
@@ -1106,7 +3041,7 @@ func (sg *schemaGenContext) shortCircuitNamedRef() (bool, error) {
 	if err := item.makeGenSchema(); err != nil {
 		return true, err
 	}
-	sg.GenSchema.resolvedType = tpe
+	sg.GenSchema.ResolvedType = tpe
 	sg.GenSchema.IsNullable = sg.GenSchema.IsNullable || nullableOverride
 	sg.MergeResult(item, true)
 	sg.GenSchema.AllOf = append(sg.GenSchema.AllOf, item.GenSchema)
@@ -1123,7 +3058,9 @@ func (sg *schemaGenContext) liftSpecialAllOf() error {
 	}
 	var seenSchema int
 	var seenNullable bool
+	var seenRequired bool
 	var schemaToLift spec.Schema
+	var narrowed []interface{}
 
 	for _, sch := range sg.Schema.AllOf {
 
@@ -1139,12 +3076,21 @@ func (sg *schemaGenContext) liftSpecialAllOf() error {
 			if (!tpe.IsAnonymous && tpe.IsComplexObject) || tpe.IsPrimitive {
 				schemaToLift = sch
 			}
+		} else if len(sch.Enum) > 0 {
+			// an enum without a type narrows the values of the other schema
+			narrowed = sch.Enum
+		} else if len(sch.Required) > 0 {
+			// a required list without a type constrains the properties of the other schema
+			seenRequired = true
 		}
 	}
 
-	if seenSchema == 1 {
+	if seenSchema == 1 && !(seenRequired && sg.Named) {
 		sg.Schema = schemaToLift
 		sg.GenSchema.IsNullable = seenNullable
+		if len(narrowed) > 0 && sg.Schema.Ref.String() != "" {
+			sg.Schema.Enum = narrowed
+		}
 	}
 	return nil
 }
@@ -1164,7 +3110,7 @@ func (sg *schemaGenContext) buildAliased() error {
 	}
 
 	if sg.GenSchema.IsInterface {
-		sg.GenSchema.IsAliased = sg.GenSchema.GoType != "interface{}"
+		sg.GenSchema.IsAliased = sg.GenSchema.GoType != sg.TypeResolver.iface()
 	}
 	if sg.GenSchema.IsMap {
 		sg.GenSchema.IsAliased = !strings.HasPrefix(sg.GenSchema.GoType, "map[")
@@ -1196,10 +3142,42 @@ func (sg *schemaGenContext) makeGenSchema() error {
 	sg.GenSchema.Description = sg.Schema.Description
 	sg.GenSchema.ReceiverName = sg.Receiver
 	sg.GenSchema.sharedValidations = sg.schemaValidations()
+	if err := sg.buildDateBounds(); err != nil {
+		return err
+	}
 	sg.GenSchema.ReadOnly = sg.Schema.ReadOnly
+	sg.GenSchema.Deprecated = isDeprecated(&sg.Schema)
+	sg.GenSchema.LegacyDocs = sg.TypeResolver.Opts.compat().LegacyDocs
+	sg.GenSchema.LegacyEnums = sg.TypeResolver.Opts.compat().NoEnumConsts
+	sg.GenSchema.TypedErrors = sg.TypeResolver.typedErrors()
+	sg.GenSchema.DocNote, sg.GenSchema.ExternalDocs = docLinks(&sg.Schema, sg.GenSchema.LegacyDocs)
 	sg.GenSchema.IncludeValidator = sg.IncludeValidator
 	sg.GenSchema.IncludeModel = sg.IncludeModel
 
+	name := sg.Name
+	if name != sg.TypeResolver.ModelName {
+		name = sg.TypeResolver.ModelName + "." + name
+	}
+	_, hasConst := sg.Schema.ExtraProps["const"]
+	types := nonNullTypes(&sg.Schema)
+	if len(types) == 1 {
+		warnBounds(name, types[0], sg.Schema.Format, sg.Schema.Minimum, sg.Schema.Maximum)
+	}
+	if (len(sg.Schema.Enum) > 0 || hasConst) && len(types) == 1 {
+		nullable := sg.TypeResolver.isNullable(&sg.Schema)
+		if ext := sg.TypeResolver.explicitNullable(&sg.Schema); ext != nil {
+			nullable = *ext
+		}
+		if err := checkEnum(name, types[0], sg.Schema.Format, sg.Schema.Enum, nullable); err != nil {
+			return err
+		}
+		if hasConst {
+			if err := checkConst(name, types[0], sg.Schema.Format, sg.Schema.ExtraProps["const"], nullable); err != nil {
+				return err
+			}
+		}
+	}
+
 	var err error
 	returns, err := sg.shortCircuitNamedRef()
 	if err != nil {
@@ -1220,7 +3198,7 @@ func (sg *schemaGenContext) makeGenSchema() error {
 		return err
 	}
 
-	var tpe resolvedType
+	var tpe ResolvedType
 	if sg.Untyped {
 		tpe, err = sg.TypeResolver.ResolveSchema(nil, !sg.Named, sg.IsTuple || sg.Required || sg.GenSchema.Required)
 	} else {
@@ -1233,7 +3211,16 @@ func (sg *schemaGenContext) makeGenSchema() error {
 		log.Println("gschema rrequired", sg.GenSchema.Required, "nullable", sg.GenSchema.IsNullable)
 	}
 	tpe.IsNullable = tpe.IsNullable || nullableOverride
-	sg.GenSchema.resolvedType = tpe
+	sg.GenSchema.ResolvedType = tpe
+	if tpe.IsExternal {
+		// the type named by x-go-type serializes and validates itself,
+		// a formatter is checked against the format registered for it
+		sg.GenSchema.sharedValidations = sharedValidations{Required: sg.GenSchema.Required}
+		sg.GenSchema.ValidatesFormat = tpe.IsCustomFormatter
+		sg.GenSchema.HasValidations = tpe.IsCustomFormatter
+		sg.GenSchema.NeedsValidation = tpe.IsCustomFormatter
+		return nil
+	}
 
 	if Debug {
 		log.Println("gschema nullable", sg.GenSchema.IsNullable)
@@ -1252,7 +3239,29 @@ func (sg *schemaGenContext) makeGenSchema() error {
 		return err
 	}
 	tpe.IsNullable = tpe.IsNullable || nullableOverride
-	sg.GenSchema.resolvedType = tpe
+	sg.GenSchema.ResolvedType = tpe
+	if !sg.GenSchema.LegacyDocs {
+		// a property defined by a $ref is documented by its target
+		if sg.GenSchema.Title == "" && sg.GenSchema.Description == "" {
+			sg.GenSchema.Description = tpe.Description
+		}
+		if sg.GenSchema.Example == "" {
+			sg.GenSchema.Example = tpe.Example
+		}
+	}
+	if tpe.GoType == "net.IP" {
+		// the address family is only checked by validation
+		sg.GenSchema.HasValidations = true
+		sg.GenSchema.NeedsValidation = true
+	}
+	sg.GenSchema.IsSensitive = isSensitive(tpe.SwaggerFormat, sg.Schema.Extensions)
+	sg.GenSchema.Default = sg.Schema.Default
+	if trim := boolExtension(sg.Schema.Extensions, xTrim); trim != nil {
+		sg.GenSchema.Trim = *trim
+	}
+	if lower := boolExtension(sg.Schema.Extensions, xLowercase); lower != nil {
+		sg.GenSchema.Lowercase = *lower
+	}
 	sg.GenSchema.IsComplexObject = prev.IsComplexObject
 	sg.GenSchema.IsMap = prev.IsMap
 	sg.GenSchema.IsAdditionalProperties = prev.IsAdditionalProperties
@@ -1265,10 +3274,22 @@ func (sg *schemaGenContext) makeGenSchema() error {
 		return err
 	}
 
+	if err := sg.buildUnion(); err != nil {
+		return err
+	}
+
+	sg.buildNotRequired()
+
+	sg.buildPropertyCount()
+
 	if err := sg.buildXMLName(); err != nil {
 		return err
 	}
 
+	if err := sg.buildCustomTag(); err != nil {
+		return err
+	}
+
 	if err := sg.buildAdditionalItems(); err != nil {
 		return err
 	}
@@ -1281,6 +3302,20 @@ func (sg *schemaGenContext) makeGenSchema() error {
 		return err
 	}
 
+	if err := sg.buildPropertyNames(); err != nil {
+		return err
+	}
+
+	if err := sg.buildMapKey(); err != nil {
+		return err
+	}
+
+	// named slices and maps validate their elements
+	collection := sg.GenSchema.IsArray && sg.GenSchema.Items != nil || sg.GenSchema.IsMap && sg.GenSchema.AdditionalProperties != nil
+	if sg.Named && collection && contextValidatable(&sg.GenSchema) && sg.TypeResolver.hasContextValidations(&sg.Schema) {
+		sg.GenSchema.HasContextValidations = true
+	}
+
 	if Debug {
 		log.Printf("finished gen schema for %q\n", sg.Name)
 	}
@@ -16,14 +16,21 @@ package generator
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"testing"
 	"text/template"
 
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
 	"github.com/go-openapi/swag"
 	"github.com/stretchr/testify/assert"
 )
@@ -42,6 +49,35 @@ func (tt *templateTest) assertRender(data interface{}, expected string) bool {
 	return assert.Equal(tt.t, expected, buf.String())
 }
 
+// writeModels generates the definitions of a fixture in a package of the workspace
+func writeModels(t *testing.T, w *goWorkspace, pkg, fixture string, opts *GenOpts, names []string) bool {
+	specDoc, err := loads.Spec(fixture)
+	if !assert.NoError(t, err) {
+		return false
+	}
+	for _, name := range names {
+		genModel, err := makeGenDefinitionHierarchy(name, pkg, "", specDoc.Spec().Definitions[name], specDoc, true, true, opts)
+		if !assert.NoError(t, err) {
+			return false
+		}
+		buf := bytes.NewBuffer(nil)
+		if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) || !assert.NoError(t, w.WriteFile(pkg, name, buf.Bytes())) {
+			return false
+		}
+	}
+	return true
+}
+
+// runModels generates the definitions of a fixture in a main package along with the program,
+// then runs it and returns the lines it printed
+func runModels(t *testing.T, fixture string, opts *GenOpts, names []string, program string) ([]string, bool) {
+	w := newGoWorkspace(t)
+	if !writeModels(t, w, "main", fixture, opts, names) || !assert.NoError(t, w.WriteFile("main", "main", []byte(program))) {
+		return nil, false
+	}
+	return w.Go(t, "main", "run", ".")
+}
+
 func TestGenerateModel_Sanity(t *testing.T) {
 	// just checks if it can render and format these things
 	specDoc, err := loads.Spec("../fixtures/codegen/todolist.models.yml")
@@ -160,12 +196,17 @@ func TestGenerateModel_SchemaField(t *testing.T) {
 
 	var gmp GenSchema
 	gmp.Name = "some name"
-	gmp.resolvedType = resolvedType{GoType: "string", IsPrimitive: true}
+	gmp.ResolvedType = ResolvedType{GoType: "string", IsPrimitive: true}
 	gmp.Title = "The title of the property"
 
+	tt.assertRender(gmp, `// The title of the property
+`+"SomeName string `json:\"some name,omitempty\"`\n")
+
+	gmp.LegacyDocs = true
 	tt.assertRender(gmp, `/* The title of the property
  */
 `+"SomeName string `json:\"some name,omitempty\"`\n")
+	gmp.LegacyDocs = false
 
 	var fl float64 = 10
 	var in1 int64 = 20
@@ -184,6 +225,23 @@ func TestGenerateModel_SchemaField(t *testing.T) {
 	gmp.MinItems = &in2
 	gmp.UniqueItems = true
 	gmp.ReadOnly = true
+	tt.assertRender(gmp, `// The title of the property
+//
+// The description of the property
+//
+// Required: true
+// Read Only: true
+// Maximum: < 10
+// Minimum: > 10
+// Max Length: 20
+// Min Length: 20
+// Pattern: \w[\w- ]+
+// Max Items: 30
+// Min Items: 30
+// Unique: true
+`+"SomeName string `json:\"some name\"`\n")
+
+	gmp.LegacyDocs = true
 	tt.assertRender(gmp, `/* The title of the property
 
 The description of the property
@@ -206,40 +264,40 @@ var schTypeGenDataSimple = []struct {
 	Value    GenSchema
 	Expected string
 }{
-	{GenSchema{resolvedType: resolvedType{GoType: "string", IsPrimitive: true}}, "string"},
-	{GenSchema{resolvedType: resolvedType{GoType: "string", IsPrimitive: true, IsNullable: true}}, "*string"},
-	{GenSchema{resolvedType: resolvedType{GoType: "bool", IsPrimitive: true}}, "bool"},
-	{GenSchema{resolvedType: resolvedType{GoType: "int32", IsPrimitive: true}}, "int32"},
-	{GenSchema{resolvedType: resolvedType{GoType: "int64", IsPrimitive: true}}, "int64"},
-	{GenSchema{resolvedType: resolvedType{GoType: "float32", IsPrimitive: true}}, "float32"},
-	{GenSchema{resolvedType: resolvedType{GoType: "float64", IsPrimitive: true}}, "float64"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.Base64", IsPrimitive: true}}, "strfmt.Base64"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.Date", IsPrimitive: true}}, "strfmt.Date"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.DateTime", IsPrimitive: true}}, "strfmt.DateTime"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.URI", IsPrimitive: true}}, "strfmt.URI"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.Email", IsPrimitive: true}}, "strfmt.Email"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.Hostname", IsPrimitive: true}}, "strfmt.Hostname"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.IPv4", IsPrimitive: true}}, "strfmt.IPv4"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.IPv6", IsPrimitive: true}}, "strfmt.IPv6"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.UUID", IsPrimitive: true}}, "strfmt.UUID"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.UUID3", IsPrimitive: true}}, "strfmt.UUID3"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.UUID4", IsPrimitive: true}}, "strfmt.UUID4"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.UUID5", IsPrimitive: true}}, "strfmt.UUID5"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.ISBN", IsPrimitive: true}}, "strfmt.ISBN"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.ISBN10", IsPrimitive: true}}, "strfmt.ISBN10"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.ISBN13", IsPrimitive: true}}, "strfmt.ISBN13"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.CreditCard", IsPrimitive: true}}, "strfmt.CreditCard"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.SSN", IsPrimitive: true}}, "strfmt.SSN"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.HexColor", IsPrimitive: true}}, "strfmt.HexColor"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.RGBColor", IsPrimitive: true}}, "strfmt.RGBColor"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.Duration", IsPrimitive: true}}, "strfmt.Duration"},
-	{GenSchema{resolvedType: resolvedType{GoType: "strfmt.Password", IsPrimitive: true}}, "strfmt.Password"},
-	{GenSchema{resolvedType: resolvedType{GoType: "io.ReadCloser", IsStream: true}}, "io.ReadCloser"},
-	{GenSchema{resolvedType: resolvedType{GoType: "interface{}", IsInterface: true}}, "interface{}"},
-	{GenSchema{resolvedType: resolvedType{GoType: "[]int32", IsArray: true}}, "[]int32"},
-	{GenSchema{resolvedType: resolvedType{GoType: "[]string", IsArray: true}}, "[]string"},
-	{GenSchema{resolvedType: resolvedType{GoType: "map[string]int32", IsMap: true}}, "map[string]int32"},
-	{GenSchema{resolvedType: resolvedType{GoType: "models.Task", IsComplexObject: true, IsNullable: true, IsAnonymous: false}}, "*models.Task"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "string", IsPrimitive: true}}, "string"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "string", IsPrimitive: true, IsNullable: true}}, "*string"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "bool", IsPrimitive: true}}, "bool"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "int32", IsPrimitive: true}}, "int32"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "int64", IsPrimitive: true}}, "int64"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "float32", IsPrimitive: true}}, "float32"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "float64", IsPrimitive: true}}, "float64"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.Base64", IsPrimitive: true}}, "strfmt.Base64"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.Date", IsPrimitive: true}}, "strfmt.Date"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.DateTime", IsPrimitive: true}}, "strfmt.DateTime"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.URI", IsPrimitive: true}}, "strfmt.URI"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.Email", IsPrimitive: true}}, "strfmt.Email"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.Hostname", IsPrimitive: true}}, "strfmt.Hostname"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.IPv4", IsPrimitive: true}}, "strfmt.IPv4"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.IPv6", IsPrimitive: true}}, "strfmt.IPv6"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.UUID", IsPrimitive: true}}, "strfmt.UUID"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.UUID3", IsPrimitive: true}}, "strfmt.UUID3"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.UUID4", IsPrimitive: true}}, "strfmt.UUID4"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.UUID5", IsPrimitive: true}}, "strfmt.UUID5"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.ISBN", IsPrimitive: true}}, "strfmt.ISBN"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.ISBN10", IsPrimitive: true}}, "strfmt.ISBN10"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.ISBN13", IsPrimitive: true}}, "strfmt.ISBN13"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.CreditCard", IsPrimitive: true}}, "strfmt.CreditCard"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.SSN", IsPrimitive: true}}, "strfmt.SSN"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.HexColor", IsPrimitive: true}}, "strfmt.HexColor"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.RGBColor", IsPrimitive: true}}, "strfmt.RGBColor"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.Duration", IsPrimitive: true}}, "strfmt.Duration"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "strfmt.Password", IsPrimitive: true}}, "strfmt.Password"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "io.ReadCloser", IsStream: true}}, "io.ReadCloser"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "interface{}", IsInterface: true}}, "interface{}"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "[]int32", IsArray: true}}, "[]int32"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "[]string", IsArray: true}}, "[]string"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "map[string]int32", IsMap: true}}, "map[string]int32"},
+	{GenSchema{ResolvedType: ResolvedType{GoType: "models.Task", IsComplexObject: true, IsNullable: true, IsAnonymous: false}}, "*models.Task"},
 }
 
 func TestGenSchemaType(t *testing.T) {
@@ -608,6 +666,152 @@ func TestGenerateModel_WithMapComplexRegistry(t *testing.T) {
 	}
 }
 
+func TestGenerateModel_WithMapKeys(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.mapkeys.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+		k := "TasksByID"
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err) {
+			assert.True(t, genModel.IsMap)
+			assert.True(t, genModel.HasValidations)
+			assert.Equal(t, "uuid", genModel.KeyFormat)
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("tasks_by_id.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "type TasksByID map[string]string", res)
+					assertInCode(t, "for k := range m {", res)
+					assertInCode(t, "validate.FormatOf(\"\", \"body\", \"uuid\", k, formats)", res)
+				}
+			}
+		}
+
+		k = "Labels"
+		genModel, err = makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("labels.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "m.validateByCode(formats)", res)
+					assertInCode(t, "for k := range m.ByCode {", res)
+					assertInCode(t, "validate.Pattern(\"byCode\"+\".\"+k, \"body\", k, `^[A-Z]{3}$`)", res)
+					assertNotInCode(t, "validate.FormatOf", res)
+				}
+			}
+		}
+
+		// formats without a validator can't be used for keys
+		sch := definitions["TasksByID"]
+		sch.Extensions = spec.Extensions{}
+		sch.AddExtension(xPropNames, map[string]interface{}{"format": "int32"})
+		_, err = makeGenDefinition("TasksByID", "models", sch, specDoc, true, true)
+		assert.Error(t, err)
+	}
+}
+
+const mapKeysRoundTrip = `package main
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	fmt.Println("valid:", TasksByID{"7b6c1d2e-5f4a-4b3c-8d9e-0f1a2b3c4d5e": "dishes"}.Validate(strfmt.Default))
+	fmt.Println("bad key:", TasksByID{"not-a-uuid": "dishes"}.Validate(strfmt.Default))
+	labels := &Labels{ByCode: map[string]int32{"ABC": 1}}
+	fmt.Println("valid code:", labels.Validate(strfmt.Default))
+	labels.ByCode["abcd"] = 2
+	fmt.Println("bad code:", labels.Validate(strfmt.Default))
+}
+`
+
+func TestGenerateModel_MapKeysRoundTrip(t *testing.T) {
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.mapkeys.yml", nil, []string{"TasksByID", "Labels"}, mapKeysRoundTrip); ok {
+		assert.Equal(t, []string{
+			"valid: <nil>",
+			`bad key:  in body must be of type uuid: "not-a-uuid"`,
+			"valid code: <nil>",
+			"bad code: validation failure list:",
+			"byCode.abcd in body should match '^[A-Z]{3}$'",
+		}, lines)
+	}
+}
+
+func TestGenerateModel_MapValueFormats(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.map-formats.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	expected := map[string][]string{
+		"Links": {
+			"type Links map[string]strfmt.URI",
+			"for k := range m {",
+			"validate.FormatOf(k, \"body\", \"uri\", m[k].String(), formats)",
+		},
+		"Task": {
+			"Links map[string]strfmt.URI `json:\"links,omitempty\"`",
+			"for k := range m.Links {",
+			"validate.FormatOf(\"links\"+\".\"+k, \"body\", \"uri\", m.Links[k].String(), formats)",
+		},
+	}
+	for k, lines := range expected {
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err) {
+			assert.True(t, genModel.HasValidations, k)
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+				ff, err := formatGoFile(swag.ToFileName(k)+".go", buf.Bytes())
+				if assert.NoError(t, err, buf.String()) {
+					res := string(ff)
+					for _, line := range lines {
+						assertInCode(t, line, res)
+					}
+				}
+			}
+		}
+	}
+}
+
+const mapValueFormatsRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	for _, raw := range []string{
+		` + "`" + `{"home":"http://example.com","docs":"https://example.com/docs"}` + "`" + `,
+		` + "`" + `{"home":"http://example.com","docs":"not a uri"}` + "`" + `,
+	} {
+		var links Links
+		if err := json.Unmarshal([]byte(raw), &links); err != nil {
+			panic(err)
+		}
+		fmt.Println(links.Validate(strfmt.Default))
+	}
+}
+`
+
+func TestGenerateModel_MapValueFormatsRoundTrip(t *testing.T) {
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.map-formats.yml", nil, []string{"Links"}, mapValueFormatsRoundTrip); ok {
+		// only the bad value fails, reported under its key
+		assert.Equal(t, "<nil>", lines[0])
+		assertInCode(t, "docs in body must be of type uri", strings.Join(lines[1:], "\n"))
+	}
+}
+
 func TestGenerateModel_WithAdditional(t *testing.T) {
 	specDoc, err := loads.Spec("../fixtures/codegen/todolist.models.yml")
 	if assert.NoError(t, err) {
@@ -1295,6 +1499,100 @@ func TestGenerateModel_WithTupleWithExtra(t *testing.T) {
 	}
 }
 
+func TestGenerateModel_TuplesAsSlices(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.models.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+		opts := &GenOpts{TuplesAsSlices: true}
+
+		k := "TupleWithExtra"
+		genModel, err := makeGenDefinitionHierarchy(k, "models", "", definitions[k], specDoc, true, true, opts)
+		if assert.NoError(t, err) && assert.Empty(t, genModel.ExtraSchemas) {
+			assert.False(t, genModel.IsTuple)
+			assert.True(t, genModel.IsArray)
+			assert.Empty(t, genModel.Properties)
+			assert.Nil(t, genModel.AdditionalItems)
+			assert.Equal(t, k, genModel.GoType)
+			assert.Equal(t, "[]interface{}", genModel.AliasedType)
+			assert.NotEmpty(t, genModel.PositionalItems)
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("tuple_with_extra.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "type "+k+" []interface{}", res)
+					assertInCode(t, "func (m "+k+") Validate(formats strfmt.Registry) error", res)
+					assertInCode(t, "validate.AgainstSchema(&tupleWithExtraPositional, swag.ToDynamicJSON(m), formats)", res)
+					// the schema is parsed once, not on every call to Validate
+					assertInCode(t, "var tupleWithExtraPositional spec.Schema", res)
+					assertInCode(t, "func init() {", res)
+					assert.Equal(t, 1, strings.Count(res, "json.Unmarshal("))
+					// refs are expanded so the runtime validation doesn't need the spec
+					assertNotInCode(t, "#/definitions/Notable", res)
+					assertNotInCode(t, "struct {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+
+		k = "WithTuple"
+		genModel, err = makeGenDefinitionHierarchy(k, "models", "", definitions[k], specDoc, true, true, opts)
+		if assert.NoError(t, err) && assert.Empty(t, genModel.ExtraSchemas) && assert.NotEmpty(t, genModel.Properties) {
+			prop := genModel.Properties[0]
+			assert.True(t, prop.IsArray)
+			assert.False(t, prop.IsTuple)
+			assert.Equal(t, "[]interface{}", prop.GoType)
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("with_tuple.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "Flags []interface{} `json:\"flags,omitempty\"`", res)
+					assertInCode(t, "validate.AgainstSchema(&withTupleFlagsPositional, swag.ToDynamicJSON(m.Flags), formats)", res)
+					assertInCode(t, "var withTupleFlagsPositional spec.Schema", res)
+					assertNotInCode(t, "FlagsTuple0", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+const tuplesAsSlicesRoundTrip = `package main
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	tuple := TupleWithExtra{1, "two", "2016-11-01T10:00:00Z", map[string]interface{}{"notes": "long enough"}, 1.5}
+	fmt.Println("valid:", tuple.Validate(strfmt.Default))
+	fmt.Println("valid again:", tuple.Validate(strfmt.Default))
+	fmt.Println("short notes:", TupleWithExtra{1, "two", "2016-11-01T10:00:00Z", map[string]interface{}{"notes": "no"}}.Validate(strfmt.Default) != nil)
+	fmt.Println("valid flags:", (&WithTuple{Flags: []interface{}{1, "two"}}).Validate(strfmt.Default))
+	fmt.Println("bad flags:", (&WithTuple{Flags: []interface{}{"one", "two"}}).Validate(strfmt.Default) != nil)
+}
+`
+
+func TestGenerateModel_TuplesAsSlicesRoundTrip(t *testing.T) {
+	opts := &GenOpts{TuplesAsSlices: true}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.models.yml", opts, []string{"TupleWithExtra", "WithTuple"}, tuplesAsSlicesRoundTrip); ok {
+		assert.Equal(t, []string{
+			"valid: <nil>",
+			"valid again: <nil>",
+			"short notes: true",
+			"valid flags: <nil>",
+			"bad flags: true",
+		}, lines)
+	}
+}
+
 func TestGenerateModel_WithAllOfAndDiscriminator(t *testing.T) {
 	specDoc, err := loads.Spec("../fixtures/codegen/todolist.models.yml")
 	if assert.NoError(t, err) {
@@ -1741,3 +2039,4566 @@ func TestGenModel_Issue524(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerateModel_WithIgnored(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.ignored.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+		k := "Task"
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("task.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "Secret string `json:\"-\"`", res)
+					assertInCode(t, "Audit *TaskAudit `json:\"-\"`", res)
+					assertInCode(t, "m.validateTitle(formats)", res)
+					assertNotInCode(t, "m.validateSecret(formats)", res)
+					assertNotInCode(t, "m.validateAudit(formats)", res)
+				}
+			}
+		}
+
+		opts := &GenOpts{OmitIgnored: true}
+		genModel, err = makeGenDefinitionHierarchy(k, "models", "", definitions[k], specDoc, true, true, opts)
+		if assert.NoError(t, err) {
+			assert.Len(t, genModel.Properties, 1)
+			assert.Empty(t, genModel.ExtraSchemas)
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("task.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "m.validateTitle(formats)", res)
+					assertNotInCode(t, "Secret", res)
+					assertNotInCode(t, "Audit", res)
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateModel_FormatWithLength(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.password.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+		k := "Account"
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("account.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "Password *strfmt.Password `json:\"password\"`", res)
+					assertInCode(t, "validate.MinLength(\"password\", \"body\", string(*m.Password), 8)", res)
+					assertInCode(t, "validate.MinLength(\"recovery\"+\".\"+strconv.Itoa(i), \"body\", string(m.Recovery[i]), 8)", res)
+				}
+			}
+		}
+	}
+}
+
+const formatWithLengthRoundTrip = `package main
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	short := strfmt.Password("short")
+	fmt.Println("short:", (&Account{Password: &short}).Validate(strfmt.Default))
+	long := strfmt.Password("correct horse")
+	fmt.Println("long:", (&Account{Password: &long, Recovery: []strfmt.Password{"battery staple"}}).Validate(strfmt.Default))
+	fmt.Println("short recovery:", (&Account{Password: &long, Recovery: []strfmt.Password{"staple"}}).Validate(strfmt.Default))
+}
+`
+
+func TestGenerateModel_FormatWithLengthRoundTrip(t *testing.T) {
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.password.yml", nil, []string{"Account"}, formatWithLengthRoundTrip); ok {
+		assert.Equal(t, []string{
+			"short: validation failure list:",
+			"password in body should be at least 8 chars long",
+			"long: <nil>",
+			"short recovery: validation failure list:",
+			"recovery.0 in body should be at least 8 chars long",
+		}, lines)
+	}
+}
+
+func TestGenerateModel_MergePatch(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.patch.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+		k := "Task"
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err) {
+			assert.True(t, genModel.WithPatch)
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("task.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "type TaskPatch struct", res)
+					assertInCode(t, "Title *string `json:\"title,omitempty\"`", res)
+					assertInCode(t, "Priority *int32 `json:\"priority,omitempty\"`", res)
+					assertInCode(t, "Tags *[]string `json:\"tags,omitempty\"`", res)
+					assertInCode(t, "func (m *TaskPatch) ApplyTo(target *Task)", res)
+					assertInCode(t, "target.Owner = &value", res)
+					assertInCode(t, "target.Priority = value", res)
+				}
+			}
+		}
+
+		genModel, err = makeGenDefinition("User", "models", definitions["User"], specDoc, true, true)
+		if assert.NoError(t, err) {
+			assert.False(t, genModel.WithPatch)
+		}
+
+		// only plain objects get a patch
+		_, err = makeGenDefinition("Tags", "models", definitions["Tags"], specDoc, true, true)
+		assert.Error(t, err)
+	}
+}
+
+const mergePatchRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func main() {
+	title := "write tests"
+	task := Task{Title: &title, Description: "first draft", Priority: 3, Tags: []string{"dev"}}
+
+	var patch TaskPatch
+	if err := json.Unmarshal([]byte(` + "`" + `{"description":null,"priority":5}` + "`" + `), &patch); err != nil {
+		panic(err)
+	}
+	patch.ApplyTo(&task)
+	out, _ := json.Marshal(task)
+	fmt.Println(string(out))
+
+	back, _ := json.Marshal(patch)
+	fmt.Println(string(back))
+}
+`
+
+func TestGenerateModel_MergePatchRoundTrip(t *testing.T) {
+	lines, ok := runModels(t, "../fixtures/codegen/todolist.patch.yml", nil, []string{"Task", "User"}, mergePatchRoundTrip)
+	if ok && assert.Len(t, lines, 2) {
+		// the title is left alone, the description is deleted and the priority is set
+		assert.JSONEq(t, `{"title":"write tests","priority":5,"tags":["dev"]}`, lines[0])
+		assert.JSONEq(t, `{"description":null,"priority":5}`, lines[1])
+	}
+}
+
+func TestGenerateModel_AllOfConflicts(t *testing.T) {
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.allof-conflicts.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+		k := "Task"
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("task.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					// the last member declaring id wins, and shadows the embedded Entity.ID
+					assertInCode(t, "Entity", res)
+					assertInCode(t, "ID string `json:\"id,omitempty\"`", res)
+					assertNotInCode(t, "ID int32", res)
+					assertInCode(t, "validate.MinLength(\"id\", \"body\", string(m.ID), 2)", res)
+					assertInCode(t, "Title string `json:\"title,omitempty\"`", res)
+					assertInCode(t, "Done bool `json:\"done,omitempty\"`", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+		assertInCode(t, "warning: Task: property \"id\" is declared with different types by the allOf members #/definitions/Entity, allOf[1], allOf[2], using string from allOf[2]", logged.String())
+
+		// two embedded types can't be disambiguated
+		_, err = makeGenDefinition("Labeled", "models", definitions["Labeled"], specDoc, true, true)
+		assert.Error(t, err)
+
+		// strict mode turns the warning into an error
+		opts := &GenOpts{Strict: true}
+		_, err = makeGenDefinitionHierarchy(k, "models", "", definitions[k], specDoc, true, true, opts)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "property \"id\" is declared with different types")
+		}
+	}
+}
+
+func TestGenerateModel_AllOfEmbedding(t *testing.T) {
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.allof-embedding.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+		k := "Task"
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"Entity", "Schedule"}, genModel.Embeds)
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("task.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "type Task struct {\n\tEntity\n\n\tSchedule\n}", res)
+					assertInCode(t, "m.Entity.Validate(formats)", res)
+					assertInCode(t, "m.Schedule.Validate(formats)", res)
+					assertNotInCode(t, "Name string", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+		assertInCode(t, "warning: Task: property \"name\" is declared by both embedded types Entity and Schedule, it must be qualified with the embedded type", logged.String())
+
+		// inline members are not embedded
+		genModel, err = makeGenDefinition("Note", "models", definitions["Note"], specDoc, true, true)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"Entity"}, genModel.Embeds)
+		}
+	}
+}
+
+func TestGenerateModel_AllOfRequired(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.allof-required.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	for _, v := range []struct {
+		Name     string
+		Expected []string
+		Absent   []string
+	}{
+		{"Task",
+			[]string{
+				"validate.Required(\"title\", \"body\", m.Entity.Title)",
+				"validate.Required(\"size\", \"body\", m.Entity.Size)",
+				"Notes *string `json:\"notes\"`",
+				"validate.Required(\"notes\", \"body\", m.Notes)",
+			},
+			[]string{"m.Entity.Notes"},
+		},
+		{"Chore",
+			[]string{
+				"type Chore struct {\n\tEntity\n}",
+				"validate.Required(\"title\", \"body\", m.Entity.Title)",
+			},
+			[]string{"m.Entity.Size"},
+		},
+		// the required list of the embedded type applies to the inline property
+		{"Labeled",
+			[]string{
+				"Label *string `json:\"label\"`",
+				"validate.Required(\"label\", \"body\", m.Label)",
+			},
+			[]string{"m.Tagged.Label"},
+		},
+	} {
+		genModel, err := makeGenDefinition(v.Name, "models", definitions[v.Name], specDoc, true, true)
+		if !assert.NoError(t, err, v.Name) {
+			continue
+		}
+		buf := bytes.NewBuffer(nil)
+		if !assert.NoError(t, modelTemplate.Execute(buf, genModel), v.Name) {
+			continue
+		}
+		ff, err := formatGoFile(swag.ToFileName(v.Name)+".go", buf.Bytes())
+		if !assert.NoError(t, err, v.Name) {
+			fmt.Println(buf.String())
+			continue
+		}
+		res := string(ff)
+		for _, line := range v.Expected {
+			assertInCode(t, line, res)
+		}
+		for _, line := range v.Absent {
+			assertNotInCode(t, line, res)
+		}
+	}
+
+	// the spec is left untouched
+	assert.Len(t, definitions["Task"].AllOf[1].Required, 3)
+	assert.Empty(t, definitions["Labeled"].AllOf[1].Required)
+}
+
+func TestGenerateModel_AllOfRequiredProperty(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.models.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	k := "Comment"
+	genModel, err := makeGenDefinition(k, "models", specDoc.Spec().Definitions[k], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("comment.go", buf.Bytes())
+	if !assert.NoError(t, err) {
+		fmt.Println(buf.String())
+		return
+	}
+	res := string(ff)
+	// content is required by the comment, the properties of its allOf members are not
+	assertInCode(t, "Content struct {", res)
+	assertInCode(t, "Title string `json:\"title,omitempty\"`", res)
+	assertInCode(t, "Body string `json:\"body,omitempty\"`", res)
+	assertInCode(t, "Age Age `json:\"age,omitempty\"`", res)
+	assertInCode(t, "LatestTag *Tag `json:\"latestTag,omitempty\"`", res)
+	for _, name := range []string{"title", "body", "age", "scores", "latestTag"} {
+		assertNotInCode(t, "validate.Required(\"content\"+\".\"+\""+name+"\"", res)
+	}
+}
+
+func TestGenerateModel_AllOfRequiredRoundTrip(t *testing.T) {
+	names := []string{"Entity", "Task", "Chore"}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.allof-required.yml", nil, names, allOfRequiredRoundTrip); ok {
+		assert.Equal(t, []string{
+			`task: true`,
+			`complete task: <nil>`,
+			`chore: true`,
+			`complete chore: <nil>`,
+		}, lines)
+	}
+}
+
+const allOfRequiredRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	var task Task
+	if err := json.Unmarshal([]byte(` + "`" + `{"notes": "n"}` + "`" + `), &task); err != nil {
+		panic(err)
+	}
+	fmt.Println("task:", task.Validate(strfmt.Default) != nil)
+	task = Task{}
+	if err := json.Unmarshal([]byte(` + "`" + `{"title": "dishes", "size": 2, "notes": "n"}` + "`" + `), &task); err != nil {
+		panic(err)
+	}
+	fmt.Println("complete task:", task.Validate(strfmt.Default))
+
+	var chore Chore
+	if err := json.Unmarshal([]byte(` + "`" + `{"size": 1}` + "`" + `), &chore); err != nil {
+		panic(err)
+	}
+	fmt.Println("chore:", chore.Validate(strfmt.Default) != nil)
+	chore = Chore{}
+	if err := json.Unmarshal([]byte(` + "`" + `{"title": "laundry"}` + "`" + `), &chore); err != nil {
+		panic(err)
+	}
+	fmt.Println("complete chore:", chore.Validate(strfmt.Default))
+}
+`
+
+func TestGenerateModel_ContextFormats(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.context-formats.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+		opts := &GenOpts{ContextFormats: []string{"registered-user"}}
+		k := "Task"
+		genModel, err := makeGenDefinitionHierarchy(k, "models", "", definitions[k], specDoc, true, true, opts)
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			err := modelTemplate.Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("task.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "\"context\"", res)
+					assertInCode(t, "func (m *Task) ContextValidate(ctx context.Context, formats strfmt.Registry) error {", res)
+					assertInCode(t, "m.contextValidateOwner(ctx, formats)", res)
+					assertInCode(t, "m.contextValidateReviewer(ctx, formats)", res)
+					assertNotInCode(t, "m.contextValidateContact(ctx, formats)", res)
+					assertNotInCode(t, "m.contextValidateTitle(ctx, formats)", res)
+					assertInCode(t, "value := string(*m.Owner)", res)
+					assertInCode(t, "value := string(m.Reviewer)", res)
+					assertInCode(t, "return cv.ValidatesContext(ctx, \"registered-user\", value)", res)
+					assertInCode(t, "validate.FormatOf(\"reviewer\", \"body\", \"registered-user\", value, formats)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+
+		// models without context-aware formats are left alone
+		genModel, err = makeGenDefinitionHierarchy("Tag", "models", "", definitions["Tag"], specDoc, true, true, opts)
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+				assertNotInCode(t, "ContextValidate", buf.String())
+			}
+		}
+
+		// without the option the format is a plain string
+		genModel, err = makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+				assertNotInCode(t, "ContextValidate", buf.String())
+			}
+		}
+	}
+}
+
+const contextFormatsRoundTrip = `package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+type usersKey struct{}
+
+// registry looks the registered users up in the request context
+type registry struct {
+	strfmt.Registry
+}
+
+func (r registry) ValidatesContext(ctx context.Context, format, value string) error {
+	users, _ := ctx.Value(usersKey{}).([]string)
+	for _, u := range users {
+		if u == value {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not a %s", value, format)
+}
+
+func main() {
+	ctx := context.WithValue(context.Background(), usersKey{}, []string{"alice", "bob"})
+	for _, raw := range []string{
+		` + "`" + `{"title":"write tests","owner":"alice","reviewer":"bob"}` + "`" + `,
+		` + "`" + `{"title":"write tests","owner":"alice","reviewer":"mallory"}` + "`" + `,
+	} {
+		var task Task
+		if err := json.Unmarshal([]byte(raw), &task); err != nil {
+			panic(err)
+		}
+		fmt.Println(task.ContextValidate(ctx, registry{strfmt.Default}))
+	}
+
+	// a registry without context-aware validators falls back to the plain ones
+	var task Task
+	if err := json.Unmarshal([]byte(` + "`" + `{"title":"write tests","owner":"alice"}` + "`" + `), &task); err != nil {
+		panic(err)
+	}
+	fmt.Println(task.ContextValidate(ctx, strfmt.Default))
+}
+`
+
+func TestGenerateModel_ContextFormatsRoundTrip(t *testing.T) {
+	opts := &GenOpts{ContextFormats: []string{"registered-user"}}
+	lines, ok := runModels(t, "../fixtures/codegen/todolist.context-formats.yml", opts, []string{"Task"}, contextFormatsRoundTrip)
+	if ok {
+		res := strings.Join(lines, "\n")
+		// only the unknown reviewer fails, the composite errors print on several lines
+		assert.Equal(t, "<nil>", lines[0])
+		assertInCode(t, "mallory is not a registered-user", res)
+		assertInCode(t, "registered-user is an invalid type name", res)
+	}
+}
+
+func TestGenerateModel_ContextFormatsCollections(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.context-formats.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{ContextFormats: []string{"registered-user"}}
+
+	expected := map[string][]string{
+		"Project": {
+			"func (m *Project) ContextValidate(ctx context.Context, formats strfmt.Registry) error {",
+			"if err := m.Backlog.ContextValidate(ctx, formats); err != nil {",
+			"if err := m.Lead.ContextValidate(ctx, formats); err != nil {",
+			"for i := 0; i < len(m.Tasks); i++ {",
+			"for k := range m.ByOwner {",
+			"val := m.ByOwner[k]",
+			"if i%1024 == 0 {",
+			"if err := ctx.Err(); err != nil {",
+			"cv.ValidatesContext(ctx, \"registered-user\", string(val))",
+			"validate.FormatOf(\"watchers\"+\".\"+strconv.Itoa(i), \"body\", \"registered-user\", string(val), formats)",
+		},
+		"TaskList": {
+			"func (m TaskList) ContextValidate(ctx context.Context, formats strfmt.Registry) error {",
+			"for i := 0; i < len(m); i++ {",
+		},
+		"TasksByOwner": {
+			"func (m TasksByOwner) ContextValidate(ctx context.Context, formats strfmt.Registry) error {",
+			"for k := range m {",
+		},
+	}
+	for k, lines := range expected {
+		genModel, err := makeGenDefinitionHierarchy(k, "models", "", definitions[k], specDoc, true, true, opts)
+		if !assert.NoError(t, err, k) {
+			continue
+		}
+		buf := bytes.NewBuffer(nil)
+		if !assert.NoError(t, modelTemplate.Execute(buf, genModel), k) {
+			continue
+		}
+		ff, err := formatGoFile(k+".go", buf.Bytes())
+		if assert.NoError(t, err, k) {
+			res := string(ff)
+			for _, line := range lines {
+				assertInCode(t, line, res)
+			}
+			// tags don't use a context-aware format
+			assertNotInCode(t, "contextValidateTags", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
+const contextCollectionsRoundTrip = `package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// registry cancels the validation once it has seen enough values
+type registry struct {
+	strfmt.Registry
+	cancel context.CancelFunc
+	seen   int
+}
+
+func (r *registry) ValidatesContext(ctx context.Context, format, value string) error {
+	r.seen++
+	if r.seen == 10 {
+		r.cancel()
+	}
+	return nil
+}
+
+func main() {
+	title, owner := "write tests", "alice"
+	tasks := make(TaskList, 100000)
+	for i := range tasks {
+		tasks[i] = &Task{Title: &title, Owner: &owner}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reg := &registry{Registry: strfmt.Default, cancel: cancel}
+	err := tasks.ContextValidate(ctx, reg)
+	fmt.Println(err == context.Canceled, reg.seen < 2048)
+
+	// a model returns the context error rather than a composite of what failed
+	project := Project{Tasks: tasks, Watchers: []string{"bob"}}
+	fmt.Println(project.ContextValidate(ctx, reg) == context.Canceled)
+
+	// with a live context every value is validated
+	reg = &registry{Registry: strfmt.Default, cancel: func() {}}
+	fmt.Println(tasks.ContextValidate(context.Background(), reg), reg.seen)
+}
+`
+
+func TestGenerateModel_ContextFormatsCollectionsRoundTrip(t *testing.T) {
+	opts := &GenOpts{ContextFormats: []string{"registered-user"}}
+	names := []string{"Task", "TaskList", "Tag", "Project"}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.context-formats.yml", opts, names, contextCollectionsRoundTrip); ok {
+		assert.Equal(t, []string{"true true", "true", "<nil> 100000"}, lines)
+	}
+}
+
+func TestGenerateModel_EnumNarrowing(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.enum-narrowing.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	k := "Task"
+	genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("task.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "Active Status `json:\"active,omitempty\"`", res)
+				assertInCode(t, "Closed Status `json:\"closed,omitempty\"`", res)
+				// the sibling enum and the one from allOf narrow the referenced values
+				assertInCode(t, "json.Unmarshal([]byte(`[\"open\",\"in-progress\"]`), &res)", res)
+				assertInCode(t, "json.Unmarshal([]byte(`[\"done\"]`), &res)", res)
+				assertInCode(t, "if err := m.Active.Validate(formats); err != nil {", res)
+				assertInCode(t, "if err := m.validateActiveEnum(\"active\", \"body\", m.Active); err != nil {", res)
+				assertInCode(t, "if err := m.validateClosedEnum(\"closed\", \"body\", m.Closed); err != nil {", res)
+				assertNotInCode(t, "m.validateStateEnum", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// only primitive types are narrowed
+	project := spec.Schema{}
+	project.Typed("object", "")
+	lead := *spec.RefProperty("#/definitions/Task")
+	lead.Enum = []interface{}{map[string]interface{}{"state": "open"}}
+	project.SetProperty("lead", lead)
+	_, err = makeGenDefinition("Project", "models", project, specDoc, true, true)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Project.lead: an enum next to a $ref only narrows primitive types")
+	}
+}
+
+const enumNarrowingRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	for _, raw := range []string{
+		` + "`" + `{"state":"blocked","active":"open","closed":"done"}` + "`" + `,
+		` + "`" + `{"state":"blocked","active":"blocked"}` + "`" + `,
+		` + "`" + `{"state":"blocked","closed":"open"}` + "`" + `,
+		` + "`" + `{"state":"blocked","active":"unknown"}` + "`" + `,
+	} {
+		var task Task
+		if err := json.Unmarshal([]byte(raw), &task); err != nil {
+			panic(err)
+		}
+		fmt.Println(task.Validate(strfmt.Default) == nil)
+	}
+}
+`
+
+func TestGenerateModel_EnumNarrowingRoundTrip(t *testing.T) {
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.enum-narrowing.yml", nil, []string{"Status", "Task"}, enumNarrowingRoundTrip); ok {
+		// blocked is a status, but not an active one
+		assert.Equal(t, []string{"true", "false", "false", "false"}, lines)
+	}
+}
+
+func TestGenerateModel_Deprecated(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.deprecated.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	expected := map[string][]string{
+		// a deprecated property documents its field
+		"Task": {"// the user working on the task\n\t//\n\t// Deprecated: assignee is deprecated in the API definition\n\tAssignee string"},
+		// a deprecated definition documents its type
+		"Labels": {"// Labels labels\n//\n// Deprecated: labels is deprecated in the API definition\n"},
+	}
+	for k, lines := range expected {
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if !assert.NoError(t, err, k) {
+			continue
+		}
+		buf := bytes.NewBuffer(nil)
+		if !assert.NoError(t, modelTemplate.Execute(buf, genModel), k) {
+			continue
+		}
+		ff, err := formatGoFile(k+".go", buf.Bytes())
+		if assert.NoError(t, err, k) {
+			res := string(ff)
+			for _, line := range lines {
+				assertInCode(t, line, res)
+			}
+			assert.Equal(t, 1, strings.Count(res, "Deprecated:"), k)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_NestedMaps(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.nested-maps.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	resolver := newTypeResolver("models", specDoc)
+	for k, expected := range map[string]string{
+		"Labels":       "map[string]map[string]string",
+		"Translations": "map[string]map[string]string",
+		"Users":        "map[string]map[string]models.Task",
+	} {
+		sch := definitions[k]
+		rt, err := resolver.ResolveSchema(&sch, true, true)
+		if assert.NoError(t, err, k) {
+			assert.True(t, rt.IsMap, k)
+			assert.Equal(t, expected, rt.GoType, k)
+			if assert.NotNil(t, rt.ElemType, k) {
+				assert.True(t, rt.ElemType.IsMap, k)
+			}
+		}
+	}
+
+	expected := map[string][]string{
+		"Labels": {"type Labels map[string]map[string]string"},
+		"Task": {
+			"Labels map[string]map[string]string `json:\"labels,omitempty\"`",
+			"Scores map[string]map[string]map[string]int32 `json:\"scores,omitempty\"`",
+		},
+		"Limited": {
+			"type Limited map[string]map[string]string",
+			"for kk := range m[k] {",
+			"validate.MaxLength(k+\".\"+kk, \"body\", string(m[k][kk]), 10)",
+		},
+		"Listed": {"ByTag []map[string]map[string]string `json:\"byTag,omitempty\"`"},
+	}
+	for k, lines := range expected {
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if !assert.NoError(t, err, k) {
+			continue
+		}
+		buf := bytes.NewBuffer(nil)
+		if !assert.NoError(t, modelTemplate.Execute(buf, genModel), k) {
+			continue
+		}
+		ff, err := formatGoFile(k+".go", buf.Bytes())
+		if assert.NoError(t, err, k) {
+			res := string(ff)
+			for _, line := range lines {
+				assertInCode(t, line, res)
+			}
+			assertNotInCode(t, "map[string]interface{}", res)
+			assertNotInCode(t, "*m[k]", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_NetIP(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.net-ip.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{NetIP: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Host", "models", "", definitions["Host"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("host.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				// goimports adds the package of the standard library
+				assertInCode(t, "\t\"net\"\n", res)
+				assertInCode(t, "Address net.IP `json:\"address\"`", res)
+				assertInCode(t, "Address6 net.IP `json:\"address6,omitempty\"`", res)
+				assertInCode(t, "Aliases []net.IP `json:\"aliases,omitempty\"`", res)
+				assertInCode(t, "validate.Required(\"address\", \"body\", net.IP(m.Address))", res)
+				assertInCode(t, "validate.FormatOf(\"address\", \"body\", \"ipv4\", m.Address.String(), formats)", res)
+				assertInCode(t, "validate.FormatOf(\"address6\", \"body\", \"ipv6\", m.Address6.String(), formats)", res)
+				assertInCode(t, "validate.FormatOf(\"aliases\"+\".\"+strconv.Itoa(i), \"body\", \"ipv4\", m.Aliases[i].String(), formats)", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	genModel, err = makeGenDefinitionHierarchy("Gateway", "models", "", definitions["Gateway"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			res := buf.String()
+			assertInCode(t, "type Gateway net.IP", res)
+			assertInCode(t, "func (m *Gateway) UnmarshalText(text []byte) error", res)
+		}
+	}
+
+	// without the option the formats keep their strfmt types
+	genModel, err = makeGenDefinition("Host", "models", definitions["Host"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		assert.NotContains(t, genModel.DefaultImports, "net")
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			res := buf.String()
+			assertInCode(t, "strfmt.IPv4", res)
+			assertNotInCode(t, "net.IP", res)
+		}
+	}
+}
+
+func TestGenerateModel_NetIPRoundTrip(t *testing.T) {
+	opts := &GenOpts{NetIP: true}
+	lines, ok := runModels(t, "../fixtures/codegen/todolist.net-ip.yml", opts, []string{"Host", "Gateway"}, netIPRoundTrip)
+	if ok {
+		assert.Equal(t, []string{
+			`{"address":"10.0.0.1","address6":"fe80::1","aliases":["10.0.0.2"]} <nil>`,
+			`"2001:db8::1" <nil>`,
+			"true",
+			"malformed", "malformed",
+			"invalid", "invalid",
+		}, lines)
+	}
+}
+
+const netIPRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	var host Host
+	if err := json.Unmarshal([]byte(` + "`" + `{"address":"10.0.0.1","address6":"fe80::1","aliases":["10.0.0.2"]}` + "`" + `), &host); err != nil {
+		panic(err)
+	}
+	out, _ := json.Marshal(host)
+	fmt.Println(string(out), host.Validate(strfmt.Default))
+
+	var gateway Gateway
+	if err := json.Unmarshal([]byte(` + "`" + `"2001:db8::1"` + "`" + `), &gateway); err != nil {
+		panic(err)
+	}
+	out, _ = json.Marshal(gateway)
+	fmt.Println(string(out), gateway.Validate(strfmt.Default))
+
+	// the zero value is nil
+	var empty Host
+	fmt.Println(empty.Address6 == nil)
+
+	// addresses which don't parse are rejected when unmarshalling
+	for _, raw := range []string{` + "`" + `{"address":"10.0.0.300"}` + "`" + `, ` + "`" + `"fe80::1::2"` + "`" + `} {
+		var target interface{} = &Host{}
+		if raw[0] == '"' {
+			target = &Gateway{}
+		}
+		if err := json.Unmarshal([]byte(raw), target); err != nil {
+			fmt.Println("malformed")
+		}
+	}
+
+	// addresses of the other family fail validation
+	var wrong Host
+	if err := json.Unmarshal([]byte(` + "`" + `{"address":"fe80::1"}` + "`" + `), &wrong); err != nil {
+		panic(err)
+	}
+	if err := wrong.Validate(strfmt.Default); err != nil {
+		fmt.Println("invalid")
+	}
+	if err := json.Unmarshal([]byte(` + "`" + `"10.0.0.1"` + "`" + `), &gateway); err != nil {
+		panic(err)
+	}
+	if err := gateway.Validate(strfmt.Default); err != nil {
+		fmt.Println("invalid")
+	}
+}
+`
+
+func TestGenerateModel_DirtyTracking(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.dirty-tracking.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{DirtyTracking: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Task", "models", "", definitions["Task"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		assert.True(t, genModel.DirtyTracking)
+		// the properties of the anonymous allOf member get setters, the embedded model has its own
+		assert.Len(t, genModel.DirtyFields, 5)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("task.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "changed uint64\n}", res)
+				assertInCode(t, "func (m *Task) SetTitle(value *string) {\n\tm.Title = value\n\tm.changed |= 1 << 4\n}", res)
+				assertInCode(t, "func (m *Task) SetOwner(value *User)", res)
+				assertInCode(t, "func (m *Task) ChangedFields() []string", res)
+				assertInCode(t, `res = append(res, "owner."+f)`, res)
+				assertInCode(t, "interface{}(&m.Record).(interface{ ChangedFields() []string })", res)
+				assertNotInCode(t, "func (m *Task) SetCreatedAt(", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// without the option the models are left as they are
+	genModel, err = makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.DirtyTracking)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			res := buf.String()
+			assertNotInCode(t, "changed uint64", res)
+			assertNotInCode(t, "ChangedFields", res)
+		}
+	}
+}
+
+func TestGenerateModel_DirtyTrackingRoundTrip(t *testing.T) {
+	opts := &GenOpts{DirtyTracking: true}
+	lines, ok := runModels(t, "../fixtures/codegen/todolist.dirty-tracking.yml", opts, []string{"Record", "User", "Task"}, dirtyTrackingRoundTrip)
+	if ok {
+		assert.Equal(t, []string{
+			"[]",
+			"[priority title]",
+			"[owner owner.email priority title updatedBy]",
+			"[]",
+		}, lines)
+	}
+}
+
+const dirtyTrackingRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func main() {
+	var task Task
+	if err := json.Unmarshal([]byte(` + "`" + `{"title":"write tests","tags":["dev"]}` + "`" + `), &task); err != nil {
+		panic(err)
+	}
+	// unmarshalling doesn't count as a change
+	fmt.Println(task.ChangedFields())
+
+	title := "write more tests"
+	task.SetTitle(&title)
+	task.SetPriority(2)
+	fmt.Println(task.ChangedFields())
+
+	// nested and embedded models report their own changes
+	task.SetOwner(&User{})
+	task.Owner.SetEmail("bob@example.com")
+	task.SetUpdatedBy("bob")
+	fmt.Println(task.ChangedFields())
+
+	var untouched *Task
+	fmt.Println(untouched.ChangedFields())
+}
+`
+
+func TestGenerateModel_DocLinks(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.doc-links.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("task.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "// Task A task to complete.\n//\n// Tasks are archived 30 days after completion.\n//\n// The lifecycle of a task: https://docs.example.com/tasks#lifecycle\n//\n// swagger:model Task\ntype Task struct", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	genModel, err = makeGenDefinition("User", "models", definitions["User"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("user.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				assertInCode(t, "// User user\n//\n// https://docs.example.com/users\n//\n// swagger:model User\ntype User struct", string(ff))
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	genModel, err = makeGenDefinition("Tag", "models", definitions["Tag"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("tag.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				assertInCode(t, `// Matches /*/ style paths */ too.`, string(ff))
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// a note can't end the block comment of the legacy docs early
+	genModel, err = makeGenDefinitionHierarchy("Tag", "models", "", definitions["Tag"], specDoc, true, true, &GenOpts{CompatMode: "0.5"})
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("tag.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				assertInCode(t, `Matches /*\/ style paths *\/ too.`, string(ff))
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+}
+
+func TestGenerateModel_Unwrap(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.unwrap.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinition("Title", "models", definitions["Title"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "value", genModel.UnwrapProperty)
+		assert.Contains(t, genModel.DefaultImports, "encoding/json")
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("title.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "Title The title of a task.", res)
+				assertInCode(t, "type Title string", res)
+				assertInCode(t, `validate.MinLength("", "body", string(m), 3)`, res)
+				assertInCode(t, "Value string `json:\"value\"`", res)
+				assertInCode(t, "*m = Title(wrapper.Value)", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// an inline object has no underlying type to convert to
+	genModel, err = makeGenDefinition("Estimate", "models", definitions["Estimate"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("estimate.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "type Estimate struct", res)
+				assertInCode(t, "type plain Estimate", res)
+				assertInCode(t, "Value plain `json:\"effort\"`", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// refs to a wrapper resolve to the unwrapped type
+	genModel, err = makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("task.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "Title Title `json:\"title\"`", res)
+				assertInCode(t, "Tags Tags `json:\"tags,omitempty\"`", res)
+				assertInCode(t, "Estimate *Estimate `json:\"estimate,omitempty\"`", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	_, err = makeGenDefinition("Broken", "models", definitions["Broken"], specDoc, true, true)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "x-go-unwrap only applies to objects with a single property")
+	}
+}
+
+func TestGenerateModel_UnwrapRoundTrip(t *testing.T) {
+	names := []string{"Title", "Tags", "Deadline", "Estimate", "Task"}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.unwrap.yml", nil, names, unwrapRoundTrip); ok {
+		assert.Equal(t, []string{
+			"write tests [dev docs] 4",
+			`{"estimate":{"effort":{"hours":4}},"tags":{"items":["dev","docs"]},"title":{"value":"write tests"}}`,
+			"<nil>",
+			"invalid",
+		}, lines)
+	}
+}
+
+const unwrapRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	var task Task
+	raw := ` + "`" + `{"title":{"value":"write tests"},"tags":{"items":["dev","docs"]},"estimate":{"effort":{"hours":4}}}` + "`" + `
+	if err := json.Unmarshal([]byte(raw), &task); err != nil {
+		panic(err)
+	}
+	// the Go values are the inner ones
+	var title string = string(task.Title)
+	var tags []string = task.Tags
+	fmt.Println(title, tags, task.Estimate.Hours)
+
+	// the wire format keeps the wrappers
+	out, err := json.Marshal(struct {
+		Estimate *Estimate ` + "`" + `json:"estimate"` + "`" + `
+		Tags     Tags      ` + "`" + `json:"tags"` + "`" + `
+		Title    Title     ` + "`" + `json:"title"` + "`" + `
+	}{task.Estimate, task.Tags, task.Title})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(out))
+	fmt.Println(task.Validate(strfmt.Default))
+
+	// the inner value keeps its validations
+	short := Title("no")
+	if err := short.Validate(strfmt.Default); err != nil {
+		fmt.Println("invalid")
+	}
+}
+`
+
+func TestGenerateModel_Builder(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.builder.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{WithBuilder: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Task", "models", "", definitions["Task"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		assert.True(t, genModel.HasBuilder)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("task.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "type TaskBuilder struct {\n\tmodel        Task\n\townerBuilder *UserBuilder\n}", res)
+				assertInCode(t, "func NewTaskBuilder() *TaskBuilder {", res)
+				// nullable properties take a value
+				assertInCode(t, "func (b *TaskBuilder) WithTitle(value string) *TaskBuilder {\n\tb.model.Title = &value", res)
+				assertInCode(t, "func (b *TaskBuilder) WithPriority(value int32) *TaskBuilder {\n\tb.model.Priority = &value", res)
+				assertInCode(t, "b.model.Done = value", res)
+				assertInCode(t, "b.model.Tags = value", res)
+				assertInCode(t, "func (b *TaskBuilder) Owner() *UserBuilder {", res)
+				assertInCode(t, "m.Owner = b.ownerBuilder.Build()", res)
+				// the anonymous object has no builder of its own
+				assertInCode(t, "b.model.Details = &value", res)
+				assertNotInCode(t, "func (b *TaskBuilder) Details()", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// maps get no builder
+	genModel, err = makeGenDefinitionHierarchy("Labels", "models", "", definitions["Labels"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.HasBuilder)
+	}
+
+	// without the option the models are left as they are
+	genModel, err = makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.HasBuilder)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertNotInCode(t, "TaskBuilder", buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_BuilderRoundTrip(t *testing.T) {
+	opts := &GenOpts{WithBuilder: true}
+	names := []string{"Address", "User", "Task"}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.builder.yml", opts, names, builderRoundTrip); ok {
+		assert.Equal(t, []string{
+			`{"owner":{"address":{"city":"Lyon"},"name":"ann"},"priority":2,"title":"write tests"}`,
+			`{"owner":{"name":"bob"},"title":"review"}`,
+		}, lines)
+	}
+}
+
+const builderRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+func main() {
+	b := NewTaskBuilder().WithTitle("write tests").WithPriority(2)
+	b.Owner().WithName("ann").Address().WithCity("Lyon")
+	task := b.Build()
+	if err := task.Validate(strfmt.Default); err != nil {
+		panic(err)
+	}
+	printJSON(task)
+
+	// an owner set as a value is the starting point of its builder
+	b = NewTaskBuilder().WithTitle("review").WithOwner(User{Name: swagString("al")})
+	b.Owner().WithName("bob")
+	printJSON(b.Build())
+}
+
+func swagString(s string) *string { return &s }
+
+func printJSON(v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(data))
+}
+`
+
+func TestGenerateModel_FixedArrays(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.fixed-arrays.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{FixedArrays: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Color", "models", "", definitions["Color"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		assert.EqualValues(t, 3, genModel.FixedSize)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("color.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "type Color [3]int32", res)
+				assertInCode(t, "func (m *Color) UnmarshalJSON(data []byte) error {", res)
+				assertInCode(t, `validate.MinItems("", "body", size, 3)`, res)
+				assertInCode(t, `validate.MaxItems("", "body", size, 3)`, res)
+				assertInCode(t, "return json.Unmarshal(data, (*[3]int32)(m))", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	genModel, err = makeGenDefinitionHierarchy("Route", "models", "", definitions["Route"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertInCode(t, "type Route [2]*Point", buf.String())
+		}
+	}
+
+	genModel, err = makeGenDefinitionHierarchy("Task", "models", "", definitions["Task"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("task.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				// a pointer tells a missing array apart from one of zero values
+				assertInCode(t, "Color *Color `json:\"color\"`", res)
+				assertInCode(t, "Route *Route `json:\"route,omitempty\"`", res)
+				// inline arrays keep their slice
+				assertInCode(t, "Labels []string `json:\"labels,omitempty\"`", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// unique items are validated on slices only
+	genModel, err = makeGenDefinitionHierarchy("Pair", "models", "", definitions["Pair"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertInCode(t, "type Pair []string", buf.String())
+		}
+	}
+
+	// without the option the arrays are slices
+	genModel, err = makeGenDefinition("Color", "models", definitions["Color"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			res := buf.String()
+			assertInCode(t, "type Color []int32", res)
+			assertNotInCode(t, "UnmarshalJSON", res)
+		}
+	}
+}
+
+func TestGenerateModel_FixedArraysRoundTrip(t *testing.T) {
+	opts := &GenOpts{FixedArrays: true}
+	names := []string{"Color", "Point", "Route", "Task"}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.fixed-arrays.yml", opts, names, fixedArraysRoundTrip); ok {
+		assert.Equal(t, []string{
+			`{"color":[255,128,0],"route":[{"x":0,"y":0},{"x":1,"y":2}]}`,
+			"in body should have at least 3 items",
+			"in body should have at most 3 items",
+			"in body should have at least 2 items",
+			"missing route",
+			"validation failure list: color in body is required",
+		}, lines)
+	}
+}
+
+const fixedArraysRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+func main() {
+	var task Task
+	if err := json.Unmarshal([]byte(` + "`" + `{"color":[255,128,0],"route":[{"x":0,"y":0},{"x":1,"y":2}]}` + "`" + `), &task); err != nil {
+		panic(err)
+	}
+	if err := task.Validate(strfmt.Default); err != nil {
+		panic(err)
+	}
+	data, err := json.Marshal(task)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(data))
+
+	for _, doc := range []string{
+		` + "`" + `{"color":[255,128]}` + "`" + `,
+		` + "`" + `{"color":[255,128,0,64]}` + "`" + `,
+		` + "`" + `{"color":[0,0,0],"route":[]}` + "`" + `,
+	} {
+		var task Task
+		if err := json.Unmarshal([]byte(doc), &task); err != nil {
+			// the size is checked without knowing the property
+			fmt.Println(strings.TrimSpace(err.Error()))
+			continue
+		}
+		fmt.Println("accepted", doc)
+	}
+
+	var black Task
+	if err := json.Unmarshal([]byte(` + "`" + `{"color":[0,0,0],"route":null}` + "`" + `), &black); err != nil {
+		panic(err)
+	}
+	if black.Route == nil {
+		fmt.Println("missing route")
+	}
+	var none Task
+	if err := none.Validate(strfmt.Default); err != nil {
+		fmt.Println(strings.Replace(err.Error(), "\n", " ", -1))
+	}
+}
+`
+
+func TestGenerateModel_Scrub(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.scrub.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{WithScrub: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Credentials", "models", "", definitions["Credentials"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		assert.True(t, genModel.HasScrub)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("credentials.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "func (m *Credentials) Scrub() *Credentials {", res)
+				assertInCode(t, "scrubbed.Password = strfmt.Password(\"[REDACTED]\")", res)
+				assertInCode(t, "redacted := \"[REDACTED]\"\n\t\tscrubbed.APIKey = &redacted", res)
+				assertInCode(t, "scrubbed.Pin = 0", res)
+				assertNotInCode(t, "scrubbed.Username", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	genModel, err = makeGenDefinitionHierarchy("User", "models", "", definitions["User"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("user.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "interface{}(m.Credentials).(interface{ Scrub() *Credentials })", res)
+				assertInCode(t, "scrubbed.Sessions = make([]*Session, len(m.Sessions))", res)
+				assertInCode(t, "scrubbed.Devices = make(map[string]Session, len(m.Devices))", res)
+				assertInCode(t, "scrubbed.Devices[k] = *nested.Scrub()", res)
+				assertInCode(t, "scrubbed.Email = strfmt.Email(\"[REDACTED]\")", res)
+				// the inline object gets its own Scrub
+				assertInCode(t, "func (m *UserProfile) Scrub() *UserProfile {", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// without the option the models are left as they are
+	genModel, err = makeGenDefinition("Credentials", "models", definitions["Credentials"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.HasScrub)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertNotInCode(t, "Scrub()", buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_ScrubRoundTrip(t *testing.T) {
+	opts := &GenOpts{WithScrub: true}
+	names := []string{"Credentials", "Session", "User", "Account"}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.scrub.yml", opts, names, scrubRoundTrip); ok {
+		assert.Equal(t, []string{
+			`{"credentials":{"apiKey":"[REDACTED]","password":"[REDACTED]","username":"ann"},"devices":{"phone":{"id":"d1","token":"[REDACTED]"}},"email":"[REDACTED]","name":"ann","profile":{"bio":"hi","ssn":"[REDACTED]"},"sessions":[{"id":"s1","token":"[REDACTED]"},null],"plan":"pro","recoveryCode":"[REDACTED]"}`,
+			`{"credentials":{"apiKey":"key","password":"secret","pin":1234,"username":"ann"},"devices":{"phone":{"id":"d1","token":"t2"}},"email":"ann@example.com","name":"ann","profile":{"bio":"hi","ssn":"123"},"sessions":[{"id":"s1","token":"t1"},null],"plan":"pro","recoveryCode":"r1"}`,
+		}, lines)
+	}
+}
+
+const scrubRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const doc = ` + "`" + `{
+  "name": "ann",
+  "email": "ann@example.com",
+  "credentials": {"username": "ann", "password": "secret", "apiKey": "key", "pin": 1234},
+  "sessions": [{"id": "s1", "token": "t1"}, null],
+  "devices": {"phone": {"id": "d1", "token": "t2"}},
+  "profile": {"bio": "hi", "ssn": "123"},
+  "plan": "pro",
+  "recoveryCode": "r1"
+}` + "`" + `
+
+func main() {
+	var account Account
+	if err := json.Unmarshal([]byte(doc), &account); err != nil {
+		panic(err)
+	}
+	printJSON(account.Scrub())
+	// the scrubbed copy leaves the account untouched
+	printJSON(&account)
+}
+
+func printJSON(v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(data))
+}
+`
+
+func TestGenerateModel_NamedSliceTypes(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.named-arrays.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	render := func(name string) string {
+		genModel, err := makeGenDefinition(name, "models", definitions[name], specDoc, true, true)
+		if !assert.NoError(t, err) {
+			return ""
+		}
+		buf := bytes.NewBuffer(nil)
+		if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			return ""
+		}
+		ff, err := formatGoFile(swag.ToFileName(name)+".go", buf.Bytes())
+		if !assert.NoError(t, err) {
+			fmt.Println(buf.String())
+			return ""
+		}
+		return string(ff)
+	}
+
+	res := render("TaskList")
+	assertInCode(t, "type TaskList []*Task", res)
+	assertInCode(t, "func (m TaskList) Validate(formats strfmt.Registry) error {", res)
+	assertInCode(t, "for i := 0; i < len(m); i++ {", res)
+	assertInCode(t, "if err := m[i].Validate(formats); err != nil {", res)
+
+	// without a type, the items make it an array
+	res = render("Untyped")
+	assertInCode(t, "type Untyped []*Task", res)
+	assertInCode(t, "if err := m[i].Validate(formats); err != nil {", res)
+
+	// the elements of nested arrays are validated
+	res = render("Matrix")
+	assertInCode(t, "type Matrix [][]*Task", res)
+	assertInCode(t, "if err := m[i][ii].Validate(formats); err != nil {", res)
+
+	// the properties holding a named slice call its Validate
+	res = render("Board")
+	assertInCode(t, "Tasks TaskList `json:\"tasks,omitempty\"`", res)
+	assertInCode(t, "if err := m.Tasks.Validate(formats); err != nil {", res)
+	assertInCode(t, "if err := m.Matrix.Validate(formats); err != nil {", res)
+}
+
+func TestGenerateModel_TimeOfDay(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.time-of-day.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, usesFormat(specDoc.Spec(), "time"))
+	assert.False(t, usesFormat(specDoc.Spec(), "date"))
+
+	definitions := specDoc.Spec().Definitions
+	genModel, err := makeGenDefinition("Reminder", "models", definitions["Reminder"], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("reminder.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "At *TimeOfDay `json:\"at\"`", res)
+		assertInCode(t, "Snooze TimeOfDay `json:\"snooze,omitempty\"`", res)
+		assertInCode(t, "Slots []TimeOfDay `json:\"slots,omitempty\"`", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	buf = bytes.NewBuffer(nil)
+	if !assert.NoError(t, timeOfDayTemplate.Execute(buf, struct{ Package string }{Package: "models"})) {
+		return
+	}
+	ff, err = formatGoFile("time_of_day.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "type TimeOfDay time.Time", res)
+		assertInCode(t, "strfmt.Default.Add(\"time\", &tod, IsTimeOfDay)", res)
+		assertInCode(t, "func (t *TimeOfDay) UnmarshalJSON(data []byte) error {", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+}
+
+func TestGenerateModel_TimeOfDayRoundTrip(t *testing.T) {
+	w := newGoWorkspace(t)
+	if !writeModels(t, w, "main", "../fixtures/codegen/todolist.time-of-day.yml", nil, []string{"Reminder"}) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, timeOfDayTemplate.Execute(buf, struct{ Package string }{Package: "main"})) ||
+		!assert.NoError(t, w.WriteFile("main", "time_of_day", buf.Bytes())) ||
+		!assert.NoError(t, w.WriteFile("main", "main", []byte(timeOfDayRoundTrip))) {
+		return
+	}
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			`{"at":"09:30:00Z","slots":["07:00:00Z","12:15:00-05:00"],"snooze":"18:45:30.25+02:00"}`,
+			`valid: <nil>`,
+			`invalid: true`,
+			`formats: true false false false`,
+			`parsed: 09:30:00Z`,
+			`zero: 00:00:00Z`,
+		}, lines)
+	}
+}
+
+const timeOfDayRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	var reminder Reminder
+	if err := json.Unmarshal([]byte(` + "`" + `{"at": "09:30:00Z", "snooze": "18:45:30.250+02:00", "slots": ["07:00:00Z", "12:15:00-05:00"]}` + "`" + `), &reminder); err != nil {
+		panic(err)
+	}
+	data, err := json.Marshal(&reminder)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(data))
+	fmt.Println("valid:", reminder.Validate(strfmt.Default))
+
+	var invalid Reminder
+	err = json.Unmarshal([]byte(` + "`" + `{"at": "25:00:00Z"}` + "`" + `), &invalid)
+	fmt.Println("invalid:", err != nil)
+
+	fmt.Println("formats:",
+		strfmt.Default.Validates("time", "09:30:00Z"),
+		strfmt.Default.Validates("time", "09:30:00"),
+		strfmt.Default.Validates("time", "9:30Z"),
+		strfmt.Default.Validates("time", "noon"))
+
+	parsed, err := strfmt.Default.Parse("time", "09:30:00Z")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("parsed:", parsed.(*TimeOfDay))
+
+	var zero TimeOfDay
+	fmt.Println("zero:", zero)
+}
+`
+
+func TestGenerateModel_SingleChar(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.single-char.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, usesSingleChar(specDoc.Spec()))
+
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{SingleChar: "rune"}
+	genModel, err := makeGenDefinitionHierarchy("Cell", "models", "", definitions["Cell"], specDoc, true, true, opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("cell.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "Mark *Char `json:\"mark\"`", res)
+		assertInCode(t, "Sep Char `json:\"sep,omitempty\"`", res)
+		assertInCode(t, "Marks []Char `json:\"marks,omitempty\"`", res)
+		assertInCode(t, "Code string `json:\"code,omitempty\"`", res)
+		assertInCode(t, "validate.Required(\"mark\", \"body\", m.Mark)", res)
+		// Char stands for the length validations
+		assertNotInCode(t, "validate.MaxLength(\"sep\"", res)
+		assertNotInCode(t, "validate.MinLength(\"mark\"", res)
+		assertInCode(t, "validate.MaxLength(\"code\", \"body\", string(m.Code), 1)", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	genModel, err = makeGenDefinitionHierarchy("Initial", "models", "", definitions["Initial"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			res := buf.String()
+			assertInCode(t, "type Initial Char", res)
+			assertInCode(t, "return (*Char)(m).UnmarshalJSON(data)", res)
+		}
+	}
+
+	for _, underlying := range []string{"rune", "byte"} {
+		buf = bytes.NewBuffer(nil)
+		data := struct {
+			Package    string
+			Underlying string
+		}{Package: "models", Underlying: underlying}
+		if !assert.NoError(t, charTemplate.Execute(buf, data)) {
+			return
+		}
+		ff, err = formatGoFile("char.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			assertInCode(t, "type Char "+underlying, res)
+			assertInCode(t, "strfmt.Default.Add(\"char\", &c, IsChar)", res)
+			assertInCode(t, "utf8.RuneCountInString(str)", res)
+			if underlying == "byte" {
+				assertInCode(t, "r >= utf8.RuneSelf", res)
+			} else {
+				assertNotInCode(t, "utf8.RuneSelf", res)
+			}
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+
+	// without the option the lengths are validated on a string
+	genModel, err = makeGenDefinition("Cell", "models", definitions["Cell"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			res := buf.String()
+			assertInCode(t, "validate.MaxLength(\"sep\", \"body\", string(m.Sep), 1)", res)
+			assertNotInCode(t, "Char", res)
+		}
+	}
+}
+
+func TestGenerateModel_SingleCharRoundTrip(t *testing.T) {
+	for _, v := range []struct {
+		Underlying string
+		Expected   []string
+	}{
+		{"rune", []string{`multi-byte: <nil>`, `formats: true false true`}},
+		{"byte", []string{`multi-byte: "é" is not a single byte character`, `formats: true false false`}},
+	} {
+		w := newGoWorkspace(t)
+		opts := &GenOpts{SingleChar: v.Underlying}
+		if !writeModels(t, w, "main", "../fixtures/codegen/todolist.single-char.yml", opts, []string{"Cell", "Initial"}) {
+			return
+		}
+		buf := bytes.NewBuffer(nil)
+		data := struct {
+			Package    string
+			Underlying string
+		}{Package: "main", Underlying: v.Underlying}
+		if !assert.NoError(t, charTemplate.Execute(buf, data)) ||
+			!assert.NoError(t, w.WriteFile("main", "char", buf.Bytes())) ||
+			!assert.NoError(t, w.WriteFile("main", "main", []byte(singleCharRoundTrip))) {
+			return
+		}
+		if lines, ok := w.Go(t, "main", "run", "."); ok {
+			assert.Equal(t, append([]string{
+				`{"initial":"Z","mark":"x","marks":["a","b"],"sep":"-"}`,
+				`valid: <nil>`,
+				`required: true`,
+				`two chars: "ab" has 2 characters instead of one`,
+				`empty: true`,
+				`initial: true`,
+			}, v.Expected...), lines, v.Underlying)
+		}
+	}
+}
+
+const singleCharRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func decode(doc string) error {
+	var cell Cell
+	return json.Unmarshal([]byte(doc), &cell)
+}
+
+func main() {
+	var cell Cell
+	if err := json.Unmarshal([]byte(` + "`" + `{"mark": "x", "sep": "-", "marks": ["a", "b"], "initial": "Z"}` + "`" + `), &cell); err != nil {
+		panic(err)
+	}
+	data, err := json.Marshal(&cell)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(data))
+	fmt.Println("valid:", cell.Validate(strfmt.Default))
+	fmt.Println("required:", new(Cell).Validate(strfmt.Default) != nil)
+
+	fmt.Println("two chars:", decode(` + "`" + `{"mark": "ab"}` + "`" + `))
+	fmt.Println("empty:", decode(` + "`" + `{"mark": ""}` + "`" + `) != nil)
+	fmt.Println("initial:", decode(` + "`" + `{"mark": "x", "initial": "ab"}` + "`" + `) != nil)
+	fmt.Println("multi-byte:", decode(` + "`" + `{"mark": "é"}` + "`" + `))
+
+	fmt.Println("formats:",
+		strfmt.Default.Validates("char", "x"),
+		strfmt.Default.Validates("char", "ab"),
+		strfmt.Default.Validates("char", "é"))
+}
+`
+
+func TestGenerateModel_PatternAndEnum(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.pattern-enum.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	genModel, err := makeGenDefinition("Task", "models", specDoc.Spec().Definitions["Task"], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "validate.Pattern(\"status\", \"body\", string(*m.Status), `^[a-z]+$`)", res)
+		assertInCode(t, "m.validateStatusEnum(\"status\", \"body\", *m.Status)", res)
+		assertInCode(t, "validate.Pattern(\"tags\"+\".\"+strconv.Itoa(i), \"body\", string(m.Tags[i]), `^[a-z]+$`)", res)
+		assertInCode(t, "m.validateTagsItemsEnum(\"tags\"+\".\"+strconv.Itoa(i), \"body\", m.Tags[i])", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+}
+
+func TestGenerateModel_PatternAndEnumRoundTrip(t *testing.T) {
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.pattern-enum.yml", nil, []string{"Task"}, patternEnumRoundTrip); ok {
+		assert.Equal(t, []string{
+			`open: valid`,
+			`Archived: pattern`,
+			`closed: enum`,
+			`home: valid`,
+			`Urgent: pattern`,
+			`later: enum`,
+		}, lines)
+	}
+}
+
+const patternEnumRoundTrip = `package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// violation tells which of the pattern or the enum rejected the value
+func violation(err error) string {
+	switch {
+	case err == nil:
+		return "valid"
+	case strings.Contains(err.Error(), "should match"):
+		return "pattern"
+	case strings.Contains(err.Error(), "should be one of"):
+		return "enum"
+	default:
+		return err.Error()
+	}
+}
+
+func main() {
+	for _, status := range []string{"open", "Archived", "closed"} {
+		s := status
+		task := Task{Status: &s}
+		fmt.Printf("%s: %s\n", status, violation(task.Validate(strfmt.Default)))
+	}
+	for _, tag := range []string{"home", "Urgent", "later"} {
+		s := "open"
+		task := Task{Status: &s, Tags: []string{tag}}
+		fmt.Printf("%s: %s\n", tag, violation(task.Validate(strfmt.Default)))
+	}
+}
+`
+
+func TestGenerateModel_AnyType(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.any.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	for _, v := range []struct {
+		Version string
+		Iface   string
+		Other   string
+	}{
+		{"1.18", "any", "interface{}"},
+		{"1.17", "interface{}", "any"},
+	} {
+		opts := &GenOpts{GoVersion: v.Version}
+		for _, name := range []string{"Task", "Metadata"} {
+			genModel, err := makeGenDefinitionHierarchy(name, "models", "", definitions[name], specDoc, true, true, opts)
+			if !assert.NoError(t, err) {
+				continue
+			}
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+				ff, err := formatGoFile(swag.ToFileName(name)+".go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					switch name {
+					case "Task":
+						assertInCode(t, "Payload "+v.Iface+" `json:\"payload,omitempty\"`", res)
+						assertInCode(t, "Extra "+v.Iface+" `json:\"extra,omitempty\"`", res)
+						assertInCode(t, "Attachments []"+v.Iface+" `json:\"attachments,omitempty\"`", res)
+						assertNotInCode(t, "Payload "+v.Other, res)
+					case "Metadata":
+						assertInCode(t, "type Metadata map[string]"+v.Iface, res)
+					}
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateModel_PolymorphicArrays(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.polymorphic-arrays.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	genModel, err := makeGenDefinition("Tasks", "models", specDoc.Spec().Definitions["Tasks"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.IsBaseType)
+		assert.True(t, genModel.HasPolymorphicItems)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("tasks.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "type Tasks []Task", res)
+				assertInCode(t, "func (m *Tasks) UnmarshalJSON(data []byte) error {", res)
+				assertInCode(t, "obj, err := UnmarshalTask(bytes.NewBuffer(element), runtime.JSONConsumer())", res)
+				assertNotInCode(t, "type Tasks interface", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+}
+
+func TestGenerateModel_PolymorphicArraysRoundTrip(t *testing.T) {
+	names := []string{"Task", "Chore", "Meeting", "Tasks"}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.polymorphic-arrays.yml", nil, names, polymorphicArraysRoundTrip); ok {
+		assert.Equal(t, []string{
+			`0: *main.Chore dishes kitchen`,
+			`1: *main.Meeting standup [ann bob]`,
+			`valid: <nil>`,
+			`null: true`,
+			`unknown: true`,
+		}, lines)
+	}
+}
+
+const polymorphicArraysRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	var tasks Tasks
+	raw := ` + "`" + `[
+		{"kind": "Chore", "title": "dishes", "room": "kitchen"},
+		{"kind": "Meeting", "title": "standup", "attendees": ["ann", "bob"]}
+	]` + "`" + `
+	if err := json.Unmarshal([]byte(raw), &tasks); err != nil {
+		panic(err)
+	}
+	for i, task := range tasks {
+		switch v := task.(type) {
+		case *Chore:
+			fmt.Printf("%d: %T %s %s\n", i, v, *v.Title(), v.Room)
+		case *Meeting:
+			fmt.Printf("%d: %T %s %v\n", i, v, *v.Title(), v.Attendees)
+		default:
+			fmt.Printf("%d: %T\n", i, v)
+		}
+	}
+	fmt.Println("valid:", tasks.Validate(strfmt.Default))
+
+	var none Tasks
+	if err := json.Unmarshal([]byte("null"), &none); err != nil {
+		panic(err)
+	}
+	fmt.Println("null:", none == nil)
+
+	err := json.Unmarshal([]byte(` + "`" + `[{"kind": "Errand", "title": "groceries"}]` + "`" + `), &tasks)
+	fmt.Println("unknown:", err != nil)
+}
+`
+
+func TestGenerateModel_FieldMask(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.field-mask.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{WithFieldMask: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Task", "models", "", definitions["Task"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		assert.True(t, genModel.HasFieldMask)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("task.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "func (m *Task) ApplyFieldMask(paths []string, src *Task) error {", res)
+				assertInCode(t, "case \"title\":", res)
+				assertInCode(t, "m.Title = src.Title", res)
+				assertInCode(t, "return fmt.Errorf(\"%s is a repeated field\", name)\n\t\t}\n\t\tm.Tags = src.Tags", res)
+				assertInCode(t, "nested, ok := interface{}(m.Owner).(interface{ applyFieldMaskPath(string, *User) error })", res)
+				assertInCode(t, "interface{}(&m.Audit).(interface{ applyFieldMaskPath(string, *Audit) error })", res)
+				// the inline object gets its own field mask
+				assertInCode(t, "func (m *TaskAO1Location) ApplyFieldMask(paths []string, src *TaskAO1Location) error {", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// without the option the models get no field mask
+	genModel, err = makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.HasFieldMask)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("task.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				assertNotInCode(t, "ApplyFieldMask", string(ff))
+			}
+		}
+	}
+}
+
+func TestGenerateModel_FieldMaskRoundTrip(t *testing.T) {
+	opts := &GenOpts{WithFieldMask: true}
+	names := []string{"Task", "User", "Address", "Audit"}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.field-mask.yml", opts, names, fieldMaskRoundTrip); ok {
+		assert.Equal(t, []string{
+			`{"revision":7,"done":true,"owner":{"address":{"city":"Lyon","street":"Main St"},"name":"ann"},"tags":["home","chores"],"title":"dishes"}`,
+			`nil owner: {"name":"ann"}`,
+			`cleared: {"revision":7,"owner":{"address":{"city":null,"street":"Main St"}},"title":null}`,
+			`unknown: field mask path "owner.nickname": unknown field "nickname" of User`,
+			`repeated: field mask path "tags.0": tags is a repeated field`,
+			`leaf: field mask path "title.text": title has no fields`,
+		}, lines)
+	}
+}
+
+const fieldMaskRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func main() {
+	title := "dishes"
+	task := Task{
+		Audit: Audit{Revision: 3},
+		Title: &title,
+		Owner: &User{Name: "bob", Email: "bob@example.com", Address: &Address{Street: "Main St", City: strPtr("Paris")}},
+		Tags:  []string{"work"},
+	}
+	update := Task{
+		Audit: Audit{Revision: 7},
+		Title: &title,
+		Done:  true,
+		Owner: &User{Name: "ann", Email: "ann@example.com", Address: &Address{Street: "Elm St", City: strPtr("Lyon")}},
+		Tags:  []string{"home", "chores"},
+	}
+	if err := task.ApplyFieldMask([]string{"done", "owner.name", "owner.address.city", "tags", "revision"}, &update); err != nil {
+		panic(err)
+	}
+	task.Owner.Email = ""
+	print("", task)
+
+	var blank Task
+	if err := blank.ApplyFieldMask([]string{"owner.name"}, &update); err != nil {
+		panic(err)
+	}
+	print("nil owner: ", blank.Owner)
+
+	if err := task.ApplyFieldMask([]string{"title", "done", "tags", "owner.name", "owner.address.city"}, nil); err != nil {
+		panic(err)
+	}
+	print("cleared: ", task)
+
+	fmt.Println("unknown:", task.ApplyFieldMask([]string{"owner.nickname"}, &update))
+	fmt.Println("repeated:", task.ApplyFieldMask([]string{"tags.0"}, &update))
+	fmt.Println("leaf:", task.ApplyFieldMask([]string{"title.text"}, &update))
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func print(prefix string, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(prefix + string(b))
+}
+`
+
+func TestGenerateModel_MutualRecursion(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.recursive.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	for _, v := range []struct {
+		Name string
+		Code []string
+	}{
+		{"Task", []string{"Subtasks Tasks `json:\"subtasks,omitempty\"`", "Project *Project `json:\"project,omitempty\"`"}},
+		{"Tasks", []string{"type Tasks []*Task"}},
+		{"Team", []string{"Projects ProjectsByName `json:\"projects,omitempty\"`"}},
+		{"Outline", []string{"type Outline []OutlineLevel"}},
+		{"OutlineLevel", []string{"type OutlineLevel []Outline"}},
+		{"Board", []string{"type Board map[string]Columns"}},
+		{"Columns", []string{"type Columns []Cards"}},
+		{"Cards", []string{"type Cards map[string]Board"}},
+		{"Assignee", []string{"Delegate *Delegate `json:\"delegate,omitempty\"`"}},
+		{"Delegate", []string{"For *Assignee `json:\"for,omitempty\"`"}},
+	} {
+		genModel, err := makeGenDefinition(v.Name, "models", definitions[v.Name], specDoc, true, true)
+		if !assert.NoError(t, err, v.Name) {
+			continue
+		}
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile(swag.ToFileName(v.Name)+".go", buf.Bytes())
+			if assert.NoError(t, err) {
+				for _, code := range v.Code {
+					assertInCode(t, code, string(ff))
+				}
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// the models embedding each other can't be generated
+	specDoc, err = loads.Spec("../fixtures/codegen/todolist.recursive-refs.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = makeGenDefinition("Task", "models", specDoc.Spec().Definitions["Task"], specDoc, true, true)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Task -> Chore -> Errand -> Task")
+	}
+	_, err = makeGenDefinition("Label", "models", specDoc.Spec().Definitions["Label"], specDoc, true, true)
+	assert.Error(t, err)
+}
+
+func TestGenerateModel_MutualRecursionRoundTrip(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.recursive.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	var names []string
+	for name := range specDoc.Spec().Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.recursive.yml", nil, names, mutualRecursionRoundTrip); ok {
+		assert.Equal(t, []string{
+			`plan: 1 subtasks, 1 nested`,
+			`valid: <nil>`,
+			`invalid: true`,
+			`project: platform`,
+			`outline: 2 1`,
+			`board: 1`,
+		}, lines)
+	}
+}
+
+const mutualRecursionRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	var tasks Tasks
+	raw := ` + "`" + `[{"title": "plan", "subtasks": [{"title": "draft", "subtasks": [{"title": "outline"}]}],
+		"project": {"name": "api", "team": {"projects": {"platform": {"name": "platform"}}}}}]` + "`" + `
+	if err := json.Unmarshal([]byte(raw), &tasks); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%s: %d subtasks, %d nested\n", *tasks[0].Title, len(tasks[0].Subtasks), len(tasks[0].Subtasks[0].Subtasks))
+	fmt.Println("valid:", tasks.Validate(strfmt.Default))
+	var untitled Tasks
+	if err := json.Unmarshal([]byte(` + "`" + `[{"title": "plan", "subtasks": [{"subtasks": []}]}]` + "`" + `), &untitled); err != nil {
+		panic(err)
+	}
+	fmt.Println("invalid:", untitled.Validate(strfmt.Default) != nil)
+
+	var project Project
+	if err := json.Unmarshal([]byte(` + "`" + `{"name": "api", "team": {"projects": {"platform": {"name": "platform"}}}}` + "`" + `), &project); err != nil {
+		panic(err)
+	}
+	fmt.Println("project:", project.Team.Projects["platform"].Name)
+
+	var outline Outline
+	if err := json.Unmarshal([]byte("[[[], [[]]]]"), &outline); err != nil {
+		panic(err)
+	}
+	fmt.Println("outline:", len(outline[0]), len(outline[0][1]))
+
+	var board Board
+	if err := json.Unmarshal([]byte(` + "`" + `{"todo": [{"next": {}}]}` + "`" + `), &board); err != nil {
+		panic(err)
+	}
+	fmt.Println("board:", len(board["todo"]))
+}
+`
+
+func TestGenerateModel_Gob(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.gob.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{WithGob: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Chore", "models", "", definitions["Chore"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		assert.True(t, genModel.HasGob)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("chore.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "func (m Chore) GobEncode() ([]byte, error) {\n\treturn m.MarshalJSON()", res)
+				assertInCode(t, "func (m *Chore) GobDecode(data []byte) error {\n\treturn m.UnmarshalJSON(data)", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// only the concrete polymorphic models get gob methods
+	genModel, err = makeGenDefinitionHierarchy("Board", "models", "", definitions["Board"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.HasGob)
+	}
+
+	assert.Equal(t, []string{"Chore", "Meeting"}, gobTypes(specDoc, nil))
+	assert.Equal(t, []string{"Meeting"}, gobTypes(specDoc, []string{"Board", "Meeting"}))
+
+	// without polymorphic models there is nothing to register
+	specDoc, err = loads.Spec("../fixtures/codegen/todolist.scrub.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Empty(t, gobTypes(specDoc, nil))
+	dir, err := ioutil.TempDir("", "gob-registry")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	if assert.NoError(t, generateGobRegistry(dir, nil)) {
+		_, err := os.Stat(filepath.Join(dir, "gob_registry.go"))
+		assert.True(t, os.IsNotExist(err))
+	}
+}
+
+func TestGenerateModel_GobRoundTrip(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.gob.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	w := newGoWorkspace(t)
+	opts := &GenOpts{WithGob: true}
+	if !writeModels(t, w, "main", "../fixtures/codegen/todolist.gob.yml", opts, []string{"Task", "Chore", "Meeting", "Board"}) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	data := struct {
+		Package string
+		Types   []string
+	}{Package: "main", Types: gobTypes(specDoc, nil)}
+	if !assert.NoError(t, gobTemplate.Execute(buf, data)) ||
+		!assert.NoError(t, w.WriteFile("main", "gob_registry", buf.Bytes())) ||
+		!assert.NoError(t, w.WriteFile("main", "main", []byte(gobRoundTrip))) {
+		return
+	}
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			`name: chores`,
+			`pinned: *main.Chore dishes kitchen`,
+			`0: *main.Chore dishes kitchen`,
+			`1: *main.Meeting standup [ann bob]`,
+		}, lines)
+	}
+}
+
+const gobRoundTrip = `package main
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+)
+
+func main() {
+	dishes, standup := "dishes", "standup"
+	chore := &Chore{Room: "kitchen"}
+	chore.SetTitle(&dishes)
+	meeting := &Meeting{Attendees: []string{"ann", "bob"}}
+	meeting.SetTitle(&standup)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(Board{Name: "chores", Pinned: chore, Tasks: []Task{chore, meeting}}); err != nil {
+		panic(err)
+	}
+	var board Board
+	if err := gob.NewDecoder(&buf).Decode(&board); err != nil {
+		panic(err)
+	}
+
+	fmt.Println("name:", board.Name)
+	fmt.Println("pinned:", describe(board.Pinned))
+	for i, task := range board.Tasks {
+		fmt.Printf("%d: %s\n", i, describe(task))
+	}
+}
+
+func describe(task Task) string {
+	switch v := task.(type) {
+	case *Chore:
+		return fmt.Sprintf("%T %s %s", v, *v.Title(), v.Room)
+	case *Meeting:
+		return fmt.Sprintf("%T %s %v", v, *v.Title(), v.Attendees)
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+`
+
+func TestGenerateModel_CustomTag(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.custom-tag.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		// the json tag comes first, then the xml tag
+		assertInCode(t, "ID *int64 `json:\"id\" validate:\"required,gt=0\"`", res)
+		assertInCode(t, "Title string `json:\"title,omitempty\" validate:\"required\" db:\"title\"`", res)
+		assertInCode(t, "Assignee strfmt.Email `json:\"assignee,omitempty\" xml:\"owner,attr\" validate:\"omitempty,email\"`", res)
+		assertInCode(t, "Notes string `json:\"notes,omitempty\"`", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	_, err = makeGenDefinition("Invalid", "models", definitions["Invalid"], specDoc, true, true)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "x-go-custom-tag")
+	}
+}
+
+func TestGenerateModel_MistypedEnum(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.enum-mistyped.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	for k, expected := range map[string]string{
+		"Task":   `Task.priority: enum value "high" is not an integer`,
+		"Status": `Status: enum value 3 is not a string`,
+		"Ratio":  `Ratio: enum value "1" is not a number`,
+		"Level":  `Level: enum value 300 overflows the int8 format`,
+		"Share":  `Share: enum value 1.5 is not an integer`,
+		"Done":   `Done: enum value "yes" is not a boolean`,
+		"Tags":   `enum value 42 is not a string`,
+		"Unset":  `Unset: enum value null is only allowed when the string is x-nullable`,
+		// const values are held to the declared type as well
+		"Five":  `Five: const value "five" is not an integer`,
+		"Chore": `Chore.kind: const value 3 is not a string`,
+		"Tiny":  `Tiny: const value 300 overflows the int8 format`,
+	} {
+		_, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.Error(t, err, k) {
+			assert.Contains(t, err.Error(), expected, k)
+		}
+	}
+
+	for _, k := range []string{"Label", "Weight", "Fixed"} {
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, true, true)
+		if assert.NoError(t, err, k) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, modelTemplate.Execute(buf, genModel), k) {
+				_, err := formatGoFile(k+".go", buf.Bytes())
+				assert.NoError(t, err, k)
+			}
+		}
+	}
+}
+
+func TestGenerateModel_ExtraFields(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.extra-fields.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{ExtraFields: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Task", "models", "", definitions["Task"], specDoc, true, true, opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, genModel.HasExtraFields)
+	assert.Contains(t, genModel.DefaultImports, "encoding/json")
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "Extra map[string]json.RawMessage `json:\"-\"`", res)
+		assertInCode(t, "func (m *Task) UnmarshalJSON(data []byte) error", res)
+		assertInCode(t, "func (m Task) MarshalJSON() ([]byte, error)", res)
+		assertInCode(t, "delete(fields, \"title\")", res)
+		// the inline object captures its own unknown fields
+		assertInCode(t, "func (m *TaskOwner) UnmarshalJSON(data []byte) error", res)
+		assertInCode(t, "delete(extra, \"name\")", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	// additionalProperties already capture the unknown fields, properties named extra would collide,
+	// and the serializers of a composition or of an embedded model would take over the others
+	for _, k := range []string{"Settings", "Entry", "Chore", "Schedule"} {
+		genModel, err := makeGenDefinitionHierarchy(k, "models", "", definitions[k], specDoc, true, true, opts)
+		if assert.NoError(t, err, k) {
+			assert.False(t, genModel.HasExtraFields, k)
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, modelTemplate.Execute(buf, genModel), k) {
+				assertNotInCode(t, "Extra map", buf.String())
+			}
+		}
+	}
+
+	// without the option the unknown fields are dropped
+	genModel, err = makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.HasExtraFields)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertNotInCode(t, "Extra map", buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_ExtraFieldsRoundTrip(t *testing.T) {
+	opts := &GenOpts{ExtraFields: true}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.extra-fields.yml", opts, []string{"Task"}, extraFieldsRoundTrip); ok {
+		assert.Equal(t, []string{
+			`{"id":1,"owner":{"name":"a","email":"a@b"},"title":"x","priority":"high"}`,
+			`extra: 1 1`,
+			`known: {"id":2,"title":"y"}`,
+			`shadowed: {"title":"z"}`,
+		}, lines)
+	}
+}
+
+const extraFieldsRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func roundTrip(doc string, v interface{}) string {
+	if err := json.Unmarshal([]byte(doc), v); err != nil {
+		panic(err)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
+
+func main() {
+	var task Task
+	fmt.Println(roundTrip(` + "`" + `{"id": 1, "title": "x", "priority": "high", "owner": {"name": "a", "email": "a@b"}}` + "`" + `, &task))
+	fmt.Println("extra:", len(task.Extra), len(task.Owner.Extra))
+
+	var known Task
+	fmt.Println("known:", roundTrip(` + "`" + `{"id": 2, "title": "y"}` + "`" + `, &known))
+
+	title := "z"
+	shadowed := Task{Title: &title, Extra: map[string]json.RawMessage{"title": json.RawMessage(` + "`" + `"w"` + "`" + `)}}
+	data, _ := json.Marshal(shadowed)
+	fmt.Println("shadowed:", string(data))
+}
+`
+
+func TestGenerateModel_FormattedItems(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.formatted-items.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinition("TaskRefs", "models", definitions["TaskRefs"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		assert.True(t, genModel.Items.ValidatesFormat)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("task_refs.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				assertInCode(t, "validate.FormatOf(strconv.Itoa(i), \"body\", \"uuid\", m[i].String(), formats)", string(ff))
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	genModel, err = makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("task.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "validate.FormatOf(\"watchers\"+\".\"+strconv.Itoa(i), \"body\", \"email\", m.Watchers[i].String(), formats)", res)
+				// dates fail to unmarshal when malformed
+				assertNotInCode(t, "\"date\"", res)
+				assertNotInCode(t, "m.Due[i]", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+}
+
+func TestGenerateModel_FormattedItemsRoundTrip(t *testing.T) {
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.formatted-items.yml", nil, []string{"TaskRefs"}, formattedItemsRoundTrip); ok {
+		assert.Equal(t, []string{
+			`valid: <nil>`,
+			`malformed: 1 in body must be of type uuid: "not-a-uuid"`,
+		}, lines)
+	}
+}
+
+const formattedItemsRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func check(doc string) error {
+	var refs TaskRefs
+	if err := json.Unmarshal([]byte(doc), &refs); err != nil {
+		panic(err)
+	}
+	return refs.Validate(strfmt.Default)
+}
+
+func main() {
+	fmt.Println("valid:", check(` + "`" + `["a8098c1a-f86e-11da-bd1a-00112444be1e"]` + "`" + `))
+	fmt.Println("malformed:", check(` + "`" + `["a8098c1a-f86e-11da-bd1a-00112444be1e", "not-a-uuid"]` + "`" + `))
+}
+`
+
+func TestGenerateDefinition_OneFilePerModel(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	for _, fixture := range []struct {
+		Spec    string
+		Helpers []string
+	}{
+		{"../fixtures/codegen/todolist.enums.yml", nil},
+		{"../fixtures/codegen/todolist.time-of-day.yml", []string{"time_of_day.go"}},
+	} {
+		specDoc, err := loads.Spec(fixture.Spec)
+		if !assert.NoError(t, err) {
+			return
+		}
+		dir, err := ioutil.TempDir("", "one-file-per-model")
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer os.RemoveAll(dir)
+
+		err = GenerateDefinition(nil, true, true, GenOpts{
+			Spec:         fixture.Spec,
+			ModelPackage: "models",
+			Target:       dir,
+		})
+		if !assert.NoError(t, err, fixture.Spec) {
+			return
+		}
+
+		// each definition is written to its own file, with its enums and inline types,
+		// the helpers shared by the models go to files of their own
+		expected := append([]string{}, fixture.Helpers...)
+		for k := range specDoc.Spec().Definitions {
+			expected = append(expected, swag.ToFileName(k)+".go")
+		}
+		files, err := ioutil.ReadDir(filepath.Join(dir, "models"))
+		if assert.NoError(t, err) {
+			var actual []string
+			for _, f := range files {
+				actual = append(actual, f.Name())
+			}
+			sort.Strings(expected)
+			assert.Equal(t, expected, actual, fixture.Spec)
+		}
+	}
+}
+
+func TestGenerateModel_MapRecursion(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.map-recursive.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	for _, v := range []struct {
+		Name string
+		Code []string
+	}{
+		{"Node", []string{"Children map[string]Node `json:\"children,omitempty\"`"}},
+		{"Tree", []string{"type Tree map[string]Tree"}},
+		{"Nested", []string{"type Nested map[string]map[string]Nested"}},
+		{"Shelf", []string{"type Shelf map[string]Catalog"}},
+		{"Catalog", []string{"type Catalog struct {", "\tShelf\n"}},
+	} {
+		genModel, err := makeGenDefinition(v.Name, "models", definitions[v.Name], specDoc, true, true)
+		if !assert.NoError(t, err, v.Name) {
+			continue
+		}
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile(swag.ToFileName(v.Name)+".go", buf.Bytes())
+			if assert.NoError(t, err) {
+				for _, code := range v.Code {
+					assertInCode(t, code, string(ff))
+				}
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+}
+
+func TestGenerateModel_MapRecursionRoundTrip(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.map-recursive.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	var names []string
+	for name := range specDoc.Spec().Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.map-recursive.yml", nil, names, mapRecursionRoundTrip); ok {
+		assert.Equal(t, []string{
+			`node: root 1 1`,
+			`valid: <nil>`,
+			`tree: 1`,
+			`nested: 1`,
+		}, lines)
+	}
+}
+
+const mapRecursionRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	var node Node
+	if err := json.Unmarshal([]byte(` + "`" + `{"name": "root", "children": {"a": {"name": "a", "children": {"b": {"name": "b"}}}}}` + "`" + `), &node); err != nil {
+		panic(err)
+	}
+	fmt.Println("node:", *node.Name, len(node.Children), len(node.Children["a"].Children))
+	fmt.Println("valid:", node.Validate(strfmt.Default))
+
+	var tree Tree
+	if err := json.Unmarshal([]byte(` + "`" + `{"a": {"b": {}}}` + "`" + `), &tree); err != nil {
+		panic(err)
+	}
+	fmt.Println("tree:", len(tree["a"]))
+
+	var nested Nested
+	if err := json.Unmarshal([]byte(` + "`" + `{"a": {"b": {"c": {}}}}` + "`" + `), &nested); err != nil {
+		panic(err)
+	}
+	fmt.Println("nested:", len(nested["a"]["b"]))
+}
+`
+
+func TestGenerateModel_Diff(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.diff.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{WithDiff: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Task", "models", "", definitions["Task"], specDoc, true, true, opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, genModel.HasDiff)
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "func (m *Task) DiffFrom(other *Task) []FieldChange {", res)
+		assertInCode(t, "changes = diffField(changes, prefix+\"title\", other.Title, current.Title)", res)
+		assertInCode(t, "changes = diffField(changes, prefix+\"watchers\", other.Watchers, current.Watchers)", res)
+		// the inline object is compared field by field too
+		assertInCode(t, "func (m *TaskChecklist) diffAt(prefix string, o interface{}) []FieldChange {", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	genModel, err = makeGenDefinitionHierarchy("Project", "models", "", definitions["Project"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			res := buf.String()
+			assertInCode(t, "changes = append(changes, embedded.diffAt(prefix, &other.Record)...)", res)
+			assertInCode(t, "changes = diffField(changes, prefix+\"name\", other.Name, current.Name)", res)
+		}
+	}
+
+	// a property named like the method leaves the model without it
+	genModel, err = makeGenDefinitionHierarchy("Entry", "models", "", definitions["Entry"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.HasDiff)
+	}
+
+	genModel, err = makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.HasDiff)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertNotInCode(t, "DiffFrom", buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_DiffRoundTrip(t *testing.T) {
+	w := newGoWorkspace(t)
+	opts := &GenOpts{WithDiff: true}
+	if !writeModels(t, w, "main", "../fixtures/codegen/todolist.diff.yml", opts, []string{"Task", "User", "Record", "Project"}) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, diffTemplate.Execute(buf, struct{ Package string }{Package: "main"})) ||
+		!assert.NoError(t, w.WriteFile("main", "field_change", buf.Bytes())) ||
+		!assert.NoError(t, w.WriteFile("main", "main", []byte(diffRoundTrip))) {
+		return
+	}
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			`checklist.closed: false -> true`,
+			`checklist.items.1: null -> "y"`,
+			`done: false -> true`,
+			`labels.prio: "high" -> "low"`,
+			`labels.size: null -> "s"`,
+			`labels.team: "x" -> null`,
+			`owner: {"name":"ann"} -> null`,
+			`tags.1: "b" -> null`,
+			`title: "write" -> "write docs"`,
+			`watchers.0.email: "" -> "bob@example.com"`,
+			`watchers.1: null -> {"name":"cy"}`,
+			`same: 0`,
+			`from nil: 2`,
+			`version: 1 -> 2`,
+			`tasks.a.title: "write" -> "write docs"`,
+			`tasks.b: null -> {"due":"0001-01-01T00:00:00.000Z","title":"test"}`,
+		}, lines)
+	}
+}
+
+const diffRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func decode(doc string, v interface{}) {
+	if err := json.Unmarshal([]byte(doc), v); err != nil {
+		panic(err)
+	}
+}
+
+func print(changes []FieldChange) {
+	for _, change := range changes {
+		old, _ := json.Marshal(change.Old)
+		new, _ := json.Marshal(change.New)
+		fmt.Printf("%s: %s -> %s\n", change.Path, old, new)
+	}
+}
+
+func main() {
+	var old, current Task
+	decode(` + "`" + `{"id": 1, "title": "write", "tags": ["a", "b"], "labels": {"prio": "high", "team": "x"},
+		"owner": {"name": "ann"}, "watchers": [{"name": "bob"}], "checklist": {"items": ["x"]}}` + "`" + `, &old)
+	decode(` + "`" + `{"id": 1, "title": "write docs", "done": true, "tags": ["a"], "labels": {"prio": "low", "size": "s"},
+		"watchers": [{"name": "bob", "email": "bob@example.com"}, {"name": "cy"}], "checklist": {"items": ["x", "y"], "closed": true}}` + "`" + `, &current)
+	print(current.DiffFrom(&old))
+	fmt.Println("same:", len(old.DiffFrom(&old)))
+	fmt.Println("from nil:", len((&Task{ID: 2, Title: old.Title}).DiffFrom(nil)))
+
+	var before, after Project
+	decode(` + "`" + `{"version": 1, "name": "docs", "tasks": {"a": {"title": "write"}}}` + "`" + `, &before)
+	decode(` + "`" + `{"version": 2, "name": "docs", "tasks": {"a": {"title": "write docs"}, "b": {"title": "test"}}}` + "`" + `, &after)
+	print(after.DiffFrom(&before))
+}
+`
+
+func TestGenerateModel_Union(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.unions.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinition("Pet", "models", definitions["Pet"], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, genModel.IsOneOf)
+	assert.Contains(t, genModel.DefaultImports, "encoding/json")
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("pet.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "type Pet struct {", res)
+		assertInCode(t, "Cat *Cat\n", res)
+		assertInCode(t, "Dog *Dog\n", res)
+		assertInCode(t, "func (m *Pet) UnmarshalJSON(raw []byte) error {", res)
+		assertInCode(t, "the value matches %d variants of Pet instead of one", res)
+		assertInCode(t, "func (m Pet) MarshalJSON() ([]byte, error) {", res)
+		assertInCode(t, "pet must have exactly one variant set, %d are set", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	genModel, err = makeGenDefinition("Identifier", "models", definitions["Identifier"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		assert.True(t, genModel.IsAnyOf)
+		var names []string
+		for _, v := range genModel.Union {
+			names = append(names, pascalize(v.Name))
+		}
+		assert.Equal(t, []string{"Int64", "UUID", "String"}, names)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			res := buf.String()
+			assertInCode(t, "identifier must have at least one variant set", res)
+			assertNotInCode(t, "instead of one", res)
+		}
+	}
+
+	// the inline objects among the variants are lifted into models
+	genModel, err = makeGenDefinition("Shape", "models", definitions["Shape"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			res := buf.String()
+			assertInCode(t, "ShapeOneOf0 *ShapeOneOf0", res)
+			assertInCode(t, "ShapeOneOf1 *ShapeOneOf1", res)
+			assertInCode(t, "type ShapeOneOf0 struct", res)
+		}
+	}
+
+	// so is an inline union property
+	genModel, err = makeGenDefinition("Owner", "models", definitions["Owner"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("owner.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "Contact *OwnerContact `json:\"contact,omitempty\"`", res)
+				assertInCode(t, "Pet *Pet `json:\"pet,omitempty\"`", res)
+				assertInCode(t, "type OwnerContact struct", res)
+				assertInCode(t, "SliceOfString []string", res)
+				assertInCode(t, "if err := m.Pet.Validate(formats); err != nil {", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+}
+
+func TestGenerateModel_UnionRoundTrip(t *testing.T) {
+	names := []string{"Cat", "Dog", "Pet", "Identifier", "Shape", "Owner"}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.unions.yml", nil, names, unionRoundTrip); ok {
+		assert.Equal(t, []string{
+			`cat: true false <nil>`,
+			`dog: false true <nil>`,
+			`both: the value matches 2 variants of Pet instead of one: [Cat Dog]`,
+			`neither: the value doesn't match any variant of Pet`,
+			`integer: 3 false false`,
+			`uuid: true true`,
+			`string: false true`,
+			`shape: false true`,
+			`{"contact":["a","b"],"pet":{"barks":true,"name":"rex"},"pets":[{"meows":true}]}`,
+			`contact: true`,
+			`valid: <nil>`,
+			`empty: true`,
+		}, lines)
+	}
+}
+
+const unionRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func decodePet(doc string) (Pet, error) {
+	var pet Pet
+	err := json.Unmarshal([]byte(doc), &pet)
+	return pet, err
+}
+
+func main() {
+	pet, err := decodePet(` + "`" + `{"name": "tom", "meows": true}` + "`" + `)
+	fmt.Println("cat:", pet.Cat != nil, pet.Dog != nil, err)
+	pet, err = decodePet(` + "`" + `{"name": "rex", "barks": true}` + "`" + `)
+	fmt.Println("dog:", pet.Cat != nil, pet.Dog != nil, err)
+	_, err = decodePet(` + "`" + `{"meows": true, "barks": false}` + "`" + `)
+	fmt.Println("both:", err)
+	_, err = decodePet(` + "`" + `{"name": "nemo"}` + "`" + `)
+	fmt.Println("neither:", err)
+
+	var id Identifier
+	if err := json.Unmarshal([]byte("3"), &id); err != nil {
+		panic(err)
+	}
+	fmt.Println("integer:", *id.Int64, id.UUID != nil, id.String != nil)
+	id = Identifier{}
+	if err := json.Unmarshal([]byte(` + "`" + `"a3bb189e-8bf9-3888-9912-ace4e6543002"` + "`" + `), &id); err != nil {
+		panic(err)
+	}
+	fmt.Println("uuid:", id.UUID != nil, id.String != nil)
+	id = Identifier{}
+	if err := json.Unmarshal([]byte(` + "`" + `"abc"` + "`" + `), &id); err != nil {
+		panic(err)
+	}
+	fmt.Println("string:", id.UUID != nil, id.String != nil)
+
+	var shape Shape
+	if err := json.Unmarshal([]byte(` + "`" + `{"side": 2}` + "`" + `), &shape); err != nil {
+		panic(err)
+	}
+	fmt.Println("shape:", shape.ShapeOneOf0 != nil, shape.ShapeOneOf1 != nil)
+
+	var owner Owner
+	if err := json.Unmarshal([]byte(` + "`" + `{"pet": {"name": "rex", "barks": true}, "pets": [{"meows": true}], "contact": ["a", "b"]}` + "`" + `), &owner); err != nil {
+		panic(err)
+	}
+	data, err := json.Marshal(&owner)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(data))
+	fmt.Println("contact:", owner.Contact.String == nil)
+	fmt.Println("valid:", owner.Validate(strfmt.Default))
+	fmt.Println("empty:", new(Pet).Validate(strfmt.Default) != nil)
+}
+`
+
+func TestGenerateModel_GoType(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.go-type.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{GoVersion: "1.18"}
+
+	genModel, err := makeGenDefinitionHierarchy("Listing", "models", "", definitions["Listing"], specDoc, true, true, opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Contains(t, genModel.DefaultImports, "github.com/acme/paging")
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		res := buf.String()
+		assertInCode(t, "Page paging.Paginated[Item] `json:\"page,omitempty\"`", res)
+		assertInCode(t, "Cursor paging.Cursor `json:\"cursor,omitempty\"`", res)
+		assertNotInCode(t, "m.validatePage(formats)", res)
+	}
+
+	genModel, err = makeGenDefinitionHierarchy("Catalog", "models", "", definitions["Catalog"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertInCode(t, "type Catalog paging.Index[string, int32]", buf.String())
+		}
+	}
+
+	_, err = makeGenDefinitionHierarchy("Listing", "models", "", definitions["Listing"], specDoc, true, true, &GenOpts{})
+	assert.Error(t, err)
+
+	genModel, err = makeGenDefinitionHierarchy("Invoice", "models", "", definitions["Invoice"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("invoice.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "Total *decimal.Decimal `json:\"total\"`", res)
+				assertInCode(t, "Discount decimal.Decimal `json:\"discount,omitempty\"`", res)
+				assertInCode(t, "Reference strfmt.UUID `json:\"reference,omitempty\"`", res)
+				assertInCode(t, "validate.Required(\"total\", \"body\", m.Total)", res)
+				assertInCode(t, "validate.FormatOf(\"total\", \"body\", \"decimal\", m.Total.String(), formats)", res)
+				assertInCode(t, "validate.FormatOf(\"reference\", \"body\", \"uuid\", m.Reference.String(), formats)", res)
+				// the length of the external type can't be checked
+				assertNotInCode(t, "validate.MaxLength(\"total\"", res)
+				assertNotInCode(t, "m.validateDiscount(formats)", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+}
+
+func TestGenerateModel_SingleAllOf(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.allof-single.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	genModel, err := makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "Priority Priority `json:\"priority,omitempty\"`", res)
+		assertInCode(t, "Effort int64 `json:\"effort,omitempty\"`", res)
+		assertInCode(t, "Weight *float64 `json:\"weight,omitempty\"`", res)
+		assertInCode(t, "Owner *Owner `json:\"owner,omitempty\"`", res)
+		assertInCode(t, "Estimates map[string]TaskEstimatesAnon `json:\"estimates,omitempty\"`", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+}
+
+func TestGenerateModel_MapRef(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.map-ref.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	for name, expected := range map[string][]string{
+		"Foos": {"type Foos map[string]Foo"},
+		"Board": {
+			"Foos map[string]Foo `json:\"foos,omitempty\"`",
+			"Levels map[string]Level `json:\"levels,omitempty\"`",
+			"Nested map[string]map[string]Foo `json:\"nested,omitempty\"`",
+			"Board map[string]*Foo `json:\"-\"`",
+		},
+		"Kennel": {
+			"ByName map[string]Foos `json:\"byName,omitempty\"`",
+			"Tags map[string]Tags `json:\"tags,omitempty\"`",
+			"Pets map[string]Pet `json:\"pets,omitempty\"`",
+			"Dogs map[string]Dog `json:\"dogs,omitempty\"`",
+		},
+	} {
+		genModel, err := makeGenDefinition(name, "models", definitions[name], specDoc, true, true)
+		if !assert.NoError(t, err, name) {
+			continue
+		}
+		// the values are never lifted as anonymous structs
+		assert.Empty(t, genModel.ExtraSchemas, name)
+		buf := bytes.NewBuffer(nil)
+		if !assert.NoError(t, modelTemplate.Execute(buf, genModel), name) {
+			continue
+		}
+		ff, err := formatGoFile(swag.ToFileName(name)+".go", buf.Bytes())
+		if assert.NoError(t, err, name) {
+			res := string(ff)
+			for _, line := range expected {
+				assertInCode(t, line, res)
+			}
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_Normalize(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.normalize.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{WithNormalize: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Task", "models", "", definitions["Task"], specDoc, true, true, opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, genModel.HasNormalize)
+	assert.Contains(t, genModel.DefaultImports, "strings")
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "func (m *Task) Normalize() {", res)
+		assertInCode(t, "*m.Title = strings.TrimSpace(*m.Title)", res)
+		assertInCode(t, "m.Code = strings.ToLower(m.Code)", res)
+		assertInCode(t, "value := int32(3)\n\t\tm.Priority = &value", res)
+		assertInCode(t, "value := \"inbox\"", res)
+		assertInCode(t, "value := true", res)
+		assertInCode(t, "interface{}(m.Owner).(interface{ Normalize() })", res)
+		assertInCode(t, "interface{}(m.Tags[i]).(interface{ Normalize() })", res)
+		assertInCode(t, "m.TagsByName[k] = v", res)
+		assertInCode(t, "func (m *TaskExtras) Normalize() {", res)
+		// the default of a date is no literal, an integer is not trimmed
+		assertNotInCode(t, "m.Due =", res)
+		assertNotInCode(t, "m.Count =", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	genModel, err = makeGenDefinitionHierarchy("Owner", "models", "", definitions["Owner"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertInCode(t, "m.Email = strfmt.Email(strings.ToLower(strings.TrimSpace(string(m.Email))))", buf.String())
+		}
+	}
+
+	genModel, err = makeGenDefinitionHierarchy("Tag", "models", "", definitions["Tag"], specDoc, true, true, nil)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.HasNormalize)
+		assert.NotContains(t, genModel.DefaultImports, "strings")
+	}
+}
+
+func TestGenerateModel_NormalizeCollectionDefaults(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.collection-defaults.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{WithNormalize: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Task", "models", "", definitions["Task"], specDoc, true, true, opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "if m.Tags == nil {\n\t\tm.Tags = []string{\"home\", \"chores\"}", res)
+		assertInCode(t, "m.Watchers = []int64{}", res)
+		assertInCode(t, `m.Scores = map[string]float64{"effort": 2, "value": 0.5}`, res)
+		assertInCode(t, "m.Grid = [][]bool{[]bool{true, false}, []bool{}}", res)
+		// the default of an array of models is no literal, an array without default is left nil
+		assertNotInCode(t, "m.Notes =", res)
+		assertNotInCode(t, "m.Steps =", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+}
+
+func TestGenerateModel_NormalizeRoundTrip(t *testing.T) {
+	opts := &GenOpts{WithNormalize: true}
+	names := []string{"State", "Tag", "Owner", "Task", "Chore"}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.normalize.yml", opts, names, normalizeRoundTrip); ok {
+		assert.Equal(t, []string{
+			`{"code":"ab-12","extras":{"note":"call back"},"label":"inbox","owner":{"email":"ann@example.com"},"priority":3,"ratio":0.5,"tags":[{"color":"grey","name":"home"}],"tagsByName":{"x":{"color":"red","name":"work"}},"title":"buy milk","urgent":true}`,
+			`{"label":"later","priority":1,"ratio":0.5,"title":"chore","urgent":false,"effort":1}`,
+		}, lines)
+	}
+}
+
+const normalizeRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func main() {
+	var task Task
+	if err := json.Unmarshal([]byte(` + "`" + `{
+		"title": "  buy milk ",
+		"code": "AB-12",
+		"extras": {"note": " call back "},
+		"owner": {"email": " Ann@Example.com"},
+		"tags": [{"name": " Home "}],
+		"tagsByName": {"x": {"name": "WORK", "color": "red"}}
+	}` + "`" + `), &task); err != nil {
+		panic(err)
+	}
+	task.Normalize()
+	raw, _ := json.Marshal(task)
+	fmt.Println(string(raw))
+
+	var chore Chore
+	if err := json.Unmarshal([]byte(` + "`" + `{"title": "chore", "label": "later", "priority": 1, "urgent": false}` + "`" + `), &chore); err != nil {
+		panic(err)
+	}
+	chore.Normalize()
+	raw, _ = json.Marshal(chore)
+	fmt.Println(string(raw))
+}
+`
+
+func TestGenerateModel_EnumConsts(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.enum-consts.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{}
+
+	for name, expected := range map[string][]string{
+		"Status": {
+			"StatusActive Status = \"active\"",
+			"StatusInProgress Status = \"in-progress\"",
+			// collides with in-progress once sanitized
+			"StatusInProgress2 Status = \"in progress\"",
+			"StatusEmpty Status = \"\"",
+			"StatusValue Status = \"$\"",
+			"StatusACTIVE Status = \"ACTIVE\"",
+		},
+		"Level": {
+			"Level1 Level = 1",
+			"LevelMinus3 Level = -3",
+		},
+		"Ratio": {
+			"Ratio0Dot5 Ratio = 0.5",
+			"RatioMinus1Dot5 Ratio = -1.5",
+		},
+	} {
+		genModel, err := makeGenDefinitionHierarchy(name, "models", "", definitions[name], specDoc, true, true, opts)
+		if !assert.NoError(t, err, name) {
+			continue
+		}
+		buf := bytes.NewBuffer(nil)
+		if !assert.NoError(t, modelTemplate.Execute(buf, genModel), name) {
+			continue
+		}
+		ff, err := formatGoFile(swag.ToFileName(name)+".go", buf.Bytes())
+		if assert.NoError(t, err, name) {
+			res := string(ff)
+			for _, line := range expected {
+				assertInCode(t, line, res)
+			}
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+
+	genModel, err := makeGenDefinitionHierarchy("Task", "models", "", definitions["Task"], specDoc, true, true, opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "TaskStateOpen string = \"open\"", res)
+		assertInCode(t, "TaskStateClosed string = \"closed\"", res)
+		assertInCode(t, "TaskPriority3 int64 = 3", res)
+		// the nested object
+		assertInCode(t, "TaskOwnerRoleAdmin string = \"admin\"", res)
+		// the constants of Status are declared with Status
+		assertNotInCode(t, "TaskStatusActive", res)
+		// TaskKind is a model
+		assertNotInCode(t, "TaskKindA", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+}
+
+func TestGenerateModel_UntypedAdditionalProps(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.free-form.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, genModel.IsAdditionalProperties)
+	if assert.NotNil(t, genModel.AdditionalProperties) {
+		assert.True(t, genModel.AdditionalProperties.IsInterface)
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "Task map[string]interface{} `json:\"-\"`", res)
+		assertInCode(t, "TaskOwner map[string]interface{} `json:\"-\"`", res)
+		assertInCode(t, "func (m *Task) UnmarshalJSON(data []byte) error {", res)
+		assertInCode(t, "m.Task = result", res)
+		assertInCode(t, "func (m TaskOwner) MarshalJSON() ([]byte, error) {", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	// additionalProperties: false is no different from an omitted one
+	for _, name := range []string{"Closed", "Omitted"} {
+		genModel, err := makeGenDefinition(name, "models", definitions[name], specDoc, true, true)
+		if !assert.NoError(t, err, name) {
+			continue
+		}
+		assert.False(t, genModel.IsAdditionalProperties, name)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel), name) {
+			assertNotInCode(t, "MarshalJSON", buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_UntypedAdditionalPropsRoundTrip(t *testing.T) {
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.free-form.yml", nil, []string{"Task"}, freeFormRoundTrip); ok {
+		assert.Equal(t, []string{
+			`buy milk 2 ann map[color:red] map[team:home]`,
+			`{"owner":{"name":"ann","team":"home"},"priority":2,"title":"buy milk","color":"red"}`,
+		}, lines)
+	}
+}
+
+const freeFormRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func main() {
+	var task Task
+	if err := json.Unmarshal([]byte(` + "`" + `{
+		"title": "buy milk",
+		"priority": 2,
+		"color": "red",
+		"owner": {"name": "ann", "team": "home"}
+	}` + "`" + `), &task); err != nil {
+		panic(err)
+	}
+	fmt.Println(*task.Title, task.Priority, task.Owner.Name, task.Task, task.Owner.TaskOwner)
+	raw, err := json.Marshal(task)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(raw))
+}
+`
+
+func TestGenerateModel_TypeArrays(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.type-arrays.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "Title *string `json:\"title\"`", res)
+		assertInCode(t, "Notes *string `json:\"notes,omitempty\"`", res)
+		assertInCode(t, "Priority *int32 `json:\"priority,omitempty\"`", res)
+		assertInCode(t, "Done *bool `json:\"done,omitempty\"`", res)
+		assertInCode(t, "Due *strfmt.DateTime `json:\"due,omitempty\"`", res)
+		assertInCode(t, "State *string `json:\"state,omitempty\"`", res)
+		assertInCode(t, "Tags []string `json:\"tags,omitempty\"`", res)
+		assertInCode(t, "Estimate interface{} `json:\"estimate,omitempty\"`", res)
+		assertInCode(t, "Count int64 `json:\"count,omitempty\"`", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	genModel, err = makeGenDefinition("Note", "models", definitions["Note"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertInCode(t, "type Note string", buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_FormatRegistry(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.format-registry.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// the formats known to strfmt and the go types which are no formatters are left out
+	formats, imports := customFormats(specDoc.Spec(), nil)
+	assert.Equal(t, []customFormat{
+		{Name: "decimal", GoType: "decimal.Decimal"},
+		{Name: "registered-user", GoType: "users.User"},
+		{Name: "task-code", GoType: "TaskCode"},
+	}, formats)
+	assert.Equal(t, []string{"github.com/acme/users", "github.com/shopspring/decimal"}, imports)
+
+	// the formats validated with the request context are not registered
+	formats, imports = customFormats(specDoc.Spec(), []string{"registered-user"})
+	assert.Len(t, formats, 2)
+	assert.Equal(t, []string{"github.com/shopspring/decimal"}, imports)
+
+	dir, err := ioutil.TempDir("", "format-registry")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	target := filepath.Join(dir, "models")
+	if assert.NoError(t, generateFormatRegistry(target, formats, imports)) {
+		b, err := ioutil.ReadFile(filepath.Join(target, "format_registry.go"))
+		if assert.NoError(t, err) {
+			res := string(b)
+			assertInCode(t, "package models", res)
+			assertInCode(t, `"github.com/shopspring/decimal"`, res)
+			assertInCode(t, "func init() {", res)
+			assertInCode(t, `strfmt.Default.Add("decimal", new(decimal.Decimal), func(str string) bool {`, res)
+			assertInCode(t, "return new(decimal.Decimal).UnmarshalText([]byte(str)) == nil", res)
+			assertInCode(t, `strfmt.Default.Add("task-code", new(TaskCode)`, res)
+		}
+	}
+
+	// without custom formats there is nothing to register
+	specDoc, err = loads.Spec("../fixtures/codegen/todolist.scrub.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	formats, imports = customFormats(specDoc.Spec(), nil)
+	assert.Empty(t, formats)
+	if assert.NoError(t, generateFormatRegistry(dir, formats, imports)) {
+		_, err := os.Stat(filepath.Join(dir, "format_registry.go"))
+		assert.True(t, os.IsNotExist(err))
+	}
+}
+
+const formatRegistryRoundTrip = `package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// TaskCode is a code like T-42
+type TaskCode string
+
+func (c TaskCode) String() string {
+	return string(c)
+}
+
+func (c TaskCode) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+func (c *TaskCode) UnmarshalText(text []byte) error {
+	if !strings.HasPrefix(string(text), "T-") {
+		return errors.New("a task code starts with T-")
+	}
+	*c = TaskCode(text)
+	return nil
+}
+
+func main() {
+	code := TaskCode("T-42")
+	valid := Ticket{Code: &code}
+	fmt.Println(valid.Validate(strfmt.Default))
+
+	bad := TaskCode("42")
+	invalid := Ticket{Code: &bad}
+	err := invalid.Validate(strfmt.Default)
+	fmt.Println(err != nil && strings.Contains(err.Error(), "task-code"))
+}
+`
+
+func TestGenerateModel_FormatRegistryRoundTrip(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.format-registry.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	w := newGoWorkspace(t)
+	if !writeModels(t, w, "main", "../fixtures/codegen/todolist.format-registry.yml", &GenOpts{}, []string{"Ticket"}) {
+		return
+	}
+
+	// only the format of the ticket, the other ones are types of packages out of this tree
+	formats, _ := customFormats(specDoc.Spec(), []string{"decimal", "registered-user"})
+	buf := bytes.NewBuffer(nil)
+	data := struct {
+		Package string
+		Formats []customFormat
+		Imports []string
+	}{Package: "main", Formats: formats}
+	if !assert.NoError(t, formatsTemplate.Execute(buf, data)) ||
+		!assert.NoError(t, w.WriteFile("main", "format_registry", buf.Bytes())) ||
+		!assert.NoError(t, w.WriteFile("main", "main", []byte(formatRegistryRoundTrip))) {
+		return
+	}
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{"<nil>", "true"}, lines)
+	}
+}
+
+func TestGenerateModel_ValueObjects(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.value-objects.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	k := "Task"
+	genModel, err := makeGenDefinition(k, "models", specDoc.Spec().Definitions[k], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "Assignee TaskAssignee `json:\"assignee\"`", res)
+		assertInCode(t, "Address Address `json:\"address\"`", res)
+		assertInCode(t, "Reviewer *TaskReviewer `json:\"reviewer,omitempty\"`", res)
+		assertInCode(t, "Owner *Owner `json:\"owner,omitempty\"`", res)
+		assertInCode(t, "Steps []TaskStepsItems0 `json:\"steps,omitempty\"`", res)
+		assertInCode(t, "Addresses []Address `json:\"addresses,omitempty\"`", res)
+		assertInCode(t, "Owners []*Owner `json:\"owners,omitempty\"`", res)
+
+		// the values are validated without nil checks, down the elements of arrays
+		assertInCode(t, "if err := m.Assignee.Validate(formats); err != nil {", res)
+		assertNotInCode(t, "if m.Assignee != nil {", res)
+		assertInCode(t, "if err := m.Addresses[i].Validate(formats); err != nil {", res)
+		assertInCode(t, "if err := m.Steps[i].Validate(formats); err != nil {", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+}
+
+func TestGenerateModel_NotRequired(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.not-required.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	k := "Task"
+	genModel, err := makeGenDefinition(k, "models", specDoc.Spec().Definitions[k], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, genModel.NotRequired, 1) {
+		assert.Equal(t, []string{"dueDate", "recurrence"}, genModel.NotRequired[0].Names)
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "if !swag.IsZero(m.DueDate) && !swag.IsZero(m.Recurrence) {", res)
+		assertInCode(t, `res = append(res, errors.New(422, "dueDate and recurrence must not be set together"))`, res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	assert.Equal(t, "a must not be set", notRequiredMessage([]string{"a"}))
+	assert.Equal(t, "a, b and c must not be set together", notRequiredMessage([]string{"a", "b", "c"}))
+}
+
+const notRequiredRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	for _, doc := range []string{
+		` + "`" + `{"title": "water plants"}` + "`" + `,
+		` + "`" + `{"title": "water plants", "dueDate": "2026-10-17"}` + "`" + `,
+		` + "`" + `{"title": "water plants", "recurrence": "weekly"}` + "`" + `,
+		` + "`" + `{"title": "water plants", "dueDate": "2026-10-17", "recurrence": "weekly"}` + "`" + `,
+	} {
+		var task Task
+		if err := json.Unmarshal([]byte(doc), &task); err != nil {
+			panic(err)
+		}
+		fmt.Println(task.Validate(strfmt.Default))
+	}
+}
+`
+
+func TestGenerateModel_NotRequiredRoundTrip(t *testing.T) {
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.not-required.yml", nil, []string{"Task"}, notRequiredRoundTrip); ok {
+		assert.Equal(t, []string{
+			"<nil>",
+			"<nil>",
+			"<nil>",
+			"validation failure list:",
+			"dueDate and recurrence must not be set together",
+		}, lines)
+	}
+}
+
+func TestGenerateModel_ArrayBounds(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.array-bounds.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	for name, expected := range map[string][]string{
+		// named arrays validate their own length and uniqueness
+		"Tags": {
+			"type Tags []string",
+			`validate.MinItems("", "body", iTagsSize, 1)`,
+			`validate.MaxItems("", "body", iTagsSize, 3)`,
+			`validate.UniqueItems("", "body", m)`,
+		},
+		"Labels": {
+			"type Labels []*Label",
+			`validate.MinItems("", "body", iLabelsSize, 2)`,
+		},
+		// and so do inline arrays, at every level
+		"Label": {
+			`validate.MaxItems("names", "body", iNamesSize, 4)`,
+			`validate.UniqueItems("names", "body", m.Names)`,
+			`validate.MinItems("nested", "body", iNestedSize, 1)`,
+			`validate.MaxItems("nested"+"."+strconv.Itoa(i), "body", iiNestedSize, 2)`,
+		},
+		// the properties referring to named arrays call their validations
+		"Holder": {
+			"if err := m.Tags.Validate(formats); err != nil {",
+			"if err := m.More.Validate(formats); err != nil {",
+			`validate.MinItems("mapped"+"."+k, "body", iMappedSize, 1)`,
+		},
+	} {
+		genModel, err := makeGenDefinition(name, "models", definitions[name], specDoc, true, true)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		buf := bytes.NewBuffer(nil)
+		if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			continue
+		}
+		ff, err := formatGoFile(swag.ToFileName(name)+".go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			for _, line := range expected {
+				assertInCode(t, line, res)
+			}
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_DateBounds(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.date-bounds.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		// the bounds are normalized to UTC, a full-date is midnight UTC
+		assertInCode(t, "if time.Time(*m.Due).Before(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)) {", res)
+		assertInCode(t, `errors.New(422, "%s in %s should be at or after %s", "due", "body", "2020-01-01T00:00:00Z")`, res)
+		assertInCode(t, "if time.Time(*m.Due).After(time.Date(2030, time.December, 31, 0, 0, 0, 0, time.UTC)) {", res)
+		assertInCode(t, "if time.Time(*m.Started).Before(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)) {", res)
+		assertInCode(t, "if time.Time(m.Reminders[i]).After(time.Date(2031, time.January, 1, 4, 59, 59, 500000000, time.UTC)) {", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	genModel, err = makeGenDefinition("Deadline", "models", definitions["Deadline"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertInCode(t, "if time.Time(m).Before(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)) {", buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_InvalidDateBounds(t *testing.T) {
+	for _, ext := range []spec.Extensions{
+		{xMinDate: "yesterday"},
+		{xMaxDate: 2020},
+		{xMinDate: "2030-01-01", xMaxDate: "2020-01-01"},
+	} {
+		_, _, err := dateBounds("date-time", ext)
+		assert.Error(t, err)
+	}
+	_, _, err := dateBounds("date", spec.Extensions{xMinDate: "2020-01-01"})
+	assert.Error(t, err)
+
+	min, max, err := dateBounds("date-time", spec.Extensions{xMinDate: "2020-01-01T09:00:00+09:00"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2020-01-01T00:00:00Z", min)
+		assert.Empty(t, max)
+	}
+}
+
+func TestGenerateModel_DateBoundsRoundTrip(t *testing.T) {
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.date-bounds.yml", nil, []string{"Task"}, dateBoundsRoundTrip); ok {
+		assert.Equal(t, []string{
+			`2020-01-01T01:30:00+01:00: <nil>`,
+			`2020-01-01T00:30:00+01:00: validation failure list:` + "\n" +
+				`due in body should be at or after 2020-01-01T00:00:00Z`,
+			`2031-01-01T00:00:00Z: validation failure list:` + "\n" +
+				`due in body should be at or before 2030-12-31T00:00:00Z`,
+		}, strings.Split(strings.Join(lines, "\n"), "\n|"))
+	}
+}
+
+const dateBoundsRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	var results []string
+	// the offsets of the dates don't matter, the instants are compared
+	for _, due := range []string{"2020-01-01T01:30:00+01:00", "2020-01-01T00:30:00+01:00", "2031-01-01T00:00:00Z"} {
+		var task Task
+		if err := json.Unmarshal([]byte(` + "`" + `{"due": "` + "`" + `+due+` + "`" + `"}` + "`" + `), &task); err != nil {
+			panic(err)
+		}
+		results = append(results, fmt.Sprintf("%s: %v", due, task.Validate(strfmt.Default)))
+	}
+	fmt.Println(strings.Join(results, "\n|"))
+}
+`
+
+func TestGenerateModel_SchemaRegistry(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.schema-registry.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	schemas, err := schemaRegistry(specDoc.Spec(), nil)
+	if !assert.NoError(t, err) || !assert.Len(t, schemas, 3) {
+		return
+	}
+	assert.Equal(t, "Tag", schemas[0].Name)
+	assert.Equal(t, `{"type":"string","enum":["home","work"]}`, schemas[0].Schema)
+	assert.Equal(t, "`{\"type\":\"string\",\"enum\":[\"home\",\"work\"]}`", schemas[0].Literal())
+
+	// the schema carries the definitions it refers to, down their own references
+	var task spec.Schema
+	if assert.Equal(t, "Task", schemas[1].Name) && assert.NoError(t, json.Unmarshal([]byte(schemas[1].Schema), &task)) {
+		assert.Len(t, task.Definitions, 2)
+		assert.Contains(t, task.Definitions, "Task")
+		assert.Contains(t, task.Definitions, "User")
+	}
+	var user spec.Schema
+	if assert.NoError(t, json.Unmarshal([]byte(schemas[2].Schema), &user)) {
+		assert.Empty(t, user.Definitions)
+	}
+
+	// only the named definitions are registered
+	schemas, err = schemaRegistry(specDoc.Spec(), []string{"User"})
+	if assert.NoError(t, err) && assert.Len(t, schemas, 1) {
+		assert.Equal(t, "User", schemas[0].Name)
+	}
+	_, err = schemaRegistry(specDoc.Spec(), []string{"Missing"})
+	assert.Error(t, err)
+
+	assert.Equal(t, strconv.Quote("{\"description\":\"a `code`\"}"), registeredSchema{Schema: "{\"description\":\"a `code`\"}"}.Literal())
+}
+
+func TestGenerateModel_SchemaRegistryRoundTrip(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.schema-registry.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	schemas, err := schemaRegistry(specDoc.Spec(), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	w := newGoWorkspace(t)
+	buf := bytes.NewBuffer(nil)
+	data := struct {
+		Package string
+		Schemas []registeredSchema
+	}{Package: "main", Schemas: schemas}
+	if !assert.NoError(t, schemasTemplate.Execute(buf, data)) ||
+		!assert.NoError(t, w.WriteFile("main", "schema_registry", buf.Bytes())) ||
+		!assert.NoError(t, w.WriteFile("main", "main", []byte(schemaRegistryRoundTrip))) {
+		return
+	}
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			"valid: <nil>",
+			"decoded: <nil>",
+			"missing owner: true",
+			"nested subtask: true",
+			"bad email: true",
+			"enum: true",
+			`unknown: no schema for the definition "Project"`,
+		}, lines)
+	}
+}
+
+const schemaRegistryRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func main() {
+	valid := []byte(` + "`" + `{"title": "dishes", "owner": {"name": "ann", "email": "ann@example.com"}, "subtasks": [{"title": "rinse", "owner": {"name": "bob"}}]}` + "`" + `)
+	fmt.Println("valid:", ValidateSchema("Task", valid, nil))
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(valid, &decoded); err != nil {
+		panic(err)
+	}
+	fmt.Println("decoded:", ValidateSchema("Task", decoded, nil))
+
+	fmt.Println("missing owner:", ValidateSchema("Task", json.RawMessage(` + "`" + `{"title": "dishes"}` + "`" + `), nil) != nil)
+	fmt.Println("nested subtask:", ValidateSchema("Task", []byte(` + "`" + `{"title": "dishes", "owner": {"name": "ann"}, "subtasks": [{"title": "", "owner": {"name": "bob"}}]}` + "`" + `), nil) != nil)
+	fmt.Println("bad email:", ValidateSchema("User", map[string]string{"name": "ann", "email": "nope"}, nil) != nil)
+	fmt.Println("enum:", ValidateSchema("Tag", "garden", nil) != nil)
+	fmt.Println("unknown:", ValidateSchema("Project", valid, nil))
+}
+`
+
+func TestGenerateModel_NullablePolicy(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.nullable-policy.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinitionHierarchy("Task", "models", "", definitions["Task"], specDoc, true, true, &GenOpts{NullablePolicy: NullableNeverPointer})
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		// the required properties are values, still serialized when zero
+		assertInCode(t, "Title string `json:\"title\"`", res)
+		assertInCode(t, "Priority int32 `json:\"priority\"`", res)
+		assertInCode(t, `validate.RequiredString("title", "body", string(m.Title))`, res)
+		assertInCode(t, "Notes string `json:\"notes,omitempty\"`", res)
+		assertInCode(t, "Done bool `json:\"done,omitempty\"`", res)
+		assertInCode(t, "Archived *bool `json:\"archived,omitempty\"`", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	genModel, err = makeGenDefinitionHierarchy("Task", "models", "", definitions["Task"], specDoc, true, true, &GenOpts{NullablePolicy: NullableAlwaysPointer})
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf = bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err = formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "Notes *string `json:\"notes,omitempty\"`", res)
+		assertInCode(t, "Due *strfmt.DateTime `json:\"due,omitempty\"`", res)
+		assertInCode(t, "Rank int64 `json:\"rank,omitempty\"`", res)
+		// the elements of collections are left as they are
+		assertInCode(t, "Tags []string `json:\"tags,omitempty\"`", res)
+		assertInCode(t, "Labels map[string]string `json:\"labels,omitempty\"`", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+}
+
+func TestGenerateModel_TupleNames(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.tuple-names.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinition("Point", "models", definitions["Point"], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, genModel.IsTuple)
+	if assert.Len(t, genModel.Properties, 2) {
+		assert.Equal(t, "lat", genModel.Properties[0].Name)
+		assert.Equal(t, "lng", genModel.Properties[1].Name)
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("point.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "Lat *float64 `json:\"-\"`", res)
+		assertInCode(t, "Lng *float64 `json:\"-\"`", res)
+		assertInCode(t, `validate.Minimum("0", "body", float64(*m.Lat), -90, false)`, res)
+		assertInCode(t, `validate.Maximum("1", "body", float64(*m.Lng), 180, false)`, res)
+		assertNotInCode(t, "P0", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	genModel, err = makeGenDefinition("Segment", "models", definitions["Segment"], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, genModel.ExtraSchemas, 1) && assert.Len(t, genModel.ExtraSchemas[0].Properties, 2) {
+		// the elements of an anonymous tuple keep their position
+		assert.Equal(t, "start", genModel.ExtraSchemas[0].Properties[0].Name)
+		assert.Equal(t, "end", genModel.ExtraSchemas[0].Properties[1].Name)
+	}
+	buf = bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err = formatGoFile("segment.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "Start *string `json:\"-\"`", res)
+		assertInCode(t, "End *string `json:\"-\"`", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	// the names don't match the arity of the tuple
+	genModel, err = makeGenDefinition("Pair", "models", definitions["Pair"], specDoc, true, true)
+	if assert.NoError(t, err) && assert.Len(t, genModel.Properties, 2) {
+		assert.Equal(t, "P0", genModel.Properties[0].Name)
+		assert.Equal(t, "P1", genModel.Properties[1].Name)
+	}
+}
+
+func TestGenerateModel_PlainBytes(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.plain-bytes.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{PlainBytes: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Attachment", "models", "", definitions["Attachment"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		for _, p := range genModel.Properties {
+			if p.Name == "content" {
+				assert.Equal(t, "nil", p.Zero())
+			}
+		}
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("attachment.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "Content []byte `json:\"content\"`", res)
+				assertInCode(t, "Thumbnail []byte `json:\"thumbnail,omitempty\"`", res)
+				assertInCode(t, "Chunks [][]byte `json:\"chunks,omitempty\"`", res)
+				assertInCode(t, "validate.Required(\"content\", \"body\", []byte(m.Content))", res)
+				assertNotInCode(t, "strfmt.Base64", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	genModel, err = makeGenDefinitionHierarchy("Digest", "models", "", definitions["Digest"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "nil", genModel.Zero())
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertInCode(t, "type Digest []byte", buf.String())
+		}
+	}
+
+	// without the option the byte format keeps its strfmt type
+	genModel, err = makeGenDefinition("Attachment", "models", definitions["Attachment"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertInCode(t, "strfmt.Base64", buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_PlainBytesRoundTrip(t *testing.T) {
+	opts := &GenOpts{PlainBytes: true}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.plain-bytes.yml", opts, []string{"Attachment", "Digest"}, plainBytesRoundTrip); ok {
+		assert.Equal(t, []string{
+			`{"chunks":["AQI=","Aw=="],"content":"aGVsbG8=","name":"greeting"} hello <nil>`,
+			`"3q2+7w==" deadbeef <nil>`,
+			"true",
+			"malformed",
+			"invalid",
+		}, lines)
+	}
+}
+
+const plainBytesRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func main() {
+	attachment := Attachment{Name: "greeting", Content: []byte("hello"), Chunks: [][]byte{{1, 2}, {3}}}
+	out, _ := json.Marshal(attachment)
+	var back Attachment
+	if err := json.Unmarshal(out, &back); err != nil {
+		panic(err)
+	}
+	fmt.Println(string(out), string(back.Content), back.Validate(strfmt.Default))
+
+	var digest Digest
+	if err := json.Unmarshal([]byte(` + "`" + `"3q2+7w=="` + "`" + `), &digest); err != nil {
+		panic(err)
+	}
+	out, _ = json.Marshal(digest)
+	fmt.Println(string(out), fmt.Sprintf("%x", []byte(digest)), digest.Validate(strfmt.Default))
+
+	// the zero value is nil
+	var empty Attachment
+	fmt.Println(empty.Thumbnail == nil)
+
+	// payloads which aren't base64 are rejected when unmarshalling
+	if err := json.Unmarshal([]byte(` + "`" + `{"content":"not base64!"}` + "`" + `), &Attachment{}); err != nil {
+		fmt.Println("malformed")
+	}
+
+	// the length is validated on the decoded bytes
+	long := Attachment{Content: []byte("x"), Thumbnail: make([]byte, 65)}
+	if err := long.Validate(strfmt.Default); err != nil {
+		fmt.Println("invalid")
+	}
+}
+`
+
+func TestGenerateModel_DocComments(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.doc-comments.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("task.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				// the property is documented by the target of its $ref, unless it has its own description
+				assertInCode(t, "\t// A postal address.\n\t// The lines are joined with commas.\n\t//\n\t// Example: map[string]interface {}{\"street\":\"1 Main St\"}\n\tHome *Address `json:\"home,omitempty\"`", res)
+				assertInCode(t, "\t// the office of the assignee\n\t//\n\t// Example: map[string]interface {}{\"street\":\"1 Main St\"}\n\tWork *Address `json:\"work,omitempty\"`", res)
+				// the lines are reflowed without trailing spaces nor carriage returns
+				assertInCode(t, "\t// The code of the task,\n\t// unique per project.\n\t//\n\t// Example: \"TSK-1\"\n\tCode string `json:\"code,omitempty\"`", res)
+				assertInCode(t, "// Task task\n//\n// swagger:model Task\ntype Task struct", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	genModel, err = makeGenDefinition("Address", "models", definitions["Address"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("address.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				assertInCode(t, "// Address A postal address.\n// The lines are joined with commas.\n//\n// Example: map[string]interface {}{\"street\":\"1 Main St\"}\n//\n// swagger:model Address\ntype Address struct", string(ff))
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// the legacy docs keep the block comments, without the documentation of the targets
+	genModel, err = makeGenDefinitionHierarchy("Task", "models", "", definitions["Task"], specDoc, true, true, &GenOpts{CompatMode: "0.5"})
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("task.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "/* home\n\t */\n\tHome *Address", res)
+				assertNotInCode(t, "Example:", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+}
+
+func TestGenerateModel_StructuredValidation(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.structured-validation.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{TypedErrors: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Contact", "models", "", definitions["Contact"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("contact.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "func (m *Contact) ValidateStructured(formats strfmt.Registry) []ValidationError {", res)
+				assertInCode(t, "return ValidationErrors(m.Validate(formats))", res)
+				assertInCode(t, "return prefixValidationPath(\"address\", err)", res)
+				assertInCode(t, "return prefixValidationPath(\"previous\"+\".\"+strconv.Itoa(i), err)", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// without the option the errors of the nested models are returned as is
+	genModel, err = makeGenDefinition("Contact", "models", definitions["Contact"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertNotInCode(t, "ValidateStructured", buf.String())
+			assertNotInCode(t, "prefixValidationPath", buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_StructuredValidationRoundTrip(t *testing.T) {
+	w := newGoWorkspace(t)
+	opts := &GenOpts{TypedErrors: true}
+	if !writeModels(t, w, "main", "../fixtures/codegen/todolist.structured-validation.yml", opts, []string{"Contact", "Address"}) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, validationErrorsTemplate.Execute(buf, struct{ Package string }{Package: "main"})) ||
+		!assert.NoError(t, w.WriteFile("main", "validation_error", buf.Bytes())) ||
+		!assert.NoError(t, w.WriteFile("main", "main", []byte(structuredValidationRoundTrip))) {
+		return
+	}
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			`[{"path":"address.street","constraint":"required","message":"address.street in body is required"},` +
+				`{"path":"address.zip","constraint":"pattern","message":"address.zip in body should match '^[0-9]{5}$'"},` +
+				`{"path":"age","constraint":"minimum","message":"age in body should be greater than or equal to 0"},` +
+				`{"path":"name","constraint":"maxLength","message":"name in body should be at most 10 chars long"},` +
+				`{"path":"previous.1.country","constraint":"enum","message":"previous.1.country in body should be one of [FR DE US]"}]`,
+			`[{"path":"name","constraint":"required","message":"name in body is required"}]`,
+			"null",
+		}, lines)
+	}
+}
+
+const structuredValidationRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+func main() {
+	contact := Contact{
+		Name:     swag.String("a name far too long"),
+		Age:      swag.Int64(-1),
+		Address:  &Address{Zip: "abc"},
+		Previous: []*Address{{Street: swag.String("main street")}, {Street: swag.String("high street"), Country: "UK"}},
+	}
+	out, _ := json.Marshal(contact.ValidateStructured(strfmt.Default))
+	fmt.Println(string(out))
+
+	// the errors of the nested model are only prefixed once
+	address := &Address{Street: swag.String("main street")}
+	out, _ = json.Marshal((&Contact{Address: address}).ValidateStructured(strfmt.Default))
+	fmt.Println(string(out))
+
+	valid := Contact{Name: swag.String("me"), Address: address}
+	out, _ = json.Marshal(valid.ValidateStructured(strfmt.Default))
+	fmt.Println(string(out))
+}
+`
+
+func TestGenerateModel_SplitReadWrite(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.read-write.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := &GenOpts{SplitReadWrite: true}
+
+	genModel, err := makeGenDefinitionHierarchy("Account", "models", "", definitions["Account"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		assert.True(t, genModel.SplitReadWrite)
+		var request, response []string
+		for _, p := range genModel.RequestFields {
+			request = append(request, p.Name)
+		}
+		for _, p := range genModel.ResponseFields {
+			response = append(response, p.Name)
+		}
+		assert.Equal(t, []string{"login", "owner", "password", "recoveryCode"}, request)
+		assert.Equal(t, []string{"createdAt", "id", "login", "owner"}, response)
+
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("account.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "type AccountRequest struct {", res)
+				assertInCode(t, "type AccountResponse struct {", res)
+				assertInCode(t, "func (m *Account) Request() *AccountRequest {", res)
+				assertInCode(t, "func (m *Account) Response() *AccountResponse {", res)
+				assertInCode(t, "func (m *AccountRequest) Model() *Account {", res)
+				assertInCode(t, "func (m *AccountResponse) Model() *Account {", res)
+				// the read-only id is required in responses only
+				assertInCode(t, "func (m *AccountRequest) Validate(formats strfmt.Registry) error {", res)
+				assertInCode(t, "if err := model.validateLogin(formats); err != nil {", res)
+				assertNotInCode(t, "model.validateID(formats)", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// a model without read-only nor write-only properties gets no variants
+	genModel, err = makeGenDefinitionHierarchy("Owner", "models", "", definitions["Owner"], specDoc, true, true, opts)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.SplitReadWrite)
+	}
+
+	// without the option neither
+	genModel, err = makeGenDefinition("Account", "models", definitions["Account"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		assert.False(t, genModel.SplitReadWrite)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			assertNotInCode(t, "AccountRequest", buf.String())
+		}
+	}
+}
+
+func TestGenerateModel_TypeKey(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.type-key.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinition("Scores", "models", definitions["Scores"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("scores.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "type Scores map[int64]*int64", res)
+				assertInCode(t, "validate.MinimumInt(strconv.FormatInt(int64(k), 10), \"body\", int64(*m[k]), 0, false)", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	genModel, err = makeGenDefinition("Schedule", "models", definitions["Schedule"], specDoc, true, true)
+	if assert.NoError(t, err) {
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+			ff, err := formatGoFile("schedule.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "Slots map[Weekday]Slot `json:\"slots,omitempty\"`", res)
+				assertInCode(t, "Counts map[uint16]*int64 `json:\"counts,omitempty\"`", res)
+				// the keys of a definition type are validated like its values
+				assertInCode(t, "if err := k.Validate(formats); err != nil {", res)
+				assertInCode(t, "\"counts\"+\".\"+strconv.FormatUint(uint64(k), 10)", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+}
+
+func TestGenerateModel_TypeKeyRoundTrip(t *testing.T) {
+	names := []string{"Weekday", "Scores", "Slot", "Schedule"}
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.type-key.yml", nil, names, typeKeyRoundTrip); ok {
+		assert.Equal(t, []string{
+			`{"42":1,"7":3}`, "3", "<nil>",
+			`{"counts":{"2":4},"slots":{"monday":{"start":"09:00"}}}`, "09:00", "<nil>",
+			"validation failure list:", "counts.2 in body should be less than or equal to 10",
+			// the key of a map has no path of its own
+			"validation failure list:", " in body should be one of [monday tuesday wednesday thursday friday]",
+			"malformed",
+		}, lines)
+	}
+}
+
+const typeKeyRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+func main() {
+	// the keys are serialized as the strings of JSON objects
+	var scores Scores
+	if err := json.Unmarshal([]byte(` + "`" + `{"42":1,"7":3}` + "`" + `), &scores); err != nil {
+		panic(err)
+	}
+	out, _ := json.Marshal(scores)
+	fmt.Println(string(out))
+	fmt.Println(*scores[7])
+	fmt.Println(scores.Validate(strfmt.Default))
+
+	var schedule Schedule
+	if err := json.Unmarshal([]byte(` + "`" + `{"slots":{"monday":{"start":"09:00"}},"counts":{"2":4}}` + "`" + `), &schedule); err != nil {
+		panic(err)
+	}
+	out, _ = json.Marshal(schedule)
+	fmt.Println(string(out))
+	fmt.Println(*schedule.Slots[Weekday("monday")].Start)
+	fmt.Println(schedule.Validate(strfmt.Default))
+
+	// the paths of the values name their key
+	schedule.Counts[2] = swag.Int64(11)
+	fmt.Println(schedule.Validate(strfmt.Default))
+
+	// the keys of an enum type are validated
+	schedule = Schedule{Slots: map[Weekday]Slot{"sunday": {}}}
+	fmt.Println(schedule.Validate(strfmt.Default))
+
+	// keys which aren't integers are rejected when unmarshalling
+	if err := json.Unmarshal([]byte(` + "`" + `{"first":1}` + "`" + `), &scores); err != nil {
+		fmt.Println("malformed")
+	}
+}
+`
+
+func TestGenerateModel_PropertyCount(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.max-properties.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+
+	genModel, err := makeGenDefinition("Contact", "models", definitions["Contact"], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.NotNil(t, genModel.PropertyCount) {
+		assert.Equal(t, []string{"address", "email", "phone"}, genModel.PropertyCount.Names)
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("contact.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "for _, set := range []bool{!swag.IsZero(m.Address), !swag.IsZero(m.Email), !swag.IsZero(m.Phone)} {", res)
+		assertInCode(t, `res = append(res, errors.TooManyProperties("", "body", 2))`, res)
+		assertInCode(t, `res = append(res, errors.TooFewProperties("", "body", 1))`, res)
+	} else {
+		fmt.Println(buf.String())
+	}
+
+	// the inline object is bounded too, the map is left out
+	genModel, err = makeGenDefinition("Task", "models", definitions["Task"], specDoc, true, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Nil(t, genModel.PropertyCount)
+	buf = bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err = formatGoFile("task.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "func (m *TaskSchedule) Validate(formats strfmt.Registry) error {", res)
+		assertInCode(t, "for _, set := range []bool{!swag.IsZero(m.End), !swag.IsZero(m.Every), !swag.IsZero(m.Start), !swag.IsZero(m.Tags)} {", res)
+		assertInCode(t, `res = append(res, errors.TooManyProperties("schedule", "body", 2))`, res)
+		assertNotInCode(t, "TooFewProperties", res)
+		assertNotInCode(t, "TooManyProperties(\"\", \"body\", 3)", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+}
+
+func TestGenerateModel_PropertyCountRoundTrip(t *testing.T) {
+	if lines, ok := runModels(t, "../fixtures/codegen/todolist.max-properties.yml", nil, []string{"Contact", "Task"}, propertyCountRoundTrip); ok {
+		assert.Equal(t, []string{
+			"two: <nil>",
+			"three: validation failure list:",
+			" in body should have at most 2 properties",
+			"none: validation failure list:",
+			" in body should have at least 1 properties",
+			"schedule: validation failure list:",
+			"validation failure list:",
+			"schedule in body should have at most 2 properties",
+			"zero every: <nil>",
+			"empty tags: validation failure list:",
+			"validation failure list:",
+			"schedule in body should have at most 2 properties",
+		}, lines)
+	}
+}
+
+const propertyCountRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func check(raw string, m interface{ Validate(strfmt.Registry) error }) error {
+	if err := json.Unmarshal([]byte(raw), m); err != nil {
+		panic(err)
+	}
+	return m.Validate(strfmt.Default)
+}
+
+func main() {
+	fmt.Println("two:", check(` + "`" + `{"email": "ann@example.com", "phone": "555"}` + "`" + `, new(Contact)))
+	fmt.Println("three:", check(` + "`" + `{"email": "ann@example.com", "phone": "555", "address": "1 main st"}` + "`" + `, new(Contact)))
+	fmt.Println("none:", check(` + "`" + `{}` + "`" + `, new(Contact)))
+
+	// the properties of the inline object count alike, the ones holding their zero value don't,
+	// unlike an empty array which is not nil
+	fmt.Println("schedule:", check(` + "`" + `{"title": "dishes", "schedule": {"start": "2026-10-17T08:00:00Z", "end": "2026-10-17T09:00:00Z", "every": 7}}` + "`" + `, new(Task)))
+	fmt.Println("zero every:", check(` + "`" + `{"title": "dishes", "schedule": {"start": "2026-10-17T08:00:00Z", "end": "2026-10-17T09:00:00Z", "every": 0}}` + "`" + `, new(Task)))
+	fmt.Println("empty tags:", check(` + "`" + `{"title": "dishes", "schedule": {"every": 7, "tags": [], "end": "2026-10-17T09:00:00Z"}}` + "`" + `, new(Task)))
+}
+`
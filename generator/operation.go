@@ -19,9 +19,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 
 	"github.com/go-openapi/analysis"
 	"github.com/go-openapi/loads"
@@ -44,13 +46,14 @@ func GenerateServerOperation(operationNames, tags []string, includeHandler, incl
 	compileTemplates()
 
 	// Load the spec
-	_, specDoc, err := loadSpec(opts.Spec)
+	_, specDoc, err := loadSpec(&opts)
 	if err != nil {
 		return err
 	}
 	analyzed := analysis.New(specDoc.Spec())
 
 	ops := gatherOperations(analyzed, operationNames)
+	shared := make(sharedParams)
 
 	for operationName, opRef := range ops {
 		method, path, operation := opRef.Method, opRef.Path, opRef.Op
@@ -92,6 +95,9 @@ func GenerateServerOperation(operationNames, tags []string, includeHandler, incl
 			DefaultConsumes:      defaultConsumes,
 			Doc:                  specDoc,
 			Analyzed:             analyzed,
+			WithLogging:          opts.WithLogging,
+			GenOpts:              &opts,
+			SharedParams:         shared,
 		}
 		if err := generator.Generate(); err != nil {
 			return err
@@ -128,6 +134,9 @@ type operationGenerator struct {
 	Doc                  *loads.Document
 	Analyzed             *analysis.Spec
 	WithContext          bool
+	WithLogging          bool
+	GenOpts              *GenOpts
+	SharedParams         sharedParams
 }
 
 func (o *operationGenerator) Generate() error {
@@ -153,7 +162,10 @@ func (o *operationGenerator) Generate() error {
 	bldr.DefaultImports = []string{filepath.ToSlash(filepath.Join(baseImport(o.Base), o.ModelsPackage))}
 	bldr.RootAPIPackage = o.APIPackage
 	bldr.WithContext = o.WithContext
+	bldr.WithLogging = o.WithLogging
+	bldr.GenOpts = o.GenOpts
 	bldr.DefaultConsumes = o.DefaultConsumes
+	bldr.SharedParams = o.SharedParams
 
 	for _, tag := range o.Operation.Tags {
 		if len(o.Tags) == 0 {
@@ -226,6 +238,9 @@ type opGen struct {
 }
 
 func (o *opGen) Generate() error {
+	if o.data.WebSocket != nil {
+		stripWebSocketBody(o.data)
+	}
 
 	if o.IncludeHandler {
 		if err := o.generateHandler(); err != nil {
@@ -234,7 +249,6 @@ func (o *opGen) Generate() error {
 		log.Println("generated handler", o.data.Package+"."+o.cname)
 	}
 
-	opParams := o.Analyzed.ParamsFor(o.data.Method, o.data.Path)
 	if o.IncludeParameters {
 		if err := o.generateParameterModel(); err != nil {
 			return fmt.Errorf("parameters: %s", err)
@@ -249,7 +263,7 @@ func (o *opGen) Generate() error {
 		log.Println("generated responses", o.data.Package+"."+o.cname+"Responses")
 	}
 
-	if len(opParams) == 0 {
+	if len(o.data.Params) == 0 {
 		log.Println("no parameters for operation", o.data.Package+"."+o.cname)
 	}
 	return nil
@@ -310,6 +324,7 @@ type codeGenOpBuilder struct {
 	Principal       string
 	Target          string
 	WithContext     bool
+	WithLogging     bool
 	Operation       spec.Operation
 	Doc             *loads.Document
 	Analyzed        *analysis.Spec
@@ -320,6 +335,88 @@ type codeGenOpBuilder struct {
 	DefaultConsumes string
 	ExtraSchemas    map[string]GenSchema
 	origDefs        map[string]spec.Schema
+	GenOpts         *GenOpts
+
+	// SharedParams is shared by the builders of all the operations of a spec
+	SharedParams sharedParams
+}
+
+// sharedParams keeps the params declared in the parameters section of a spec by $ref,
+// their type is resolved once and reused by all the operations referencing them
+type sharedParams map[string]GenParameter
+
+// refParam is a param of an operation, along with the $ref it was dereferenced from
+type refParam struct {
+	Ref string
+	spec.Parameter
+}
+
+// paramsFor dereferences the params of the operation and the ones of its path,
+// the operation overrides a param of the path with the same name
+func (b *codeGenOpBuilder) paramsFor() ([]refParam, error) {
+	res := make(map[string]refParam)
+	var order []string
+	add := func(params []spec.Parameter) error {
+		for _, param := range params {
+			var ref string
+			// a shared param may point to another one in turn
+			for param.Ref.String() != "" {
+				ref = param.Ref.String()
+				resolved, err := spec.ResolveParameter(b.Doc.Spec(), param.Ref)
+				if err != nil {
+					return fmt.Errorf("%s: param %s: %v", b.Name, ref, err)
+				}
+				param = *resolved
+			}
+			key := swag.ToGoName(param.Name)
+			if nm, ok := param.Extensions.GetString("go-name"); ok {
+				key = nm
+			}
+			if _, ok := res[key]; !ok {
+				order = append(order, key)
+			}
+			res[key] = refParam{Ref: ref, Parameter: param}
+		}
+		return nil
+	}
+	if pi, ok := b.Doc.Spec().Paths.Paths[b.Path]; ok {
+		if err := add(pi.Parameters); err != nil {
+			return nil, err
+		}
+	}
+	if err := add(b.Operation.Parameters); err != nil {
+		return nil, err
+	}
+	params := make([]refParam, 0, len(order))
+	for _, key := range order {
+		params = append(params, res[key])
+	}
+	return params, nil
+}
+
+// makeRefParameter resolves a param dereferenced from the parameters section only once.
+// A body param is resolved for every operation: its schema adds to the imports of the operation.
+func (b *codeGenOpBuilder) makeRefParameter(receiver string, resolver *typeResolver, param refParam) (GenParameter, error) {
+	if param.Ref == "" || param.In == "body" || b.SharedParams == nil {
+		return b.MakeParameter(receiver, resolver, param.Parameter)
+	}
+	if cp, ok := b.SharedParams[param.Ref]; ok {
+		if cp.EnumType != "" {
+			cp.EnumType = enumTypeName(b.Name, param.Name)
+		}
+		return cp, nil
+	}
+	cp, err := b.MakeParameter(receiver, resolver, param.Parameter)
+	if err != nil {
+		return GenParameter{}, err
+	}
+	b.SharedParams[param.Ref] = cp
+	return cp, nil
+}
+
+// enumTypeName is the name of the type an enum query param binds into, one per operation
+func enumTypeName(opName, paramName string) string {
+	return swag.ToGoName(opName + " " + paramName)
 }
 
 func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
@@ -327,13 +424,18 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 		log.Printf("[%s %s] parsing operation (id: %q)", b.Method, b.Path, b.Operation.ID)
 	}
 	resolver := newTypeResolver(b.ModelsPackage, b.Doc.ResetDefinitions())
+	resolver.Opts = b.GenOpts
 	receiver := "o"
 
 	operation := b.Operation
 	var params, qp, pp, hp, fp GenParameters
 	var hasQueryParams, hasFormParams, hasFileParams, hasFormValueParams bool
-	for _, p := range b.Analyzed.ParamsFor(b.Method, b.Path) {
-		cp, err := b.MakeParameter(receiver, resolver, p)
+	opParams, err := b.paramsFor()
+	if err != nil {
+		return GenOperation{}, err
+	}
+	for _, p := range opParams {
+		cp, err := b.makeRefParameter(receiver, resolver, p)
 		if err != nil {
 			return GenOperation{}, err
 		}
@@ -342,10 +444,9 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 			qp = append(qp, cp)
 		}
 		if cp.IsFormParam() {
-			if p.Type == "file" {
+			if cp.IsFileParam() || cp.IsFileArrayParam() {
 				hasFileParams = true
-			}
-			if p.Type != "file" {
+			} else {
 				hasFormValueParams = true
 			}
 			hasFormParams = true
@@ -393,6 +494,27 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 		}
 	}
 
+	var defaultError *GenSchema
+	if defaultResponse == nil && b.GenOpts != nil && b.GenOpts.DefaultError != "" {
+		var err error
+		if defaultError, err = b.makeDefaultError(resolver); err != nil {
+			return GenOperation{}, err
+		}
+	}
+
+	defaultImports := b.DefaultImports
+	if imports := resolver.importList(); len(imports) > 0 {
+		defaultImports = append(append([]string(nil), b.DefaultImports...), imports...)
+	}
+
+	var webSocket *GenWebSocket
+	if ws, ok := operation.Extensions.GetBool("x-websocket"); ok && ws {
+		var err error
+		if webSocket, err = b.makeWebSocket(params, responses, successResponse); err != nil {
+			return GenOperation{}, err
+		}
+	}
+
 	prin := b.Principal
 	if prin == "" {
 		prin = "interface{}"
@@ -420,6 +542,23 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 	consumes := producesOrDefault(operation.Consumes, swsp.Consumes, b.DefaultConsumes)
 	sort.Strings(consumes)
 
+	var eventStream *GenEventStream
+	if producesEventStream(produces) {
+		var err error
+		if eventStream, err = b.makeEventStream(operation, successResponse); err != nil {
+			return GenOperation{}, err
+		}
+		successResponse.IsEventStream = true
+		responses[successResponse.Code] = *successResponse
+	}
+
+	if b.GenOpts != nil && b.GenOpts.SeekableStreams && successResponse != nil && successResponse.Code == http.StatusOK &&
+		successResponse.Schema != nil && successResponse.Schema.IsStream && !successResponse.IsEventStream {
+		// a seekable content answers the Range header with 206 Partial Content, which only applies to 200 OK
+		successResponse.IsSeekable = true
+		responses[successResponse.Code] = *successResponse
+	}
+
 	var hasStreamingResponse bool
 	if defaultResponse != nil && defaultResponse.Schema != nil && defaultResponse.Schema.IsStream {
 		hasStreamingResponse = true
@@ -445,7 +584,7 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 		Tags:                 operation.Tags[:],
 		Description:          operation.Description,
 		ReceiverName:         receiver,
-		DefaultImports:       b.DefaultImports,
+		DefaultImports:       defaultImports,
 		Params:               params,
 		Summary:              operation.Summary,
 		QueryParams:          qp,
@@ -461,6 +600,7 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 		Principal:            prin,
 		Responses:            responses,
 		DefaultResponse:      defaultResponse,
+		DefaultError:         defaultError,
 		SuccessResponse:      successResponse,
 		ExtraSchemas:         extra,
 		Schemes:              schemeOrDefault(schemes, b.DefaultScheme),
@@ -468,9 +608,96 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 		ConsumesMediaTypes:   consumes,
 		ExtraSchemes:         extraSchemes,
 		WithContext:          b.WithContext,
+		WithLogging:          b.WithLogging,
+		WebSocket:            webSocket,
+		EventStream:          eventStream,
+		Deprecated:           operation.Deprecated,
 	}, nil
 }
 
+// makeDefaultError resolves the definition the client decodes undeclared responses with
+func (b *codeGenOpBuilder) makeDefaultError(resolver *typeResolver) (*GenSchema, error) {
+	name := b.GenOpts.DefaultError
+	if _, ok := resolver.Doc.Spec().Definitions[name]; !ok {
+		return nil, fmt.Errorf("default error %q is not a definition of this spec", name)
+	}
+	tpe, err := resolver.ResolveSchema(spec.RefProperty("#/definitions/"+name), false, true)
+	if err != nil {
+		return nil, err
+	}
+	return &GenSchema{ResolvedType: tpe}, nil
+}
+
+// makeWebSocket picks the messages of an operation served over a websocket:
+// the client sends the schema of the body parameter, the server the schema of
+// the 101 response (or of the success response when there is none).
+func (b *codeGenOpBuilder) makeWebSocket(params GenParameters, responses map[int]GenResponse, success *GenResponse) (*GenWebSocket, error) {
+	if b.Method != "GET" {
+		return nil, fmt.Errorf("operation %q: x-websocket requires a GET operation, got %s", b.Name, b.Method)
+	}
+	ws := new(GenWebSocket)
+	for _, p := range params {
+		if p.IsBodyParam() {
+			ws.Receive = p.Schema
+		}
+	}
+	if r, ok := responses[http.StatusSwitchingProtocols]; ok && r.Schema != nil {
+		ws.Send = r.Schema
+	} else if success != nil {
+		ws.Send = success.Schema
+	}
+	if ws.Receive == nil && ws.Send == nil {
+		return nil, fmt.Errorf("operation %q: x-websocket requires a body parameter or a 101 response with a schema for its messages", b.Name)
+	}
+	for _, msg := range []*GenSchema{ws.Receive, ws.Send} {
+		if msg != nil && (msg.IsStream || msg.IsBaseType) {
+			return nil, fmt.Errorf("operation %q: x-websocket messages must be plain JSON values", b.Name)
+		}
+	}
+	return ws, nil
+}
+
+// stripWebSocketBody removes the body parameter of a websocket operation from the parameters
+// bound on the upgrade request: it is the message received over the connection
+func stripWebSocketBody(op *GenOperation) {
+	var params GenParameters
+	for _, p := range op.Params {
+		if !p.IsBodyParam() {
+			params = append(params, p)
+		}
+	}
+	op.Params = params
+}
+
+// makeEventStream picks the events of an operation producing text/event-stream:
+// each event carries the schema of the success response, x-sse-event names them.
+func (b *codeGenOpBuilder) makeEventStream(operation spec.Operation, success *GenResponse) (*GenEventStream, error) {
+	if success == nil || success.Schema == nil {
+		return nil, fmt.Errorf("operation %q: text/event-stream requires a success response with a schema for its events", b.Name)
+	}
+	if success.Schema.IsStream || success.Schema.IsBaseType {
+		return nil, fmt.Errorf("operation %q: server-sent events must be plain JSON values", b.Name)
+	}
+	es := &GenEventStream{Code: success.Code, Event: success.Schema}
+	if name, ok := operation.Extensions.GetString("x-sse-event"); ok {
+		if strings.ContainsAny(name, "\r\n") {
+			return nil, fmt.Errorf("operation %q: x-sse-event must be a single line, got %q", b.Name, name)
+		}
+		es.Name = name
+	}
+	return es, nil
+}
+
+// producesEventStream tells if text/event-stream is among the media types an operation produces
+func producesEventStream(produces []string) bool {
+	for _, mt := range produces {
+		if nm, _ := mediaTypeName(mt); nm == "eventStream" {
+			return true
+		}
+	}
+	return false
+}
+
 func producesOrDefault(produces []string, fallback []string, defaultProduces string) []string {
 	if len(produces) > 0 {
 		return produces
@@ -592,6 +819,7 @@ func (b *codeGenOpBuilder) MakeHeader(receiver, name string, hdr spec.Header) Ge
 	hasValidations := hasNumberValidation || hasStringValidation || hasSliceValidations || len(hdr.Enum) > 0
 
 	tpe := typeForHeader(hdr) //simpleResolvedType(hdr.Type, hdr.Format, hdr.Items)
+	tpe.GoType = b.GenOpts.anyType(qualifyTimeOfDay(tpe.GoType, b.ModelsPackage))
 
 	return GenHeader{
 		sharedValidations: sharedValidations{
@@ -611,7 +839,7 @@ func (b *codeGenOpBuilder) MakeHeader(receiver, name string, hdr spec.Header) Ge
 			HasValidations:      hasValidations,
 			HasSliceValidations: hasSliceValidations,
 		},
-		resolvedType: tpe,
+		ResolvedType: tpe,
 		Package:      b.APIPackage,
 		ReceiverName: receiver,
 		Name:         name,
@@ -626,7 +854,8 @@ func (b *codeGenOpBuilder) MakeHeader(receiver, name string, hdr spec.Header) Ge
 
 func (b *codeGenOpBuilder) MakeParameterItem(receiver, paramName, indexVar, path, valueExpression, location string, resolver *typeResolver, items, parent *spec.Items) (GenItems, error) {
 	var res GenItems
-	res.resolvedType = simpleResolvedType(items.Type, items.Format, items.Items)
+	res.ResolvedType = itemResolvedType(items)
+	res.GoType = resolver.Opts.anyType(qualifyTimeOfDay(res.GoType, resolver.ModelsPackage))
 	res.sharedValidations = sharedValidations{
 		Maximum:          items.Maximum,
 		ExclusiveMaximum: items.ExclusiveMaximum,
@@ -676,6 +905,15 @@ func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver
 		}
 		param = *param2
 	}
+	if param.In != "body" {
+		if err := checkEnum(b.Name+"."+param.Name, param.Type, param.Format, param.Enum, false); err != nil {
+			return GenParameter{}, err
+		}
+		warnBounds(b.Name+"."+param.Name, param.Type, param.Format, param.Minimum, param.Maximum)
+		for it := param.Items; it != nil; it = it.Items {
+			warnBounds(b.Name+"."+param.Name+" items", it.Type, it.Format, it.Minimum, it.Maximum)
+		}
+	}
 
 	var child *GenItems
 	res := GenParameter{
@@ -694,6 +932,7 @@ func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver
 		Child:            child,
 		Location:         param.In,
 		AllowEmptyValue:  (param.In == "query" || param.In == "formData") && param.AllowEmptyValue,
+		IsSensitive:      isSensitive(param.Format, param.Extensions),
 	}
 
 	if param.In == "body" {
@@ -716,6 +955,11 @@ func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver
 		}
 
 		schema := sc.GenSchema
+		if schema.IsStream {
+			// a binary body is read from the request on the server and from the caller's reader
+			// on the client, whatever the binary responses resolve to
+			schema.GoType = "io.ReadCloser"
+		}
 		if schema.IsAnonymous {
 			schema.Name = swag.ToGoName(b.Operation.ID + " Body")
 			nm := schema.Name
@@ -733,7 +977,7 @@ func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver
 			schema.GoType = nm
 			schema.SwaggerType = nm
 			if len(prevSchema.Properties) == 0 {
-				schema.GoType = "interface{}"
+				schema.GoType = b.GenOpts.iface()
 			}
 			schema.IsComplexObject = true
 			schema.IsInterface = len(schema.Properties) == 0
@@ -745,7 +989,7 @@ func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver
 		var prev *GenItems
 		next := items
 		for it != nil {
-			next.resolvedType = it.resolvedType
+			next.ResolvedType = it.ResolvedType
 			next.sharedValidations = it.sharedValidations
 			next.Formatter = stringFormatters[it.SwaggerFormat]
 			_, next.IsCustomFormatter = customFormatters[it.SwaggerFormat]
@@ -757,12 +1001,13 @@ func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver
 			next = new(GenItems)
 		}
 		res.Child = items
-		res.resolvedType = schema.resolvedType
+		res.ResolvedType = schema.ResolvedType
 		res.sharedValidations = schema.sharedValidations
 		res.ZeroValue = schema.Zero()
 
 	} else {
-		res.resolvedType = simpleResolvedType(param.Type, param.Format, param.Items)
+		res.ResolvedType = simpleResolvedType(param.Type, param.Format, param.Items)
+		res.GoType = resolver.Opts.anyType(qualifyTimeOfDay(res.GoType, resolver.ModelsPackage))
 		res.sharedValidations = sharedValidations{
 			Required:         param.Required,
 			Maximum:          param.Maximum,
@@ -787,13 +1032,20 @@ func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver
 			res.Child = &pi
 		}
 		res.IsNullable = !param.Required && !param.AllowEmptyValue
-
+		if (param.In == "query" || param.In == "path") && len(param.Enum) > 0 && res.IsPrimitive && !res.IsCustomFormatter && res.GoType != "bool" {
+			res.EnumType = enumTypeName(b.Name, param.Name)
+			res.EnumStatus = resolver.enumStatus()
+			if res.EnumStatus < 400 || res.EnumStatus > 499 {
+				return GenParameter{}, fmt.Errorf("%s.%s: the status %d answering a value out of the enum is not a client error", b.Name, param.Name, res.EnumStatus)
+			}
+		}
 	}
 
 	hasNumberValidation := param.Maximum != nil || param.Minimum != nil || param.MultipleOf != nil
 	hasStringValidation := param.MaxLength != nil || param.MinLength != nil || param.Pattern != ""
 	hasSliceValidations := param.MaxItems != nil || param.MinItems != nil || param.UniqueItems
-	hasValidations := hasNumberValidation || hasStringValidation || hasSliceValidations || len(param.Enum) > 0
+	// a typed enum is checked while binding
+	hasValidations := hasNumberValidation || hasStringValidation || hasSliceValidations || (len(param.Enum) > 0 && res.EnumType == "")
 
 	res.Converter = stringConverters[res.GoType]
 	res.Formatter = stringFormatters[res.GoType]
@@ -22,7 +22,9 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"text/template"
 
 	"github.com/go-openapi/analysis"
 	"github.com/go-openapi/loads"
@@ -201,6 +203,1283 @@ func TestMakeOperation(t *testing.T) {
 	}
 }
 
+func TestMakeOperation_WebSocket(t *testing.T) {
+	b, err := opBuilder("watchTask", "../fixtures/codegen/todolist.websocket.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	op, err := b.MakeOperation()
+	if assert.NoError(t, err) && assert.NotNil(t, op.WebSocket) {
+		assert.Equal(t, "WatchTaskBody", op.WebSocket.Receive.GoType)
+		assert.Equal(t, "WatchTaskSwitchingProtocolsBodyBody", op.WebSocket.Send.GoType)
+	}
+
+	// websockets are opened with a GET request
+	b.Method = "POST"
+	_, err = b.makeWebSocket(op.Params, op.Responses, op.SuccessResponse)
+	assert.Error(t, err)
+
+	b, err = opBuilder("listTasks", "../fixtures/codegen/todolist.websocket.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			assert.Nil(t, op.WebSocket)
+		}
+	}
+}
+
+func TestMakeOperation_EventStream(t *testing.T) {
+	b, err := opBuilder("watchTasks", "../fixtures/codegen/todolist.sse.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) || !assert.NotNil(t, op.EventStream) {
+		return
+	}
+	assert.Equal(t, "task", op.EventStream.Name)
+	assert.Equal(t, 200, op.EventStream.Code)
+	assert.Equal(t, "models.TaskEvent", op.EventStream.Event.GoType)
+	assert.True(t, op.Responses[200].IsEventStream)
+	assert.False(t, op.DefaultResponse.IsEventStream)
+
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, responsesTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("watch_tasks_responses.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			assertInCode(t, "func (w *WatchTasksEventWriter) Send(event *models.TaskEvent) error {", res)
+			assertInCode(t, "frame.WriteString(\"event: task\\n\")", res)
+			assertInCode(t, "type WatchTasksEventStream func(events *WatchTasksEventWriter) error", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+
+	buf.Reset()
+	if assert.NoError(t, clientResponseTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("watch_tasks_responses.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			assertInCode(t, "events  chan<- *models.TaskEvent", res)
+			assertInCode(t, "func (r *WatchTasksEventReader) Next() (event *models.TaskEvent, err error) {", res)
+			assertInCode(t, "if len(data) > 0 && name == \"task\" {", res)
+			// the events are read from the body instead of a payload
+			assertNotInCode(t, "Payload *models.TaskEvent", res)
+			assertInCode(t, "Payload *models.Error", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+
+	// the events of a primitive type are unnamed values
+	b, err = opBuilder("watchTicks", "../fixtures/codegen/todolist.sse.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) && assert.NotNil(t, op.EventStream) {
+			assert.Empty(t, op.EventStream.Name)
+			assert.Equal(t, "int32", op.EventStream.Event.GoType)
+		}
+	}
+
+	b, err = opBuilder("listTasks", "../fixtures/codegen/todolist.sse.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			assert.Nil(t, op.EventStream)
+		}
+	}
+
+	// events need a schema
+	b, err = opBuilder("watchTasks", "../fixtures/codegen/todolist.sse.yml")
+	if assert.NoError(t, err) {
+		_, err = b.makeEventStream(b.Operation, &GenResponse{Code: 200})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "requires a success response with a schema")
+		}
+	}
+}
+
+func TestMakeOperation_SeekableStream(t *testing.T) {
+	b, err := opBuilder("downloadAttachment", "../fixtures/codegen/todolist.seekable.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	b.GenOpts = &GenOpts{SeekableStreams: true}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, op.SuccessResponse.IsSeekable)
+	assert.True(t, op.Responses[200].IsSeekable)
+	assert.False(t, op.Responses[404].IsSeekable)
+
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, responsesTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("download_attachment_responses.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			assertInCode(t, "Content io.ReadSeeker `json:\"-\"`", res)
+			assertInCode(t, "func (o *DownloadAttachmentOK) WithContent(content io.ReadSeeker, modTime time.Time) *DownloadAttachmentOK {", res)
+			assertInCode(t, "http.ServeContent(rw, o.request, \"\", o.ModTime, o.Content)", res)
+			assertNotInCode(t, "func (o *DownloadAttachmentNotFound) setRequest", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+
+	buf.Reset()
+	if assert.NoError(t, operationTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("download_attachment.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			assertInCode(t, "if seekable, ok := res.(interface{ setRequest(*http.Request) }); ok {", string(ff))
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+
+	// only the binary responses are seekable, and only with the option
+	for _, opts := range []*GenOpts{{SeekableStreams: true}, nil} {
+		for _, name := range []string{"listTasks", "downloadAttachment"} {
+			if opts != nil && name == "downloadAttachment" {
+				continue
+			}
+			b, err := opBuilder(name, "../fixtures/codegen/todolist.seekable.yml")
+			if !assert.NoError(t, err) {
+				return
+			}
+			b.GenOpts = opts
+			op, err := b.MakeOperation()
+			if assert.NoError(t, err) {
+				assert.False(t, op.SuccessResponse.IsSeekable, name)
+				buf.Reset()
+				if assert.NoError(t, responsesTemplate.Execute(buf, op)) {
+					assertNotInCode(t, "setRequest", buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestMakeOperation_SeekableStreamRoundTrip(t *testing.T) {
+	b, err := opBuilder("downloadAttachment", "../fixtures/codegen/todolist.seekable.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	b.APIPackage = "main"
+	b.GenOpts = &GenOpts{SeekableStreams: true}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	w := newGoWorkspace(t)
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, responsesTemplate.Execute(buf, op)) ||
+		!assert.NoError(t, w.WriteFile("main", "download_attachment_responses", buf.Bytes())) ||
+		!assert.NoError(t, w.WriteFile("main", "main", []byte(seekableStreamRoundTrip))) {
+		return
+	}
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			"206 bytes 2-5/10 4 bytes 2345",
+			"200  10 bytes 0123456789",
+			"206 bytes 7-9/10 3 bytes 789",
+			"416 bytes */10",
+			"200 0123456789",
+			"200 payload",
+		}, lines)
+	}
+}
+
+const seekableStreamRoundTrip = `package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+)
+
+func serve(res *DownloadAttachmentOK, header ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/attachments/1", nil)
+	for i := 0; i < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	res.setRequest(req)
+	rec := httptest.NewRecorder()
+	res.WriteResponse(rec, runtime.ByteStreamProducer())
+	return rec
+}
+
+func main() {
+	content := func() *strings.Reader { return strings.NewReader("0123456789") }
+	modTime := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	rec := serve(NewDownloadAttachmentOK().WithContent(content(), time.Time{}), "Range", "bytes=2-5")
+	fmt.Println(rec.Code, rec.Header().Get("Content-Range"), rec.Header().Get("Content-Length"), rec.Header().Get("Accept-Ranges"), rec.Body.String())
+
+	// the content changed since the range was asked for: it is served whole
+	rec = serve(NewDownloadAttachmentOK().WithContent(content(), modTime),
+		"Range", "bytes=2-5", "If-Range", modTime.Add(-time.Hour).Format(http.TimeFormat))
+	fmt.Println(rec.Code, rec.Header().Get("Content-Range"), rec.Header().Get("Content-Length"), rec.Header().Get("Accept-Ranges"), rec.Body.String())
+
+	rec = serve(NewDownloadAttachmentOK().WithContent(content(), modTime),
+		"Range", "bytes=-3", "If-Range", modTime.Format(http.TimeFormat))
+	fmt.Println(rec.Code, rec.Header().Get("Content-Range"), rec.Header().Get("Content-Length"), rec.Header().Get("Accept-Ranges"), rec.Body.String())
+
+	rec = serve(NewDownloadAttachmentOK().WithContent(content(), time.Time{}), "Range", "bytes=20-30")
+	fmt.Println(rec.Code, rec.Header().Get("Content-Range"))
+
+	// without a request the content is served whole
+	rec = httptest.NewRecorder()
+	NewDownloadAttachmentOK().WithContent(content(), time.Time{}).WriteResponse(rec, runtime.ByteStreamProducer())
+	fmt.Println(rec.Code, rec.Body.String())
+
+	// without a content the payload is produced as before
+	rec = serve(NewDownloadAttachmentOK().WithPayload(ioutil.NopCloser(strings.NewReader("payload"))), "Range", "bytes=2-5")
+	fmt.Println(rec.Code, rec.Body.String())
+}
+`
+
+const eventStreamRoundTrip = `package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"%[1]s/models"
+	"%[1]s/server"
+)
+
+type response struct {
+	res *http.Response
+}
+
+func (r response) Code() int                  { return r.res.StatusCode }
+func (r response) Message() string            { return r.res.Status }
+func (r response) GetHeader(name string) string { return r.res.Header.Get(name) }
+func (r response) Body() io.ReadCloser        { return r.res.Body }
+
+func main() {
+	var fail bool
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		stream := server.WatchTasksEventStream(func(events *server.WatchTasksEventWriter) error {
+			if fail {
+				return errors.New("the database is down")
+			}
+			for _, kind := range []string{"created", "deleted"} {
+				task := &models.Task{ID: 7, Title: swag.String("dishes")}
+				if err := events.Send(&models.TaskEvent{Kind: swag.String(kind), Task: task}); err != nil {
+					return err
+				}
+			}
+			// a comment and another kind of event, both skipped by the reader
+			_, err := io.WriteString(rw, ": keep alive\nevent: ping\ndata: {}\n\n")
+			return err
+		})
+		stream.WriteResponse(rw, nil)
+	}))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(resp.StatusCode, resp.Header.Get("Content-Type"))
+	reader := NewWatchTasksEventReader(resp.Body)
+	for {
+		event, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(*event.Kind, event.Task.ID, *event.Task.Title)
+	}
+	resp.Body.Close()
+
+	resp, err = http.Get(srv.URL)
+	if err != nil {
+		panic(err)
+	}
+	events := make(chan *models.TaskEvent, 10)
+	result, err := (&WatchTasksReader{formats: strfmt.Default, events: events}).ReadResponse(response{resp}, runtime.ByteStreamConsumer())
+	resp.Body.Close()
+	fmt.Printf("%%T %%v\n", result, err)
+	var forwarded int
+	for range events {
+		forwarded++
+	}
+	fmt.Println("forwarded:", forwarded)
+
+	fail = true
+	resp, err = http.Get(srv.URL)
+	if err != nil {
+		panic(err)
+	}
+	resp.Body.Close()
+	fmt.Println(resp.StatusCode)
+}
+`
+
+func TestMakeOperation_EventStreamRoundTrip(t *testing.T) {
+	w := newGoWorkspace(t)
+	if !writeModels(t, w, "models", "../fixtures/codegen/todolist.sse.yml", nil, []string{"Error", "Task", "TaskEvent"}) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	for _, v := range []struct {
+		Package  string
+		Template *template.Template
+	}{
+		{"server", responsesTemplate},
+		{"main", clientResponseTemplate},
+	} {
+		b, err := opBuilder("watchTasks", "../fixtures/codegen/todolist.sse.yml")
+		if !assert.NoError(t, err) {
+			return
+		}
+		b.APIPackage = v.Package
+		b.DefaultImports = []string{w.Import("models")}
+		op, err := b.MakeOperation()
+		if !assert.NoError(t, err) {
+			return
+		}
+		buf.Reset()
+		if !assert.NoError(t, v.Template.Execute(buf, op)) || !assert.NoError(t, w.WriteFile(v.Package, "watch_tasks_responses", buf.Bytes())) {
+			return
+		}
+	}
+	if !assert.NoError(t, w.WriteFile("main", "main", []byte(fmt.Sprintf(eventStreamRoundTrip, w.Import(""))))) {
+		return
+	}
+
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			"200 text/event-stream",
+			"created 7 dishes",
+			"deleted 7 dishes",
+			"*main.WatchTasksOK <nil>",
+			"forwarded: 2",
+			"500",
+		}, lines)
+	}
+}
+
+// generateClient generates the client of a fixture with its models in the workspace
+func generateClient(t *testing.T, w *goWorkspace, fixture string) bool {
+	log.SetOutput(ioutil.Discard)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+	return assert.NoError(t, GenerateClient("todo", nil, nil, GenOpts{
+		Spec:              filepath.FromSlash(fixture),
+		IncludeModel:      true,
+		IncludeValidator:  true,
+		IncludeHandler:    true,
+		IncludeParameters: true,
+		IncludeResponses:  true,
+		IncludeSupport:    true,
+		APIPackage:        "operations",
+		ModelPackage:      "models",
+		ServerPackage:     "restapi",
+		ClientPackage:     "client",
+		Target:            w.Dir(""),
+	}))
+}
+
+func TestGenerateClient_HTTPClient(t *testing.T) {
+	w := newGoWorkspace(t)
+	if !generateClient(t, w, "../fixtures/codegen/todolist.custom-client.yml") {
+		return
+	}
+	facade, err := ioutil.ReadFile(filepath.Join(w.Dir("client"), "todo_client.go"))
+	if assert.NoError(t, err) {
+		res := string(facade)
+		assertInCode(t, "func NewHTTPClientWithClient(formats strfmt.Registry, client *http.Client) *Todo {", res)
+		assertInCode(t, "transport.Transport = clientTransport{client: client}", res)
+		assertInCode(t, "return NewHTTPClientWithClient(formats, nil)", res)
+	}
+	if !assert.NoError(t, w.WriteFile("main", "main", []byte(fmt.Sprintf(httpClientRoundTrip, w.Import(""))))) {
+		return
+	}
+
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			"task: 7 dishes",
+			"recorded: GET http://tasks.example.com/api/tasks/7",
+			"operation timeout: true",
+			"client timeout: true",
+			"recorded: 3",
+		}, lines)
+	}
+}
+
+const httpClientRoundTrip = `package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"%[1]s/client"
+	"%[1]s/client/tasks"
+)
+
+// recorder records the requests before sending them with the next round tripper
+type recorder struct {
+	next     http.RoundTripper
+	requests []string
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.requests = append(r.requests, req.Method+" "+req.URL.String())
+	return r.next.RoundTrip(req)
+}
+
+func main() {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/tasks/7":
+			fmt.Fprint(rw, ` + "`" + `{"id": 7, "title": "dishes"}` + "`" + `)
+		case "/api/tasks/7/report":
+			time.Sleep(200 * time.Millisecond)
+			fmt.Fprint(rw, ` + "`" + `"done"` + "`" + `)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	proxy, err := url.Parse(server.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	// the requests go through the proxy of the http client to the test server
+	rec := &recorder{next: &http.Transport{Proxy: http.ProxyURL(proxy)}}
+	httpClient := &http.Client{Transport: rec, Timeout: 5 * time.Second}
+	todo := client.NewHTTPClientWithClient(nil, httpClient)
+
+	ok, err := todo.Tasks.GetTask(tasks.NewGetTaskParams().WithID(7))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("task:", ok.Payload.ID, ok.Payload.Title)
+	fmt.Println("recorded:", rec.requests[0])
+
+	// the timeout of the operation applies over the longer timeout of the http client
+	_, err = todo.Tasks.GetReport(tasks.NewGetReportParamsWithTimeout(50 * time.Millisecond).WithID(7))
+	fmt.Println("operation timeout:", err != nil)
+
+	// and the shorter timeout of the http client over the default timeout of the operation
+	httpClient.Timeout = 50 * time.Millisecond
+	_, err = todo.Tasks.GetReport(tasks.NewGetReportParams().WithID(7))
+	fmt.Println("client timeout:", err != nil)
+
+	fmt.Println("recorded:", len(rec.requests))
+}
+`
+
+func TestGenerateClient_ServerVariables(t *testing.T) {
+	w := newGoWorkspace(t)
+	if !generateClient(t, w, "../fixtures/codegen/todolist.server-variables.yml") ||
+		!assert.NoError(t, w.WriteFile("main", "main", []byte(fmt.Sprintf(serverVariablesRoundTrip, w.Import(""))))) {
+		return
+	}
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			"default: https://eu.todo.example.com:443/v1/api",
+			"client: us.todo.example.com:8080 /v2/api",
+			`invalid: invalid value "asia" for the server variable region, expected one of: eu, us`,
+		}, lines)
+	}
+}
+
+const serverVariablesRoundTrip = `package main
+
+import (
+	"fmt"
+
+	httptransport "github.com/go-openapi/runtime/client"
+
+	"%[1]s/client"
+)
+
+func main() {
+	u, err := client.ServerURL(client.ServerVariables{})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("default:", u)
+
+	todo, err := client.NewHTTPClientWithServerVariables(nil, client.ServerVariables{Scheme: "http", Region: "us", Port: "8080", Version: "v2"})
+	if err != nil {
+		panic(err)
+	}
+	rt := todo.Transport.(*httptransport.Runtime)
+	fmt.Println("client:", rt.Host, rt.BasePath)
+
+	_, err = client.NewHTTPClientWithServerVariables(nil, client.ServerVariables{Region: "asia"})
+	fmt.Println("invalid:", err)
+}
+`
+
+func TestGenerateClient_Signer(t *testing.T) {
+	w := newGoWorkspace(t)
+	if !generateClient(t, w, "../fixtures/codegen/todolist.signed-client.yml") {
+		return
+	}
+	facade, err := ioutil.ReadFile(filepath.Join(w.Dir("client"), "todo_client.go"))
+	if assert.NoError(t, err) {
+		res := string(facade)
+		assertInCode(t, "func NewHTTPClientWithSigner(formats strfmt.Registry, client *http.Client, signer RequestSigner) *Todo {", res)
+		assertInCode(t, "func Signer(key []byte) RequestSigner {", res)
+		assertInCode(t, "mac := hmac.New(sha256.New, key)", res)
+		assertInCode(t, `req.Header.Set("X-Hub-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))`, res)
+	}
+	if !assert.NoError(t, w.WriteFile("main", "main", []byte(fmt.Sprintf(signerRoundTrip, w.Import(""))))) {
+		return
+	}
+
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			"added: 7 dishes",
+			"digest: 7 dishes",
+			"listed: 1",
+			"wrong key: true",
+		}, lines)
+	}
+}
+
+func TestGenerateClient_InvalidSignature(t *testing.T) {
+	for _, ext := range []map[string]interface{}{
+		{"scheme": "rsa-sha256"},
+		{"scheme": "hmac-sha256", "encoding": "base32"},
+	} {
+		sw := &spec.Swagger{}
+		sw.AddExtension(xSignature, ext)
+		_, err := makeGenSignature(sw)
+		assert.Error(t, err)
+	}
+
+	sw := &spec.Swagger{}
+	sw.AddExtension(xSignature, map[string]interface{}{"scheme": "HMAC-SHA512", "encoding": "base64"})
+	signature, err := makeGenSignature(sw)
+	if assert.NoError(t, err) {
+		assert.Equal(t, &GenSignature{Scheme: "hmac-sha512", Hash: "sha512", Header: "X-Signature", Encoding: "base64"}, signature)
+	}
+}
+
+const signerRoundTrip = `package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"%[1]s/client"
+	"%[1]s/client/tasks"
+	"%[1]s/models"
+)
+
+var key = []byte("secret")
+
+func main() {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		mac := hmac.New(sha256.New, key)
+		mac.Write(body)
+		if r.Header.Get("X-Hub-Signature") != "sha256="+hex.EncodeToString(mac.Sum(nil)) {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			rw.WriteHeader(http.StatusCreated)
+			rw.Write(body)
+		default:
+			fmt.Fprint(rw, ` + "`" + `[{"id": 7, "title": "dishes"}]` + "`" + `)
+		}
+	}))
+	defer server.Close()
+	proxy, err := url.Parse(server.URL)
+	if err != nil {
+		panic(err)
+	}
+	httpClient := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxy)}}
+
+	// the signer of the spec adds the signature of the body
+	todo := client.NewHTTPClientWithSigner(nil, httpClient, client.Signer(key))
+	added, err := todo.Tasks.AddTask(tasks.NewAddTaskParams().WithBody(&models.Task{ID: 7, Title: "dishes"}))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("added:", added.Payload.ID, added.Payload.Title)
+
+	// a custom signer sees the serialized body and sets the headers of the request
+	digest := client.NewHTTPClientWithSigner(nil, httpClient, func(req *http.Request, body []byte) error {
+		mac := hmac.New(sha256.New, key)
+		mac.Write(body)
+		req.Header.Set("X-Hub-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+		return nil
+	})
+	added, err = digest.Tasks.AddTask(tasks.NewAddTaskParams().WithBody(&models.Task{ID: 7, Title: "dishes"}))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("digest:", added.Payload.ID, added.Payload.Title)
+
+	listed, err := todo.Tasks.ListTasks(tasks.NewListTasksParams())
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("listed:", len(listed.Payload))
+
+	wrong := client.NewHTTPClientWithSigner(nil, httpClient, client.Signer([]byte("wrong")))
+	_, err = wrong.Tasks.ListTasks(tasks.NewListTasksParams())
+	fmt.Println("wrong key:", err != nil)
+}
+`
+
+func TestGenerateClient_Config(t *testing.T) {
+	w := newGoWorkspace(t)
+	if !generateClient(t, w, "../fixtures/codegen/todolist.client-config.yml") {
+		return
+	}
+	facade, err := ioutil.ReadFile(filepath.Join(w.Dir("client"), "todo_client.go"))
+	if assert.NoError(t, err) {
+		res := string(facade)
+		assertInCode(t, "type ClientConfig struct {", res)
+		assertInCode(t, "Timeout time.Duration", res)
+		assertInCode(t, "Verbose bool", res)
+		assertInCode(t, "Timeout:  150 * time.Second,", res)
+		assertInCode(t, `Host:     "tasks.example.com",`, res)
+		assertInCode(t, "func NewHTTPClientWithConfig(formats strfmt.Registry, config ClientConfig) *Todo {", res)
+		assertNotInCode(t, "Verbose: ", res)
+	}
+	if !assert.NoError(t, w.WriteFile("main", "main", []byte(fmt.Sprintf(clientConfigRoundTrip, w.Import(""))))) {
+		return
+	}
+
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			"defaults: tasks.example.com /api https 2m30s 2 eu false",
+			"transport: 127.0.0.1 /v2",
+			"retried: 7 dishes after 3 attempts",
+			"no retries: true after 1 attempts",
+			"timeout: true",
+		}, lines)
+	}
+}
+
+func TestGenerateClient_InvalidClientConfig(t *testing.T) {
+	for _, ext := range []map[string]interface{}{
+		{"region": map[string]interface{}{"type": "object"}},
+		{"timeout": map[string]interface{}{"type": "integer"}},
+		{"timeout": map[string]interface{}{"type": "duration", "default": "soon"}},
+		{"retries": map[string]interface{}{"type": "integer", "default": 1.5}},
+		{"verbose": map[string]interface{}{"type": "boolean", "default": "yes"}},
+	} {
+		sw := &spec.Swagger{}
+		sw.AddExtension(xClientConfig, ext)
+		_, err := makeGenClientConfig(sw, "localhost", "/", "http")
+		assert.Error(t, err)
+	}
+
+	sw := &spec.Swagger{}
+	sw.AddExtension(xClientConfig, map[string]interface{}{
+		"timeout": map[string]interface{}{"type": "duration"},
+		"ratio":   map[string]interface{}{"type": "number", "default": 0.5},
+		"scheme":  map[string]interface{}{"type": "string"},
+	})
+	config, err := makeGenClientConfig(sw, "localhost", "/", "http")
+	if assert.NoError(t, err) {
+		assert.Equal(t, &GenClientConfig{
+			Fields: []GenClientConfigField{
+				{Name: "ratio", GoName: "Ratio", GoType: "float64", Default: "0.5"},
+				{Name: "scheme", GoName: "Scheme", GoType: "string", Default: `"http"`},
+				{Name: "timeout", GoName: "Timeout", GoType: "time.Duration", Default: "30 * time.Second"},
+			},
+			HasScheme:   true,
+			HasTimeout:  true,
+			HasDuration: true,
+		}, config)
+	}
+}
+
+const clientConfigRoundTrip = `package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+
+	"%[1]s/client"
+	"%[1]s/client/tasks"
+	"%[1]s/models"
+)
+
+func main() {
+	defaults := client.DefaultClientConfig()
+	fmt.Println("defaults:", defaults.Host, defaults.BasePath, defaults.Scheme, defaults.Timeout, defaults.Retries, defaults.Region, defaults.Verbose)
+
+	// the server fails twice before adding the task, and is slow to list them
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/tasks" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == "GET" {
+			time.Sleep(500 * time.Millisecond)
+			rw.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(rw, "[]")
+			return
+		}
+		attempts++
+		if attempts < 3 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write(body)
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	config := client.DefaultClientConfig()
+	config.Host, config.BasePath, config.Scheme = u.Host, "/v2", "http"
+	todo := client.NewHTTPClientWithConfig(nil, config)
+	rt := todo.Transport.(*httptransport.Runtime)
+	fmt.Println("transport:", u.Hostname(), rt.BasePath)
+
+	added, err := todo.Tasks.AddTask(tasks.NewAddTaskParams().WithBody(&models.Task{ID: 7, Title: "dishes"}))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("retried:", added.Payload.ID, added.Payload.Title, "after", attempts, "attempts")
+
+	attempts = 0
+	config.Retries = 0
+	_, err = client.NewHTTPClientWithConfig(nil, config).Tasks.AddTask(tasks.NewAddTaskParams().WithBody(&models.Task{ID: 7, Title: "dishes"}))
+	fmt.Println("no retries:", err != nil, "after", attempts, "attempts")
+
+	config.Timeout = 50 * time.Millisecond
+	_, err = client.NewHTTPClientWithConfig(nil, config).Tasks.ListTasks(tasks.NewListTasksParams())
+	fmt.Println("timeout:", err != nil)
+}
+`
+
+func TestGenerateClient_WithHeader(t *testing.T) {
+	w := newGoWorkspace(t)
+	if !generateClient(t, w, "../fixtures/codegen/todolist.custom-client.yml") {
+		return
+	}
+	client, err := ioutil.ReadFile(filepath.Join(w.Dir("client/tasks"), "tasks_client.go"))
+	if assert.NoError(t, err) {
+		res := string(client)
+		assertInCode(t, "func (a *Client) ListTasks(params *ListTasksParams, opts ...ClientOption) (*ListTasksOK, error) {", res)
+		assertInCode(t, "func WithHeader(key string, values ...string) ClientOption {", res)
+		assertInCode(t, "func WithHeaderOverride(key string, values ...string) ClientOption {", res)
+	}
+	if !assert.NoError(t, w.WriteFile("main", "main", []byte(fmt.Sprintf(clientHeaderRoundTrip, w.Import(""))))) {
+		return
+	}
+
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			"custom: acme/",
+			"unset param: acme/trace-1",
+			"set param: acme/param-1",
+			"override: acme/trace-1",
+			"none: /",
+		}, lines)
+	}
+}
+
+const clientHeaderRoundTrip = `package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"%[1]s/client/tasks"
+)
+
+func main() {
+	// the title of the task echoes the headers of the request
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(rw, ` + "`" + `[{"id": 1, "title": "%%s/%%s"}]` + "`" + `, r.Header.Get("X-Tenant"), r.Header.Get("X-Request-Id"))
+	}))
+	defer server.Close()
+
+	transport := client.New(server.Listener.Addr().String(), "/api", []string{"http"})
+	todo := tasks.New(transport, strfmt.Default)
+	list := func(label string, params *tasks.ListTasksParams, opts ...tasks.ClientOption) {
+		ok, err := todo.ListTasks(params, opts...)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(label+":", ok.Payload[0].Title)
+	}
+
+	list("custom", nil, tasks.WithHeader("X-Tenant", "acme"))
+	list("unset param", nil, tasks.WithHeader("X-Tenant", "acme"), tasks.WithHeader("X-Request-Id", "trace-1"))
+	// the header parameter of the operation wins, unless it is overridden
+	list("set param", tasks.NewListTasksParams().WithXRequestID(swag.String("param-1")),
+		tasks.WithHeader("X-Tenant", "acme"), tasks.WithHeader("X-Request-Id", "trace-1"))
+	list("override", tasks.NewListTasksParams().WithXRequestID(swag.String("param-1")),
+		tasks.WithHeader("X-Tenant", "acme"), tasks.WithHeaderOverride("X-Request-Id", "trace-1"))
+	list("none", nil)
+}
+`
+
+func TestGenClient_MultipleFiles(t *testing.T) {
+	w := newGoWorkspace(t)
+	if !generateClient(t, w, "../fixtures/codegen/todolist.multiple-files.yml") {
+		return
+	}
+	if !assert.NoError(t, w.WriteFile("main", "main", []byte(fmt.Sprintf(clientMultipleFilesRoundTrip, w.Import(""))))) {
+		return
+	}
+
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			"one file: attachments=[a.txt] cover=[c.txt] scans=[s.txt]",
+			"two files: attachments in formData should have at most 1 items",
+			"requests: 1",
+		}, lines)
+	}
+}
+
+const clientMultipleFilesRoundTrip = `package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"%[1]s/client/operations"
+)
+
+func main() {
+	// the server records the names of the files it receives per field
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			panic(err)
+		}
+		var fields []string
+		for field, headers := range r.MultipartForm.File {
+			var names []string
+			for _, h := range headers {
+				names = append(names, h.Filename)
+			}
+			fields = append(fields, fmt.Sprintf("%%s=%%v", field, names))
+		}
+		sort.Strings(fields)
+		received = append(received, strings.Join(fields, " "))
+		rw.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "uploads")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	open := func(name string) *os.File {
+		pth := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(pth, []byte(name), 0644); err != nil {
+			panic(err)
+		}
+		f, err := os.Open(pth)
+		if err != nil {
+			panic(err)
+		}
+		return f
+	}
+
+	transport := client.New(server.Listener.Addr().String(), "/", []string{"http"})
+	uploads := operations.New(transport, strfmt.Default)
+
+	_, err = uploads.UploadFiles(operations.NewUploadFilesParams().
+		WithAttachments([]*os.File{open("a.txt")}).
+		WithScans([]*os.File{open("s.txt")}).
+		WithCover(*open("c.txt")))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("one file:", received[0])
+
+	// the runtime can't send several files in one field, the client refuses them
+	_, err = uploads.UploadFiles(operations.NewUploadFilesParams().
+		WithAttachments([]*os.File{open("a.txt"), open("b.txt")}).
+		WithScans([]*os.File{open("s.txt")}).
+		WithCover(*open("c.txt")))
+	fmt.Println("two files:", err)
+	fmt.Println("requests:", len(received))
+}
+`
+
+func TestMakeOperation_DefaultError(t *testing.T) {
+	b, err := opBuilder("getTask", "../fixtures/codegen/todolist.default-error.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	b.GenOpts = &GenOpts{DefaultError: "Error"}
+	op, err := b.MakeOperation()
+	if assert.NoError(t, err) && assert.NotNil(t, op.DefaultError) {
+		assert.Equal(t, "models.Error", op.DefaultError.GoType)
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, clientResponseTemplate.Execute(buf, op)) {
+			ff, err := formatGoFile("get_task_responses.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "body, err := ioutil.ReadAll(response.Body())", res)
+				assertInCode(t, "payload := new(models.Error)", res)
+				assertInCode(t, "return nil, runtime.NewAPIError(\"unknown error\", body, response.Code())", res)
+				assertInCode(t, "return nil, runtime.NewAPIError(\"unknown error\", payload, response.Code())", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+
+	// a declared default response wins
+	b, err = opBuilder("deleteTask", "../fixtures/codegen/todolist.default-error.yml")
+	if assert.NoError(t, err) {
+		b.GenOpts = &GenOpts{DefaultError: "Error"}
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			assert.Nil(t, op.DefaultError)
+		}
+	}
+
+	b, err = opBuilder("getTask", "../fixtures/codegen/todolist.default-error.yml")
+	if assert.NoError(t, err) {
+		// without the option undeclared responses keep the raw response
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			assert.Nil(t, op.DefaultError)
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, clientResponseTemplate.Execute(buf, op)) {
+				assertInCode(t, "return nil, runtime.NewAPIError(\"unknown error\", response, response.Code())", buf.String())
+			}
+		}
+
+		b.GenOpts = &GenOpts{DefaultError: "Failure"}
+		_, err = b.MakeOperation()
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "default error \"Failure\" is not a definition of this spec")
+		}
+	}
+}
+
+const defaultErrorRoundTrip = `package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"%s"
+)
+
+type response struct {
+	code        int
+	contentType string
+	body        string
+}
+
+func (r response) Code() int                { return r.code }
+func (r response) Message() string          { return "" }
+func (r response) GetHeader(string) string  { return r.contentType }
+func (r response) Body() io.ReadCloser      { return ioutil.NopCloser(strings.NewReader(r.body)) }
+
+func main() {
+	reader := &GetTaskReader{formats: strfmt.Default}
+	for _, resp := range []response{
+		{404, "application/json", ""},
+		{500, "application/json", ` + "`" + `{"code":42,"message":"the database is down"}` + "`" + `},
+		{502, "text/html", "<html>bad gateway</html>"},
+	} {
+		_, err := reader.ReadResponse(resp, runtime.JSONConsumer())
+		switch e := err.(type) {
+		case *GetTaskNotFound:
+			fmt.Println("not found")
+		case *runtime.APIError:
+			switch payload := e.Response.(type) {
+			case *models.Error:
+				fmt.Println(e.Code, payload.Code, *payload.Message)
+			case []byte:
+				fmt.Println(e.Code, string(payload))
+			}
+		default:
+			fmt.Println("unexpected", err)
+		}
+	}
+}
+`
+
+func TestMakeOperation_DefaultErrorRoundTrip(t *testing.T) {
+	w := newGoWorkspace(t)
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.default-error.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	for _, k := range []string{"Error", "Task"} {
+		genModel, err := makeGenDefinition(k, "models", specDoc.Spec().Definitions[k], specDoc, true, true)
+		if !assert.NoError(t, err, k) {
+			return
+		}
+		buf.Reset()
+		if !assert.NoError(t, modelTemplate.Execute(buf, genModel), k) || !assert.NoError(t, w.WriteFile("models", k, buf.Bytes()), k) {
+			return
+		}
+	}
+
+	b, err := opBuilder("getTask", "../fixtures/codegen/todolist.default-error.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	b.APIPackage = "main"
+	b.DefaultImports = []string{w.Import("models")}
+	b.GenOpts = &GenOpts{DefaultError: "Error"}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf.Reset()
+	if !assert.NoError(t, clientResponseTemplate.Execute(buf, op)) ||
+		!assert.NoError(t, w.WriteFile("main", "get_task_responses", buf.Bytes())) ||
+		!assert.NoError(t, w.WriteFile("main", "main", []byte(fmt.Sprintf(defaultErrorRoundTrip, w.Import("models"))))) {
+		return
+	}
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{"not found", "500 42 the database is down", "502 <html>bad gateway</html>"}, lines)
+	}
+}
+
+func TestGenClient_Deprecated(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenertor(t, "../fixtures/codegen/todolist.deprecated.yml", "todo")
+	if !assert.NoError(t, err) {
+		return
+	}
+	app, err := gen.makeCodegenApp()
+	if !assert.NoError(t, err) || !assert.Len(t, app.OperationGroups, 1) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, clientTemplate.Execute(buf, app.OperationGroups[0])) {
+		ff, err := formatGoFile("operations_client.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			// only the deprecated operation documents its client method as such
+			assertInCode(t, "ListTasks list tasks API\n\nDeprecated: list tasks is deprecated in the API definition\n*/\nfunc (a *Client) ListTasks(", res)
+			assert.Equal(t, 1, strings.Count(res, "Deprecated:"))
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
+func TestGenParameter_BinaryBody(t *testing.T) {
+	b, err := opBuilder("uploadAttachment", "../fixtures/codegen/todolist.binary-body.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, parameterTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("upload_attachment_parameters.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			// the server hands the request body over without reading it
+			assertInCode(t, "Content io.ReadCloser", res)
+			assertInCode(t, "o.Content = r.Body", res)
+			assertNotInCode(t, "defer r.Body.Close()", res)
+			assertNotInCode(t, "Consumer.Consume", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
+func TestGenParameter_NamedSliceBody(t *testing.T) {
+	b, err := opBuilder("listTasks", "../fixtures/codegen/todolist.named-arrays.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, parameterTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("list_tasks_parameters.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			assertInCode(t, "Body models.TaskList", res)
+			// the named slice validates its elements
+			assertInCode(t, "if err := body.Validate(route.Formats); err != nil {", res)
+			assertNotInCode(t, "range o.Body", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
+func TestGenParameter_TimeOfDay(t *testing.T) {
+	b, err := opBuilder("listReminders", "../fixtures/codegen/todolist.time-of-day.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, parameterTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("list_reminders_parameters.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			// the time of the day lives in the models package
+			assertInCode(t, "After *models.TimeOfDay", res)
+			assertInCode(t, "value, err := formats.Parse(\"time\", raw)", res)
+			assertInCode(t, "o.After = (value.(*models.TimeOfDay))", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
+func TestGenParameter_PatternAndEnum(t *testing.T) {
+	b, err := opBuilder("createTask", "../fixtures/codegen/todolist.pattern-enum.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, parameterTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("create_task_parameters.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			// a primitive body is checked against both its pattern and its enum
+			assertInCode(t, "if err := validate.Pattern(\"status\", \"body\", string(body), `^[a-z]+$`); err != nil {", res)
+			assertInCode(t, "if err := validate.Enum(\"status\", \"body\", body, []interface{}{\"open\", \"done\", \"Archived\"}); err != nil {", res)
+			assertInCode(t, "if err := validate.Pattern(\"priority\", \"header\", string(*o.Priority), `^p[0-9]$`); err != nil {", res)
+			assertInCode(t, "if err := validate.Enum(\"priority\", \"header\", *o.Priority, []interface{}{\"p1\", \"p2\", \"high\"}); err != nil {", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
+func TestGenClient_BinaryBody(t *testing.T) {
+	// binary responses are written to an io.Writer by the client
+	defer func() {
+		typeMapping["binary"] = "io.ReadCloser"
+	}()
+	typeMapping["binary"] = "io.Writer"
+
+	b, err := opBuilder("uploadAttachment", "../fixtures/codegen/todolist.binary-body.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, clientParamTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("upload_attachment_parameters.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			// the request body is streamed from the reader of the caller
+			assertInCode(t, "Content io.ReadCloser", res)
+			assertInCode(t, "WithContent(Content io.ReadCloser) *UploadAttachmentParams", res)
+			assertInCode(t, "r.SetBodyParam(o.Content)", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+
+	b, err = opBuilder("downloadAttachment", "../fixtures/codegen/todolist.binary-body.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	op, err = b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf = bytes.NewBuffer(nil)
+	if assert.NoError(t, clientResponseTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("download_attachment_responses.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			assertInCode(t, "Payload io.Writer", string(ff))
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
 func TestRenderOperation_InstagramSearch(t *testing.T) {
 	b, err := methodPathOpBuilder("get", "/media/search", "../fixtures/codegen/instagram.yml")
 	if assert.NoError(t, err) {
@@ -221,6 +1500,50 @@ func TestRenderOperation_InstagramSearch(t *testing.T) {
 	}
 }
 
+func TestRenderOperation_WithLogging(t *testing.T) {
+	b, err := opBuilder("login", "../fixtures/codegen/todolist.logging.yml")
+	if assert.NoError(t, err) {
+		b.WithLogging = true
+		gO, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			err := operationTemplate.Execute(buf, gO)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("login.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "Logger *slog.Logger", res)
+					assertInCode(t, "logger = logger.With(slog.String(\"operationId\", \"login\"))", res)
+					assertInCode(t, "attrs = append(attrs, slog.Any(\"username\", Params.Username))", res)
+					assertInCode(t, "attrs = append(attrs, slog.Any(\"remember\", *Params.Remember))", res)
+					assertInCode(t, "attrs = append(attrs, slog.String(\"password\", \"[REDACTED]\"))", res)
+					assertInCode(t, "attrs = append(attrs, slog.String(\"X-Api-Token\", \"[REDACTED]\"))", res)
+					assertNotInCode(t, "Params.Password", res)
+					assertNotInCode(t, "Params.XAPIToken", res)
+					assertInCode(t, "Params.HTTPRequest = Params.HTTPRequest.WithContext(context.WithValue(r.Context(), loginLoggerKey{}, logger))", res)
+					assertInCode(t, "func LoginLogger(ctx context.Context) *slog.Logger {", res)
+					assertInCode(t, "slog.Int(\"status\", sr.status)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+
+		b.WithLogging = false
+		gO, err = b.MakeOperation()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			err := operationTemplate.Execute(buf, gO)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("login.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					assertNotInCode(t, "slog", string(ff))
+				}
+			}
+		}
+	}
+}
+
 func methodPathOpBuilder(method, path, fname string) (codeGenOpBuilder, error) {
 	if fname == "" {
 		fname = "../fixtures/codegen/todolist.simple.yml"
@@ -253,6 +1576,30 @@ func methodPathOpBuilder(method, path, fname string) (codeGenOpBuilder, error) {
 	}, nil
 }
 
+func TestGenClientResponse_FormattedItems(t *testing.T) {
+	b, err := opBuilder("getTaskHistory", "../fixtures/codegen/todolist.formatted-items.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, clientResponseTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("get_task_history_responses.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			assertInCode(t, "func (o *GetTaskHistoryOKBodyBody) validateChanged(formats strfmt.Registry) error {", res)
+			assertInCode(t, "validate.FormatOf(\"getTaskHistoryOK\"+\".\"+\"changed\"+\".\"+strconv.Itoa(i), \"body\", \"uuid\", o.Changed[i].String(), formats)", res)
+			// date-times fail to unmarshal when malformed
+			assertNotInCode(t, "\"date-time\"", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
 func opBuilder(name, fname string) (codeGenOpBuilder, error) {
 	if fname == "" {
 		fname = "../fixtures/codegen/todolist.simple.yml"
@@ -584,7 +584,9 @@ func TestGenParameter_Issue248(t *testing.T) {
 				ff, err := formatGoFile("create_thing.go", buf.Bytes())
 				if assert.NoError(t, err) {
 					res := string(ff)
-					assertInCode(t, ", *o.OptionalQueryEnum", res)
+					// optional query enums are bound into a typed pointer and checked while binding
+					assertInCode(t, "OptionalQueryEnum *CreateThingOptionalQueryEnum", res)
+					assertInCode(t, "o.OptionalQueryEnum = &value", res)
 				} else {
 					fmt.Println(buf.String())
 				}
@@ -654,3 +656,293 @@ func TestGenParameter_Issue511(t *testing.T) {
 		}
 	}
 }
+
+func TestGenParameter_FormatWithLength(t *testing.T) {
+	b, err := opBuilder("login", "../fixtures/codegen/todolist.password.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			for _, p := range op.Params {
+				if p.Name == "password" {
+					assert.True(t, p.IsSensitive)
+					assert.True(t, p.HasValidations)
+				}
+			}
+			buf := bytes.NewBuffer(nil)
+			err := parameterTemplate.Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("login_parameters.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "validate.MinLength(\"password\", \"query\", string(o.Password), 8)", res)
+					assertInCode(t, "validate.FormatOf(\"password\", \"query\", \"password\", string(o.Password), formats)", res)
+					assertInCode(t, "validate.MaxLength(\"homepage\", \"query\", string(*o.Homepage), 20)", res)
+					assertInCode(t, "validate.FormatOf(\"homepage\", \"query\", \"uri\", string(*o.Homepage), formats)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestGenParameter_EnumQuery(t *testing.T) {
+	b, err := opBuilder("listTasks", "../fixtures/codegen/todolist.enum-params.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			for _, p := range op.Params {
+				switch p.Name {
+				case "status":
+					assert.Equal(t, "ListTasksStatus", p.EnumType)
+					assert.False(t, p.HasValidations)
+				case "X-Mode":
+					// only query params get a type
+					assert.Empty(t, p.EnumType)
+				}
+			}
+			buf := bytes.NewBuffer(nil)
+			err := parameterTemplate.Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := formatGoFile("list_tasks_parameters.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "Status *ListTasksStatus", res)
+					assertInCode(t, "Priority *ListTasksPriority", res)
+					assertInCode(t, "Sort ListTasksSort", res)
+					assertInCode(t, "XMode *string", res)
+					assertInCode(t, "type ListTasksStatus string", res)
+					assertInCode(t, "type ListTasksPriority int32", res)
+					assertInCode(t, "statusDefault ListTasksStatus = ListTasksStatus(\"open\")", res)
+					assertInCode(t, "value := ListTasksStatus(raw)", res)
+					assertInCode(t, "value := ListTasksPriority(converted)", res)
+					assertInCode(t, "return errors.New(400, \"%s in %s should be one of %v\", \"sort\", \"query\", AllListTasksSortValues())", res)
+					assertNotInCode(t, "func (o *ListTasksParams) validateSort(", res)
+					assertInCode(t, "validate.Enum(\"X-Mode\", \"header\", *o.XMode, []interface{}{\"fast\", \"slow\"})", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestGenParameter_EnumStatus(t *testing.T) {
+	for _, v := range []struct {
+		Opts   *GenOpts
+		Status int
+	}{
+		{nil, 400},
+		{&GenOpts{EnumStatus: 422}, 422},
+	} {
+		b, err := opBuilder("listTasksByState", "../fixtures/codegen/todolist.enum-status.yml")
+		if !assert.NoError(t, err) {
+			return
+		}
+		b.GenOpts = v.Opts
+		op, err := b.MakeOperation()
+		if !assert.NoError(t, err) {
+			return
+		}
+		for _, p := range op.Params {
+			// path params get a type too
+			assert.Equal(t, swag.ToGoName("list tasks by state "+p.Name), p.EnumType, p.Name)
+			assert.Equal(t, v.Status, p.EnumStatus, p.Name)
+		}
+		buf := bytes.NewBuffer(nil)
+		if !assert.NoError(t, parameterTemplate.Execute(buf, op)) {
+			return
+		}
+		ff, err := formatGoFile("list_tasks_by_state_parameters.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			assertInCode(t, "State ListTasksByStateState", res)
+			assertInCode(t, "// ListTasksByStateState is the type of the state path param of the list tasks by state operation", res)
+			assertInCode(t, fmt.Sprintf("return errors.New(%d, \"%%s in %%s should be one of %%v\", \"state\", \"path\", AllListTasksByStateStateValues())", v.Status), res)
+			assertInCode(t, fmt.Sprintf("return errors.New(%d, \"%%s in %%s should be one of %%v\", \"sort\", \"query\", AllListTasksByStateSortValues())", v.Status), res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+
+	// only client errors answer a value out of the enum
+	b, err := opBuilder("listTasksByState", "../fixtures/codegen/todolist.enum-status.yml")
+	if assert.NoError(t, err) {
+		b.GenOpts = &GenOpts{EnumStatus: 200}
+		_, err := b.MakeOperation()
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "listTasksByState.state: the status 200 answering a value out of the enum is not a client error")
+		}
+	}
+}
+
+func TestGenParameter_SharedParams(t *testing.T) {
+	shared := make(sharedParams)
+	for _, opID := range []string{"listTasks", "listTags", "listUsers"} {
+		b, err := opBuilder(opID, "../fixtures/codegen/todolist.shared-params.yml")
+		if !assert.NoError(t, err) {
+			return
+		}
+		b.SharedParams = shared
+		op, err := b.MakeOperation()
+		if !assert.NoError(t, err) {
+			return
+		}
+		var limit *GenParameter
+		for i := range op.Params {
+			p := op.Params[i]
+			switch p.Name {
+			case "limit":
+				limit = &p
+			case "order":
+				// every operation binds the enum into its own type
+				assert.Equal(t, swag.ToGoName(opID+" order"), p.EnumType)
+			}
+		}
+		if assert.NotNil(t, limit, opID) {
+			assert.Equal(t, "int32", limit.GoType)
+			assert.Equal(t, "the maximum number of items to return", limit.Description)
+			assert.True(t, limit.HasValidations)
+			assert.Equal(t, float64(20), limit.Default)
+		}
+
+		buf := bytes.NewBuffer(nil)
+		if assert.NoError(t, parameterTemplate.Execute(buf, op)) {
+			ff, err := formatGoFile(swag.ToFileName(opID)+"_parameters.go", buf.Bytes())
+			if assert.NoError(t, err) {
+				res := string(ff)
+				assertInCode(t, "Limit *int32", res)
+				assertInCode(t, "limitDefault int32 = int32(20)", res)
+				assertInCode(t, "validate.MinimumInt(\"limit\", \"query\", int64(*o.Limit), 1, false)", res)
+				assertInCode(t, "validate.MaximumInt(\"limit\", \"query\", int64(*o.Limit), 100, false)", res)
+			} else {
+				fmt.Println(buf.String())
+			}
+		}
+	}
+	// the shared params are resolved once for all the operations
+	assert.Len(t, shared, 2)
+	assert.Contains(t, shared, "#/parameters/limit")
+	assert.Contains(t, shared, "#/parameters/order")
+}
+
+func TestGenParameter_UnresolvedSharedParam(t *testing.T) {
+	b, err := opBuilder("listTasks", "../fixtures/codegen/todolist.shared-params.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	b.Operation.Parameters = []spec.Parameter{{Refable: spec.Refable{Ref: spec.MustCreateRef("#/parameters/missing")}}}
+	_, err = b.MakeOperation()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "#/parameters/missing")
+	}
+}
+
+func TestGenParameter_MistypedEnum(t *testing.T) {
+	b, err := opBuilder("listTasks", "../fixtures/codegen/todolist.enum-mistyped.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = b.MakeOperation()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `listTasks.order: enum value "asc" is not an integer`)
+	}
+}
+
+func TestGenParameter_MultipleFiles(t *testing.T) {
+	items := &spec.Items{SimpleSchema: spec.SimpleSchema{Type: "file"}}
+	rt := simpleResolvedType("array", "", items)
+	assert.Equal(t, "[]runtime.File", rt.GoType)
+	if assert.NotNil(t, rt.ElemType) {
+		assert.True(t, rt.ElemType.IsStream)
+	}
+
+	b, err := opBuilder("uploadFiles", "../fixtures/codegen/todolist.multiple-files.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, op.HasFileParams)
+	for _, p := range op.Params {
+		switch p.Name {
+		case "attachments", "scans":
+			assert.True(t, p.IsFileArrayParam(), p.Name)
+			assert.False(t, p.IsFileParam(), p.Name)
+			assert.Equal(t, "[]runtime.File", p.GoType, p.Name)
+		default:
+			assert.False(t, p.IsFileArrayParam(), p.Name)
+		}
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, parameterTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("upload_files_parameters.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			assertInCode(t, "Attachments []runtime.File", res)
+			assertInCode(t, `fhAttachments = r.MultipartForm.File["attachments"]`, res)
+			assertInCode(t, "func (o *UploadFilesParams) bindAttachments(headers []*multipart.FileHeader, formats strfmt.Registry) error {", res)
+			assertInCode(t, "files = append(files, runtime.File{Data: data, Header: header})", res)
+			assertInCode(t, `validate.MaxItems("attachments", "formData", attachmentsSize, 5)`, res)
+			assertInCode(t, `return errors.Required("scans", "formData")`, res)
+			assertNotInCode(t, `fds.GetOK("attachments")`, res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+
+	buf = bytes.NewBuffer(nil)
+	if assert.NoError(t, clientParamTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("upload_files_parameters.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			assertInCode(t, "Attachments []*os.File", res)
+			assertInCode(t, "func (o *UploadFilesParams) WithAttachments(Attachments []*os.File) *UploadFilesParams {", res)
+			assertInCode(t, `if err := r.SetFileParam("attachments", f); err != nil {`, res)
+			assertInCode(t, `validate.MaxItems("attachments", "formData", int64(len(o.Attachments)), 1)`, res)
+			assertNotInCode(t, "swag.JoinByFormat(valuesAttachments", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
+func TestGenParameter_NarrowIntegers(t *testing.T) {
+	b, err := opBuilder("listReadings", "../fixtures/codegen/todolist.narrow-integers.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, parameterTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("list_readings_parameters.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			assertInCode(t, "Channel *uint8", res)
+			assertInCode(t, "Offset *int16", res)
+			assertInCode(t, "Ids []int8", res)
+			assertInCode(t, "value, err := swag.ConvertUint8(raw)", res)
+			assertInCode(t, "value, err := swag.ConvertInt16(raw)", res)
+			assertInCode(t, `validate.MinimumInt("offset", "query", int64(*o.Offset), -10, false)`, res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+	buf = bytes.NewBuffer(nil)
+	if assert.NoError(t, clientParamTemplate.Execute(buf, op)) {
+		ff, err := formatGoFile("list_readings_parameters.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(ff)
+			assertInCode(t, "qChannel := swag.FormatUint8(qrChannel)", res)
+			assertInCode(t, "qOffset := swag.FormatInt16(qrOffset)", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
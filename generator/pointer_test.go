@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -42,6 +43,89 @@ func TestTypeResolver_PointerLifting(t *testing.T) {
 	}
 }
 
+func TestTypeResolver_StringAndStrfmtNullability(t *testing.T) {
+	_, resolver, err := basicTaskListResolver(t)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// a strfmt string is a pointer exactly when a plain string is one
+	for i, v := range []struct {
+		Required bool
+		Default  interface{}
+		ReadOnly bool
+		Nullable bool
+	}{
+		{Nullable: false},
+		{Default: "todo@example.com", Nullable: true},
+		{Required: true, Nullable: true},
+		{Required: true, Default: "todo@example.com", Nullable: true},
+		{ReadOnly: true, Nullable: false},
+		{Default: "todo@example.com", ReadOnly: true, Nullable: false},
+		{Required: true, ReadOnly: true, Nullable: false},
+		{Required: true, Default: "todo@example.com", ReadOnly: true, Nullable: false},
+	} {
+		for _, format := range []string{"", "email"} {
+			sch := new(spec.Schema)
+			sch.Typed("string", format)
+			sch.Default = v.Default
+			sch.ReadOnly = v.ReadOnly
+
+			rt, err := resolver.ResolveSchema(sch, true, v.Required)
+			if assert.NoError(t, err) {
+				assert.Equal(t, v.Nullable, rt.IsNullable, fmt.Sprintf("%q at %d", format, i))
+			}
+		}
+	}
+}
+
+func TestTypeResolver_OpenAPI3Nullable(t *testing.T) {
+	for _, v := range []struct {
+		Version  string
+		Schema   string
+		Nullable bool
+	}{
+		{"3.0.1", `{"type": "string", "nullable": true}`, true},
+		{"3.0.1", `{"type": "string", "format": "date-time", "nullable": true}`, true},
+		{"3.0.1", `{"type": "integer", "nullable": true}`, true},
+		{"3.0.1", `{"type": "number", "format": "double", "nullable": true}`, true},
+		{"3.0.1", `{"type": "boolean", "nullable": true}`, true},
+		{"3.0.1", `{"type": "object", "properties": {"name": {"type": "string"}}, "nullable": true}`, true},
+		// nullable: false keeps a value even where a pointer would tell it from its zero value
+		{"3.0.1", `{"type": "string", "default": "todo", "nullable": false}`, false},
+		{"3.0.1", `{"type": "integer", "default": 3, "nullable": false}`, false},
+		{"3.0.1", `{"type": "boolean", "default": true, "nullable": false}`, false},
+		{"3.0.1", `{"type": "object", "properties": {"name": {"type": "string"}}, "nullable": false}`, false},
+		// the keyword wins over the extensions
+		{"3.0.1", `{"type": "string", "nullable": false, "x-nullable": true}`, false},
+		// without the keyword the extensions still apply
+		{"3.0.1", `{"type": "string", "x-nullable": true}`, true},
+		{"3.0.1", `{"type": "string"}`, false},
+		// swagger 2.0 doesn't know of the keyword
+		{"", `{"type": "string", "nullable": true}`, false},
+		{"", `{"type": "string", "default": "todo", "nullable": false}`, true},
+		{"", `{"type": "string", "x-nullable": true}`, true},
+	} {
+		raw := `{"swagger": "2.0", "info": {"title": "nullable", "version": "1.0.0"}, "paths": {}}`
+		if v.Version != "" {
+			raw = `{"openapi": "` + v.Version + `", "info": {"title": "nullable", "version": "1.0.0"}, "paths": {}}`
+		}
+		doc, err := loads.Analyzed(json.RawMessage(raw), "")
+		if !assert.NoError(t, err) {
+			return
+		}
+		var sch spec.Schema
+		if !assert.NoError(t, json.Unmarshal([]byte(v.Schema), &sch)) {
+			return
+		}
+		resolver := newTypeResolver("models", doc)
+		rt, err := resolver.ResolveSchema(&sch, true, false)
+		if assert.NoError(t, err) {
+			assert.Equal(t, v.Nullable, rt.IsNullable, v.Version+" "+v.Schema)
+		}
+	}
+}
+
 type builtinVal struct {
 	Type, Format, Expected, AliasedType string
 
@@ -690,13 +774,13 @@ func assertBuiltinAdditionalPropertiesElem(t testing.TB, resolver *typeResolver,
 	return true
 }
 
-func assertBuiltinResolve(t testing.TB, tpe, tfmt, exp string, tr resolvedType, i int) bool {
+func assertBuiltinResolve(t testing.TB, tpe, tfmt, exp string, tr ResolvedType, i int) bool {
 	return assert.Equal(t, tpe, tr.SwaggerType, fmt.Sprintf("expected %q (%q, %q) at %d for the swagger type but got %q", tpe, tfmt, exp, i, tr.SwaggerType)) &&
 		assert.Equal(t, tfmt, tr.SwaggerFormat, fmt.Sprintf("expected %q (%q, %q) at %d for the swagger format but got %q", tfmt, tpe, exp, i, tr.SwaggerFormat)) &&
 		assert.Equal(t, exp, tr.GoType, fmt.Sprintf("expected %q (%q, %q) at %d for the go type but got %q", exp, tpe, tfmt, i, tr.GoType))
 }
 
-func assertBuiltinSliceElemnResolve(t testing.TB, tpe, tfmt, exp string, tr resolvedType, i int) bool {
+func assertBuiltinSliceElemnResolve(t testing.TB, tpe, tfmt, exp string, tr ResolvedType, i int) bool {
 	return assert.Equal(t, tpe, tr.ElemType.SwaggerType, fmt.Sprintf("expected %q (%q, %q) at %d for the swagger type but got %q", tpe, tfmt, exp, i, tr.SwaggerType)) &&
 		assert.Equal(t, tfmt, tr.ElemType.SwaggerFormat, fmt.Sprintf("expected %q (%q, %q) at %d for the swagger format but got %q", tfmt, tpe, exp, i, tr.SwaggerFormat)) &&
 		assert.Equal(t, exp, tr.GoType, fmt.Sprintf("expected %q (%q, %q) at %d for the go type but got %q", exp, tpe, tfmt, i, tr.GoType))
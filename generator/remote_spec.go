@@ -0,0 +1,160 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/loads/fmts"
+	"gopkg.in/yaml.v2"
+)
+
+// maxSpecRedirects is the number of redirects followed when fetching a remote spec
+const maxSpecRedirects = 10
+
+// specTimeout bounds the time it takes to fetch a remote spec
+const specTimeout = 30 * time.Second
+
+// hasSpecAuth returns true when a remote spec is fetched with credentials or a custom CA
+func (g *GenOpts) hasSpecAuth() bool {
+	return g != nil && (g.SpecAuthHeader != "" || g.SpecBearerToken != "" || g.SpecCACert != "")
+}
+
+// specAuthHeaders returns the headers carrying the credentials for a remote spec
+func (g *GenOpts) specAuthHeaders() (http.Header, error) {
+	hdr := make(http.Header)
+	if g.SpecAuthHeader != "" {
+		parts := strings.SplitN(g.SpecAuthHeader, ":", 2)
+		name := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || name == "" {
+			return nil, fmt.Errorf("spec auth header %q should be formatted as \"Name: value\"", g.SpecAuthHeader)
+		}
+		hdr.Set(name, strings.TrimSpace(parts[1]))
+	}
+	if g.SpecBearerToken != "" {
+		if hdr.Get("Authorization") != "" {
+			return nil, errors.New("the spec auth header and the spec bearer token both set the Authorization header")
+		}
+		hdr.Set("Authorization", "Bearer "+g.SpecBearerToken)
+	}
+	return hdr, nil
+}
+
+// specClient builds the http client fetching a remote spec.
+//
+// The credentials follow redirects to the same host and scheme only,
+// they are dropped when a redirect leads to another server or downgrades to plain http.
+func (g *GenOpts) specClient(hdr http.Header) (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if g.SpecCACert != "" {
+		pem, err := ioutil.ReadFile(g.SpecCACert)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", g.SpecCACert)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return &http.Client{
+		Timeout: specTimeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxSpecRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxSpecRedirects)
+			}
+			if req.URL.Host != via[0].URL.Host || req.URL.Scheme != via[0].URL.Scheme {
+				for k := range hdr {
+					req.Header.Del(k)
+				}
+			}
+			return nil
+		},
+	}, nil
+}
+
+// fetchSpec gets a remote spec with the credentials of these options and returns it as json
+func (g *GenOpts) fetchSpec(specURL string) (json.RawMessage, error) {
+	hdr, err := g.specAuthHeaders()
+	if err != nil {
+		return nil, err
+	}
+	client, err := g.specClient(hdr)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", specURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range hdr {
+		req.Header[k] = v
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not access document at %q [%s]", specURL, resp.Status)
+	}
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if isYAMLSpec(resp) {
+		var data map[interface{}]interface{}
+		if err := yaml.Unmarshal(raw, &data); err != nil {
+			return nil, err
+		}
+		return fmts.YAMLToJSON(data)
+	}
+	return json.RawMessage(raw), nil
+}
+
+// isYAMLSpec returns true when the fetched spec is a yaml document, judging from
+// its content type or else from the extension of the url it was finally served from
+func isYAMLSpec(resp *http.Response) bool {
+	if mt, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil {
+		switch {
+		case strings.Contains(mt, "yaml"):
+			return true
+		case strings.Contains(mt, "json"):
+			return false
+		}
+	}
+	var u *url.URL
+	if resp.Request != nil {
+		u = resp.Request.URL
+	}
+	return u != nil && fmts.YAMLMatcher(u.Path)
+}
@@ -4,9 +4,16 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/go-openapi/analysis"
@@ -80,6 +87,73 @@ func testAppGenertor(t testing.TB, specPath, name string) (*appGenerator, error)
 	}, nil
 }
 
+func TestServer_GoGenerate(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenertor(t, "../fixtures/codegen/todolist.simple.yml", "todo")
+	if assert.NoError(t, err) {
+		gen.Target = "todo app"
+		gen.GenOpts.Spec = "../fixtures/codegen/todolist.simple.yml"
+		gen.GenOpts.TemplateDir = "my templates"
+		gen.GenOpts.GoGenerate = true
+		gen.GenOpts.WithContext = true
+		gen.GenOpts.ContextFormats = []string{"registered-user"}
+		gen.OperationIDs = []string{"getTasks", "createTask"}
+
+		// go generate runs the command from the directory of the main package
+		cmd, err := gen.goGenerateCommand("server", filepath.Join(gen.Target, "cmd", "todo-server"))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "swagger generate server"+
+				" --spec ../../../../fixtures/codegen/todolist.simple.yml --target ../.. --template-dir \"../../../my templates\""+
+				" --name Todo --api-package operations --model-package models --server-package restapi --client-package client"+
+				" --default-scheme http --operation getTasks --operation createTask"+
+				" --exclude-main --exclude-spec --with-context --context-format registered-user --go-generate", cmd)
+		}
+
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			app.GoGenerate = cmd
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, mainTemplate.Execute(buf, app)) {
+				formatted, err := formatGoFile("main.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					assertInCode(t, "//go:generate swagger generate server --spec ../../../../fixtures/codegen/todolist.simple.yml", string(formatted))
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_StandaloneGoGenerate(t *testing.T) {
+	w := newGoWorkspace(t)
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/codegen/todolist.simple.yml"
+	opts.Target = w.Dir("")
+	opts.StandaloneServer = true
+	opts.GoGenerate = true
+	if !assert.NoError(t, GenerateServer("todo", nil, []string{"getTasks"}, opts)) {
+		return
+	}
+	b, err := ioutil.ReadFile(filepath.Join(w.Dir("restapi"), "todo_server.go"))
+	if assert.NoError(t, err) {
+		// go generate runs the command from the directory of the standalone server
+		abs, _ := filepath.Abs(opts.Spec)
+		spec, err := filepath.Rel(w.Dir("restapi"), abs)
+		if assert.NoError(t, err) {
+			assertInCode(t, "//go:generate swagger generate server --spec "+filepath.ToSlash(spec)+" --target .."+
+				" --name Todo --api-package operations --model-package models --server-package restapi --client-package client"+
+				" --default-scheme http --operation getTasks --exclude-main --exclude-spec --standalone-server --go-generate", string(b))
+		}
+	}
+	if _, ok := w.Go(t, "restapi", "vet", "."); !ok {
+		t.Fail()
+	}
+}
+
 func TestServer_UrlEncoded(t *testing.T) {
 	log.SetOutput(ioutil.Discard)
 	defer log.SetOutput(os.Stderr)
@@ -121,3 +195,643 @@ func TestServer_MultipartForm(t *testing.T) {
 		}
 	}
 }
+
+func TestServer_Standalone(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenertor(t, "../fixtures/codegen/todolist.standalone.yml", "todo")
+	if assert.NoError(t, err) {
+		gen.GenOpts.StandaloneServer = true
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			sapp, err := gen.makeStandaloneApp(&app)
+			if assert.NoError(t, err) {
+				// listTasks has 2 tags but gets a single route
+				assert.Len(t, sapp.Operations, 3)
+				buf := bytes.NewBuffer(nil)
+				if assert.NoError(t, standaloneServerTemplate.Execute(buf, sapp)) {
+					ffn, _ := filepath.Abs("todo_server.go")
+					formatted, err := formatGoFile(ffn, buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(formatted)
+						assertInCode(t, "ListTasks(r *http.Request, params ListTasksParams) (int, interface{}, error)", res)
+						assertInCode(t, "func NewTodoRouter(handler TodoHandler) http.Handler", res)
+						assertInCode(t, "rt.add(\"PUT\", \"/tasks/{id}\"", res)
+						assertInCode(t, "rID, rhkID := pathParams[\"id\"]", res)
+						assertInCode(t, "json.NewDecoder(r.Body).Decode(&body)", res)
+						assertInCode(t, "type UpdateTaskBody struct", res)
+
+						// the generated server compiles without the go-openapi runtime
+						fset := token.NewFileSet()
+						f, err := parser.ParseFile(fset, ffn, formatted, 0)
+						if assert.NoError(t, err) {
+							for _, imp := range f.Imports {
+								assert.False(t, strings.Contains(imp.Path.Value, "go-openapi/runtime"), imp.Path.Value)
+							}
+							conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+							_, err := conf.Check("restapi", fset, []*ast.File{f}, nil)
+							assert.NoError(t, err)
+						}
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+			}
+		}
+	}
+}
+
+// runStandaloneServer generates the standalone server of a fixture in a main package along with the files
+// of the program, then runs it and returns the lines it printed. The options are adjusted by configure when set.
+func runStandaloneServer(t *testing.T, fixture string, configure func(*GenOpts), program ...string) ([]string, bool) {
+	w := newGoWorkspace(t)
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenertor(t, fixture, "todo")
+	if !assert.NoError(t, err) {
+		return nil, false
+	}
+	gen.GenOpts.StandaloneServer = true
+	if configure != nil {
+		configure(gen.GenOpts)
+	}
+	app, err := gen.makeCodegenApp()
+	if !assert.NoError(t, err) {
+		return nil, false
+	}
+	sapp, err := gen.makeStandaloneApp(&app)
+	if !assert.NoError(t, err) {
+		return nil, false
+	}
+	sapp.APIPackage = "main"
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, standaloneServerTemplate.Execute(buf, sapp)) || !assert.NoError(t, w.WriteFile("main", "todo_server", buf.Bytes())) {
+		return nil, false
+	}
+	for i, src := range program {
+		if !assert.NoError(t, w.WriteFile("main", fmt.Sprintf("main%d", i), []byte(src))) {
+			return nil, false
+		}
+	}
+	return w.Go(t, "main", "run", ".")
+}
+
+const enumParamsRoundTrip = `package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+)
+
+type handler struct{}
+
+func (handler) ListTasks(r *http.Request, params ListTasksParams) (int, interface{}, error) {
+	return http.StatusOK, []string{string(*params.Status), string(params.Sort)}, nil
+}
+
+func main() {
+	router := NewTodoRouter(handler{})
+	for _, query := range []string{"sort=asc", "sort=asc&status=done&priority=2", "sort=up", "sort=asc&priority=7"} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("GET", "/api/tasks?"+query, nil))
+		fmt.Println(rec.Code)
+	}
+}
+`
+
+func TestServer_StandaloneEnumQueryParams(t *testing.T) {
+	if lines, ok := runStandaloneServer(t, "../fixtures/codegen/todolist.enum-params.yml", nil, enumParamsRoundTrip); ok {
+		// invalid enum values are rejected with a 400 before reaching the handler
+		assert.Equal(t, []string{"200", "200", "400", "400"}, lines)
+	}
+}
+
+const enumStatusRoundTrip = `package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+)
+
+type handler struct{}
+
+func (handler) ListTasksByState(r *http.Request, params ListTasksByStateParams) (int, interface{}, error) {
+	return http.StatusOK, []string{string(params.State)}, nil
+}
+
+func main() {
+	router := NewTodoRouter(handler{})
+	for _, path := range []string{"/api/tasks/open", "/api/tasks/done?sort=desc", "/api/tasks/lost", "/api/tasks/open?sort=up"} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+		fmt.Println(rec.Code)
+	}
+}
+`
+
+func TestServer_StandaloneEnumStatus(t *testing.T) {
+	configure := func(opts *GenOpts) { opts.EnumStatus = 422 }
+	if lines, ok := runStandaloneServer(t, "../fixtures/codegen/todolist.enum-status.yml", configure, enumStatusRoundTrip); ok {
+		// the path and query params out of their enum are answered with the configured status
+		assert.Equal(t, []string{"200", "200", "422", "422"}, lines)
+	}
+}
+
+// webSocketRoundTrip talks to the watchTask operation of the server returned by newServer
+const webSocketRoundTrip = `package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gorilla/websocket"
+)
+
+func main() {
+	srv := httptest.NewServer(newServer())
+	defer srv.Close()
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/tasks/"
+
+	client, _, err := websocket.DefaultDialer.Dial(wsURL+"42/watch", nil)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer client.Close()
+	if err := client.WriteJSON(map[string]string{"action": "subscribe"}); err != nil {
+		fmt.Println(err)
+		return
+	}
+	var event map[string]interface{}
+	if err := client.ReadJSON(&event); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(event["taskId"], event["action"])
+
+	// an invalid message closes the connection
+	if err := client.WriteJSON(map[string]string{"action": "explode"}); err != nil {
+		fmt.Println(err)
+		return
+	}
+	_, _, err = client.ReadMessage()
+	if ce, ok := err.(*websocket.CloseError); ok {
+		fmt.Println(ce.Code)
+	} else {
+		fmt.Println(err)
+	}
+
+	// the params are bound before upgrading
+	if _, res, err := websocket.DefaultDialer.Dial(wsURL+"abc/watch", nil); err != nil && res != nil {
+		fmt.Println(res.StatusCode)
+	}
+
+	res, err := http.Get(srv.URL + "/api/tasks")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(res.StatusCode)
+}
+`
+
+const standaloneWebSocketServer = `package main
+
+import "net/http"
+
+type handler struct{}
+
+func (handler) ListTasks(r *http.Request, params ListTasksParams) (int, interface{}, error) {
+	return http.StatusOK, []string{"shopping"}, nil
+}
+
+func (handler) WatchTask(r *http.Request, params WatchTaskParams, conn *WatchTaskConn) error {
+	for {
+		cmd, err := conn.Receive()
+		if err != nil {
+			return err
+		}
+		if err := conn.Send(WatchTaskSwitchingProtocolsBodyBody{TaskID: &params.ID, Action: cmd.Action}); err != nil {
+			return err
+		}
+	}
+}
+
+func newServer() http.Handler {
+	return NewTodoRouter(handler{})
+}
+`
+
+func TestServer_StandaloneWebSocket(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenertor(t, "../fixtures/codegen/todolist.websocket.yml", "todo")
+	if !assert.NoError(t, err) {
+		return
+	}
+	gen.GenOpts.StandaloneServer = true
+	app, err := gen.makeCodegenApp()
+	if !assert.NoError(t, err) {
+		return
+	}
+	sapp, err := gen.makeStandaloneApp(&app)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, standaloneServerTemplate.Execute(buf, sapp)) {
+		res := buf.String()
+		assertInCode(t, "WatchTask(r *http.Request, params WatchTaskParams, conn *WatchTaskConn) error", res)
+		assertInCode(t, "func (c *WatchTaskConn) Receive() (WatchTaskBody, error)", res)
+		assertInCode(t, "func (c *WatchTaskConn) Send(msg WatchTaskSwitchingProtocolsBodyBody) error", res)
+		assertNotInCode(t, "Command WatchTaskBody", res)
+	}
+
+	if lines, ok := runStandaloneServer(t, "../fixtures/codegen/todolist.websocket.yml", nil, webSocketRoundTrip, standaloneWebSocketServer); ok {
+		assert.Equal(t, []string{"42 subscribe", "1007", "422", "200"}, lines)
+	}
+}
+
+const webSocketServer = `package main
+
+import (
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"%[1]s"
+	"%[1]s/operations"
+)
+
+func newServer() http.Handler {
+	// the operations log the requests they serve, keep them out of the output
+	log.SetOutput(io.Discard)
+	swaggerSpec, err := loads.Analyzed(restapi.SwaggerJSON, "")
+	if err != nil {
+		panic(err)
+	}
+	api := operations.NewTodoAPI()
+	api.SetSpec(swaggerSpec)
+	api.ServeError = errors.ServeError
+	api.JSONConsumer = runtime.JSONConsumer()
+	api.JSONProducer = runtime.JSONProducer()
+	api.ListTasksHandler = operations.ListTasksHandlerFunc(func(params operations.ListTasksParams) middleware.Responder {
+		return operations.NewListTasksOK().WithPayload([]string{"shopping"})
+	})
+	api.WatchTaskHandler = operations.WatchTaskHandlerFunc(func(params operations.WatchTaskParams, conn *operations.WatchTaskConn) error {
+		for {
+			cmd, err := conn.Receive()
+			if err != nil {
+				return err
+			}
+			if err := conn.Send(operations.WatchTaskSwitchingProtocolsBodyBody{TaskID: &params.ID, Action: cmd.Action}); err != nil {
+				return err
+			}
+		}
+	})
+	return api.Serve(nil)
+}
+`
+
+func TestServer_WebSocket(t *testing.T) {
+	w := newGoWorkspace(t)
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/codegen/todolist.websocket.yml"
+	opts.Target = w.Dir("")
+	opts.IncludeSupport = true
+	opts.ExcludeSpec = false
+	opts.WithLogging = true
+	if !assert.NoError(t, GenerateServer("todo", nil, nil, opts)) {
+		return
+	}
+	handler, err := ioutil.ReadFile(filepath.Join(w.Dir("restapi/operations"), "watch_task.go"))
+	if assert.NoError(t, err) {
+		res := string(handler)
+		assertInCode(t, "type WatchTaskHandlerFunc func(WatchTaskParams, *WatchTaskConn) error", res)
+		assertInCode(t, "ws, err := o.Upgrader.Upgrade(rw, r, nil)", res)
+		assertInCode(t, "func (c *WatchTaskConn) Receive() (WatchTaskBody, error)", res)
+		assertInCode(t, "func (w *watchTaskStatusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error)", res)
+	}
+	params, err := ioutil.ReadFile(filepath.Join(w.Dir("restapi/operations"), "watch_task_parameters.go"))
+	if assert.NoError(t, err) {
+		// the body is the message received over the connection
+		assertNotInCode(t, "Command WatchTaskBody", string(params))
+	}
+
+	for i, src := range []string{webSocketRoundTrip, fmt.Sprintf(webSocketServer, w.Import("restapi"))} {
+		if !assert.NoError(t, w.WriteFile("main", fmt.Sprintf("main%d", i), []byte(src))) {
+			return
+		}
+	}
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{"42 subscribe", "1007", "422", "200"}, lines)
+	}
+}
+
+const loggingServer = `package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"%[1]s"
+	"%[1]s/operations"
+	"%[1]s/operations/auth"
+)
+
+func main() {
+	log.SetOutput(io.Discard)
+	swaggerSpec, err := loads.Analyzed(restapi.SwaggerJSON, "")
+	if err != nil {
+		panic(err)
+	}
+	api := operations.NewTodoAPI()
+	api.SetSpec(swaggerSpec)
+	api.ServeError = errors.ServeError
+	api.UrlformConsumer = runtime.DiscardConsumer
+	api.JSONProducer = runtime.JSONProducer()
+	api.AuthLoginHandler = auth.LoginHandlerFunc(func(params auth.LoginParams) middleware.Responder {
+		auth.LoginLogger(params.HTTPRequest.Context()).Info("checking credentials")
+		if params.Password != "secret" {
+			return auth.NewLoginUnauthorized()
+		}
+		return auth.NewLoginOK()
+	})
+	server := api.Serve(nil)
+
+	var records bytes.Buffer
+	handler, _ := api.HandlerFor(http.MethodPost, "/login")
+	handler.(*auth.Login).Logger = slog.New(slog.NewJSONHandler(&records, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	for _, form := range []url.Values{
+		{"username": {"ann"}, "password": {"secret"}, "remember": {"true"}},
+		{"username": {"bob"}, "password": {"guess"}},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("X-Api-Token", "token")
+		server.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	fmt.Print(records.String())
+}
+`
+
+func TestServer_WithLogging(t *testing.T) {
+	w := newGoWorkspace(t)
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/codegen/todolist.logging.yml"
+	opts.Target = w.Dir("")
+	opts.IncludeSupport = true
+	opts.ExcludeSpec = false
+	opts.WithLogging = true
+	if !assert.NoError(t, GenerateServer("todo", nil, nil, opts)) {
+		return
+	}
+	if !assert.NoError(t, w.WriteFile("main", "main", []byte(fmt.Sprintf(loggingServer, w.Import("restapi"))))) {
+		return
+	}
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		// the handler logs through the logger of its request, the password and the token are never written
+		assert.Equal(t, []string{
+			`{"level":"INFO","msg":"checking credentials","operationId":"login","params":{"X-Api-Token":"[REDACTED]","password":"[REDACTED]","remember":true,"username":"ann"}}`,
+			`{"level":"INFO","msg":"request served","operationId":"login","params":{"X-Api-Token":"[REDACTED]","password":"[REDACTED]","remember":true,"username":"ann"},"status":200}`,
+			`{"level":"INFO","msg":"checking credentials","operationId":"login","params":{"X-Api-Token":"[REDACTED]","password":"[REDACTED]","username":"bob"}}`,
+			`{"level":"INFO","msg":"request served","operationId":"login","params":{"X-Api-Token":"[REDACTED]","password":"[REDACTED]","username":"bob"},"status":401}`,
+		}, lines)
+	}
+}
+
+func TestServer_StandaloneEventStream(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenertor(t, "../fixtures/codegen/todolist.sse.yml", "todo")
+	if !assert.NoError(t, err) {
+		return
+	}
+	gen.GenOpts.StandaloneServer = true
+	app, err := gen.makeCodegenApp()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, app.HasEventStream)
+	sapp, err := gen.makeStandaloneApp(&app)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if assert.NoError(t, standaloneServerTemplate.Execute(buf, sapp)) {
+		formatted, err := formatGoFile("todo_server.go", buf.Bytes())
+		if assert.NoError(t, err) {
+			res := string(formatted)
+			assertInCode(t, "WatchTasks(r *http.Request, params WatchTasksParams, events *WatchTasksEventWriter) error", res)
+			assertInCode(t, "if err := handler.WatchTasks(r, params, events); err != nil && !events.Started() {", res)
+			assertInCode(t, "func (w *WatchTicksEventWriter) Send(event int32) error {", res)
+		} else {
+			fmt.Println(buf.String())
+		}
+	}
+}
+
+func TestServer_StandaloneJSONOnly(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenertor(t, "../fixtures/codegen/shipyard.yml", "shipyard")
+	if assert.NoError(t, err) {
+		gen.GenOpts.StandaloneServer = true
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			_, err := gen.makeStandaloneApp(&app)
+			assert.Error(t, err)
+		}
+	}
+}
+
+func TestServer_StandaloneUnsupportedOptions(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	for flag, configure := range map[string]func(*GenOpts){
+		"--with-logging":     func(opts *GenOpts) { opts.WithLogging = true },
+		"--with-route-map":   func(opts *GenOpts) { opts.RouteMap = true },
+		"--seekable-streams": func(opts *GenOpts) { opts.SeekableStreams = true },
+	} {
+		opts := testGenOpts()
+		opts.Spec = "../fixtures/codegen/todolist.simple.yml"
+		opts.Target = "nowhere"
+		opts.StandaloneServer = true
+		configure(&opts)
+		err := GenerateServer("todo", nil, nil, opts)
+		if assert.Error(t, err, flag) {
+			assert.Equal(t, flag+" can't be used with --standalone-server, the standalone server doesn't render them", err.Error())
+		}
+		_, err = os.Stat("nowhere")
+		assert.True(t, os.IsNotExist(err), flag)
+	}
+}
+
+const requestValidatorRoundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+func main() {
+	// the application echoes the body it receives
+	app := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusTeapot)
+		w.Write(body)
+	})
+	validator := NewTodoValidator(app)
+	for _, req := range []*http.Request{
+		httptest.NewRequest("POST", "/api/tasks?ttl=60", strings.NewReader(` + "`" + `{"title":"write tests","priority":2}` + "`" + `)),
+		httptest.NewRequest("POST", "/api/tasks", strings.NewReader(` + "`" + `{"title":"no","priority":9}` + "`" + `)),
+		httptest.NewRequest("POST", "/api/tasks?ttl=forever", strings.NewReader(` + "`" + `{"title":"write tests","priority":2}` + "`" + `)),
+		httptest.NewRequest("GET", "/api/tasks/0", nil),
+		httptest.NewRequest("GET", "/api/tasks/12", nil),
+		httptest.NewRequest("GET", "/health", nil),
+	} {
+		rec := httptest.NewRecorder()
+		validator.ServeHTTP(rec, req)
+		if rec.Code != http.StatusBadRequest {
+			fmt.Println(rec.Code, rec.Body.String())
+			continue
+		}
+		var res RequestError
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			panic(err)
+		}
+		fmt.Print(rec.Code)
+		for _, v := range res.Violations {
+			fmt.Printf(" %s/%s", v.In, v.Name)
+		}
+		fmt.Println()
+	}
+}
+`
+
+func TestServer_RequestValidator(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenertor(t, "../fixtures/codegen/todolist.request-validator.yml", "todo")
+	if !assert.NoError(t, err) {
+		return
+	}
+	gen.GenOpts.RequestValidator = true
+	app, err := gen.makeCodegenApp()
+	if !assert.NoError(t, err) {
+		return
+	}
+	sapp, err := gen.makeStandaloneApp(&app)
+	if !assert.NoError(t, err) {
+		return
+	}
+	sapp.APIPackage = "main"
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, requestValidatorTemplate.Execute(buf, sapp)) {
+		return
+	}
+	ff, err := formatGoFile("todo_validator.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "func NewTodoValidator(next http.Handler) http.Handler {", res)
+		assertInCode(t, "rt := &router{basePath: strings.TrimSuffix(\"/api\", \"/\"), next: next}", res)
+		// handlers aren't part of the validator
+		assertNotInCode(t, "TodoHandler", res)
+	} else {
+		fmt.Println(buf.String())
+		return
+	}
+
+	w := newGoWorkspace(t)
+	if !assert.NoError(t, w.WriteFile("main", "todo_validator", buf.Bytes())) || !assert.NoError(t, w.WriteFile("main", "main", []byte(requestValidatorRoundTrip))) {
+		return
+	}
+	if lines, ok := w.Go(t, "main", "run", "."); ok {
+		assert.Equal(t, []string{
+			// a valid request reaches the application with its body
+			`418 {"title":"write tests","priority":2}`,
+			"400 body/body.priority body/body.title",
+			"400 query/ttl",
+			"400 path/id",
+			"418 ",
+			// paths outside of the spec are passed through
+			"418",
+		}, lines)
+	}
+}
+
+func TestServer_RouteMap(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenertor(t, "../fixtures/codegen/todolist.route-map.yml", "todo")
+	if !assert.NoError(t, err) {
+		return
+	}
+	gen.GenOpts.RouteMap = true
+	app, err := gen.makeCodegenApp()
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, routeMapTemplate.Execute(buf, app)) {
+		return
+	}
+	ff, err := formatGoFile("todo_routes.go", buf.Bytes())
+	if assert.NoError(t, err) {
+		res := string(ff)
+		assertInCode(t, "type TodoRoute struct {", res)
+		assertInCode(t, "var TodoRoutes = map[string]TodoRoute{", res)
+		if assert.Len(t, app.Operations, 4) {
+			for _, op := range app.Operations {
+				assertInCode(t, fmt.Sprintf("%q: {", strings.ToUpper(op.Method)+" "+op.Path), res)
+				assertInCode(t, fmt.Sprintf("OperationID: %q,", op.Name), res)
+			}
+		}
+		assertInCode(t, "params := tasks.NewCreateTaskParams()", res)
+		assertInCode(t, "params := NewHealthParams()", res)
+		assertInCode(t, "return new(models.Task)", res)
+		// the default response is under 0
+		assertInCode(t, "0: func() interface {", res)
+		assertInCode(t, "422: func() interface {", res)
+		// anonymous and primitive bodies have no model to validate
+		assertNotInCode(t, "200: func() interface {", res)
+	} else {
+		fmt.Println(buf.String())
+	}
+}
@@ -15,12 +15,15 @@
 package generator
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/go-openapi/analysis"
@@ -79,6 +82,7 @@ func findSwaggerSpec(name string) (string, error) {
 // GenOpts the options for the generator
 type GenOpts struct {
 	Spec              string
+	ExtraSpecs        []string
 	APIPackage        string
 	ModelPackage      string
 	ServerPackage     string
@@ -101,6 +105,46 @@ type GenOpts struct {
 	ExcludeSpec       bool
 	TemplateDir       string
 	WithContext       bool
+	WithLogging       bool
+	TuplesAsSlices    bool
+	OmitIgnored       bool
+	StandaloneServer  bool
+	RequestValidator  bool
+	RouteMap          bool
+	Strict            bool
+	ContextFormats    []string
+	GoGenerate        bool
+	NetIP             bool
+	PlainBytes        bool
+	SingleChar        string
+	DefaultError      string
+	DirtyTracking     bool
+	WithBuilder       bool
+	FixedArrays       bool
+	WithScrub         bool
+	WithFieldMask     bool
+	WithGob           bool
+	ExtraFields       bool
+	WithDiff          bool
+	WithNormalize     bool
+	TypedErrors       bool
+	SplitReadWrite    bool
+	SeekableStreams   bool
+	SchemaRegistry    bool
+	SkipFormats       bool
+	CompatMode        string
+	NullablePolicy    NullableBehavior
+	TypeMappingFile   string
+	EnumStatus        int
+	GoVersion         string
+	SpecAuthHeader    string
+	SpecBearerToken   string
+	SpecCACert        string
+
+	// typeOverrides are the go types the definitions are mapped to, read from TypeMappingFile and TypeMapping
+	typeOverrides map[string]typeOverride
+	// remoteDocs are the documents the remote refs of the spec point to, shared by all the models
+	remoteDocs *remoteDocuments
 }
 
 // type generatorOptions struct {
@@ -108,15 +152,102 @@ type GenOpts struct {
 // 	TargetDirectory string
 // }
 
-func loadSpec(specFile string) (string, *loads.Document, error) {
+// goVersionAtLeast returns true when the targeted go version, like 1.18 or go1.18, is at least major.minor.
+// An empty or unparsable version targets the oldest supported release.
+func goVersionAtLeast(version string, major, minor int) bool {
+	parts := strings.SplitN(strings.TrimPrefix(version, "go"), ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	mj, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false
+	}
+	mn, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false
+	}
+	return mj > major || (mj == major && mn >= minor)
+}
+
+// generics returns true when the targeted go version has type parameters
+func (g *GenOpts) generics() bool {
+	return g != nil && goVersionAtLeast(g.GoVersion, 1, 18)
+}
+
+// iface returns the empty interface type, rendered as any when targeting go 1.18 or later
+func (g *GenOpts) iface() string {
+	if g.generics() && !g.compat().EmptyInterface {
+		return "any"
+	}
+	return iface
+}
+
+// anyType renders the empty interfaces of a type resolved without a type resolver,
+// like the simple types of parameters and headers, as any when targeting go 1.18 or later
+func (g *GenOpts) anyType(tpe string) string {
+	if ifc := g.iface(); ifc != iface {
+		return strings.Replace(tpe, iface, ifc, -1)
+	}
+	return tpe
+}
+
+// checkStandalone returns an error when a standalone server is asked for features
+// only the server built on the go-openapi runtime renders
+func (g *GenOpts) checkStandalone() error {
+	if !g.StandaloneServer {
+		return nil
+	}
+	var unsupported []string
+	if g.WithLogging {
+		unsupported = append(unsupported, "--with-logging")
+	}
+	if g.RouteMap {
+		unsupported = append(unsupported, "--with-route-map")
+	}
+	if g.SeekableStreams {
+		unsupported = append(unsupported, "--seekable-streams")
+	}
+	if len(unsupported) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s can't be used with --standalone-server, the standalone server doesn't render them", strings.Join(unsupported, ", "))
+}
+
+func loadSpec(opts *GenOpts) (string, *loads.Document, error) {
+	if err := checkCompatMode(opts.CompatMode); err != nil {
+		return "", nil, err
+	}
+	if err := checkNullablePolicy(opts.NullablePolicy); err != nil {
+		return "", nil, err
+	}
+	if err := opts.loadTypeMapping(); err != nil {
+		return "", nil, err
+	}
+	opts.remoteDocs = newRemoteDocuments()
 	// find swagger spec document, verify it exists
-	specPath := specFile
+	specPath := opts.Spec
 	var err error
 	if !strings.HasPrefix(specPath, "http") {
-		specPath, err = findSwaggerSpec(specFile)
+		specPath, err = findSwaggerSpec(opts.Spec)
+		if err != nil {
+			return "", nil, err
+		}
+	} else if opts.hasSpecAuth() {
+		// the loaders of the spec package can't authenticate
+		raw, err := opts.fetchSpec(specPath)
+		if err != nil {
+			return "", nil, err
+		}
+		specDoc, err := loads.Analyzed(raw, "")
+		if err != nil {
+			return "", nil, err
+		}
+		specDoc, err = mergeSpecs(specPath, specDoc, opts.ExtraSpecs)
 		if err != nil {
 			return "", nil, err
 		}
+		return specPath, specDoc, nil
 	}
 
 	// load swagger spec
@@ -124,9 +255,76 @@ func loadSpec(specFile string) (string, *loads.Document, error) {
 	if err != nil {
 		return "", nil, err
 	}
+	specDoc, err = mergeSpecs(specPath, specDoc, opts.ExtraSpecs)
+	if err != nil {
+		return "", nil, err
+	}
 	return specPath, specDoc, nil
 }
 
+// mergeSpecs adds the definitions of the additional specs to the spec document, for the models
+// of several specs to be generated into one package. A definition found in several specs is
+// generated once, it must then be identical in all of them.
+func mergeSpecs(specPath string, specDoc *loads.Document, extraSpecs []string) (*loads.Document, error) {
+	if len(extraSpecs) == 0 {
+		return specDoc, nil
+	}
+	merged := specDoc.Pristine().OrigSpec()
+	if merged.Definitions == nil {
+		merged.Definitions = make(spec.Definitions)
+	}
+	origins := make(map[string]string, len(merged.Definitions))
+	for k := range merged.Definitions {
+		origins[k] = specPath
+	}
+	for _, extraPath := range extraSpecs {
+		extraDoc, err := loads.Spec(extraPath)
+		if err != nil {
+			return nil, err
+		}
+		definitions := extraDoc.OrigSpec().Definitions
+		names := make([]string, 0, len(definitions))
+		for k := range definitions {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		for _, k := range names {
+			sch := definitions[k]
+			prev, ok := merged.Definitions[k]
+			if !ok {
+				merged.Definitions[k] = sch
+				origins[k] = extraPath
+				continue
+			}
+			same, err := sameSchema(prev, sch)
+			if err != nil {
+				return nil, err
+			}
+			if !same {
+				return nil, fmt.Errorf("conflicting definitions %q in %s and %s: a definition shared by several specs must be identical in all of them", k, origins[k], extraPath)
+			}
+		}
+	}
+	raw, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	return loads.Analyzed(raw, specDoc.Version())
+}
+
+// sameSchema returns true when two schemas have the same json representation
+func sameSchema(a, b spec.Schema) (bool, error) {
+	ja, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	jb, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(ja, jb), nil
+}
+
 func fileExists(target, name string) bool {
 	ffn := swag.ToFileName(name) + ".go"
 	_, err := os.Stat(filepath.Join(target, ffn))
@@ -170,21 +368,21 @@ func writeToFile(target, name string, content []byte) error {
 	return writeFile(target, ffn, res)
 }
 
-// func writeToTestFile(target, name string, content []byte) error {
-// 	ffn := swag.ToFileName(name)
-// 	if !strings.HasSuffix(ffn, "_test") {
-// 		ffn += "_test"
-// 	}
-// 	ffn += ".go"
-//
-// 	res, err := formatGoFile(filepath.Join(target, ffn), content)
-// 	if err != nil {
-// 		log.Println(err)
-// 		return writeFile(target, ffn, content)
-// 	}
-//
-// 	return writeFile(target, ffn, res)
-// }
+func writeToTestFile(target, name string, content []byte) error {
+	ffn := swag.ToFileName(name)
+	if !strings.HasSuffix(ffn, "_test") {
+		ffn += "_test"
+	}
+	ffn += ".go"
+
+	res, err := formatGoFile(filepath.Join(target, ffn), content)
+	if err != nil {
+		log.Println(err)
+		return writeFile(target, ffn, content)
+	}
+
+	return writeFile(target, ffn, res)
+}
 
 func writeFile(target, ffn string, content []byte) error {
 	if err := os.MkdirAll(target, 0755); err != nil {
@@ -1,6 +1,17 @@
 package generator
 
 import (
+	"encoding/pem"
+	"go/build"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -17,3 +28,221 @@ func TestFileNaming(t *testing.T) {
 		assert.Equal(t, v.Expected, stripTestFromFileName(v.Source))
 	}
 }
+
+func TestLoadSpec_BearerToken(t *testing.T) {
+	raw, err := ioutil.ReadFile("../fixtures/codegen/todolist.simple.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-yaml")
+		w.Write(raw)
+	}))
+	defer ts.Close()
+
+	_, specDoc, err := loadSpec(&GenOpts{Spec: ts.URL + "/swagger", SpecBearerToken: "s3cr3t"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Private to-do list", specDoc.Spec().Info.Title)
+	}
+
+	_, _, err = loadSpec(&GenOpts{Spec: ts.URL + "/swagger", SpecBearerToken: "wrong"})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "401 Unauthorized")
+	}
+
+	_, _, err = loadSpec(&GenOpts{Spec: ts.URL + "/swagger", SpecAuthHeader: "Authorization: Basic Zm9v", SpecBearerToken: "s3cr3t"})
+	assert.Error(t, err)
+}
+
+func TestLoadSpec_AuthRedirects(t *testing.T) {
+	raw, err := ioutil.ReadFile("../fixtures/codegen/todolist.simple.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	var leaked []string
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		leaked = append(leaked, r.Header.Get("X-Api-Key"))
+		w.Write(raw)
+	}))
+	defer other.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Header.Get("X-Api-Key") != "s3cr3t":
+			w.WriteHeader(http.StatusForbidden)
+		case r.URL.Path == "/latest":
+			// the credentials follow redirects on the same server
+			http.Redirect(w, r, "/v1/swagger.yml", http.StatusFound)
+		case r.URL.Path == "/mirror":
+			http.Redirect(w, r, other.URL+"/swagger.yml", http.StatusFound)
+		default:
+			w.Write(raw)
+		}
+	}))
+	defer ts.Close()
+
+	opts := &GenOpts{Spec: ts.URL + "/latest", SpecAuthHeader: "X-Api-Key: s3cr3t"}
+	_, specDoc, err := loadSpec(opts)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Private to-do list", specDoc.Spec().Info.Title)
+	}
+
+	// but they aren't sent to another server
+	opts.Spec = ts.URL + "/mirror"
+	if _, _, err := loadSpec(opts); assert.NoError(t, err) {
+		assert.Equal(t, []string{""}, leaked)
+	}
+}
+
+func TestLoadSpec_CACert(t *testing.T) {
+	raw, err := ioutil.ReadFile("../fixtures/codegen/todolist.simple.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write(raw)
+	}))
+	ts.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	defer ts.Close()
+
+	// the test server isn't signed by a known authority
+	_, _, err = loadSpec(&GenOpts{Spec: ts.URL + "/swagger.yml", SpecBearerToken: "s3cr3t"})
+	assert.Error(t, err)
+
+	f, err := ioutil.TempFile("", "spec-ca")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.Remove(f.Name())
+	pem.Encode(f, &pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	f.Close()
+
+	_, specDoc, err := loadSpec(&GenOpts{Spec: ts.URL + "/swagger.yml", SpecBearerToken: "s3cr3t", SpecCACert: f.Name()})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Private to-do list", specDoc.Spec().Info.Title)
+	}
+}
+
+func TestLoadSpec_AdditionalSpecs(t *testing.T) {
+	opts := &GenOpts{
+		Spec:       "../fixtures/codegen/todolist.merge.yml",
+		ExtraSpecs: []string{"../fixtures/codegen/todolist.merge-users.yml"},
+	}
+	_, specDoc, err := loadSpec(opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+	// the paths are the ones of the spec, the definitions are merged
+	assert.Equal(t, "Private to-do list", specDoc.Spec().Info.Title)
+	assert.Len(t, specDoc.Spec().Paths.Paths, 1)
+	assert.Len(t, specDoc.Spec().Definitions, 3)
+	for _, k := range []string{"Task", "Error", "User"} {
+		assert.Contains(t, specDoc.Spec().Definitions, k)
+		assert.Contains(t, specDoc.OrigSpec().Definitions, k)
+	}
+	resolver := newTypeResolver("models", specDoc)
+	assert.Contains(t, resolver.KnownDefs, "User")
+
+	// a merged definition refers to the shared one
+	genModel, err := makeGenDefinition("User", "models", specDoc.Spec().Definitions["User"], specDoc, true, true)
+	if assert.NoError(t, err) && assert.Len(t, genModel.Properties, 2) {
+		assert.Equal(t, "Error", genModel.Properties[0].GoType)
+	}
+
+	// a shared definition must be identical in all the specs
+	opts.ExtraSpecs = append(opts.ExtraSpecs, "../fixtures/codegen/todolist.merge-conflict.yml")
+	_, _, err = loadSpec(opts)
+	if assert.Error(t, err) {
+		assert.Equal(t, `conflicting definitions "Task" in ../fixtures/codegen/todolist.merge.yml and ../fixtures/codegen/todolist.merge-conflict.yml: a definition shared by several specs must be identical in all of them`, err.Error())
+	}
+}
+
+// goWorkspaceRoot is a GOPATH of its own, out of the source tree, where the tests compile and run
+// the code they generate: its vendor directory links the dependencies vendored by this repository.
+// It is set up before goimports scans the GOPATH, for the generated files to get their imports.
+var goWorkspaceRoot string
+
+// setupGoWorkspace creates the workspace of the tests and adds it to the GOPATH,
+// the returned func removes it.
+func setupGoWorkspace() (func(), error) {
+	vendor, err := filepath.Abs(filepath.FromSlash("../vendor"))
+	if err != nil {
+		return nil, err
+	}
+	root, err := ioutil.TempDir("", "swagger-gen")
+	if err != nil {
+		return nil, err
+	}
+	cleanup := func() { os.RemoveAll(root) }
+	gen := filepath.Join(root, "src", "gen")
+	if err := os.MkdirAll(gen, 0755); err != nil {
+		cleanup()
+		return nil, err
+	}
+	if err := os.Symlink(vendor, filepath.Join(gen, "vendor")); err != nil {
+		cleanup()
+		return nil, err
+	}
+	gopath := root + string(filepath.ListSeparator) + build.Default.GOPATH
+	build.Default.GOPATH = gopath
+	os.Setenv("GOPATH", gopath)
+	goWorkspaceRoot = root
+	return cleanup, nil
+}
+
+// goWorkspace is a directory of the workspace, holding the packages generated by a test
+type goWorkspace struct {
+	dir string
+}
+
+// newGoWorkspace creates a directory in the workspace removed at the end of the test,
+// or skips the test in short mode and without a go tool.
+func newGoWorkspace(t *testing.T) *goWorkspace {
+	if testing.Short() {
+		t.Skip("compiles and runs generated code")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go tool not available")
+	}
+	dir, err := ioutil.TempDir(filepath.Join(goWorkspaceRoot, "src", "gen"), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return &goWorkspace{dir: dir}
+}
+
+// Dir is the directory of a package of the workspace
+func (w *goWorkspace) Dir(pkg string) string {
+	return filepath.Join(w.dir, filepath.FromSlash(pkg))
+}
+
+// Import is the import path of a package of the workspace
+func (w *goWorkspace) Import(pkg string) string {
+	return path.Join("gen", filepath.Base(w.dir), pkg)
+}
+
+// WriteFile writes a go source file to a package of the workspace
+func (w *goWorkspace) WriteFile(pkg, name string, content []byte) error {
+	return writeToFile(w.Dir(pkg), name, content)
+}
+
+// Go runs the go tool in the directory of a package and returns the lines of its output
+func (w *goWorkspace) Go(t *testing.T, pkg string, args ...string) ([]string, bool) {
+	cmd := exec.Command("go", args...)
+	cmd.Dir = w.Dir(pkg)
+	cmd.Env = append(os.Environ(), "GOPATH="+goWorkspaceRoot, "GO111MODULE=off", "GOFLAGS=")
+	out, err := cmd.CombinedOutput()
+	if !assert.NoError(t, err, string(out)) {
+		return nil, false
+	}
+	return strings.Split(strings.TrimSpace(string(out)), "\n"), true
+}
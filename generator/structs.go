@@ -18,6 +18,10 @@ type GenDefinition struct {
 	DefaultImports []string
 	ExtraSchemas   []GenSchema
 	DependsOn      []string
+	WithPatch      bool
+	UnwrapProperty string
+	// PositionalSchemas are the schemas with positional items, parsed once per package
+	PositionalSchemas []GenSchema
 }
 
 // GenSchemaList is a list of schemas for generation.
@@ -33,7 +37,7 @@ func (g GenSchemaList) Less(i, j int) bool { return g[i].Name < g[j].Name }
 // GenSchema contains all the information needed to generate the code
 // for a schema
 type GenSchema struct {
-	resolvedType
+	ResolvedType
 	sharedValidations
 	Example                 string
 	Name                    string
@@ -50,18 +54,35 @@ type GenSchema struct {
 	AllowsAdditionalItems   bool
 	HasAdditionalItems      bool
 	AdditionalItems         *GenSchema
+	PositionalItems         string
+	PositionalItemsVar      string
 	Object                  *GenSchema
 	XMLName                 string
+	CustomTag               string
 	Properties              GenSchemaList
 	AllOf                   []GenSchema
+	EmbeddedRequired        []GenEmbeddedRequired
+	NotRequired             []GenNotRequired
+	PropertyCount           *GenPropertyCount
 	HasAdditionalProperties bool
 	IsAdditionalProperties  bool
 	AdditionalProperties    *GenSchema
+	KeyPattern              string
+	KeyFormat               string
+	KeyString               string
+	ValidateKeys            bool
+	ValidatesFormat         bool
 	ReadOnly                bool
+	Deprecated              bool
+	DocNote                 string
+	LegacyDocs              bool
+	LegacyEnums             bool
+	ExternalDocs            *spec.ExternalDocumentation
 	IsVirtual               bool
 	IsBaseType              bool
 	HasBaseType             bool
 	IsSubType               bool
+	HasPolymorphicItems     bool
 	IsExported              bool
 	DiscriminatorField      string
 	DiscriminatorValue      string
@@ -69,6 +90,72 @@ type GenSchema struct {
 	Parents                 []string
 	IncludeValidator        bool
 	IncludeModel            bool
+	HasContextValidations   bool
+	DirtyTracking           bool
+	HasBuilder              bool
+	DirtyFields             GenSchemaList
+	IsSensitive             bool
+	HasScrub                bool
+	ScrubFields             GenSchemaList
+	HasFieldMask            bool
+	FieldMaskFields         GenSchemaList
+	HasGob                  bool
+	HasDiff                 bool
+	DiffFields              GenSchemaList
+	// Union holds the variants of a oneOf or anyOf composition, named after their types
+	Union GenSchemaList
+	// HasExtraFields is true when the unknown fields of the JSON object are kept in an Extra map
+	HasExtraFields bool
+	// TypedErrors is true when the errors of the nested models are prefixed with their path, for ValidateStructured
+	TypedErrors bool
+	// SplitReadWrite is true when the model gets Request and Response variants, listing the properties
+	// sent in a request, the read-only ones left out, and the ones sent in a response, the write-only ones left out
+	SplitReadWrite bool
+	RequestFields  GenSchemaList
+	ResponseFields GenSchemaList
+	// Redacted is the value of a sensitive string once scrubbed, other sensitive values are cleared
+	Redacted string
+	// Default is the default value of the schema
+	Default interface{}
+	// Trim and Lowercase are set by x-trim and x-lowercase on the strings cleaned up by Normalize
+	Trim      bool
+	Lowercase bool
+	// DefaultValue is the go expression of the default Normalize applies to an unset property
+	DefaultValue    string
+	HasNormalize    bool
+	NormalizeFields GenSchemaList
+	// EnumConsts are the go constants declared for the enum values of the schema and of its properties
+	EnumConsts []GenEnumConst
+}
+
+// GenEnumConst is a go constant declared for an enum value
+type GenEnumConst struct {
+	Name   string
+	GoType string
+	Value  string
+}
+
+// GenEmbeddedRequired is a property of an embedded allOf member
+// which another member of the composition makes required
+type GenEmbeddedRequired struct {
+	Name     string
+	GoName   string
+	Embedded string
+}
+
+// GenNotRequired lists the properties of a not: { required: [...] },
+// which can't all be set together
+type GenNotRequired struct {
+	Names   []string
+	Message string
+}
+
+// GenPropertyCount bounds how many properties of an object are set, with maxProperties and minProperties.
+// The fields of a struct are always there, the ones holding their zero value are not counted.
+type GenPropertyCount struct {
+	Names []string
+	Min   *int64
+	Max   *int64
 }
 
 type sharedValidations struct {
@@ -87,6 +174,8 @@ type sharedValidations struct {
 	MinItems            *int64
 	MaxItems            *int64
 	UniqueItems         bool
+	MinDate             string
+	MaxDate             string
 	HasSliceValidations bool
 	NeedsSize           bool
 	NeedsValidation     bool
@@ -101,7 +190,10 @@ type GenResponse struct {
 	Name          string
 	Description   string
 
-	IsSuccess bool
+	IsSuccess     bool
+	IsEventStream bool
+	// IsSeekable is true for a binary success response which can serve a seekable content with its byte ranges
+	IsSeekable bool
 
 	Code               int
 	Method             string
@@ -116,7 +208,7 @@ type GenResponse struct {
 
 // GenHeader represents a header on a response for code generation
 type GenHeader struct {
-	resolvedType
+	ResolvedType
 	sharedValidations
 
 	Package      string
@@ -144,7 +236,7 @@ func (g GenHeaders) Less(i, j int) bool { return g[i].Name < g[j].Name }
 // GenParameter is used to represent
 // a parameter or a header for code generation.
 type GenParameter struct {
-	resolvedType
+	ResolvedType
 	sharedValidations
 
 	Name            string
@@ -173,6 +265,12 @@ type GenParameter struct {
 	Enum            []interface{}
 	ZeroValue       string
 	AllowEmptyValue bool
+	IsSensitive     bool
+
+	// EnumType is the name of the type generated server-side to bind an enum path or query param,
+	// EnumStatus is the status code answering a value out of the enum
+	EnumType   string
+	EnumStatus int
 }
 
 // IsQueryParam returns true when this parameter is a query param
@@ -205,6 +303,11 @@ func (g *GenParameter) IsFileParam() bool {
 	return g.SwaggerType == "file"
 }
 
+// IsFileArrayParam returns true when this parameter accepts several files
+func (g *GenParameter) IsFileArrayParam() bool {
+	return g.IsArray && g.Child != nil && g.Child.IsStream && g.Child.SwaggerType == "file"
+}
+
 // GenParameters represents a sorted parameter collection
 type GenParameters []GenParameter
 
@@ -212,10 +315,20 @@ func (g GenParameters) Len() int           { return len(g) }
 func (g GenParameters) Less(i, j int) bool { return g[i].Name < g[j].Name }
 func (g GenParameters) Swap(i, j int)      { g[i], g[j] = g[j], g[i] }
 
+// HasBodyParam returns true when one of the parameters is the body of the request
+func (g GenParameters) HasBodyParam() bool {
+	for _, p := range g {
+		if p.IsBodyParam() {
+			return true
+		}
+	}
+	return false
+}
+
 // GenItems represents the collection items for a collection parameter
 type GenItems struct {
 	sharedValidations
-	resolvedType
+	ResolvedType
 
 	Name             string
 	Path             string
@@ -318,6 +431,9 @@ type GenOperation struct {
 	SuccessResponse *GenResponse
 	Responses       GenStatusCodeResponses
 	DefaultResponse *GenResponse
+	// DefaultError is the schema the client decodes undeclared responses with,
+	// only set when the operation has no default response
+	DefaultError *GenSchema
 
 	Params               GenParameters
 	QueryParams          GenParameters
@@ -335,6 +451,28 @@ type GenOperation struct {
 	ProducesMediaTypes []string
 	ConsumesMediaTypes []string
 	WithContext        bool
+	WithLogging        bool
+	Deprecated         bool
+
+	WebSocket   *GenWebSocket
+	EventStream *GenEventStream
+}
+
+// GenWebSocket represents the messages exchanged over the connection of an
+// operation marked with x-websocket.
+// Receive is the message sent by the client, Send the one sent by the server.
+type GenWebSocket struct {
+	Receive *GenSchema
+	Send    *GenSchema
+}
+
+// GenEventStream represents the server-sent events of an operation producing text/event-stream.
+// Every event carries the Event schema, Name is written in the event field of the frames
+// and Code is the status of the response.
+type GenEventStream struct {
+	Name  string
+	Code  int
+	Event *GenSchema
 }
 
 // GenOperations represents a list of operations to generate
@@ -372,6 +510,60 @@ type GenApp struct {
 	SwaggerJSON         string
 	ExcludeSpec         bool
 	WithContext         bool
+	GoGenerate          string
+	HasEventStream      bool
+	// Server is the templated url of the server, which the clients build with the values of its variables
+	Server *GenServer
+	// Signature is the scheme the clients sign the bodies of their requests with
+	Signature *GenSignature
+	// ClientConfig are the settings of the clients, gathered in a struct they are created with
+	ClientConfig *GenClientConfig
+}
+
+// GenClientConfig is the configuration of the clients, like their base url, timeout and retries,
+// the Has fields tell which of the settings the clients apply are present
+type GenClientConfig struct {
+	Fields      []GenClientConfigField
+	HasHost     bool
+	HasBasePath bool
+	HasScheme   bool
+	HasTimeout  bool
+	HasRetries  bool
+	HasDuration bool
+}
+
+// GenClientConfigField is a setting of the configuration of the clients, with its default as a go literal
+type GenClientConfigField struct {
+	Name        string
+	GoName      string
+	GoType      string
+	Default     string
+	Description string
+}
+
+// GenSignature is a signature of the body of the requests, sent in a header,
+// like an HMAC-SHA256 of the body, hex encoded in X-Signature
+type GenSignature struct {
+	Scheme   string
+	Hash     string
+	Header   string
+	Encoding string
+	Prefix   string
+}
+
+// GenServer is a server url with variables, like {scheme}://{host}/api
+type GenServer struct {
+	URL       string
+	Variables []GenServerVariable
+}
+
+// GenServerVariable is a variable of a server url, with its default and the values it is restricted to
+type GenServerVariable struct {
+	Name        string
+	GoName      string
+	Default     string
+	Enum        []string
+	Description string
 }
 
 // GenSerGroup represents a group of serializers, most likely this is a media type to a list of
@@ -407,3 +599,29 @@ type GenSecurityScheme struct {
 	Source       string
 	Principal    string
 }
+
+// GenConformance represents the conformance test of a model, checking the generated type
+// against the example of its schema
+type GenConformance struct {
+	Package   string
+	Name      string
+	GoType    string
+	Example   string
+	Mutations []GenMutation
+}
+
+// GenBenchmark represents the benchmarks of the marshaling and unmarshaling of a model,
+// seeded with the example of its schema
+type GenBenchmark struct {
+	Package string
+	Name    string
+	GoType  string
+	Example string
+}
+
+// GenMutation is a change to the example of a schema which breaks one of its constraints,
+// like a required property removed or a value out of range
+type GenMutation struct {
+	Name string
+	JSON string
+}
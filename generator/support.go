@@ -24,7 +24,9 @@ import (
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/analysis"
 	"github.com/go-openapi/loads"
@@ -43,6 +45,16 @@ func GenerateServer(name string, modelNames, operationIDs []string, opts GenOpts
 	return generator.Generate()
 }
 
+// GenerateValidator generates the models and a middleware validating the requests of the selected operations
+func GenerateValidator(name string, modelNames, operationIDs []string, opts GenOpts) error {
+	opts.RequestValidator = true
+	generator, err := newAppGenerator(name, modelNames, operationIDs, &opts)
+	if err != nil {
+		return err
+	}
+	return generator.Generate()
+}
+
 // GenerateSupport generates the supporting files for an API
 func GenerateSupport(name string, modelNames, operationIDs []string, opts GenOpts) error {
 
@@ -55,6 +67,10 @@ func GenerateSupport(name string, modelNames, operationIDs []string, opts GenOpt
 
 func newAppGenerator(name string, modelNames, operationIDs []string, opts *GenOpts) (*appGenerator, error) {
 
+	if err := opts.checkStandalone(); err != nil {
+		return nil, err
+	}
+
 	if opts.TemplateDir != "" {
 		if err := templates.LoadDir(opts.TemplateDir); err != nil {
 			return nil, err
@@ -64,7 +80,7 @@ func newAppGenerator(name string, modelNames, operationIDs []string, opts *GenOp
 	compileTemplates()
 
 	// Load the spec
-	_, specDoc, err := loadSpec(opts.Spec)
+	_, specDoc, err := loadSpec(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +90,7 @@ func newAppGenerator(name string, modelNames, operationIDs []string, opts *GenOp
 	if err != nil {
 		return nil, err
 	}
+	opts.dropMappedModels(models)
 	operations := gatherOperations(analyzed, operationIDs)
 	if len(operations) == 0 {
 		return nil, errors.New("no operations were selected")
@@ -96,13 +113,15 @@ func newAppGenerator(name string, modelNames, operationIDs []string, opts *GenOp
 
 	apiPackage := mangleName(swag.ToFileName(opts.APIPackage), "api")
 	return &appGenerator{
-		Name:       appNameOrDefault(specDoc, name, "swagger"),
-		Receiver:   "o",
-		SpecDoc:    specDoc,
-		Analyzed:   analyzed,
-		Models:     models,
-		Operations: operations,
-		Target:     opts.Target,
+		Name:         appNameOrDefault(specDoc, name, "swagger"),
+		ModelNames:   modelNames,
+		OperationIDs: operationIDs,
+		Receiver:     "o",
+		SpecDoc:      specDoc,
+		Analyzed:     analyzed,
+		Models:       models,
+		Operations:   operations,
+		Target:       opts.Target,
 		// Package:       filepath.Base(opts.Target),
 		DumpData:        opts.DumpData,
 		Package:         apiPackage,
@@ -137,6 +156,10 @@ type appGenerator struct {
 	DefaultProduces string
 	DefaultConsumes string
 	GenOpts         *GenOpts
+
+	// the models and operations selected on the command line
+	ModelNames   []string
+	OperationIDs []string
 }
 
 func baseImport(tgt string) string {
@@ -207,9 +230,58 @@ func (a *appGenerator) Generate() error {
 				}
 			})
 		}
+		if a.GenOpts.SingleChar != "" && usesSingleChar(a.SpecDoc.Spec()) {
+			if err := generateChar(filepath.Join(a.Target, a.ModelsPackage), a.GenOpts.SingleChar); err != nil {
+				errChan <- err
+			}
+		}
+		if usesFormat(a.SpecDoc.Spec(), "time") {
+			if err := generateTimeOfDay(filepath.Join(a.Target, a.ModelsPackage)); err != nil {
+				errChan <- err
+			}
+		}
+		if a.GenOpts.WithGob {
+			if err := generateGobRegistry(filepath.Join(a.Target, a.ModelsPackage), gobTypes(a.SpecDoc, a.ModelNames)); err != nil {
+				errChan <- err
+			}
+		}
+		if a.GenOpts.WithDiff {
+			if err := generateDiff(filepath.Join(a.Target, a.ModelsPackage)); err != nil {
+				errChan <- err
+			}
+		}
+		if a.GenOpts.TypedErrors {
+			if err := generateValidationErrors(filepath.Join(a.Target, a.ModelsPackage)); err != nil {
+				errChan <- err
+			}
+		}
+		if a.GenOpts.SchemaRegistry {
+			if err := generateSchemaRegistry(filepath.Join(a.Target, a.ModelsPackage), a.SpecDoc.Spec(), a.ModelNames); err != nil {
+				errChan <- err
+			}
+		}
+		if a.GenOpts.formatRegistry() {
+			formats, imports := customFormats(a.SpecDoc.Spec(), a.GenOpts.ContextFormats)
+			if err := generateFormatRegistry(filepath.Join(a.Target, a.ModelsPackage), formats, imports); err != nil {
+				errChan <- err
+			}
+		}
 	}
 	wg.Wait()
 
+	if a.GenOpts.StandaloneServer {
+		if len(errChan) > 0 {
+			return <-errChan
+		}
+		return a.generateStandaloneServer(&app)
+	}
+	if a.GenOpts.RequestValidator {
+		if len(errChan) > 0 {
+			return <-errChan
+		}
+		return a.generateRequestValidator(&app)
+	}
+
 	if a.GenOpts.IncludeHandler {
 		for _, opg := range app.OperationGroups {
 			opgCopy := opg
@@ -266,6 +338,13 @@ func (a *appGenerator) GenerateSupport(ap *GenApp) error {
 		app = &ca
 	}
 
+	if a.GenOpts != nil && a.GenOpts.StandaloneServer {
+		return a.generateStandaloneServer(app)
+	}
+	if a.GenOpts != nil && a.GenOpts.RequestValidator {
+		return a.generateRequestValidator(app)
+	}
+
 	if a.GenOpts == nil || !a.GenOpts.ExcludeSpec {
 		if err := a.generateEmbeddedSwaggerJSON(app); err != nil {
 			return err
@@ -283,6 +362,12 @@ func (a *appGenerator) GenerateSupport(ap *GenApp) error {
 		return err
 	}
 
+	if a.GenOpts != nil && a.GenOpts.RouteMap {
+		if err := a.generateRouteMap(app); err != nil {
+			return err
+		}
+	}
+
 	if err := a.generateAPIServer(app); err != nil {
 		return err
 	}
@@ -326,14 +411,165 @@ func (a *appGenerator) generateMain(app *GenApp) error {
 		log.Println("skipped (already exists) main template:", app.Package+".Main")
 		return nil
 	}
+	appc := *app
+	if a.GenOpts != nil && a.GenOpts.GoGenerate {
+		cmd, err := a.goGenerateCommand("server", pth)
+		if err != nil {
+			return err
+		}
+		appc.GoGenerate = cmd
+	}
 	buf := bytes.NewBuffer(nil)
-	if err := mainTemplate.Execute(buf, app); err != nil {
+	if err := mainTemplate.Execute(buf, &appc); err != nil {
 		return err
 	}
 	log.Println("rendered main template:", "server."+swag.ToGoName(app.Name))
 	return writeToFile(pth, "main", buf.Bytes())
 }
 
+// goGenerateCommand renders the swagger command reproducing this generation for a
+// //go:generate directive, go generate runs it from dir so the paths are made relative to it
+func (a *appGenerator) goGenerateCommand(command, dir string) (string, error) {
+	opts := a.GenOpts
+	args := []string{"swagger", "generate", command}
+	flag := func(name string, values ...string) {
+		for _, v := range values {
+			args = append(args, "--"+name, quoteGoGenerateArg(v))
+		}
+	}
+	path := func(name, value string) error {
+		if value == "" {
+			return nil
+		}
+		if strings.Contains(value, "://") {
+			flag(name, value)
+			return nil
+		}
+		rel, err := relativePath(dir, value)
+		if err != nil {
+			return err
+		}
+		flag(name, rel)
+		return nil
+	}
+	toggle := func(name string, on bool) {
+		if on {
+			args = append(args, "--"+name)
+		}
+	}
+
+	if err := path("spec", opts.Spec); err != nil {
+		return "", err
+	}
+	for _, extra := range opts.ExtraSpecs {
+		if err := path("additional-spec", extra); err != nil {
+			return "", err
+		}
+	}
+	if err := path("target", a.Target); err != nil {
+		return "", err
+	}
+	if err := path("template-dir", opts.TemplateDir); err != nil {
+		return "", err
+	}
+	// the credentials of a remote spec are left out, they don't belong in the generated code
+	if err := path("spec-ca-cert", opts.SpecCACert); err != nil {
+		return "", err
+	}
+	flag("name", a.Name)
+	flag("api-package", opts.APIPackage)
+	flag("model-package", opts.ModelPackage)
+	flag("server-package", opts.ServerPackage)
+	flag("client-package", opts.ClientPackage)
+	if opts.Principal != "" {
+		flag("principal", opts.Principal)
+	}
+	flag("default-scheme", a.DefaultScheme)
+	if command == "client" {
+		flag("default-produces", a.DefaultProduces)
+		if opts.DefaultError != "" {
+			flag("default-error", opts.DefaultError)
+		}
+	}
+	flag("operation", a.OperationIDs...)
+	flag("model", a.ModelNames...)
+	toggle("skip-models", !opts.IncludeModel)
+	toggle("skip-operations", !opts.IncludeHandler)
+	if command == "server" {
+		toggle("skip-support", !opts.IncludeSupport)
+		toggle("exclude-main", !opts.IncludeMain)
+		toggle("exclude-spec", opts.ExcludeSpec)
+		toggle("standalone-server", opts.StandaloneServer)
+		toggle("with-context", opts.WithContext)
+		toggle("with-logging", opts.WithLogging)
+		toggle("with-route-map", opts.RouteMap)
+	}
+	toggle("tuples-as-slices", opts.TuplesAsSlices)
+	toggle("omit-ignored", opts.OmitIgnored)
+	toggle("strict", opts.Strict)
+	flag("context-format", opts.ContextFormats...)
+	toggle("net-ip", opts.NetIP)
+	toggle("plain-bytes", opts.PlainBytes)
+	if opts.SingleChar != "" {
+		flag("single-char", opts.SingleChar)
+	}
+	toggle("with-dirty-tracking", opts.DirtyTracking)
+	toggle("with-builder", opts.WithBuilder)
+	toggle("fixed-arrays", opts.FixedArrays)
+	toggle("with-scrub", opts.WithScrub)
+	toggle("with-field-mask", opts.WithFieldMask)
+	toggle("with-gob", opts.WithGob)
+	toggle("with-extra-fields", opts.ExtraFields)
+	toggle("with-diff", opts.WithDiff)
+	toggle("with-schema-registry", opts.SchemaRegistry)
+	toggle("with-normalize", opts.WithNormalize)
+	toggle("with-structured-validation", opts.TypedErrors)
+	toggle("split-read-write", opts.SplitReadWrite)
+	toggle("seekable-streams", opts.SeekableStreams)
+	toggle("skip-format-registry", opts.SkipFormats)
+	if opts.CompatMode != "" {
+		flag("compat-mode", opts.CompatMode)
+	}
+	if opts.NullablePolicy != "" && opts.NullablePolicy != NullableSpec {
+		flag("nullable-policy", string(opts.NullablePolicy))
+	}
+	if err := path("type-mapping", opts.TypeMappingFile); err != nil {
+		return "", err
+	}
+	if opts.EnumStatus != 0 && opts.EnumStatus != 400 {
+		flag("enum-status", strconv.Itoa(opts.EnumStatus))
+	}
+	if opts.GoVersion != "" {
+		flag("go-version", opts.GoVersion)
+	}
+	toggle("go-generate", true)
+	return strings.Join(args, " "), nil
+}
+
+// quoteGoGenerateArg quotes an argument when go generate would otherwise split it
+func quoteGoGenerateArg(arg string) string {
+	if arg == "" || strings.ContainsAny(arg, " \t\"\\") {
+		return strconv.Quote(arg)
+	}
+	return arg
+}
+
+func relativePath(base, target string) (string, error) {
+	absBase, err := filepath.Abs(base)
+	if err != nil {
+		return "", err
+	}
+	absTarget, err := filepath.Abs(target)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(absBase, absTarget)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
 func (a *appGenerator) generateEmbeddedSwaggerJSON(app *GenApp) error {
 	buf := bytes.NewBuffer(nil)
 	appc := *app
@@ -354,6 +590,17 @@ func (a *appGenerator) generateAPIBuilder(app *GenApp) error {
 	return writeToFile(filepath.Join(a.Target, a.ServerPackage, app.Package), swag.ToGoName(app.Name)+"Api", buf.Bytes())
 }
 
+// generateRouteMap renders a map of the method and path of the operations to their param binders
+// and body models, for plugging them into another router
+func (a *appGenerator) generateRouteMap(app *GenApp) error {
+	buf := bytes.NewBuffer(nil)
+	if err := routeMapTemplate.Execute(buf, app); err != nil {
+		return err
+	}
+	log.Println("rendered route map template:", app.Package+"."+swag.ToGoName(app.Name)+"Routes")
+	return writeToFile(filepath.Join(a.Target, a.ServerPackage, app.Package), swag.ToGoName(app.Name)+"Routes", buf.Bytes())
+}
+
 func (a *appGenerator) generateAPIServer(app *GenApp) error {
 	buf := bytes.NewBuffer(nil)
 	if err := serverTemplate.Execute(buf, app); err != nil {
@@ -363,6 +610,104 @@ func (a *appGenerator) generateAPIServer(app *GenApp) error {
 	return writeToFile(filepath.Join(a.Target, a.ServerPackage), "Server", buf.Bytes())
 }
 
+// generateStandaloneServer renders a router, the param binders and a handler interface
+// for all the operations, which only depend on net/http instead of the go-openapi runtime.
+func (a *appGenerator) generateStandaloneServer(app *GenApp) error {
+	appc, err := a.makeStandaloneApp(app)
+	if err != nil {
+		return err
+	}
+	pth := filepath.Join(a.Target, a.ServerPackage)
+	if a.GenOpts != nil && a.GenOpts.GoGenerate {
+		cmd, err := a.goGenerateCommand("server", pth)
+		if err != nil {
+			return err
+		}
+		appc.GoGenerate = cmd
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := standaloneServerTemplate.Execute(buf, appc); err != nil {
+		return err
+	}
+	log.Println("rendered standalone server template:", app.APIPackage+"."+swag.ToGoName(app.Name))
+	return writeToFile(pth, swag.ToGoName(app.Name)+"Server", buf.Bytes())
+}
+
+// generateRequestValidator renders a net/http middleware which binds and validates the requests
+// of all the operations with the param binders of the standalone server, before passing them on.
+func (a *appGenerator) generateRequestValidator(app *GenApp) error {
+	appc, err := a.makeStandaloneApp(app)
+	if err != nil {
+		return err
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := requestValidatorTemplate.Execute(buf, appc); err != nil {
+		return err
+	}
+	log.Println("rendered request validator template:", app.APIPackage+"."+swag.ToGoName(app.Name))
+	return writeToFile(filepath.Join(a.Target, a.ServerPackage), swag.ToGoName(app.Name)+"Validator", buf.Bytes())
+}
+
+func (a *appGenerator) makeStandaloneApp(app *GenApp) (*GenApp, error) {
+	appc := *app
+	appc.DefaultImports = []string{filepath.ToSlash(filepath.Join(baseImport(a.Target), a.ModelsPackage))}
+	appc.Operations = nil
+
+	// an operation with several tags appears once per tag, but there is only one router
+	seen := make(map[string]bool, len(app.Operations))
+	for _, op := range app.Operations {
+		if seen[op.Name] {
+			continue
+		}
+		seen[op.Name] = true
+		if err := checkStandaloneOperation(op); err != nil {
+			return nil, err
+		}
+		if op.WebSocket != nil {
+			stripWebSocketBody(&op)
+			if _, ok := appc.Imports["websocket"]; !ok {
+				imports := make(map[string]string, len(app.Imports)+1)
+				for k, v := range app.Imports {
+					imports[k] = v
+				}
+				imports["websocket"] = "github.com/gorilla/websocket"
+				appc.Imports = imports
+			}
+		}
+		appc.Operations = append(appc.Operations, op)
+	}
+	return &appc, nil
+}
+
+// checkStandaloneOperation verifies an operation can be served without the runtime,
+// which means it consumes and produces JSON only.
+func checkStandaloneOperation(op GenOperation) error {
+	for _, mt := range op.ConsumesMediaTypes {
+		if nm, _ := mediaTypeName(mt); nm != "json" {
+			return fmt.Errorf("operation %q consumes %s, the standalone server only supports JSON", op.Name, mt)
+		}
+	}
+	for _, mt := range op.ProducesMediaTypes {
+		if nm, _ := mediaTypeName(mt); nm != "json" && (nm != "eventStream" || op.EventStream == nil) {
+			return fmt.Errorf("operation %q produces %s, the standalone server only supports JSON", op.Name, mt)
+		}
+	}
+	if op.HasFormParams {
+		return fmt.Errorf("operation %q takes form parameters, the standalone server only supports JSON", op.Name)
+	}
+	if op.HasStreamingResponse {
+		return fmt.Errorf("operation %q streams its response, the standalone server only supports JSON", op.Name)
+	}
+	for _, p := range op.Params {
+		if p.IsBodyParam() && p.Schema != nil && p.Schema.IsBaseType {
+			return fmt.Errorf("operation %q takes a polymorphic body, which the standalone server can't bind", op.Name)
+		}
+	}
+	return nil
+}
+
 func (a *appGenerator) generateDoc(app *GenApp) error {
 	buf := bytes.NewBuffer(nil)
 	if err := mainDocTemplate.Execute(buf, app); err != nil {
@@ -386,6 +731,7 @@ var mediaTypeNames = map[*regexp.Regexp]string{
 	regexp.MustCompile("text/.*javascript"):                 "js",
 	regexp.MustCompile("text/.*css"):                        "css",
 	regexp.MustCompile("text/.*plain"):                      "txt",
+	regexp.MustCompile("text/event-stream"):                 "eventStream",
 	regexp.MustCompile("application/.*octet-stream"):        "bin",
 	regexp.MustCompile("application/.*tar"):                 "tar",
 	regexp.MustCompile("application/.*gzip"):                "gzip",
@@ -401,6 +747,7 @@ var knownProducers = map[string]string{
 	"xml":           "runtime.XMLProducer()",
 	"txt":           "runtime.TextProducer()",
 	"bin":           "runtime.ByteStreamProducer()",
+	"eventStream":   "runtime.ByteStreamProducer()",
 	"urlform":       "runtime.DiscardProducer",
 	"multipartform": "runtime.DiscardProducer",
 }
@@ -411,6 +758,7 @@ var knownConsumers = map[string]string{
 	"xml":           "runtime.XMLConsumer()",
 	"txt":           "runtime.TextConsumer()",
 	"bin":           "runtime.ByteStreamConsumer()",
+	"eventStream":   "runtime.ByteStreamConsumer()",
 	"urlform":       "runtime.DiscardConsumer",
 	"multipartform": "runtime.DiscardConsumer",
 }
@@ -609,13 +957,15 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 
 	log.Println("planning definitions")
 	for mn, m := range a.Models {
-		mod, err := makeGenDefinition(
+		mod, err := makeGenDefinitionHierarchy(
 			mn,
 			a.ModelsPackage,
+			"",
 			m,
 			a.SpecDoc,
 			true,
 			true,
+			a.GenOpts,
 		)
 		if err != nil {
 			return GenApp{}, err
@@ -626,6 +976,7 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 
 	log.Println("planning operations")
 	tns := make(map[string]struct{})
+	shared := make(sharedParams)
 	var genOps GenOperations
 	for on, opp := range a.Operations {
 		o := opp.Op
@@ -647,6 +998,9 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 		ap := a.APIPackage
 		bldr.RootAPIPackage = swag.ToFileName(a.APIPackage)
 		bldr.WithContext = a.GenOpts != nil && a.GenOpts.WithContext
+		bldr.WithLogging = a.GenOpts != nil && a.GenOpts.WithLogging
+		bldr.GenOpts = a.GenOpts
+		bldr.SharedParams = shared
 		if len(o.Tags) > 0 {
 			for _, tag := range o.Tags {
 				tns[tag] = struct{}{}
@@ -708,9 +1062,11 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 
 	var collectedSchemes []string
 	var extraSchemes []string
+	var hasEventStream bool
 	for _, op := range genOps {
 		collectedSchemes = concatUnique(collectedSchemes, op.Schemes)
 		extraSchemes = concatUnique(extraSchemes, op.ExtraSchemes)
+		hasEventStream = hasEventStream || op.EventStream != nil
 	}
 
 	host := "localhost"
@@ -723,6 +1079,22 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 		basePath = sw.BasePath
 	}
 
+	server, err := makeGenServer(sw)
+	if err != nil {
+		return GenApp{}, err
+	}
+
+	signature, err := makeGenSignature(sw)
+	if err != nil {
+		return GenApp{}, err
+	}
+
+	schemes := schemeOrDefault(collectedSchemes, a.DefaultScheme)
+	clientConfig, err := makeGenClientConfig(sw, host, basePath, schemes[0])
+	if err != nil {
+		return GenApp{}, err
+	}
+
 	return GenApp{
 		APIPackage:          a.ServerPackage,
 		Package:             a.Package,
@@ -730,7 +1102,7 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 		Name:                a.Name,
 		Host:                host,
 		BasePath:            basePath,
-		Schemes:             schemeOrDefault(collectedSchemes, a.DefaultScheme),
+		Schemes:             schemes,
 		ExtraSchemes:        extraSchemes,
 		ExternalDocs:        sw.ExternalDocs,
 		Info:                sw.Info,
@@ -747,5 +1119,244 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 		SwaggerJSON:         fmt.Sprintf("%#v", jsonb),
 		ExcludeSpec:         a.GenOpts != nil && a.GenOpts.ExcludeSpec,
 		WithContext:         a.GenOpts != nil && a.GenOpts.WithContext,
+		HasEventStream:      hasEventStream,
+		Server:              server,
+		Signature:           signature,
+		ClientConfig:        clientConfig,
 	}, nil
 }
+
+// xSignature describes how the clients sign the bodies of their requests
+const xSignature = "x-signature"
+
+// signatureHashes are the hash packages of the signature schemes
+var signatureHashes = map[string]string{
+	"hmac-sha1":   "sha1",
+	"hmac-sha256": "sha256",
+	"hmac-sha512": "sha512",
+}
+
+// makeGenSignature reads the signature scheme kept in x-signature, the header defaults to X-Signature
+// and the signature is hex encoded unless its encoding is base64
+func makeGenSignature(sw *spec.Swagger) (*GenSignature, error) {
+	v, ok := sw.Extensions[xSignature]
+	if !ok {
+		return nil, nil
+	}
+	var signature struct {
+		Scheme   string `json:"scheme"`
+		Header   string `json:"header"`
+		Encoding string `json:"encoding"`
+		Prefix   string `json:"prefix"`
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &signature); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", xSignature, err)
+	}
+
+	hash, ok := signatureHashes[strings.ToLower(signature.Scheme)]
+	if !ok {
+		return nil, fmt.Errorf("invalid %s: unsupported scheme %q, expected one of: hmac-sha1, hmac-sha256, hmac-sha512", xSignature, signature.Scheme)
+	}
+	result := &GenSignature{
+		Scheme:   strings.ToLower(signature.Scheme),
+		Hash:     hash,
+		Header:   signature.Header,
+		Encoding: strings.ToLower(signature.Encoding),
+		Prefix:   signature.Prefix,
+	}
+	if result.Header == "" {
+		result.Header = "X-Signature"
+	}
+	switch result.Encoding {
+	case "":
+		result.Encoding = "hex"
+	case "hex", "base64":
+	default:
+		return nil, fmt.Errorf("invalid %s: unsupported encoding %q, expected hex or base64", xSignature, signature.Encoding)
+	}
+	return result, nil
+}
+
+// makeGenServer reads the templated server url and its variables kept in x-server,
+// like the ones of an OpenAPI 3 document converted to swagger 2.0
+func makeGenServer(sw *spec.Swagger) (*GenServer, error) {
+	v, ok := sw.Extensions[xServer]
+	if !ok {
+		return nil, nil
+	}
+	var server struct {
+		URL       string `json:"url"`
+		Variables map[string]struct {
+			Default     string   `json:"default"`
+			Enum        []string `json:"enum"`
+			Description string   `json:"description"`
+		} `json:"variables"`
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &server); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", xServer, err)
+	}
+	if server.URL == "" {
+		return nil, fmt.Errorf("invalid %s: the url is missing", xServer)
+	}
+
+	names := make([]string, 0, len(server.Variables))
+	for name := range server.Variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := &GenServer{URL: server.URL}
+	for _, name := range names {
+		variable := server.Variables[name]
+		if len(variable.Enum) > 0 && !containsString(variable.Enum, variable.Default) {
+			return nil, fmt.Errorf("invalid %s: the default %q of the variable %s is not one of its enum", xServer, variable.Default, name)
+		}
+		result.Variables = append(result.Variables, GenServerVariable{
+			Name:        name,
+			GoName:      pascalize(name),
+			Default:     variable.Default,
+			Enum:        variable.Enum,
+			Description: variable.Description,
+		})
+	}
+	return result, nil
+}
+
+// xClientConfig describes the settings of the clients, gathered in a ClientConfig struct
+const xClientConfig = "x-client-config"
+
+// clientConfigTypes are the go types of the settings of the clients
+var clientConfigTypes = map[string]string{
+	"string":   "string",
+	"integer":  "int",
+	"number":   "float64",
+	"boolean":  "bool",
+	"duration": "time.Duration",
+}
+
+// clientConfigSettings are the types of the settings the clients apply, the other ones are only carried in the config
+var clientConfigSettings = map[string]string{
+	"host":     "string",
+	"basePath": "string",
+	"scheme":   "string",
+	"timeout":  "duration",
+	"retries":  "integer",
+}
+
+// makeGenClientConfig reads the settings of the clients kept in x-client-config. The host, base path
+// and scheme default to the ones of the spec, the timeout to 30 seconds and the other settings to their zero value
+func makeGenClientConfig(sw *spec.Swagger, host, basePath, scheme string) (*GenClientConfig, error) {
+	v, ok := sw.Extensions[xClientConfig]
+	if !ok {
+		return nil, nil
+	}
+	var fields map[string]struct {
+		Type        string      `json:"type"`
+		Default     interface{} `json:"default"`
+		Description string      `json:"description"`
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", xClientConfig, err)
+	}
+
+	defaults := map[string]interface{}{
+		"host":     host,
+		"basePath": basePath,
+		"scheme":   scheme,
+		"timeout":  "30s",
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := new(GenClientConfig)
+	for _, name := range names {
+		field := fields[name]
+		goType, ok := clientConfigTypes[field.Type]
+		if !ok {
+			return nil, fmt.Errorf("invalid %s: unsupported type %q for the setting %s, expected one of: string, integer, number, boolean, duration", xClientConfig, field.Type, name)
+		}
+		if expected, ok := clientConfigSettings[name]; ok && expected != field.Type {
+			return nil, fmt.Errorf("invalid %s: the setting %s must be of type %s", xClientConfig, name, expected)
+		}
+		value := field.Default
+		if value == nil {
+			value = defaults[name]
+		}
+		literal, err := clientConfigLiteral(field.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: the default of the setting %s %v", xClientConfig, name, err)
+		}
+
+		result.Fields = append(result.Fields, GenClientConfigField{
+			Name:        name,
+			GoName:      pascalize(name),
+			GoType:      goType,
+			Default:     literal,
+			Description: field.Description,
+		})
+		switch name {
+		case "host":
+			result.HasHost = true
+		case "basePath":
+			result.HasBasePath = true
+		case "scheme":
+			result.HasScheme = true
+		case "timeout":
+			result.HasTimeout = true
+		case "retries":
+			result.HasRetries = true
+		}
+		result.HasDuration = result.HasDuration || field.Type == "duration"
+	}
+	return result, nil
+}
+
+// clientConfigLiteral renders the default of a setting as a go literal, empty when there is no default
+func clientConfigLiteral(tpe string, value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	switch tpe {
+	case "string":
+		if s, ok := value.(string); ok {
+			return asGoLiteral(s), nil
+		}
+	case "integer":
+		if f, ok := value.(float64); ok && f == float64(int64(f)) {
+			return strconv.FormatInt(int64(f), 10), nil
+		}
+	case "number":
+		if f, ok := value.(float64); ok {
+			return asGoLiteral(f), nil
+		}
+	case "boolean":
+		if b, ok := value.(bool); ok {
+			return asGoLiteral(b), nil
+		}
+	case "duration":
+		if s, ok := value.(string); ok {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return "", fmt.Errorf("is not a duration: %v", err)
+			}
+			return asDurationLiteral(d), nil
+		}
+	}
+	return "", fmt.Errorf("is not a %s: %v", tpe, value)
+}
@@ -29,6 +29,13 @@ var protectedTemplates = map[string]bool{
 	"propertyValidationDocString":    true,
 	"typeSchemaType":                 true,
 	"docstring":                      true,
+	"docComment":                     true,
+	"fieldDoc":                       true,
+	"typeDoc":                        true,
+	"typeDocComment":                 true,
+	"docLinksDocComment":             true,
+	"deprecatedDocComment":           true,
+	"propertyValidationDocComment":   true,
 	"dereffedSchemaType":             true,
 	"model":                          true,
 	"modelvalidator":                 true,
@@ -81,6 +88,7 @@ var FuncMap template.FuncMap = map[string]interface{}{
 	},
 	"json":       asJSON,
 	"prettyjson": asPrettyJSON,
+	"goLiteral":  asGoLiteral,
 	"hasInsecure": func(arg []string) bool {
 		return swag.ContainsStringsCI(arg, "http") || swag.ContainsStringsCI(arg, "ws")
 	},
@@ -106,6 +114,8 @@ var FuncMap template.FuncMap = map[string]interface{}{
 	"upper": func(str string) string {
 		return strings.ToUpper(str)
 	},
+	"timeLiteral": asTimeLiteral,
+	"comment":     asComment,
 	"contains": func(coll []string, arg string) bool {
 		for _, v := range coll {
 			if v == arg {
@@ -16,7 +16,11 @@ package generator
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 	"text/template"
+	"time"
 )
 
 //go:generate go-bindata -pkg=generator -ignore=.*\.sw? ./templates/...
@@ -24,7 +28,18 @@ import (
 // fwiw, don't get attached to this, still requires a better abstraction
 
 var (
-	modelTemplate *template.Template
+	modelTemplate     *template.Template
+	timeOfDayTemplate *template.Template
+	charTemplate      *template.Template
+	diffTemplate      *template.Template
+	gobTemplate       *template.Template
+	formatsTemplate   *template.Template
+	schemasTemplate   *template.Template
+
+	conformanceTemplate        *template.Template
+	conformanceHelpersTemplate *template.Template
+	benchmarkTemplate          *template.Template
+	validationErrorsTemplate   *template.Template
 	// modelValidatorTemplate *template.Template
 	operationTemplate      *template.Template
 	parameterTemplate      *template.Template
@@ -39,6 +54,10 @@ var (
 	clientParamTemplate    *template.Template
 	clientResponseTemplate *template.Template
 	clientFacadeTemplate   *template.Template
+
+	standaloneServerTemplate *template.Template
+	requestValidatorTemplate *template.Template
+	routeMapTemplate         *template.Template
 )
 
 var assets = map[string][]byte{
@@ -57,6 +76,33 @@ var assets = map[string][]byte{
 	"model.gotmpl":                          MustAsset("templates/model.gotmpl"),
 	"header.gotmpl":                         MustAsset("templates/header.gotmpl"),
 	"swagger_json_embed.gotmpl":             MustAsset("templates/swagger_json_embed.gotmpl"),
+	"patch.gotmpl":                          MustAsset("templates/patch.gotmpl"),
+	"readwrite.gotmpl":                      MustAsset("templates/readwrite.gotmpl"),
+	"contextvalidator.gotmpl":               MustAsset("templates/contextvalidator.gotmpl"),
+	"dirtytracking.gotmpl":                  MustAsset("templates/dirtytracking.gotmpl"),
+	"unwrapserializer.gotmpl":               MustAsset("templates/unwrapserializer.gotmpl"),
+	"builder.gotmpl":                        MustAsset("templates/builder.gotmpl"),
+	"fixedarray.gotmpl":                     MustAsset("templates/fixedarray.gotmpl"),
+	"polymorphicslice.gotmpl":               MustAsset("templates/polymorphicslice.gotmpl"),
+	"scrub.gotmpl":                          MustAsset("templates/scrub.gotmpl"),
+	"normalize.gotmpl":                      MustAsset("templates/normalize.gotmpl"),
+	"enumconsts.gotmpl":                     MustAsset("templates/enumconsts.gotmpl"),
+	"fieldmask.gotmpl":                      MustAsset("templates/fieldmask.gotmpl"),
+	"timeofday.gotmpl":                      MustAsset("templates/timeofday.gotmpl"),
+	"char.gotmpl":                           MustAsset("templates/char.gotmpl"),
+	"charserializer.gotmpl":                 MustAsset("templates/charserializer.gotmpl"),
+	"extrafields.gotmpl":                    MustAsset("templates/extrafields.gotmpl"),
+	"diff.gotmpl":                           MustAsset("templates/diff.gotmpl"),
+	"modeldiff.gotmpl":                      MustAsset("templates/modeldiff.gotmpl"),
+	"union.gotmpl":                          MustAsset("templates/union.gotmpl"),
+	"gob.gotmpl":                            MustAsset("templates/gob.gotmpl"),
+	"gobregistry.gotmpl":                    MustAsset("templates/gobregistry.gotmpl"),
+	"formatregistry.gotmpl":                 MustAsset("templates/formatregistry.gotmpl"),
+	"schemaregistry.gotmpl":                 MustAsset("templates/schemaregistry.gotmpl"),
+	"validationerrors.gotmpl":               MustAsset("templates/validationerrors.gotmpl"),
+	"conformance.gotmpl":                    MustAsset("templates/conformance.gotmpl"),
+	"conformancehelpers.gotmpl":             MustAsset("templates/conformancehelpers.gotmpl"),
+	"benchmark.gotmpl":                      MustAsset("templates/benchmark.gotmpl"),
 
 	"server/parameter.gotmpl":    MustAsset("templates/server/parameter.gotmpl"),
 	"server/responses.gotmpl":    MustAsset("templates/server/responses.gotmpl"),
@@ -66,6 +112,11 @@ var assets = map[string][]byte{
 	"server/configureapi.gotmpl": MustAsset("templates/server/configureapi.gotmpl"),
 	"server/main.gotmpl":         MustAsset("templates/server/main.gotmpl"),
 	"server/doc.gotmpl":          MustAsset("templates/server/doc.gotmpl"),
+	"server/standalone.gotmpl":   MustAsset("templates/server/standalone.gotmpl"),
+	"server/validator.gotmpl":    MustAsset("templates/server/validator.gotmpl"),
+	"server/routemap.gotmpl":     MustAsset("templates/server/routemap.gotmpl"),
+	"server/eventstream.gotmpl":  MustAsset("templates/server/eventstream.gotmpl"),
+	"server/websocket.gotmpl":    MustAsset("templates/server/websocket.gotmpl"),
 
 	"client/parameter.gotmpl": MustAsset("templates/client/parameter.gotmpl"),
 	"client/response.gotmpl":  MustAsset("templates/client/response.gotmpl"),
@@ -88,6 +139,16 @@ func init() {
 func compileTemplates() {
 
 	modelTemplate = template.Must(templates.Get("model"))
+	timeOfDayTemplate = template.Must(templates.Get("timeofday"))
+	charTemplate = template.Must(templates.Get("char"))
+	diffTemplate = template.Must(templates.Get("diff"))
+	gobTemplate = template.Must(templates.Get("gobregistry"))
+	formatsTemplate = template.Must(templates.Get("formatregistry"))
+	schemasTemplate = template.Must(templates.Get("schemaregistry"))
+	validationErrorsTemplate = template.Must(templates.Get("validationerrors"))
+	conformanceTemplate = template.Must(templates.Get("conformance"))
+	conformanceHelpersTemplate = template.Must(templates.Get("conformancehelpers"))
+	benchmarkTemplate = template.Must(templates.Get("benchmark"))
 
 	// server templates
 	parameterTemplate = template.Must(templates.Get("serverParameter"))
@@ -102,6 +163,9 @@ func compileTemplates() {
 	configureAPITemplate = template.Must(templates.Get("serverConfigureapi"))
 	mainTemplate = template.Must(templates.Get("serverMain"))
 	mainDocTemplate = template.Must(templates.Get("serverDoc"))
+	standaloneServerTemplate = template.Must(templates.Get("serverStandalone"))
+	requestValidatorTemplate = template.Must(templates.Get("serverValidator"))
+	routeMapTemplate = template.Must(templates.Get("serverRoutemap"))
 
 	embeddedSpecTemplate = template.Must(templates.Get("swaggerJsonEmbed"))
 
@@ -124,6 +188,68 @@ func asJSON(data interface{}) (string, error) {
 	return string(b), nil
 }
 
+// asGoLiteral renders a json value (as found in enums and defaults) as a go literal
+func asGoLiteral(data interface{}) string {
+	switch v := data.(type) {
+	case string:
+		return strconv.Quote(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return "nil"
+	default:
+		return fmt.Sprintf("%#v", v)
+	}
+}
+
+// asComment renders a text as // comment lines, keeping its line breaks
+func asComment(text string) string {
+	lines := strings.Split(strings.TrimSpace(strings.Replace(text, "\r\n", "\n", -1)), "\n")
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			lines[i] = "//"
+			continue
+		}
+		lines[i] = "// " + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// asTimeLiteral renders an RFC 3339 date-time as a go expression building that time in UTC
+func asTimeLiteral(data string) (string, error) {
+	t, err := time.Parse(time.RFC3339Nano, data)
+	if err != nil {
+		return "", err
+	}
+	t = t.UTC()
+	return fmt.Sprintf("time.Date(%d, time.%s, %d, %d, %d, %d, %d, time.UTC)",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond()), nil
+}
+
+// asDurationLiteral renders a duration as a go expression in the largest unit it is a whole number of, like 30 * time.Second
+func asDurationLiteral(d time.Duration) string {
+	if d == 0 {
+		return "0"
+	}
+	units := []struct {
+		name string
+		unit time.Duration
+	}{
+		{"time.Hour", time.Hour},
+		{"time.Minute", time.Minute},
+		{"time.Second", time.Second},
+		{"time.Millisecond", time.Millisecond},
+		{"time.Microsecond", time.Microsecond},
+	}
+	for _, u := range units {
+		if d%u.unit == 0 {
+			return fmt.Sprintf("%d * %s", d/u.unit, u.name)
+		}
+	}
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+}
+
 func asPrettyJSON(data interface{}) (string, error) {
 	b, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
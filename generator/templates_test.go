@@ -16,6 +16,7 @@ package generator
 
 import (
 	"bytes"
+	"log"
 	"os"
 	"testing"
 
@@ -25,7 +26,12 @@ import (
 // We need to compile the templates because this is no longer done in init
 func TestMain(m *testing.M) {
 	compileTemplates()
+	cleanup, err := setupGoWorkspace()
+	if err != nil {
+		log.Fatalln(err)
+	}
 	retCode := m.Run()
+	cleanup()
 	os.Exit(retCode)
 }
 
@@ -0,0 +1,118 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/go-openapi/loads/fmts"
+	"github.com/go-openapi/spec"
+	"gopkg.in/yaml.v2"
+)
+
+// typeOverride is the go type a definition is mapped to by the file passed with --type-mapping
+type typeOverride struct {
+	// Pkg is the import path of the package of the type
+	Pkg string
+	// GoType is the name of the type qualified with the name of its package, like decimal.Decimal
+	GoType string
+	// Formatter and Zero are the hints of x-go-type
+	Formatter bool
+	Zero      string
+}
+
+// readTypeMapping reads the file passed with --type-mapping, a yaml or json document like:
+//
+//	Money: github.com/shopspring/decimal.Decimal
+//	Pet:
+//	  type: Animal
+//	  import:
+//	    package: github.com/acme/zoo
+func readTypeMapping(pth string) (map[string]interface{}, error) {
+	raw, err := ioutil.ReadFile(pth)
+	if err != nil {
+		return nil, err
+	}
+	var data map[interface{}]interface{}
+	if err := yaml.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("%s: %v", pth, err)
+	}
+	doc, err := fmts.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", pth, err)
+	}
+	var mapping map[string]interface{}
+	if err := json.Unmarshal(doc, &mapping); err != nil {
+		return nil, fmt.Errorf("%s: %v", pth, err)
+	}
+	return mapping, nil
+}
+
+// loadTypeMapping reads the go types the definitions are mapped to, from TypeMappingFile and then TypeMapping.
+// Each type is written like the value of x-go-type and must be qualified with its import path.
+// A mapped definition isn't generated, the schemas referring to it are rendered with its go type.
+func (g *GenOpts) loadTypeMapping() error {
+	if g.typeOverrides != nil || (g.TypeMappingFile == "" && len(g.TypeMapping) == 0) {
+		return nil
+	}
+	mapping := make(map[string]interface{}, len(g.TypeMapping))
+	if g.TypeMappingFile != "" {
+		m, err := readTypeMapping(g.TypeMappingFile)
+		if err != nil {
+			return err
+		}
+		for def, tpe := range m {
+			mapping[def] = tpe
+		}
+	}
+	for def, tpe := range g.TypeMapping {
+		mapping[def] = tpe
+	}
+
+	overrides := make(map[string]typeOverride, len(mapping))
+	for def, v := range mapping {
+		name, formatter, zero, err := goTypeExtension(v)
+		i := strings.LastIndex(name, ".")
+		if err != nil || i < 0 || strings.Contains(name, "[") {
+			return fmt.Errorf("type mapping: %s must be mapped to a go type qualified with its import path, like github.com/acme/paging.Page", def)
+		}
+		pkg := name[:i]
+		overrides[def] = typeOverride{Pkg: pkg, GoType: path.Base(pkg) + name[i:], Formatter: formatter, Zero: zero}
+	}
+	g.typeOverrides = overrides
+	return nil
+}
+
+// typeOverride returns the go type the named definition is mapped to by --type-mapping
+func (g *GenOpts) typeOverride(name string) (typeOverride, bool) {
+	if g == nil {
+		return typeOverride{}, false
+	}
+	ov, ok := g.typeOverrides[name]
+	return ov, ok
+}
+
+// dropMappedModels removes the definitions mapped to go types from the models to generate
+func (g *GenOpts) dropMappedModels(models map[string]spec.Schema) {
+	for name := range models {
+		if _, ok := g.typeOverride(name); ok {
+			delete(models, name)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeMapping_Load(t *testing.T) {
+	opts := &GenOpts{
+		TypeMappingFile: "../fixtures/codegen/todolist.type-mapping.overrides.yml",
+		TypeMapping:     map[string]string{"Owner": "github.com/acme/zoo.Keeper"},
+	}
+	if !assert.NoError(t, opts.loadTypeMapping()) {
+		return
+	}
+	assert.Equal(t, map[string]typeOverride{
+		"Money": {Pkg: "github.com/go-openapi/strfmt", GoType: "strfmt.Duration", Formatter: true},
+		"Pet":   {Pkg: "encoding/json", GoType: "json.RawMessage"},
+		"Owner": {Pkg: "github.com/acme/zoo", GoType: "zoo.Keeper"},
+	}, opts.typeOverrides)
+
+	var none *GenOpts
+	_, ok := none.typeOverride("Pet")
+	assert.False(t, ok)
+
+	for _, tpe := range []string{"Keeper", "github.com/acme/paging.Page[T]", ""} {
+		err := (&GenOpts{TypeMapping: map[string]string{"Owner": tpe}}).loadTypeMapping()
+		if assert.Error(t, err, tpe) {
+			assert.Contains(t, err.Error(), "Owner must be mapped to a go type qualified with its import path", tpe)
+		}
+	}
+
+	_, _, err := loadSpec(&GenOpts{Spec: "../fixtures/codegen/todolist.type-mapping.yml", TypeMappingFile: "nowhere.yml"})
+	assert.Error(t, err)
+}
+
+func TestTypeMapping_Resolver(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.type-mapping.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	opts := &GenOpts{TypeMappingFile: "../fixtures/codegen/todolist.type-mapping.overrides.yml"}
+	if !assert.NoError(t, opts.loadTypeMapping()) {
+		return
+	}
+	resolver := newTypeResolver("models", specDoc)
+	resolver.Opts = opts
+
+	order := specDoc.Spec().Definitions["Order"]
+	total := order.Properties["total"]
+	rt, err := resolver.ResolveSchema(&total, true, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "strfmt.Duration", rt.GoType)
+		assert.True(t, rt.IsExternal)
+		assert.True(t, rt.IsCustomFormatter)
+		assert.False(t, rt.IsAliased)
+	}
+	pets := order.Properties["pets"]
+	rt, err = resolver.ResolveSchema(&pets, true, false)
+	if assert.NoError(t, err) {
+		if assert.NotNil(t, rt.ElemType) {
+			assert.Equal(t, "json.RawMessage", rt.ElemType.GoType)
+			assert.True(t, rt.ElemType.IsExternal)
+		}
+	}
+	assert.Equal(t, "json.RawMessage", resolver.goTypeName("Pet"))
+	assert.Equal(t, "models.Order", resolver.goTypeName("Order"))
+	// goimports adds the packages of the standard library, some templates import them already
+	assert.Empty(t, resolver.importList())
+
+	resolver.Opts = &GenOpts{TypeMapping: map[string]string{"Pet": "github.com/acme/pets.Pet"}}
+	if !assert.NoError(t, resolver.Opts.loadTypeMapping()) {
+		return
+	}
+	assert.Equal(t, "pets.Pet", resolver.goTypeName("Pet"))
+	assert.Equal(t, []string{"github.com/acme/pets"}, resolver.importList())
+}
+
+func TestTypeMapping_OperationImports(t *testing.T) {
+	b, err := opBuilder("updatePet", "../fixtures/codegen/todolist.type-mapping.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	b.GenOpts = &GenOpts{TypeMappingFile: "../fixtures/codegen/todolist.type-mapping.overrides.yml"}
+	if !assert.NoError(t, b.GenOpts.loadTypeMapping()) {
+		return
+	}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	// the client responses import encoding/json on their own
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, clientResponseTemplate.Execute(buf, op)) {
+		return
+	}
+	ff, err := formatGoFile("update_pet_responses.go", buf.Bytes())
+	if assert.NoError(t, err, buf.String()) {
+		res := string(ff)
+		assert.Equal(t, 1, strings.Count(res, `"encoding/json"`), res)
+		assertInCode(t, "Payload json.RawMessage", res)
+	}
+}
+
+func TestTypeMapping_Generate(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.type-mapping.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	opts := &GenOpts{TypeMappingFile: "../fixtures/codegen/todolist.type-mapping.overrides.yml"}
+	if !assert.NoError(t, opts.loadTypeMapping()) {
+		return
+	}
+	k := "Order"
+	genModel, err := makeGenDefinitionHierarchy(k, "models", "", specDoc.Spec().Definitions[k], specDoc, true, true, opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, modelTemplate.Execute(buf, genModel)) {
+		return
+	}
+	ff, err := formatGoFile("order.go", buf.Bytes())
+	if !assert.NoError(t, err) {
+		fmt.Println(buf.String())
+		return
+	}
+	res := string(ff)
+	assertInCode(t, `"encoding/json"`, res)
+	assertInCode(t, "Pet *json.RawMessage `json:\"pet,omitempty\"`", res)
+	assertInCode(t, "Pets []*json.RawMessage `json:\"pets,omitempty\"`", res)
+	assertInCode(t, "Total *strfmt.Duration `json:\"total\"`", res)
+	assertInCode(t, `validate.FormatOf("total", "body", "decimal", m.Total.String(), formats)`, res)
+	assertNotInCode(t, "m.Pet.Validate(formats)", res)
+	assertNotInCode(t, "m.Total.Validate(formats)", res)
+
+	// the mapped definitions aren't generated
+	target, err := ioutil.TempDir("", "type-mapping")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(target)
+	err = GenerateDefinition(nil, true, true, GenOpts{
+		Spec:            "../fixtures/codegen/todolist.type-mapping.yml",
+		Target:          target,
+		ModelPackage:    "models",
+		TypeMappingFile: "../fixtures/codegen/todolist.type-mapping.overrides.yml",
+		SkipFormats:     true,
+	})
+	if assert.NoError(t, err) {
+		files, err := filepath.Glob(filepath.Join(target, "models", "*.go"))
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{filepath.Join(target, "models", "order.go")}, files)
+		}
+	}
+}
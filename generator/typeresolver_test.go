@@ -16,6 +16,12 @@ package generator
 
 import (
 	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/go-openapi/loads"
@@ -60,6 +66,7 @@ var schTypeVals = []struct{ Type, Format, Expected string }{
 	{"string", "rgbcolor", "strfmt.RGBColor"},
 	{"string", "duration", "strfmt.Duration"},
 	{"string", "password", "strfmt.Password"},
+	{"string", "time", "models.TimeOfDay"},
 	{"file", "", "runtime.File"},
 }
 
@@ -364,6 +371,78 @@ func TestTypeResolver_Notables(t *testing.T) {
 	}
 }
 
+func TestTypeResolver_BundledRefs(t *testing.T) {
+	doc, resolver, err := specResolver(t, "../fixtures/codegen/bundled/swagger.yml")
+	if assert.NoError(t, err) {
+		resolver.Opts = &GenOpts{Spec: "../fixtures/codegen/bundled/swagger.yml"}
+		owner := doc.Spec().Definitions["Owner"]
+
+		// a ref to the original document of a bundled definition
+		pet := owner.Properties["pet"]
+		rt, err := resolver.ResolveSchema(&pet, true, true)
+		if assert.NoError(t, err) {
+			assert.False(t, rt.IsAnonymous)
+			assert.True(t, rt.IsComplexObject)
+			assert.True(t, rt.IsNullable)
+			assert.Equal(t, "models.Pet", rt.GoType)
+		}
+
+		pets := owner.Properties["pets"]
+		rt, err = resolver.ResolveSchema(&pets, true, true)
+		if assert.NoError(t, err) {
+			assert.True(t, rt.IsArray)
+			assert.Equal(t, "[]models.Pet", rt.GoType)
+		}
+	}
+
+	// the local definition of the same name differs from the remote one
+	doc, resolver, err = specResolver(t, "../fixtures/codegen/bundled/mismatch.yml")
+	if assert.NoError(t, err) {
+		resolver.Opts = &GenOpts{Spec: "../fixtures/codegen/bundled/mismatch.yml"}
+		pet := doc.Spec().Definitions["Owner"].Properties["pet"]
+		_, err := resolver.ResolveSchema(&pet, true, true)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), `$ref "pets.yml#/definitions/Pet" doesn't match the definition "Pet"`)
+		}
+		local := doc.OrigSpec().Definitions["Pet"]
+		sch, err := resolver.resolveRef(&pet.Ref)
+		if assert.NoError(t, err) {
+			assert.NotEqual(t, local.Properties, sch.Properties)
+		}
+	}
+}
+
+func TestTypeResolver_RemoteDocumentsLoadedOnce(t *testing.T) {
+	opts := &GenOpts{Spec: "../fixtures/codegen/bundled/swagger.yml"}
+	_, specDoc, err := loadSpec(opts)
+	if assert.NoError(t, err) && assert.NotNil(t, opts.remoteDocs) {
+		// the resolvers of different models don't derive from one another, they share the options
+		owner := specDoc.Spec().Definitions["Owner"]
+		for _, prop := range []string{"pet", "pets"} {
+			resolver := newTypeResolver("models", specDoc)
+			resolver.ModelName = "Owner"
+			resolver.Opts = opts
+			sch := owner.Properties[prop]
+			_, err := resolver.ResolveSchema(&sch, true, true)
+			assert.NoError(t, err)
+		}
+		// both refs point to the same document
+		assert.Len(t, opts.remoteDocs.docs, 1)
+		for _, ref := range []string{"pets.yml#/definitions/Pet", "./pets.yml#/definitions/Pet"} {
+			state, ok := opts.remoteDocs.comparison(ref)
+			assert.True(t, ok, ref)
+			assert.Equal(t, bundledCopy, state, ref)
+		}
+
+		location := filepath.Join("..", "fixtures", "codegen", "bundled", "pets.yml")
+		first, err := opts.remoteDocs.load(location)
+		if assert.NoError(t, err) {
+			second, _ := opts.remoteDocs.load(location)
+			assert.True(t, first == second)
+		}
+	}
+}
+
 func specResolver(t testing.TB, path string) (*loads.Document, *typeResolver, error) {
 	tlb, err := loads.Spec(path)
 	if err != nil {
@@ -456,13 +535,13 @@ func TestTypeResolver_AnonymousStructs(t *testing.T) {
 			assert.True(t, rt.IsComplexObject)
 		}
 
-		// Also test that it's nullable with just x-nullable
+		// an explicit false opts out of the pointer
 		parent.Extensions[xIsNullable] = false
 		parent.Extensions[xNullable] = false
 
 		rt, err = resolver.ResolveSchema(parent, true, true)
 		if assert.NoError(t, err) {
-			assert.True(t, rt.IsNullable)
+			assert.False(t, rt.IsNullable)
 			assert.True(t, rt.IsAnonymous)
 			assert.True(t, rt.IsComplexObject)
 		}
@@ -553,8 +632,938 @@ func TestTypeResolver_AliasTypes(t *testing.T) {
 	}
 }
 
-func assertPrimitiveResolve(t testing.TB, tpe, tfmt, exp string, tr resolvedType) {
+func assertPrimitiveResolve(t testing.TB, tpe, tfmt, exp string, tr ResolvedType) {
 	assert.Equal(t, tpe, tr.SwaggerType, fmt.Sprintf("expected %q (%q, %q) to for the swagger type but got %q", tpe, tfmt, exp, tr.SwaggerType))
 	assert.Equal(t, tfmt, tr.SwaggerFormat, fmt.Sprintf("expected %q (%q, %q) to for the swagger format but got %q", tfmt, tpe, exp, tr.SwaggerFormat))
 	assert.Equal(t, exp, tr.GoType, fmt.Sprintf("expected %q (%q, %q) to for the go type but got %q", exp, tpe, tfmt, tr.GoType))
 }
+
+func TestTypeResolver_AnyType(t *testing.T) {
+	for _, v := range []struct {
+		Version string
+		Iface   string
+	}{
+		{"", "interface{}"},
+		{"1.17", "interface{}"},
+		{"1.18", "any"},
+		{"go1.21", "any"},
+		{"2.0", "any"},
+	} {
+		_, resolver, err := basicTaskListResolver(t)
+		if !assert.NoError(t, err) {
+			return
+		}
+		resolver.Opts = &GenOpts{GoVersion: v.Version}
+
+		rt, err := resolver.ResolveSchema(nil, true, true)
+		if assert.NoError(t, err) {
+			assert.Equal(t, v.Iface, rt.GoType, "version %q", v.Version)
+		}
+		rt, err = resolver.ResolveSchema(new(spec.Schema).Typed("object", ""), true, true)
+		if assert.NoError(t, err) {
+			assert.Equal(t, v.Iface, rt.GoType, "version %q", v.Version)
+		}
+		rt, err = resolver.ResolveSchema(new(spec.Schema).Typed("array", ""), true, true)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "[]"+v.Iface, rt.GoType, "version %q", v.Version)
+		}
+		tpe := simpleResolvedType("array", "", nil)
+		assert.Equal(t, "[]"+v.Iface, resolver.Opts.anyType(tpe.GoType), "version %q", v.Version)
+	}
+}
+
+func TestTypeResolver_AllOfEmbeds(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.allof-embedding.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := doc.Spec().Definitions
+
+	resolver := newTypeResolver("", doc)
+	resolver.ModelName = "Task"
+	task := definitions["Task"]
+	rt, err := resolver.ResolveSchema(&task, false, true)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsComplexObject)
+		assert.Equal(t, "Task", rt.GoType)
+		assert.Equal(t, []string{"Entity", "Schedule"}, rt.Embeds)
+	}
+
+	resolver.ModelName = "Entity"
+	entity := definitions["Entity"]
+	rt, err = resolver.ResolveSchema(&entity, false, true)
+	if assert.NoError(t, err) {
+		assert.Empty(t, rt.Embeds)
+	}
+}
+
+func TestTypeResolver_MutualRecursion(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.recursive.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	for name, def := range doc.Spec().Definitions {
+		def := def
+		resolver := newTypeResolver("models", doc)
+		resolver.ModelName = name
+		_, err := resolver.ResolveSchema(&def, false, true)
+		assert.NoError(t, err, name)
+	}
+
+	resolver := newTypeResolver("models", doc)
+	for _, v := range []struct {
+		Ref     string
+		GoType  string
+		IsArray bool
+	}{
+		{"Tasks", "models.Tasks", true},
+		{"Outline", "models.Outline", true},
+		{"OutlineLevel", "models.OutlineLevel", true},
+		{"Board", "models.Board", false},
+		{"Cards", "models.Cards", false},
+		{"Columns", "models.Columns", true},
+	} {
+		rt, err := resolver.ResolveSchema(spec.RefSchema("#/definitions/"+v.Ref), false, true)
+		if assert.NoError(t, err, v.Ref) {
+			assert.Equal(t, v.GoType, rt.GoType)
+			assert.Equal(t, v.IsArray, rt.IsArray, v.Ref)
+		}
+	}
+
+	// the objects of a cycle are pointers
+	rt, err := resolver.ResolveSchema(spec.RefSchema("#/definitions/Project"), false, true)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsComplexObject)
+		assert.True(t, rt.IsNullable)
+	}
+
+	// the pair of objects with a property of the type of each other
+	for _, name := range []string{"Assignee", "Delegate"} {
+		rt, err := resolver.ResolveSchema(spec.RefSchema("#/definitions/"+name), false, false)
+		if assert.NoError(t, err, name) {
+			assert.Equal(t, "models."+name, rt.GoType)
+			assert.True(t, rt.IsComplexObject, name)
+			assert.True(t, rt.IsNullable, name)
+		}
+	}
+
+	// refs referring to each other make no type
+	doc, err = loads.Spec("../fixtures/codegen/todolist.recursive-refs.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	resolver = newTypeResolver("models", doc)
+	_, err = resolver.ResolveSchema(spec.RefSchema("#/definitions/Label"), false, true)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "circular $ref")
+	}
+}
+
+func TestTypeResolver_SingleChar(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.single-char.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	cell := doc.Spec().Definitions["Cell"]
+
+	resolver := newTypeResolver("models", doc)
+	resolver.Opts = &GenOpts{SingleChar: "rune"}
+	for _, v := range []struct {
+		Prop   string
+		GoType string
+		IsChar bool
+	}{
+		{"mark", "models.Char", true},
+		{"sep", "models.Char", true},
+		{"marks", "[]models.Char", false},
+		// a pattern or other lengths keep it a string
+		{"code", "string", false},
+		{"name", "string", false},
+	} {
+		prop := cell.Properties[v.Prop]
+		rt, err := resolver.ResolveSchema(&prop, true, false)
+		if assert.NoError(t, err, v.Prop) {
+			assert.Equal(t, v.GoType, rt.GoType, v.Prop)
+			assert.Equal(t, v.IsChar, rt.IsChar, v.Prop)
+		}
+	}
+	rt, err := resolver.ResolveSchema(spec.RefSchema("#/definitions/Initial"), false, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "models.Initial", rt.GoType)
+	}
+
+	// without the option format char is a rune and the lengths are validated on a string
+	resolver = newTypeResolver("models", doc)
+	for prop, goType := range map[string]string{"mark": "rune", "sep": "string"} {
+		sch := cell.Properties[prop]
+		rt, err := resolver.ResolveSchema(&sch, true, false)
+		if assert.NoError(t, err, prop) {
+			assert.Equal(t, goType, rt.GoType, prop)
+			assert.False(t, rt.IsChar, prop)
+		}
+	}
+}
+
+func TestTypeResolver_CustomTag(t *testing.T) {
+	for _, v := range []struct {
+		Tag      interface{}
+		Expected string
+		Err      string
+	}{
+		{`validate:"required"`, `validate:"required"`, ""},
+		{`  validate:"required,min=1"    db:"title" `, `validate:"required,min=1" db:"title"`, ""},
+		{`validate:"a \"quoted\" value"`, `validate:"a \"quoted\" value"`, ""},
+		{`validate:required`, "", "key:\"value\" pairs"},
+		{`validate:"required`, "", "key:\"value\" pairs"},
+		{`json:"name"`, "", "can't set the json tag"},
+		{`db:"a" db:"b"`, "", "sets the db tag twice"},
+		{"db:\"`a`\"", "", "backquote"},
+		{true, "", "must be a string"},
+	} {
+		sch := new(spec.Schema).Typed("string", "")
+		sch.AddExtension(xCustomTag, v.Tag)
+		tag, err := customTag(sch)
+		msg := fmt.Sprintf("%v", v.Tag)
+		if v.Err != "" {
+			if assert.Error(t, err, msg) {
+				assert.Contains(t, err.Error(), v.Err)
+			}
+			continue
+		}
+		if assert.NoError(t, err, msg) {
+			assert.Equal(t, v.Expected, tag)
+			// the normalized tag is read back by reflect
+			_, ok := reflect.StructTag(tag).Lookup("validate")
+			assert.True(t, ok, tag)
+		}
+	}
+
+	tag, err := customTag(new(spec.Schema).Typed("string", ""))
+	if assert.NoError(t, err) {
+		assert.Empty(t, tag)
+	}
+}
+
+func TestTypeResolver_MapRecursion(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.map-recursive.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	for name, def := range doc.Spec().Definitions {
+		def := def
+		resolver := newTypeResolver("models", doc)
+		resolver.ModelName = name
+		_, err := resolver.ResolveSchema(&def, false, true)
+		assert.NoError(t, err, name)
+	}
+
+	// the map breaks the cycle of the refs
+	resolver := newTypeResolver("models", doc)
+	resolver.ModelName = "Shelf"
+	def := doc.Spec().Definitions["Shelf"]
+	rt, err := resolver.ResolveSchema(&def, false, true)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsMap)
+		assert.Equal(t, "map[string]models.Catalog", rt.AliasedType)
+	}
+}
+
+func TestTypeResolver_Union(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.unions.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := doc.Spec().Definitions
+
+	resolver := newTypeResolver("models", doc)
+	resolver.ModelName = "Pet"
+	pet := definitions["Pet"]
+	rt, err := resolver.ResolveSchema(&pet, false, true)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsOneOf)
+		assert.False(t, rt.IsAnyOf)
+		assert.True(t, rt.IsComplexObject)
+		assert.Equal(t, "models.Pet", rt.GoType)
+		if assert.Len(t, rt.Variants, 2) {
+			assert.Equal(t, "models.Cat", rt.Variants[0].GoType)
+			assert.Equal(t, "models.Dog", rt.Variants[1].GoType)
+		}
+	}
+
+	resolver = newTypeResolver("models", doc)
+	resolver.ModelName = "Identifier"
+	identifier := definitions["Identifier"]
+	rt, err = resolver.ResolveSchema(&identifier, false, true)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsAnyOf)
+		assert.False(t, rt.IsOneOf)
+		var variants []string
+		for _, v := range rt.Variants {
+			variants = append(variants, v.GoType)
+		}
+		assert.Equal(t, []string{"int64", "strfmt.UUID", "string"}, variants)
+	}
+
+	// a property referring to a union holds a pointer to it
+	owner := definitions["Owner"]
+	prop := owner.Properties["pet"]
+	rt, err = newTypeResolver("models", doc).ResolveSchema(&prop, true, false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "models.Pet", rt.GoType)
+		assert.True(t, rt.IsNullable)
+	}
+
+	// an inline union left to the resolver alone stays an interface{}
+	prop = owner.Properties["contact"]
+	rt, err = newTypeResolver("models", doc).ResolveSchema(&prop, true, false)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsInterface)
+		assert.False(t, rt.IsOneOf)
+	}
+}
+
+func TestTypeResolver_Public(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.models.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := doc.Spec().Definitions
+	var resolver TypeResolver = NewTypeResolver("models", doc)
+
+	// a definition is resolved by the resolver for its model
+	tag := definitions["Tag"]
+	rt, err := resolver.ForModel("Tag").ResolveSchema(&tag, false, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "models.Tag", rt.GoType)
+		assert.True(t, rt.IsComplexObject)
+		assert.False(t, rt.IsAnonymous)
+	}
+
+	// so are its inline schemas
+	name := tag.Properties["name"]
+	rt, err = resolver.ForModel("Tag").ResolveSchema(&name, true, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "string", rt.GoType)
+		assert.True(t, rt.IsPrimitive)
+		assert.True(t, rt.IsNullable)
+	}
+
+	ref := spec.RefProperty("#/definitions/Tag")
+	rt, err = resolver.ResolveSchema(spec.ArrayProperty(ref), true, false)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsArray)
+		assert.Equal(t, "[]models.Tag", rt.GoType)
+		if assert.NotNil(t, rt.ElemType) {
+			assert.Equal(t, "models.Tag", rt.ElemType.GoType)
+		}
+	}
+}
+
+func TestTypeResolver_GoType(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.go-type.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := doc.Spec().Definitions
+	listing := definitions["Listing"]
+
+	resolver := newTypeResolver("models", doc)
+	resolver.Opts = &GenOpts{GoVersion: "1.18"}
+	page := listing.Properties["page"]
+	rt, err := resolver.ResolveSchema(&page, true, false)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsExternal)
+		assert.False(t, rt.IsAnonymous)
+		assert.Equal(t, "paging.Paginated[models.Item]", rt.GoType)
+		assert.Contains(t, resolver.importList(), "github.com/acme/paging")
+	}
+
+	cursor := listing.Properties["cursor"]
+	rt, err = resolver.ResolveSchema(&cursor, true, false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "paging.Cursor", rt.GoType)
+	}
+
+	catalog := definitions["Catalog"]
+	rt, err = resolver.NewWithModelName("Catalog").ResolveSchema(&catalog, false, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "models.Catalog", rt.GoType)
+		assert.True(t, rt.IsAliased)
+		assert.Equal(t, "paging.Index[string, int32]", rt.AliasedType)
+	}
+
+	// type parameters need generics
+	resolver = newTypeResolver("models", doc)
+	_, err = resolver.ResolveSchema(&page, true, false)
+	assert.Error(t, err)
+	_, err = resolver.ResolveSchema(&cursor, true, false)
+	assert.NoError(t, err)
+
+	// T stands for the element of an array or a map
+	resolver.Opts = &GenOpts{GoVersion: "1.18"}
+	noElem := new(spec.Schema).Typed("string", "")
+	noElem.AddExtension(xGoType, "github.com/acme/paging.Paginated[T]")
+	_, err = resolver.ResolveSchema(noElem, true, false)
+	assert.Error(t, err)
+}
+
+func TestTypeResolver_GoTypeFormat(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.go-type.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	invoice := doc.Spec().Definitions["Invoice"]
+	resolver := newTypeResolver("models", doc)
+
+	// the object form, hinted as a formatter
+	total := invoice.Properties["total"]
+	rt, err := resolver.ResolveSchema(&total, true, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "decimal.Decimal", rt.GoType)
+		assert.Equal(t, "decimal", rt.SwaggerFormat)
+		assert.True(t, rt.IsPrimitive)
+		assert.True(t, rt.IsCustomFormatter)
+		assert.True(t, rt.IsNullable)
+	}
+
+	// the default mapping of the format is overridden, the type is not known to be a formatter
+	discount := invoice.Properties["discount"]
+	rt, err = resolver.ResolveSchema(&discount, true, false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "decimal.Decimal", rt.GoType)
+		assert.Equal(t, "number", rt.SwaggerType)
+		assert.False(t, rt.IsCustomFormatter)
+		assert.False(t, rt.IsNullable)
+	}
+
+	// the types of strfmt are formatters, and strfmt is always imported
+	reference := invoice.Properties["reference"]
+	rt, err = resolver.ResolveSchema(&reference, true, false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "strfmt.UUID", rt.GoType)
+		assert.True(t, rt.IsCustomFormatter)
+	}
+	assert.Equal(t, []string{"github.com/shopspring/decimal"}, resolver.importList())
+
+	bad := new(spec.Schema).Typed("string", "decimal")
+	bad.AddExtension(xGoType, map[string]interface{}{"import": map[string]interface{}{"package": "github.com/shopspring/decimal"}})
+	_, err = resolver.ResolveSchema(bad, true, false)
+	assert.Error(t, err)
+}
+
+func TestTypeResolver_CustomFormatterZero(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.custom-zero.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	task := doc.Spec().Definitions["Task"]
+	resolver := newTypeResolver("", doc)
+
+	for _, tc := range []struct {
+		property string
+		goType   string
+		zero     string
+	}{
+		{"id", "strfmt.UUID", `strfmt.UUID("")`},
+		{"parentId", "strfmt.UUID", "nil"},
+		{"taskId", "TaskID", `TaskID(strfmt.UUID(""))`},
+		{"dueAt", "strfmt.DateTime", "strfmt.DateTime{}"},
+		{"closedAt", "strfmt.DateTime", "nil"},
+		// a go type registered as a formatter, with and without a zero hint
+		{"total", "decimal.Decimal", "decimal.Zero"},
+		{"price", "currency.Money", "currency.Money{}"},
+		{"amount", "Amount", "Amount(decimal.Zero)"},
+	} {
+		prop := task.Properties[tc.property]
+		rt, err := resolver.ResolveSchema(&prop, true, false)
+		if assert.NoError(t, err, tc.property) {
+			assert.Equal(t, tc.goType, rt.GoType, tc.property)
+			assert.True(t, rt.IsCustomFormatter, tc.property)
+			assert.Equal(t, tc.zero, rt.Zero(), tc.property)
+		}
+	}
+}
+
+func TestTypeResolver_ValueObjects(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.value-objects.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := doc.Spec().Definitions
+	resolver := newTypeResolver("", doc)
+
+	// an object with properties is a pointer, unless x-nullable is explicitly false
+	address := definitions["Address"]
+	assert.False(t, resolver.IsNullable(&address))
+	owner := definitions["Owner"]
+	assert.True(t, resolver.IsNullable(&owner))
+
+	task := definitions["Task"]
+	assignee := task.Properties["assignee"]
+	rt, err := resolver.ResolveSchema(&assignee, true, true)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsComplexObject)
+		assert.False(t, rt.IsNullable)
+	}
+	reviewer := task.Properties["reviewer"]
+	rt, err = resolver.ResolveSchema(&reviewer, true, false)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsNullable)
+	}
+
+	// the elements of arrays follow the override too
+	steps := task.Properties["steps"]
+	rt, err = resolver.ResolveSchema(&steps, true, false)
+	if assert.NoError(t, err) && assert.NotNil(t, rt.ElemType) {
+		assert.False(t, rt.ElemType.IsNullable)
+		assert.False(t, strings.HasPrefix(rt.GoType, "[]*"), rt.GoType)
+	}
+}
+
+func TestTypeResolver_SingleAllOfNullable(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.allof-single.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	task := doc.Spec().Definitions["Task"]
+	resolver := newTypeResolver("models", doc)
+
+	for _, v := range []struct {
+		Property string
+		Nullable bool
+	}{
+		{"priority", false},
+		{"effort", false},
+		{"weight", true},
+		{"owner", true},
+		{"assignee", true},
+	} {
+		prop := task.Properties[v.Property]
+		assert.Equal(t, v.Nullable, resolver.IsNullable(&prop), v.Property)
+	}
+
+	estimates := task.Properties["estimates"]
+	assert.False(t, resolver.IsNullable(estimates.AdditionalProperties.Schema))
+	owners := task.Properties["owners"]
+	assert.True(t, resolver.IsNullable(owners.Items.Schema))
+}
+
+func TestTypeResolver_MapRef(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.map-ref.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := doc.Spec().Definitions
+	resolver := newTypeResolver("models", doc)
+
+	// an inline map of refs
+	board := definitions["Board"]
+	for prop, goType := range map[string]string{
+		"foos":   "models.Foo",
+		"levels": "models.Level",
+		"nested": "map[string]models.Foo",
+	} {
+		sch := board.Properties[prop]
+		rt, err := resolver.ResolveSchema(&sch, true, false)
+		if assert.NoError(t, err, prop) {
+			assert.True(t, rt.IsMap, prop)
+			assert.Equal(t, "map[string]"+goType, rt.GoType, prop)
+			if assert.NotNil(t, rt.ElemType, prop) {
+				assert.Equal(t, goType, rt.ElemType.GoType, prop)
+				elem := rt.ElemType
+				if elem.IsMap {
+					// the values of the inline map of the nested one
+					elem = elem.ElemType
+				}
+				assert.False(t, elem.IsAnonymous, prop)
+			}
+		}
+	}
+
+	// a named map of refs
+	foos := definitions["Foos"]
+	rt, err := resolver.NewWithModelName("Foos").ResolveSchema(&foos, false, false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "models.Foos", rt.GoType)
+		assert.True(t, rt.IsAliased)
+		assert.Equal(t, "map[string]models.Foo", rt.AliasedType)
+		if assert.NotNil(t, rt.ElemType) {
+			assert.Equal(t, "models.Foo", rt.ElemType.GoType)
+			assert.True(t, rt.ElemType.IsComplexObject)
+			assert.False(t, rt.ElemType.IsAnonymous)
+		}
+	}
+}
+
+func TestTypeResolver_EnumValues(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.enum-consts.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := doc.Spec().Definitions
+	resolver := newTypeResolver("", doc)
+
+	// the named enums, of strings and numbers
+	for name, goType := range map[string]string{"Status": "string", "Level": "int32", "Ratio": "float64"} {
+		resolver.ModelName = name
+		sch := definitions[name]
+		rt, err := resolver.ResolveSchema(&sch, false, true)
+		if assert.NoError(t, err, name) {
+			assert.Equal(t, name, rt.GoType, name)
+			assert.Equal(t, goType, rt.AliasedType, name)
+			assert.Equal(t, sch.Enum, rt.EnumValues, name)
+		}
+	}
+	resolver.ModelName = ""
+
+	// the enum of an inline property
+	task := definitions["Task"]
+	state := task.Properties["state"]
+	rt, err := resolver.ResolveSchema(&state, true, false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "string", rt.GoType)
+		assert.Equal(t, []interface{}{"open", "closed"}, rt.EnumValues)
+	}
+
+	// no enum, no values
+	title := task.Properties["title"]
+	rt, err = resolver.ResolveSchema(&title, true, true)
+	if assert.NoError(t, err) {
+		assert.Empty(t, rt.EnumValues)
+	}
+}
+
+func TestTypeResolver_UntypedAdditionalProps(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.free-form.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := doc.Spec().Definitions
+	resolver := newTypeResolver("models", doc)
+
+	for name, untyped := range map[string]bool{
+		"Task": true,
+		// additionalProperties: false, omitted or with a schema
+		"Closed":  false,
+		"Omitted": false,
+		"Labels":  false,
+	} {
+		resolver.ModelName = name
+		sch := definitions[name]
+		rt, err := resolver.ResolveSchema(&sch, false, true)
+		if assert.NoError(t, err, name) {
+			assert.True(t, rt.IsComplexObject, name)
+			assert.Equal(t, untyped, rt.HasUntypedAdditionalProps, name)
+		}
+	}
+	resolver.ModelName = ""
+
+	// an inline object
+	owner := definitions["Task"].Properties["owner"]
+	rt, err := resolver.ResolveSchema(&owner, true, false)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.HasUntypedAdditionalProps)
+	}
+}
+
+func TestTypeResolver_TypeArrays(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.type-arrays.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	task := doc.Spec().Definitions["Task"]
+	resolver := newTypeResolver("models", doc)
+	for prop, v := range map[string]struct {
+		GoType     string
+		IsNullable bool
+	}{
+		// the null member of the type array makes the type nullable
+		"notes":    {"string", true},
+		"priority": {"int32", true},
+		"ratio":    {"float64", true},
+		"done":     {"bool", true},
+		"due":      {"strfmt.DateTime", true},
+		"state":    {"string", true},
+		"count":    {"int64", false},
+		"tags":     {"[]string", false},
+	} {
+		sch := task.Properties[prop]
+		rt, err := resolver.ResolveSchema(&sch, true, false)
+		if assert.NoError(t, err, prop) {
+			assert.Equal(t, v.GoType, rt.GoType, prop)
+			assert.Equal(t, v.IsNullable, rt.IsNullable, prop)
+		}
+	}
+
+	// several types but null make no go type
+	estimate := task.Properties["estimate"]
+	rt, err := resolver.ResolveSchema(&estimate, true, false)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsInterface)
+		assert.Equal(t, "interface{}", rt.GoType)
+	}
+}
+
+func TestTypeResolver_NarrowIntegers(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.narrow-integers.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	reading := doc.Spec().Definitions["Reading"]
+	resolver := newTypeResolver("", doc)
+	for prop, expected := range map[string]string{
+		"level": "int8",
+		"port":  "uint16",
+		"gain":  "int16",
+		"codes": "[]uint8",
+	} {
+		sch := reading.Properties[prop]
+		rt, err := resolver.ResolveSchema(&sch, true, false)
+		if assert.NoError(t, err, prop) {
+			assert.Equal(t, expected, rt.GoType, prop)
+			assert.Equal(t, !rt.IsArray, rt.IsPrimitive, prop)
+		}
+	}
+
+	for _, format := range []string{"int8", "int16", "uint8", "uint16"} {
+		rt := simpleResolvedType("integer", format, nil)
+		assert.Equal(t, format, rt.GoType)
+		assert.True(t, rt.IsPrimitive, format)
+		assert.NotEmpty(t, stringConverters[rt.GoType], format)
+		assert.NotEmpty(t, stringFormatters[rt.GoType], format)
+
+		rt = simpleResolvedType("array", "", &spec.Items{SimpleSchema: spec.SimpleSchema{Type: "integer", Format: format}})
+		assert.Equal(t, "[]"+format, rt.GoType)
+	}
+
+	lo, hi, over := float64(-100), float64(100), float64(300)
+	assert.Empty(t, boundsMismatch("int8", &lo, &hi))
+	assert.Empty(t, boundsMismatch("int64", nil, &over))
+	assert.Equal(t, []string{"maximum 300 is beyond the range of the uint8 format"}, boundsMismatch("uint8", nil, &over))
+	assert.Equal(t, []string{
+		"minimum -100 is beyond the range of the uint16 format",
+		"maximum 300 is beyond the range of the int8 format",
+	}, append(boundsMismatch("uint16", &lo, nil), boundsMismatch("int8", nil, &over)...))
+}
+
+func TestTypeResolver_NullablePolicy(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.nullable-policy.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	task := doc.Spec().Definitions["Task"]
+	required := map[string]bool{"title": true, "priority": true}
+
+	for policy, expected := range map[NullableBehavior]map[string]bool{
+		"": {
+			"title": true, "notes": false, "priority": true, "estimate": true, "done": true,
+			"due": false, "attachment": false, "archived": true, "rank": false,
+		},
+		NullableAlwaysPointer: {
+			"title": true, "notes": true, "priority": true, "estimate": true, "done": true,
+			"due": true, "attachment": false, "archived": true, "rank": false,
+		},
+		// x-nullable prevails over the policy, binaries are never pointers
+		NullableNeverPointer: {
+			"title": false, "notes": false, "priority": false, "estimate": false, "done": false,
+			"due": false, "attachment": false, "archived": true, "rank": false,
+		},
+	} {
+		resolver := newTypeResolver("", doc)
+		resolver.Opts = &GenOpts{NullablePolicy: policy}
+		for prop, nullable := range expected {
+			sch := task.Properties[prop]
+			rt, err := resolver.ResolveSchema(&sch, true, required[prop])
+			if assert.NoError(t, err, prop) {
+				assert.Equal(t, nullable, rt.IsNullable, "%s with policy %q", prop, policy)
+			}
+		}
+	}
+
+	assert.NoError(t, checkNullablePolicy(""))
+	assert.NoError(t, checkNullablePolicy(NullableNeverPointer))
+	assert.Error(t, checkNullablePolicy("sometimes"))
+}
+
+func TestTypeResolver_UnresolvableRef(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.unresolvable-ref.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	resolver := newTypeResolver("models", doc)
+
+	task := doc.Spec().Definitions["Task"]
+	owner := task.Properties["owner"]
+	_, err = resolver.ResolveSchema(&owner, true, false)
+	if assert.Error(t, err) {
+		assert.Equal(t, `unresolvable $ref "#/definitions/Usr" in #/definitions/Task/properties/owner: there is no definition "Usr", did you mean "User" or "Users"?`, err.Error())
+	}
+
+	// the ref is named as written, along with every place it is written
+	body := doc.Spec().Paths.Paths["/tasks"].Post.Parameters[0].Schema
+	_, err = resolver.ResolveSchema(body, true, false)
+	if assert.Error(t, err) {
+		assert.Equal(t, `unresolvable $ref "#/definitions/task" in #/paths/~1tasks/post/parameters/0/schema, #/paths/~1tasks/post/responses/200/schema: there is no definition "task", did you mean "Task"?`, err.Error())
+	}
+
+	// a ref into a known definition is left to the resolution
+	title := task.Properties["title"]
+	_, err = resolver.ResolveSchema(&title, true, false)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 0, editDistance("Task", "Task"))
+	assert.Equal(t, 1, editDistance("Usr", "User"))
+	assert.Equal(t, 3, editDistance("kitten", "sitting"))
+	assert.Empty(t, closestNames("Invoice", []string{"Task", "User", "Label"}))
+}
+
+func TestTypeResolver_Description(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.doc-comments.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	resolver := newTypeResolver("models", doc)
+	task := doc.Spec().Definitions["Task"]
+
+	// a $ref carries the documentation of its target
+	home := task.Properties["home"]
+	rt, err := resolver.ResolveSchema(&home, true, false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "A postal address.\nThe lines are joined with commas.\n", rt.Description)
+		assert.Equal(t, `map[string]interface {}{"street":"1 Main St"}`, rt.Example)
+	}
+
+	code := task.Properties["code"]
+	rt, err = resolver.ResolveSchema(&code, true, false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, code.Description, rt.Description)
+		assert.Equal(t, `"TSK-1"`, rt.Example)
+	}
+}
+
+func TestTypeResolver_ReadWriteOnly(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.read-write.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	resolver := newTypeResolver("models", doc)
+	account := doc.Spec().Definitions["Account"]
+
+	for _, tc := range []struct {
+		property  string
+		readOnly  bool
+		writeOnly bool
+	}{
+		{"id", true, false},
+		{"createdAt", true, false},
+		{"login", false, false},
+		// the writeOnly keyword of OpenAPI 3 and the x-writeOnly extension
+		{"password", false, true},
+		{"recoveryCode", false, true},
+		{"owner", false, false},
+	} {
+		prop := account.Properties[tc.property]
+		rt, err := resolver.ResolveSchema(&prop, true, false)
+		if assert.NoError(t, err, tc.property) {
+			assert.Equal(t, tc.readOnly, rt.IsReadOnly, tc.property)
+			assert.Equal(t, tc.writeOnly, rt.IsWriteOnly, tc.property)
+		}
+	}
+}
+
+func TestTypeResolver_MapKeyType(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.type-key.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	resolver := newTypeResolver("models", doc)
+	scores := doc.Spec().Definitions["Scores"]
+	rt, err := resolver.ResolveSchema(&scores, false, true)
+	if assert.NoError(t, err) {
+		assert.True(t, rt.IsMap)
+		assert.Equal(t, "int64", rt.KeyType)
+	}
+
+	schedule := doc.Spec().Definitions["Schedule"]
+	for property, goType := range map[string]string{
+		"slots":  "map[models.Weekday]models.Slot",
+		"counts": "map[uint16]*int64",
+	} {
+		prop := schedule.Properties[property]
+		rt, err := resolver.ResolveSchema(&prop, true, false)
+		if assert.NoError(t, err, property) {
+			assert.Equal(t, goType, rt.GoType, property)
+		}
+	}
+
+	doc, err = loads.Spec("../fixtures/codegen/todolist.type-key-invalid.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	resolver = newTypeResolver("models", doc)
+	for name, message := range map[string]string{
+		"FloatKeys":   `x-go-type-key "float64" isn't a supported map key`,
+		"RatioKeys":   `x-go-type-key "Ratio" isn't a supported map key`,
+		"SlotKeys":    `x-go-type-key "Slot" isn't a supported map key`,
+		"UnknownKeys": `x-go-type-key "Missing" isn't a supported map key`,
+		"ObjectKeys":  "x-go-type-key only applies to maps",
+		"PatternKeys": "x-go-type-key and x-property-names can't be used together",
+	} {
+		sch := doc.Spec().Definitions[name]
+		resolver.ModelName = name
+		_, err := resolver.ResolveSchema(&sch, false, true)
+		if assert.Error(t, err, name) {
+			assert.Contains(t, err.Error(), name+": "+message)
+		}
+	}
+}
+
+func TestTypeResolver_CollectionDefaultZero(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/todolist.collection-defaults.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := doc.Spec().Definitions
+	task := definitions["Task"]
+	resolver := newTypeResolver("", doc)
+
+	for _, tc := range []struct {
+		property string
+		zero     string
+	}{
+		{"tags", `[]string{"home", "chores"}`},
+		// an empty default is still a non nil slice
+		{"watchers", "[]int64{}"},
+		{"scores", `map[string]float64{"effort": 2, "value": 0.5}`},
+		{"grid", "[][]bool{[]bool{true, false}, []bool{}}"},
+		// the default of an array of models is no literal
+		{"notes", "make([]Note)"},
+		{"steps", "make([]string)"},
+	} {
+		prop := task.Properties[tc.property]
+		rt, err := resolver.ResolveSchema(&prop, true, false)
+		if assert.NoError(t, err, tc.property) {
+			assert.Equal(t, tc.zero, rt.Zero(), tc.property)
+		}
+	}
+
+	// a named array starts with its own default
+	labels := definitions["Labels"]
+	resolver.ModelName = "Labels"
+	rt, err := resolver.ResolveSchema(&labels, false, false)
+	resolver.ModelName = ""
+	if assert.NoError(t, err) {
+		assert.Equal(t, `Labels{"inbox"}`, rt.Zero())
+	}
+
+	// a null default, which the spec leaves out when it is parsed, is a nil slice
+	prop := task.Properties["steps"]
+	rt, err = resolver.ResolveSchema(&prop, true, false)
+	if assert.NoError(t, err) {
+		rt.hasDefault = true
+		assert.Equal(t, "nil", rt.Zero())
+	}
+}
@@ -15,11 +15,21 @@
 package generator
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"math"
+	"net/url"
+	"path"
 	"path/filepath"
+	"reflect"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/spec"
@@ -41,12 +51,59 @@ const (
 	boolean     = "boolean"
 	str         = "string"
 	object      = "object"
+	null        = "null"
 	binary      = "binary"
 	xNullable   = "x-nullable"
 	xIsNullable = "x-isnullable"
+	xSensitive  = "x-sensitive"
+	xPropNames  = "x-property-names"
+	xGoIgnore   = "x-go-ignore"
+	xGoPatch    = "x-go-patch"
+	xDocNote    = "x-doc-note"
+	xGoUnwrap   = "x-go-unwrap"
+	xCustomTag  = "x-go-custom-tag"
+	xGoType     = "x-go-type"
+	xTrim       = "x-trim"
+	xLowercase  = "x-lowercase"
+	xMinDate    = "x-min-date"
+	xMaxDate    = "x-max-date"
+	xTupleNames = "x-go-tuple-names"
+	xWriteOnly  = "x-writeOnly"
+	xGoTypeKey  = "x-go-type-key"
+	strfmtPkg   = "github.com/go-openapi/strfmt"
 	sHTTP       = "http"
+
+	// timeOfDay is generated in the models package, strfmt has no type for the full-time of RFC 3339
+	timeOfDay = "TimeOfDay"
+	// char is generated in the models package for the single character strings, with the single-char option
+	char = "Char"
+)
+
+// NullableBehavior is the policy deciding which primitive properties of the models are rendered as pointers.
+// The nullability set explicitly on a schema, with x-nullable or the nullable keyword, prevails over it.
+type NullableBehavior string
+
+const (
+	// NullableSpec renders as pointers the properties whose zero value can't be told apart from a missing one,
+	// like the required ones and the ones with a default or bounds allowing the zero value
+	NullableSpec NullableBehavior = "spec"
+	// NullableAlwaysPointer renders every primitive property as a pointer
+	NullableAlwaysPointer NullableBehavior = "always-pointer"
+	// NullableNeverPointer renders every primitive property as a value,
+	// the required ones are still serialized when they hold the zero value
+	NullableNeverPointer NullableBehavior = "never-pointer"
 )
 
+// checkNullablePolicy returns an error when the policy is not a known nullable behavior
+func checkNullablePolicy(policy NullableBehavior) error {
+	switch policy {
+	case "", NullableSpec, NullableAlwaysPointer, NullableNeverPointer:
+		return nil
+	default:
+		return fmt.Errorf("unknown nullable policy %q, expected one of %s, %s, %s", policy, NullableSpec, NullableAlwaysPointer, NullableNeverPointer)
+	}
+}
+
 var zeroes = map[string]string{
 	"string":            "\"\"",
 	"int8":              "0",
@@ -81,8 +138,13 @@ var zeroes = map[string]string{
 	"strfmt.Password":   "strfmt.Password(\"\")",
 	"strfmt.HexColor":   "strfmt.HexColor(\"#000000\")",
 	"strfmt.RGBColor":   "strfmt.RGBColor(\"rgb(0,0,0)\")",
+	"strfmt.MAC":        "strfmt.MAC(\"\")",
 	"strfmt.Base64":     "nil",
 	"strfmt.Duration":   "0",
+	"net.IP":            "nil",
+	"[]byte":            "nil",
+	"io.ReadCloser":     "nil",
+	"io.Writer":         "nil",
 }
 
 var stringConverters = map[string]string{
@@ -138,6 +200,7 @@ var typeMapping = map[string]string{
 	"rgbcolor":   "strfmt.RGBColor",
 	"duration":   "strfmt.Duration",
 	"password":   "strfmt.Password",
+	"time":       timeOfDay,
 	"binary":     "io.ReadCloser",
 	"char":       "rune",
 	"int":        "int64",
@@ -168,7 +231,7 @@ func init() {
 	}
 }
 
-func simpleResolvedType(tn, fmt string, items *spec.Items) (result resolvedType) {
+func simpleResolvedType(tn, fmt string, items *spec.Items) (result ResolvedType) {
 	result.SwaggerType = tn
 	result.SwaggerFormat = fmt
 	//_, result.IsPrimitive = primitives[tn]
@@ -200,8 +263,9 @@ func simpleResolvedType(tn, fmt string, items *spec.Items) (result resolvedType)
 			result.GoType = "[]" + iface
 			return
 		}
-		res := simpleResolvedType(items.Type, items.Format, items.Items)
+		res := itemResolvedType(items)
 		result.GoType = "[]" + res.GoType
+		result.ElemType = &res
 		return
 	}
 	result.GoType = tn
@@ -209,7 +273,14 @@ func simpleResolvedType(tn, fmt string, items *spec.Items) (result resolvedType)
 	return
 }
 
-func typeForHeader(header spec.Header) resolvedType {
+// itemResolvedType resolves the items of a simple array, the files of a multipart form are streamed one by one
+func itemResolvedType(items *spec.Items) ResolvedType {
+	res := simpleResolvedType(items.Type, items.Format, items.Items)
+	res.IsStream = res.IsStream || items.Type == file
+	return res
+}
+
+func typeForHeader(header spec.Header) ResolvedType {
 	return simpleResolvedType(header.Type, header.Format, header.Items)
 }
 
@@ -232,16 +303,31 @@ func resolveSimpleType(tn, fmt string, items *spec.Items) string {
 	if tn == "array" {
 		// TODO: Items can't be nil per spec, this should return an error
 		if items == nil {
-			return "[]interface{}"
+			return "[]" + iface
 		}
 		return "[]" + resolveSimpleType(items.Type, items.Format, items.Items)
 	}
 	return tn
 }
 
+// TypeResolver resolves the go types the schemas of a swagger document are rendered with
+type TypeResolver interface {
+	// ResolveSchema resolves the go type of a schema. The schemas which aren't anonymous are named
+	// after the model the resolver is for, a required schema may end up as a pointer.
+	ResolveSchema(schema *spec.Schema, isAnonymous, isRequired bool) (ResolvedType, error)
+	// ForModel returns a resolver for the schemas of the named definition, sharing the imports of this one
+	ForModel(name string) TypeResolver
+}
+
+// NewTypeResolver creates a resolver for the types of the document, rendered in the models package pkg
+func NewTypeResolver(pkg string, doc *loads.Document) TypeResolver {
+	return newTypeResolver(pkg, doc)
+}
+
 func newTypeResolver(pkg string, doc *loads.Document) *typeResolver {
 	resolver := typeResolver{ModelsPackage: pkg, Doc: doc}
 	resolver.KnownDefs = make(map[string]struct{}, 64)
+	resolver.Imports = make(map[string]struct{})
 	for k, sch := range doc.OrigSpec().Definitions {
 		resolver.KnownDefs[k] = struct{}{}
 		if nm, ok := sch.Extensions["x-go-name"]; ok {
@@ -256,14 +342,259 @@ type typeResolver struct {
 	ModelsPackage string
 	ModelName     string
 	KnownDefs     map[string]struct{}
+	Opts          *GenOpts
+
+	// Imports collects the packages of the resolved types which aren't imported
+	// by default, it is shared by all the resolvers derived from this one
+	Imports map[string]struct{}
+
+	// resolving holds the refs being resolved, to tell the refs met again while
+	// resolving their own target
+	resolving map[string]struct{}
+
+	// openAPI3 caches whether the document is an OpenAPI 3.x one, nil until it's known
+	openAPI3 *bool
+}
+
+// NewWithModelName creates a new resolver for the same document and options,
+// resolving types in the context of the named model
+func (t *typeResolver) NewWithModelName(name string) *typeResolver {
+	tr := newTypeResolver(t.ModelsPackage, t.Doc)
+	tr.ModelName = name
+	tr.Opts = t.Opts
+	tr.Imports = t.Imports
+	nativeNullable := t.nativeNullable()
+	tr.openAPI3 = &nativeNullable
+	return tr
+}
+
+// ForModel returns a resolver for the schemas of the named definition
+func (t *typeResolver) ForModel(name string) TypeResolver {
+	return t.NewWithModelName(name)
+}
+
+// addImport collects the package of a resolved type. The packages of the standard library are left
+// to goimports, which adds them to the generated files lacking them: some templates import them already.
+func (t *typeResolver) addImport(pkg string) {
+	if !strings.Contains(strings.SplitN(pkg, "/", 2)[0], ".") {
+		return
+	}
+	if t.Imports == nil {
+		t.Imports = make(map[string]struct{})
+	}
+	t.Imports[pkg] = struct{}{}
+}
+
+// importList returns the sorted packages registered while resolving types
+func (t *typeResolver) importList() []string {
+	res := make([]string, 0, len(t.Imports))
+	for imp := range t.Imports {
+		res = append(res, imp)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func (t *typeResolver) tuplesAsSlices() bool {
+	return t.Opts != nil && t.Opts.TuplesAsSlices
+}
+
+// fixedArrays returns true when the array definitions with as many minItems as maxItems
+// are rendered as a fixed-size array
+func (t *typeResolver) fixedArrays() bool {
+	return t.Opts != nil && t.Opts.FixedArrays
+}
+
+// fixedArraySize returns the size of a fixed-size array definition, 0 when the items can vary.
+//
+// Unique items are left to slices: validate.UniqueItems only checks slices.
+func fixedArraySize(schema *spec.Schema) int64 {
+	if schema.MinItems == nil || schema.MaxItems == nil || *schema.MinItems != *schema.MaxItems {
+		return 0
+	}
+	if schema.UniqueItems || schema.AdditionalItems != nil {
+		return 0
+	}
+	return *schema.MaxItems
+}
+
+func (t *typeResolver) strict() bool {
+	return t.Opts != nil && t.Opts.Strict
+}
+
+func (t *typeResolver) omitIgnored() bool {
+	return t.Opts != nil && t.Opts.OmitIgnored
+}
+
+// dirtyTracking returns true when the models record the properties set with their setters
+func (t *typeResolver) dirtyTracking() bool {
+	return t.Opts != nil && t.Opts.DirtyTracking
+}
+
+// withNormalize returns true when the models get a Normalize method applying their defaults and cleaning up their strings
+func (t *typeResolver) withNormalize() bool {
+	return t.Opts != nil && t.Opts.WithNormalize
+}
+
+// withScrub returns true when the models get a Scrub method redacting their sensitive properties
+func (t *typeResolver) withScrub() bool {
+	return t.Opts != nil && t.Opts.WithScrub
+}
+
+// iface returns the empty interface type, rendered as any when targeting go 1.18 or later
+func (t *typeResolver) iface() string {
+	return t.Opts.iface()
+}
+
+// withFieldMask returns true when the models get an ApplyFieldMask method copying the fields named by a field mask
+func (t *typeResolver) withFieldMask() bool {
+	return t.Opts != nil && t.Opts.WithFieldMask
+}
+
+// withGob returns true when the polymorphic models are registered with encoding/gob
+func (t *typeResolver) withGob() bool {
+	return t.Opts != nil && t.Opts.WithGob
+}
+
+// extraFields returns true when the models capture the unknown fields of the JSON objects they unmarshal
+func (t *typeResolver) extraFields() bool {
+	return t.Opts != nil && t.Opts.ExtraFields
+}
+
+// withDiff returns true when the models get a DiffFrom method listing the fields which differ from another version
+func (t *typeResolver) withDiff() bool {
+	return t.Opts != nil && t.Opts.WithDiff
+}
+
+// typedErrors returns true when the models get a ValidateStructured method, and prefix the errors of their nested models with their path
+func (t *typeResolver) typedErrors() bool {
+	return t.Opts != nil && t.Opts.TypedErrors
+}
+
+// splitReadWrite returns true when the models with read-only or write-only properties get Request and Response variants
+func (t *typeResolver) splitReadWrite() bool {
+	return t.Opts != nil && t.Opts.SplitReadWrite
+}
+
+// withBuilder returns true when the models get a fluent builder
+func (t *typeResolver) withBuilder() bool {
+	return t.Opts != nil && t.Opts.WithBuilder
+}
+
+// netIP returns true when the ipv4 and ipv6 formats resolve to net.IP
+func (t *typeResolver) netIP() bool {
+	return t.Opts != nil && t.Opts.NetIP
+}
+
+// plainBytes returns true when the byte format resolves to a []byte, which encoding/json
+// marshals as a base64 string
+func (t *typeResolver) plainBytes() bool {
+	return t.Opts != nil && t.Opts.PlainBytes
+}
+
+// singleChar returns the underlying type of the Char type of the single character strings,
+// it is empty when they resolve to a string or to the rune of format char
+func (t *typeResolver) singleChar() string {
+	if t.Opts == nil {
+		return ""
+	}
+	return t.Opts.SingleChar
+}
+
+// enumStatus returns the status code answering a value out of the enum of a param bound server-side
+func (t *typeResolver) enumStatus() int {
+	if t.Opts == nil || t.Opts.EnumStatus == 0 {
+		return 400
+	}
+	return t.Opts.EnumStatus
+}
+
+// contextFormat returns true when the format is validated with the request context
+func (t *typeResolver) contextFormat(format string) bool {
+	return t.Opts != nil && format != "" && containsString(t.Opts.ContextFormats, format)
+}
+
+// hasContextValidations returns true when the schema, or a schema it refers to or contains,
+// uses a context-aware format
+func (t *typeResolver) hasContextValidations(schema *spec.Schema) bool {
+	if t.Opts == nil || len(t.Opts.ContextFormats) == 0 {
+		return false
+	}
+	return t.walkContextValidations(schema, make(map[string]struct{}))
+}
+
+func (t *typeResolver) walkContextValidations(schema *spec.Schema, seen map[string]struct{}) bool {
+	if schema == nil {
+		return false
+	}
+	if ref := schema.Ref.String(); ref != "" {
+		if _, ok := seen[ref]; ok {
+			return false
+		}
+		seen[ref] = struct{}{}
+		sch, err := spec.ResolveRef(t.Doc.Spec(), &schema.Ref)
+		if err != nil {
+			return false
+		}
+		return t.walkContextValidations(sch, seen)
+	}
+	if t.contextFormat(schema.Format) {
+		return true
+	}
+	for i := range schema.AllOf {
+		if t.walkContextValidations(&schema.AllOf[i], seen) {
+			return true
+		}
+	}
+	for k := range schema.Properties {
+		prop := schema.Properties[k]
+		if t.walkContextValidations(&prop, seen) {
+			return true
+		}
+	}
+	if schema.Items != nil {
+		if t.walkContextValidations(schema.Items.Schema, seen) {
+			return true
+		}
+		for i := range schema.Items.Schemas {
+			if t.walkContextValidations(&schema.Items.Schemas[i], seen) {
+				return true
+			}
+		}
+	}
+	if schema.AdditionalProperties != nil && t.walkContextValidations(schema.AdditionalProperties.Schema, seen) {
+		return true
+	}
+	return false
 }
 
 func (t *typeResolver) IsNullable(schema *spec.Schema) bool {
 	nullable := t.isNullable(schema)
-	return nullable || len(schema.AllOf) > 0
+	if len(schema.AllOf) == 1 && !t.Opts.compat().NullableAllOf {
+		// a single member only wraps its type, with a description or the like
+		return nullable || t.isNullableMember(&schema.AllOf[0])
+	}
+	return nullable || len(schema.AllOf) > 0 || isUnion(schema)
+}
+
+// isNullableMember tells if the member of an allOf is nullable, following its $ref
+func (t *typeResolver) isNullableMember(member *spec.Schema) bool {
+	if member.Ref.String() == "" {
+		return t.IsNullable(member)
+	}
+	target, err := t.resolveRef(&member.Ref)
+	if err != nil || target == nil {
+		return true
+	}
+	return t.isNullable(member) || t.IsNullable(target)
+}
+
+// isUnion returns true when the schema is a oneOf or an anyOf composition
+func isUnion(schema *spec.Schema) bool {
+	return schema.Ref.String() == "" && (len(schema.OneOf) > 0 || len(schema.AnyOf) > 0)
 }
 
-func (t *typeResolver) resolveSchemaRef(schema *spec.Schema, isRequired bool) (returns bool, result resolvedType, err error) {
+func (t *typeResolver) resolveSchemaRef(schema *spec.Schema, isRequired bool) (returns bool, result ResolvedType, err error) {
 	if schema.Ref.String() != "" {
 		if Debug {
 			_, file, pos, _ := runtime.Caller(1)
@@ -271,12 +602,29 @@ func (t *typeResolver) resolveSchemaRef(schema *spec.Schema, isRequired bool) (r
 		}
 		returns = true
 
-		ref, er := spec.ResolveRef(t.Doc.Spec(), &schema.Ref)
+		if err = t.checkRef(&schema.Ref); err != nil {
+			return
+		}
+		ref, er := t.resolveRef(&schema.Ref)
 		if er != nil {
 			err = er
 			return
 		}
+		defer func() {
+			// a $ref is documented by its target, including a mapped or a cyclic one
+			if result.Description == "" {
+				result.Description = ref.Description
+			}
+			if result.Example == "" && ref.Example != nil {
+				result.Example = fmt.Sprintf("%#v", ref.Example)
+			}
+		}()
 		var nm = filepath.Base(schema.Ref.GetURL().Fragment)
+		if ov, ok := t.mappedType(nm); ok {
+			// a mapped definition isn't generated, it is rendered as the go type it is mapped to
+			result = t.externalType(ref, ov.GoType, ov.Formatter, ov.Zero, isRequired)
+			return
+		}
 		var tn string
 		if gn, ok := ref.Extensions["x-go-name"]; ok {
 			tn = gn.(string)
@@ -285,7 +633,24 @@ func (t *typeResolver) resolveSchemaRef(schema *spec.Schema, isRequired bool) (r
 			tn = swag.ToGoName(nm)
 		}*/
 
-		res, er := t.ResolveSchema(ref, false, isRequired)
+		// a wrapper marked with x-go-unwrap is rendered as the type of its single property
+		target := ref
+		if _, inner, er := unwrapProperty(ref); er == nil && inner != nil {
+			target = inner
+		}
+
+		key := schema.Ref.String()
+		if _, cyclic := t.resolving[key]; cyclic {
+			result, err = t.resolveCyclicRef(target, nm)
+			return
+		}
+		if t.resolving == nil {
+			t.resolving = make(map[string]struct{})
+		}
+		t.resolving[key] = struct{}{}
+		defer delete(t.resolving, key)
+
+		res, er := t.ResolveSchema(target, false, isRequired)
 		if er != nil {
 			err = er
 			return
@@ -293,8 +658,19 @@ func (t *typeResolver) resolveSchemaRef(schema *spec.Schema, isRequired bool) (r
 		result = res
 
 		result.GoType = t.goTypeName(nm)
+		if result.IsCustomFormatter && !result.IsAliased {
+			// the zero of the named formatter converts the one of its underlying type
+			if zr := res.zeroValue(); zr != "" && zr != "nil" {
+				result.zero = result.GoType + "(" + zr + ")"
+			}
+		}
 		result.HasDiscriminator = ref.Discriminator != ""
-		result.IsNullable = t.IsNullable(ref)
+		result.DiscriminatorValues = nil
+		if result.HasDiscriminator {
+			result.DiscriminatorValues = t.discriminatorValues(nm)
+		}
+		// a fixed-size array is never empty, only a pointer tells a missing one apart
+		result.IsNullable = t.IsNullable(target) || result.FixedSize > 0
 		//result.IsAliased = true
 		return
 
@@ -302,7 +678,488 @@ func (t *typeResolver) resolveSchemaRef(schema *spec.Schema, isRequired bool) (r
 	return
 }
 
-func (t *typeResolver) inferAliasing(result *resolvedType, schema *spec.Schema, isAnonymous bool, isRequired bool) {
+// resolveGoType resolves a schema marked with x-go-type to the go type it names, qualified with its
+// import path like github.com/acme/paging.Paginated[T]. A type without a path is one of the models package.
+// The type parameter T stands for the resolved type of the items of an array or of the values of a map.
+//
+// The go type overrides the mapping of the format of a scalar. Such a type is validated with the strfmt
+// registry under the name of the format, like the types of the strfmt package, when the extension hints
+// it is a formatter.
+func (t *typeResolver) resolveGoType(schema *spec.Schema, isAnonymous, isRequired bool) (returns bool, result ResolvedType, err error) {
+	v, ok := schema.Extensions[xGoType]
+	if !ok {
+		return
+	}
+	returns = true
+	name, formatter, zero, err := goTypeExtension(v)
+	if err != nil {
+		return
+	}
+
+	base, params := name, ""
+	if i := strings.Index(name, "["); i >= 0 {
+		if !strings.HasSuffix(name, "]") {
+			err = fmt.Errorf("%s %q has unbalanced type parameters", xGoType, name)
+			return
+		}
+		base, params = name[:i], name[i+1:len(name)-1]
+	}
+	goType := t.goTypeName(base)
+	if i := strings.LastIndex(base, "."); i >= 0 {
+		pkg := base[:i]
+		if pkg != strfmtPkg {
+			// the generated code imports strfmt already
+			t.addImport(pkg)
+		}
+		goType = path.Base(pkg) + base[i:]
+	}
+
+	if params != "" {
+		if !t.Opts.generics() {
+			err = fmt.Errorf("%s %q has type parameters, which need --go-version 1.18 or later", xGoType, name)
+			return
+		}
+		args := strings.Split(params, ",")
+		for i, arg := range args {
+			arg = strings.TrimSpace(arg)
+			if arg == "T" {
+				var elem *spec.Schema
+				switch {
+				case schema.Items != nil && schema.Items.Schema != nil:
+					elem = schema.Items.Schema
+				case schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil:
+					elem = schema.AdditionalProperties.Schema
+				default:
+					err = fmt.Errorf("%s %q: T stands for the items of an array or the values of a map", xGoType, name)
+					return
+				}
+				et, er := t.ResolveSchema(elem, true, false)
+				if er != nil {
+					err = er
+					return
+				}
+				arg = et.GoType
+			}
+			args[i] = arg
+		}
+		goType += "[" + strings.Join(args, ", ") + "]"
+	}
+
+	result = t.externalType(schema, goType, formatter, zero, isRequired)
+	if !isAnonymous {
+		// a definition is a named type of the models package
+		result.IsAliased = true
+		result.AliasedType = goType
+		result.GoType = t.goTypeName(t.ModelName)
+	}
+	return
+}
+
+// externalType resolves a schema rendered as a go type which isn't generated, named by x-go-type or
+// by the type mapping
+func (t *typeResolver) externalType(schema *spec.Schema, goType string, formatter bool, zero string, isRequired bool) (result ResolvedType) {
+	result.IsExternal = true
+	result.SwaggerType = t.firstType(schema)
+	result.SwaggerFormat = schema.Format
+	result.IsNullable = t.isNullable(schema)
+	result.GoType = goType
+	switch result.SwaggerType {
+	case str:
+		result.IsPrimitive = true
+		result.IsNullable = t.nullableStrfmt(schema, isRequired)
+	case number, integer:
+		result.IsPrimitive = true
+		result.IsNullable = t.nullableNumber(schema, isRequired)
+	case boolean:
+		result.IsPrimitive = true
+		result.IsNullable = t.nullableBool(schema, isRequired)
+	}
+	if result.IsPrimitive && schema.Format != "" {
+		_, known := customFormatters[goType]
+		result.IsCustomFormatter = formatter || known
+	}
+	result.zero = zero
+	return
+}
+
+// goTypeExtension reads the go type named by x-go-type, either a string like github.com/shopspring/decimal.Decimal
+// or an object like:
+//
+//	x-go-type:
+//	  type: Decimal
+//	  import:
+//	    package: github.com/shopspring/decimal
+//	  hints:
+//	    formatter: true
+//	    zero: decimal.Zero
+//
+// The formatter hint tells the type implements the interfaces of the strfmt registry,
+// the zero hint is the go expression of the zero value of the type.
+func goTypeExtension(v interface{}) (name string, formatter bool, zero string, err error) {
+	switch ext := v.(type) {
+	case string:
+		name = strings.TrimSpace(ext)
+	case map[string]interface{}:
+		tpe, _ := ext["type"].(string)
+		name = strings.TrimSpace(tpe)
+		if imp, ok := ext["import"].(map[string]interface{}); ok {
+			if pkg, ok := imp["package"].(string); ok && strings.TrimSpace(pkg) != "" && name != "" {
+				name = strings.TrimSpace(pkg) + "." + name
+			}
+		}
+		if hints, ok := ext["hints"].(map[string]interface{}); ok {
+			formatter, _ = hints["formatter"].(bool)
+			zero, _ = hints["zero"].(string)
+			zero = strings.TrimSpace(zero)
+		}
+	}
+	if name == "" {
+		err = fmt.Errorf("%s must be a go type qualified with its import path, like github.com/acme/paging.Page", xGoType)
+	}
+	return
+}
+
+// resolveCyclicRef resolves a ref met again while resolving its own target, like the named arrays
+// or maps of mutually recursive types, down to the name of its type without walking its target again.
+//
+// An object is always referred to with a pointer, and a map or a slice is a reference already,
+// either breaks the cycle in the generated types. A target which is itself a $ref is followed
+// to the type it ends up with: refs referring to each other without any type in between can't be generated.
+func (t *typeResolver) resolveCyclicRef(target *spec.Schema, nm string) (result ResolvedType, err error) {
+	seen := make(map[string]struct{})
+	for target.Ref.String() != "" {
+		key := target.Ref.String()
+		if _, loops := seen[key]; loops {
+			return result, fmt.Errorf("%s: circular $ref %s, the cycle has no type to break it", nm, key)
+		}
+		seen[key] = struct{}{}
+		next, er := t.resolveRef(&target.Ref)
+		if er != nil {
+			return result, er
+		}
+		target = next
+	}
+	result.GoType = t.goTypeName(nm)
+	result.SwaggerType = t.firstType(target)
+	// like the other named types, the maps referred to are complex objects
+	result.IsArray = result.SwaggerType == array
+	result.IsComplexObject = result.SwaggerType == object
+	result.HasDiscriminator = target.Discriminator != ""
+	if result.HasDiscriminator {
+		result.DiscriminatorValues = t.discriminatorValues(nm)
+	}
+	result.IsNullable = t.IsNullable(target)
+	return
+}
+
+// resolveRef resolves a schema ref, reconciling refs to definitions that were bundled
+// into this document from other documents.
+//
+// When a ref points to a definition in another document which was copied in the
+// definitions of this document, the local definition is used. This keeps the identity
+// of the known definition instead of ending up with an anonymous copy of it.
+func (t *typeResolver) resolveRef(ref *spec.Ref) (*spec.Schema, error) {
+	u := ref.GetURL()
+	remote := u != nil && (u.Host != "" || u.Path != "")
+	if remote {
+		if nm, ok := t.knownDefinition(ref); ok && t.bundledDefinition(ref, nm) == bundledCopy {
+			if def, ok := t.Doc.OrigSpec().Definitions[nm]; ok {
+				if Debug {
+					log.Printf("reconciled ref %s with local definition %q", ref.String(), nm)
+				}
+				return &def, nil
+			}
+		}
+	}
+
+	sch, err := spec.ResolveRef(t.Doc.Spec(), ref)
+	if err != nil {
+		// the document we resolve against may have diverged from the original one
+		if nm, ok := t.knownDefinition(ref); ok && (!remote || t.bundledDefinition(ref, nm) == bundledCopy) {
+			if def, ok := t.Doc.OrigSpec().Definitions[nm]; ok {
+				return &def, nil
+			}
+		}
+		return nil, err
+	}
+	return sch, nil
+}
+
+type bundledState int
+
+const (
+	bundledUnknown bundledState = iota
+	bundledCopy
+	bundledMismatch
+)
+
+// remoteDocuments caches the documents remote refs point to, loaded once per location for
+// all the models of a generation, along with the comparisons of their definitions with the
+// local ones. A nil cache loads the documents every time.
+type remoteDocuments struct {
+	mu      sync.Mutex
+	docs    map[string]*remoteDocument
+	bundled map[string]bundledState
+}
+
+type remoteDocument struct {
+	once sync.Once
+	doc  *loads.Document
+	err  error
+}
+
+func newRemoteDocuments() *remoteDocuments {
+	return &remoteDocuments{
+		docs:    make(map[string]*remoteDocument),
+		bundled: make(map[string]bundledState),
+	}
+}
+
+// load returns the document at location, loading it on first use
+func (r *remoteDocuments) load(location string) (*loads.Document, error) {
+	if r == nil {
+		return loads.Spec(location)
+	}
+	r.mu.Lock()
+	d, ok := r.docs[location]
+	if !ok {
+		d = new(remoteDocument)
+		r.docs[location] = d
+	}
+	r.mu.Unlock()
+	d.once.Do(func() {
+		d.doc, d.err = loads.Spec(location)
+	})
+	return d.doc, d.err
+}
+
+func (r *remoteDocuments) comparison(key string) (bundledState, bool) {
+	if r == nil {
+		return bundledUnknown, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	state, ok := r.bundled[key]
+	return state, ok
+}
+
+func (r *remoteDocuments) setComparison(key string, state bundledState) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bundled[key] = state
+}
+
+// bundledDefinition tells if the definition a remote ref points to is a copy of the definition of the same
+// name in this document, comparing both once expanded in their own document. The remote document is found
+// relative to the spec, when it can't be loaded the state is unknown.
+func (t *typeResolver) bundledDefinition(ref *spec.Ref, nm string) bundledState {
+	if t.Opts == nil || t.Opts.Spec == "" {
+		return bundledUnknown
+	}
+	key := ref.String()
+	if state, ok := t.Opts.remoteDocs.comparison(key); ok {
+		return state
+	}
+	state := t.compareBundled(ref, nm)
+	t.Opts.remoteDocs.setComparison(key, state)
+	return state
+}
+
+func (t *typeResolver) compareBundled(ref *spec.Ref, nm string) bundledState {
+	loc := *ref.GetURL()
+	loc.Fragment = ""
+	location := loc.String()
+	if !loc.IsAbs() && !filepath.IsAbs(loc.Path) {
+		if base, err := url.Parse(t.Opts.Spec); err == nil && base.Host != "" {
+			location = base.ResolveReference(&loc).String()
+		} else {
+			location = filepath.Join(filepath.Dir(t.Opts.Spec), filepath.FromSlash(loc.Path))
+		}
+	}
+	doc, err := t.Opts.remoteDocs.load(location)
+	if err != nil {
+		if Debug {
+			log.Printf("can't load %s to compare the definition %q: %v", location, nm, err)
+		}
+		return bundledUnknown
+	}
+	remote, ok := doc.Spec().Definitions[nm]
+	if !ok {
+		return bundledMismatch
+	}
+	local := t.Doc.OrigSpec().Definitions[nm]
+	remoteExpanded, err := expandedCopy(remote, doc.Spec())
+	if err != nil {
+		return bundledUnknown
+	}
+	localExpanded, err := expandedCopy(local, t.Doc.OrigSpec())
+	if err != nil {
+		return bundledUnknown
+	}
+	if !reflect.DeepEqual(remoteExpanded, localExpanded) {
+		return bundledMismatch
+	}
+	return bundledCopy
+}
+
+// expandedCopy expands the refs of a copy of the schema against its document, leaving the schema untouched
+func expandedCopy(schema spec.Schema, root *spec.Swagger) (*spec.Schema, error) {
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	var cp spec.Schema
+	if err := json.Unmarshal(b, &cp); err != nil {
+		return nil, err
+	}
+	if err := spec.ExpandSchema(&cp, root, nil); err != nil {
+		return nil, err
+	}
+	return &cp, nil
+}
+
+// knownDefinition returns the name of the definition a ref points to,
+// when that definition is known to this resolver
+func (t *typeResolver) knownDefinition(ref *spec.Ref) (string, bool) {
+	u := ref.GetURL()
+	if u == nil || !strings.HasPrefix(u.Fragment, "/definitions/") {
+		return "", false
+	}
+	nm := strings.TrimPrefix(u.Fragment, "/definitions/")
+	if strings.Contains(nm, "/") {
+		return "", false
+	}
+	_, ok := t.KnownDefs[nm]
+	return nm, ok
+}
+
+// checkRef returns an error when a local ref points to a definition which doesn't exist, naming the ref
+// as it is written in the spec, where the spec writes it and the definitions with the closest names
+func (t *typeResolver) checkRef(ref *spec.Ref) error {
+	u := ref.GetURL()
+	if u != nil && (u.Host != "" || u.Path != "") {
+		// the type of a remote definition is named after the local one, which must be the same
+		if nm, ok := t.knownDefinition(ref); ok && t.bundledDefinition(ref, nm) == bundledMismatch {
+			return fmt.Errorf("$ref %q doesn't match the definition %q of this document, its type would be mistaken for it", ref.String(), nm)
+		}
+		return nil
+	}
+	if u == nil || !strings.HasPrefix(u.Fragment, "/definitions/") {
+		return nil
+	}
+	// the ref may point into the definition, which is its first token
+	nm := strings.SplitN(strings.TrimPrefix(u.Fragment, "/definitions/"), "/", 2)[0]
+	nm = strings.Replace(strings.Replace(nm, "~1", "/", -1), "~0", "~", -1)
+	if _, ok := t.KnownDefs[nm]; ok {
+		return nil
+	}
+	if _, ok := t.Doc.Spec().Definitions[nm]; ok {
+		return nil
+	}
+
+	msg := fmt.Sprintf("unresolvable $ref %q", ref.String())
+	if locations := refLocations(t.Doc, ref.String()); len(locations) > 0 {
+		msg += " in " + strings.Join(locations, ", ")
+	}
+	msg += fmt.Sprintf(": there is no definition %q", nm)
+	names := make([]string, 0, len(t.Doc.Spec().Definitions))
+	for k := range t.Doc.Spec().Definitions {
+		names = append(names, k)
+	}
+	if suggestions := closestNames(nm, names); len(suggestions) > 0 {
+		quoted := make([]string, 0, len(suggestions))
+		for _, suggestion := range suggestions {
+			quoted = append(quoted, strconv.Quote(suggestion))
+		}
+		msg += ", did you mean " + strings.Join(quoted, " or ") + "?"
+	}
+	return errors.New(msg)
+}
+
+// refLocations returns the sorted paths of the schemas of the document written as the ref
+func refLocations(doc *loads.Document, ref string) []string {
+	if doc == nil || doc.Analyzer == nil {
+		return nil
+	}
+	var locations []string
+	for _, sch := range doc.Analyzer.AllDefinitions() {
+		if sch.Schema.Ref.String() == ref {
+			locations = append(locations, sch.Ref.String())
+		}
+	}
+	sort.Strings(locations)
+	return locations
+}
+
+// closestNames returns up to 3 names which are the closest to the missing one, by edit distance ignoring the case.
+// The names too far from the missing one to be a typo of it are left out.
+func closestNames(missing string, names []string) []string {
+	type candidate struct {
+		name     string
+		distance int
+	}
+	threshold := len(missing) / 3
+	if threshold < 2 {
+		threshold = 2
+	}
+	var candidates []candidate
+	for _, name := range names {
+		if d := editDistance(strings.ToLower(missing), strings.ToLower(name)); d <= threshold {
+			candidates = append(candidates, candidate{name: name, distance: d})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+	if len(candidates) > 3 {
+		candidates = candidates[:3]
+	}
+	result := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		result = append(result, c.name)
+	}
+	return result
+}
+
+// editDistance returns the Levenshtein distance between two strings, in runes
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+func (t *typeResolver) inferAliasing(result *ResolvedType, schema *spec.Schema, isAnonymous bool, isRequired bool) {
 	if !isAnonymous && t.ModelName != "" {
 		result.AliasedType = result.GoType
 		result.IsAliased = true
@@ -310,7 +1167,20 @@ func (t *typeResolver) inferAliasing(result *resolvedType, schema *spec.Schema,
 	}
 }
 
-func (t *typeResolver) resolveFormat(schema *spec.Schema, isAnonymous bool, isRequired bool) (returns bool, result resolvedType, err error) {
+func (t *typeResolver) resolveFormat(schema *spec.Schema, isAnonymous bool, isRequired bool) (returns bool, result ResolvedType, err error) {
+
+	if t.singleChar() != "" && isSingleChar(schema) {
+		// Char unmarshals from a JSON string of exactly one character, it stands for its length validations
+		returns = true
+		result.SwaggerType = str
+		result.SwaggerFormat = schema.Format
+		result.GoType = qualifyChar(t.ModelsPackage)
+		result.IsChar = true
+		t.inferAliasing(&result, schema, isAnonymous, isRequired)
+		result.IsPrimitive = true
+		result.IsNullable = t.nullableStrfmt(schema, isRequired)
+		return
+	}
 
 	if schema.Format != "" {
 		if Debug {
@@ -318,14 +1188,35 @@ func (t *typeResolver) resolveFormat(schema *spec.Schema, isAnonymous bool, isRe
 			log.Printf("%s:%d: resolving format (anon: %t, req: %t)\n", filepath.Base(file), pos, isAnonymous, isRequired) //, bbb)
 		}
 		schFmt := strings.Replace(schema.Format, "-", "", -1)
+		if (schFmt == "ipv4" || schFmt == "ipv6") && t.netIP() {
+			// a nil net.IP is the zero value, it marshals to and from its string form
+			returns = true
+			result.SwaggerType = str
+			result.SwaggerFormat = schema.Format
+			result.GoType = "net.IP"
+			result.IsPrimitive = true
+			t.addImport("net")
+			return
+		}
+		if schFmt == "byte" && t.plainBytes() {
+			// a nil []byte is the zero value, encoding/json base64-encodes it
+			returns = true
+			result.SwaggerType = str
+			result.SwaggerFormat = schema.Format
+			result.GoType = "[]byte"
+			result.zero = "nil"
+			t.inferAliasing(&result, schema, isAnonymous, isRequired)
+			result.IsPrimitive = true
+			return
+		}
 		if tpe, ok := typeMapping[schFmt]; ok {
 			returns = true
 			result.SwaggerType = str
 			if len(schema.Type) > 0 {
-				result.SwaggerType = schema.Type[0]
+				result.SwaggerType = t.firstType(schema)
 			}
 			result.SwaggerFormat = schema.Format
-			result.GoType = tpe
+			result.GoType = qualifyTimeOfDay(tpe, t.ModelsPackage)
 			t.inferAliasing(&result, schema, isAnonymous, isRequired)
 			result.IsPrimitive = schFmt != binary
 			result.IsStream = schFmt == binary
@@ -333,9 +1224,9 @@ func (t *typeResolver) resolveFormat(schema *spec.Schema, isAnonymous bool, isRe
 
 			switch result.SwaggerType {
 			case str:
-				result.IsNullable = nullableStrfmt(schema, isRequired)
+				result.IsNullable = t.nullableStrfmt(schema, isRequired)
 			case number, integer:
-				result.IsNullable = nullableNumber(schema, isRequired)
+				result.IsNullable = t.nullableNumber(schema, isRequired)
 			default:
 				result.IsNullable = t.IsNullable(schema)
 			}
@@ -346,23 +1237,64 @@ func (t *typeResolver) resolveFormat(schema *spec.Schema, isAnonymous bool, isRe
 }
 
 func (t *typeResolver) isNullable(schema *spec.Schema) bool {
-	return t.checkIsNullable(xIsNullable, schema) || t.checkIsNullable(xNullable, schema)
+	if hasNullType(schema) {
+		return true
+	}
+	if t.nativeNullable() {
+		if nullable := nullableKeyword(schema); nullable != nil {
+			return *nullable
+		}
+	}
+	return t.checkIsNullable(schema)
 }
 
-func (t *typeResolver) checkIsNullable(extension string, schema *spec.Schema) bool {
-	v, found := schema.Extensions[extension]
-	nullable, cast := v.(bool)
-	return (found && cast && nullable) || len(schema.Properties) > 0
+// checkIsNullable tells if a schema is nullable from its x-nullable or x-isnullable extension.
+// An object with properties is rendered as a pointer, unless the extension is explicitly false.
+func (t *typeResolver) checkIsNullable(schema *spec.Schema) bool {
+	if nullable := nullableExtension(schema.Extensions); nullable != nil && (*nullable || !t.Opts.compat().PointerObjects) {
+		return *nullable
+	}
+	return len(schema.Properties) > 0
 }
 
+// firstType returns the type of the schema, the null member of a type array like ["string", "null"] aside
 func (t *typeResolver) firstType(schema *spec.Schema) string {
-	if len(schema.Type) == 0 || schema.Type[0] == "" {
+	types := nonNullTypes(schema)
+	if len(types) == 0 || types[0] == "" {
+		// items are only allowed on arrays
+		if schema.Items != nil {
+			return array
+		}
 		return object
 	}
-	return schema.Type[0]
+	return types[0]
+}
+
+// nonNullTypes returns the members of the type array of the schema but null
+func nonNullTypes(schema *spec.Schema) []string {
+	if !hasNullType(schema) {
+		return schema.Type
+	}
+	types := make([]string, 0, len(schema.Type))
+	for _, tpe := range schema.Type {
+		if tpe != null {
+			types = append(types, tpe)
+		}
+	}
+	return types
 }
 
-func (t *typeResolver) resolveArray(schema *spec.Schema, isAnonymous, isRequired bool) (result resolvedType, err error) {
+// hasNullType returns true when the type array of the schema has a null member, which makes it nullable
+func hasNullType(schema *spec.Schema) bool {
+	for _, tpe := range schema.Type {
+		if tpe == null {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *typeResolver) resolveArray(schema *spec.Schema, isAnonymous, isRequired bool) (result ResolvedType, err error) {
 	if Debug {
 		_, file, pos, _ := runtime.Caller(1)
 		log.Printf("%s:%d: resolving array (anon: %t, req: %t)\n", filepath.Base(file), pos, isAnonymous, isRequired) //, bbb)
@@ -375,7 +1307,17 @@ func (t *typeResolver) resolveArray(schema *spec.Schema, isAnonymous, isRequired
 	}
 
 	if schema.Items == nil {
-		result.GoType = "[]" + iface
+		result.GoType = "[]" + t.iface()
+		result.SwaggerType = array
+		result.SwaggerFormat = ""
+		t.inferAliasing(&result, schema, isAnonymous, isRequired)
+
+		return
+	}
+
+	if len(schema.Items.Schemas) > 0 && t.tuplesAsSlices() {
+		result.GoType = "[]" + t.iface()
+		result.ElemType = &ResolvedType{IsInterface: true, GoType: t.iface(), SwaggerType: object}
 		result.SwaggerType = array
 		result.SwaggerFormat = ""
 		t.inferAliasing(&result, schema, isAnonymous, isRequired)
@@ -400,6 +1342,7 @@ func (t *typeResolver) resolveArray(schema *spec.Schema, isAnonymous, isRequired
 	}
 
 	rt.IsNullable = t.IsNullable(schema.Items.Schema) && !rt.HasDiscriminator
+	rt.IsStream = rt.IsStream || rt.SwaggerType == file
 	result.GoType = "[]" + rt.GoType
 	if rt.IsNullable && !strings.HasPrefix(rt.GoType, "*") {
 		result.GoType = "[]*" + rt.GoType
@@ -408,12 +1351,72 @@ func (t *typeResolver) resolveArray(schema *spec.Schema, isAnonymous, isRequired
 	result.ElemType = &rt
 	result.SwaggerType = array
 	result.SwaggerFormat = ""
+	// only definitions get a fixed size: their UnmarshalJSON rejects the wrong number of items
+	if n := fixedArraySize(schema); n > 0 && !isAnonymous && t.fixedArrays() {
+		result.GoType = fmt.Sprintf("[%d]%s", n, strings.TrimPrefix(result.GoType, "[]"))
+		result.FixedSize = n
+	}
 	t.inferAliasing(&result, schema, isAnonymous, isRequired)
 
 	return
 }
 
+// qualifyTimeOfDay refers to the TimeOfDay of the models package when resolving outside of it
+func qualifyTimeOfDay(tpe, pkg string) string {
+	if tpe != timeOfDay || pkg == "" {
+		return tpe
+	}
+	return pkg + "." + timeOfDay
+}
+
+func isTimeOfDay(tpe string) bool {
+	return tpe == timeOfDay || strings.HasSuffix(tpe, "."+timeOfDay)
+}
+
+// qualifyChar refers to the Char of the models package when resolving outside of it
+func qualifyChar(pkg string) string {
+	if pkg == "" {
+		return char
+	}
+	return pkg + "." + char
+}
+
+func isChar(tpe string) bool {
+	return tpe == char || strings.HasSuffix(tpe, "."+char)
+}
+
+// isSingleChar tells if the schema is a string of exactly one character: either of format char,
+// or with a minLength and a maxLength of 1. An enum or a pattern keeps it a string.
+func isSingleChar(schema *spec.Schema) bool {
+	if len(schema.Type) != 1 || schema.Type[0] != str || len(schema.Enum) > 0 || schema.Pattern != "" {
+		return false
+	}
+	if schema.Format == "char" {
+		return true
+	}
+	return schema.Format == "" && schema.MinLength != nil && *schema.MinLength == 1 &&
+		schema.MaxLength != nil && *schema.MaxLength == 1
+}
+
+// mappedType returns the go type the named definition is mapped to by the type mapping, importing its package
+func (t *typeResolver) mappedType(nm string) (typeOverride, bool) {
+	ov, ok := t.Opts.typeOverride(nm)
+	if ok && ov.Pkg != strfmtPkg {
+		t.addImport(ov.Pkg)
+	}
+	return ov, ok
+}
+
+// isMappedRef returns true when the ref points to a definition mapped to a go type by the type mapping
+func (t *typeResolver) isMappedRef(ref spec.Ref) bool {
+	_, ok := t.Opts.typeOverride(filepath.Base(ref.GetURL().Fragment))
+	return ok
+}
+
 func (t *typeResolver) goTypeName(nm string) string {
+	if ov, ok := t.mappedType(nm); ok {
+		return ov.GoType
+	}
 	if t.ModelsPackage == "" {
 		return swag.ToGoName(nm)
 	}
@@ -423,7 +1426,111 @@ func (t *typeResolver) goTypeName(nm string) string {
 	return swag.ToGoName(nm)
 }
 
-func (t *typeResolver) resolveObject(schema *spec.Schema, isAnonymous bool) (result resolvedType, err error) {
+// mapKeyKinds are the go types encoding/json serializes as the string keys of a JSON object
+var mapKeyKinds = map[string]bool{
+	"string": true,
+	"int":    true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+}
+
+// mapKeyType resolves the go type of the keys of a map declared with x-go-type-key, which is either
+// a string or an integer type, or a definition of one like a string enum. Underlying is the builtin
+// type of the keys.
+func (t *typeResolver) mapKeyType(schema *spec.Schema) (goType, underlying string, err error) {
+	name, ok := schema.Extensions.GetString(xGoTypeKey)
+	if !ok || name == "" {
+		return "", "", fmt.Errorf("%s: %s must be the name of a go type", t.ModelName, xGoTypeKey)
+	}
+	if mapKeyKinds[name] {
+		return name, name, nil
+	}
+	unsupported := fmt.Errorf("%s: %s %q isn't a supported map key, use a string or an integer type, or a definition of one", t.ModelName, xGoTypeKey, name)
+	defs := t.Doc.Spec().Definitions
+	def := name
+	if _, ok := defs[def]; !ok {
+		def = ""
+		for k := range defs {
+			if swag.ToGoName(k) == name {
+				def = k
+				break
+			}
+		}
+	}
+	if def == "" {
+		return "", "", unsupported
+	}
+	ref := spec.RefSchema("#/definitions/" + def)
+	kt, err := t.NewWithModelName(def).ResolveSchema(ref, true, true)
+	if err != nil {
+		return "", "", err
+	}
+	underlying = kt.GoType
+	if kt.IsAliased {
+		underlying = kt.AliasedType
+	}
+	if !kt.IsPrimitive || kt.IsCustomFormatter || kt.IsExternal || !mapKeyKinds[underlying] {
+		return "", "", unsupported
+	}
+	return kt.GoType, underlying, nil
+}
+
+// mapKeyString returns the go expression of a map key held in a variable, as the string it is serialized to
+func mapKeyString(goType, underlying, keyVar string) string {
+	switch {
+	case goType == "" || goType == "string":
+		return keyVar
+	case underlying == "string":
+		return "string(" + keyVar + ")"
+	case strings.HasPrefix(underlying, "uint"):
+		return "strconv.FormatUint(uint64(" + keyVar + "), 10)"
+	default:
+		return "strconv.FormatInt(int64(" + keyVar + "), 10)"
+	}
+}
+
+// discriminatorValues maps the discriminator values of the subtypes of a base type to their go type. The subtypes
+// are the known definitions composing the base type with allOf, down to the subtypes of subtypes which have no
+// discriminator of their own. A subtype is told apart by its x-class, or else by its name.
+func (t *typeResolver) discriminatorValues(base string) map[string]string {
+	definitions := t.Doc.Spec().Definitions
+	baseRef := "#/definitions/" + base
+	parents := map[string]struct{}{baseRef: {}}
+	values := make(map[string]string)
+	for found := true; found; {
+		found = false
+		for name := range t.KnownDefs {
+			schema, ok := definitions[name]
+			ref := "#/definitions/" + name
+			if !ok || ref == baseRef {
+				continue
+			}
+			if _, done := parents[ref]; done {
+				continue
+			}
+			for _, member := range schema.AllOf {
+				parent := member.Ref.String()
+				if _, ok := parents[parent]; !ok || (parent != baseRef && schema.Discriminator != "") {
+					continue
+				}
+				value, _ := schema.Extensions.GetString("x-class")
+				if value == "" {
+					value = name
+				}
+				goName, _ := schema.Extensions.GetString("x-go-name")
+				if goName == "" {
+					goName = name
+				}
+				values[value] = t.goTypeName(goName)
+				parents[ref] = struct{}{}
+				found = true
+				break
+			}
+		}
+	}
+	return values
+}
+
+func (t *typeResolver) resolveObject(schema *spec.Schema, isAnonymous bool) (result ResolvedType, err error) {
 	if Debug {
 		_, file, pos, _ := runtime.Caller(1)
 		log.Printf("%s:%d: resolving object (anon: %t, req: %t)\n", filepath.Base(file), pos, isAnonymous, false) //, bbb)
@@ -432,6 +1539,9 @@ func (t *typeResolver) resolveObject(schema *spec.Schema, isAnonymous bool) (res
 	result.IsAnonymous = isAnonymous
 
 	result.IsBaseType = schema.Discriminator != ""
+	if result.IsBaseType && !isAnonymous && t.ModelName != "" {
+		result.DiscriminatorValues = t.discriminatorValues(t.ModelName)
+	}
 	if !isAnonymous {
 		result.SwaggerType = object
 		result.GoType = t.goTypeName(t.ModelName)
@@ -444,12 +1554,48 @@ func (t *typeResolver) resolveObject(schema *spec.Schema, isAnonymous bool) (res
 			if t.IsNullable(&p) {
 				isNullable = true
 			}
+			if p.Ref.String() == "" {
+				continue
+			}
+			// a $ref member ends up as an embedded type of the composed struct
+			member := p
+			mt, er := t.ResolveSchema(&member, true, false)
+			if er != nil {
+				err = er
+				return
+			}
+			result.Embeds = append(result.Embeds, mt.GoType)
 		}
 		result.IsNullable = isNullable
 		result.SwaggerType = object
 		return
 	}
 
+	if isUnion(schema) && !isAnonymous {
+		// a union is rendered as a struct with a field for each variant,
+		// inline unions stay an interface{} unless the model builder lifts them into a model
+		result.GoType = t.goTypeName(t.ModelName)
+		result.IsComplexObject = true
+		result.IsOneOf = len(schema.OneOf) > 0
+		result.IsAnyOf = !result.IsOneOf
+		members := schema.OneOf
+		if result.IsAnyOf {
+			members = schema.AnyOf
+		}
+		for _, m := range members {
+			member := m
+			vt, er := t.ResolveSchema(&member, true, true)
+			if er != nil {
+				err = er
+				return
+			}
+			result.Variants = append(result.Variants, &vt)
+		}
+		result.IsNullable = t.IsNullable(schema)
+		result.SwaggerType = object
+		return
+	}
+
 	// if this schema has properties, build a map of property name to
 	// resolved type, this should also flag the object as anonymous,
 	// when a ref is found, the anonymous flag will be reset
@@ -469,9 +1615,24 @@ func (t *typeResolver) resolveObject(schema *spec.Schema, isAnonymous bool) (res
 		result.IsMap = !result.IsComplexObject
 		result.SwaggerType = object
 		et.IsNullable = t.IsNullable(schema.AdditionalProperties.Schema)
-		result.GoType = "map[string]" + et.GoType
+		key := "string"
+		if _, ok := schema.Extensions[xGoTypeKey]; ok {
+			if !result.IsMap {
+				err = fmt.Errorf("%s: %s only applies to maps, not to objects with properties", t.ModelName, xGoTypeKey)
+				return
+			}
+			if _, ok := schema.Extensions[xPropNames]; ok {
+				err = fmt.Errorf("%s: %s and %s can't be used together, the key type already constrains the keys", t.ModelName, xGoTypeKey, xPropNames)
+				return
+			}
+			if key, _, err = t.mapKeyType(schema); err != nil {
+				return
+			}
+			result.KeyType = key
+		}
+		result.GoType = "map[" + key + "]" + et.GoType
 		if et.IsNullable { //&& et.IsComplexObject && !et.IsBaseType {
-			result.GoType = "map[string]*" + et.GoType
+			result.GoType = "map[" + key + "]*" + et.GoType
 		}
 		t.inferAliasing(&result, schema, isAnonymous, false)
 		result.ElemType = &et
@@ -479,9 +1640,13 @@ func (t *typeResolver) resolveObject(schema *spec.Schema, isAnonymous bool) (res
 	}
 
 	if len(schema.Properties) > 0 {
+		// additionalProperties: true allows any extra key, unlike an omitted or false additionalProperties.
+		// Polymorphic types leave them out: their fields are spread across types
+		result.HasUntypedAdditionalProps = schema.AdditionalProperties != nil && schema.AdditionalProperties.Allows &&
+			schema.Discriminator == ""
 		return
 	}
-	result.GoType = iface
+	result.GoType = t.iface()
 	result.IsMap = true
 	result.IsMap = !result.IsComplexObject
 	result.SwaggerType = object
@@ -490,20 +1655,26 @@ func (t *typeResolver) resolveObject(schema *spec.Schema, isAnonymous bool) (res
 	return
 }
 
-func nullableBool(schema *spec.Schema, isRequired bool) bool {
-	if nullable := nullableExtension(schema.Extensions); nullable != nil {
+func (t *typeResolver) nullableBool(schema *spec.Schema, isRequired bool) bool {
+	if nullable := t.explicitNullable(schema); nullable != nil {
 		return *nullable
 	}
+	if nullable, ok := t.nullablePolicy(); ok {
+		return nullable
+	}
 	required := isRequired && schema.Default == nil && !schema.ReadOnly
 	optional := !isRequired && (schema.Default != nil || schema.ReadOnly)
 
 	return required || optional
 }
 
-func nullableNumber(schema *spec.Schema, isRequired bool) bool {
-	if nullable := nullableExtension(schema.Extensions); nullable != nil {
+func (t *typeResolver) nullableNumber(schema *spec.Schema, isRequired bool) bool {
+	if nullable := t.explicitNullable(schema); nullable != nil {
 		return *nullable
 	}
+	if nullable, ok := t.nullablePolicy(); ok {
+		return nullable
+	}
 	hasDefault := schema.Default != nil && !swag.IsZero(schema.Default)
 
 	isMin := schema.Minimum != nil && *schema.Minimum != 0
@@ -517,10 +1688,13 @@ func nullableNumber(schema *spec.Schema, isRequired bool) bool {
 	return nullable
 }
 
-func nullableString(schema *spec.Schema, isRequired bool) bool {
-	if nullable := nullableExtension(schema.Extensions); nullable != nil {
+func (t *typeResolver) nullableString(schema *spec.Schema, isRequired bool) bool {
+	if nullable := t.explicitNullable(schema); nullable != nil {
 		return *nullable
 	}
+	if nullable, ok := t.nullablePolicy(); ok {
+		return nullable
+	}
 	hasDefault := schema.Default != nil && !swag.IsZero(schema.Default)
 
 	isMin := schema.MinLength != nil && *schema.MinLength != 0
@@ -530,17 +1704,83 @@ func nullableString(schema *spec.Schema, isRequired bool) bool {
 	return nullable
 }
 
-func nullableStrfmt(schema *spec.Schema, isRequired bool) bool {
+func (t *typeResolver) nullableStrfmt(schema *spec.Schema, isRequired bool) bool {
 	notBinary := schema.Format != binary
-	if nullable := nullableExtension(schema.Extensions); nullable != nil && notBinary {
+	if nullable := t.explicitNullable(schema); nullable != nil && notBinary {
 		return *nullable
 	}
+	if nullable, ok := t.nullablePolicy(); ok {
+		return notBinary && nullable
+	}
 	hasDefault := schema.Default != nil && !swag.IsZero(schema.Default)
 
 	nullable := !schema.ReadOnly && (isRequired || hasDefault)
 	return notBinary && nullable
 }
 
+// nullablePolicy returns the nullability the policy imposes on the primitive properties, ok is false
+// when the heuristic of the spec decides
+func (t *typeResolver) nullablePolicy() (nullable, ok bool) {
+	if t.Opts == nil {
+		return false, false
+	}
+	switch t.Opts.NullablePolicy {
+	case NullableAlwaysPointer:
+		return true, true
+	case NullableNeverPointer:
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// explicitNullable returns the nullability set explicitly on a schema: the nullable keyword of
+// OpenAPI 3.x documents first, then the x-nullable and x-isnullable extensions
+func (t *typeResolver) explicitNullable(schema *spec.Schema) *bool {
+	if hasNullType(schema) {
+		nullable := true
+		return &nullable
+	}
+	if t.nativeNullable() {
+		if nullable := nullableKeyword(schema); nullable != nil {
+			return nullable
+		}
+	}
+	return nullableExtension(schema.Extensions)
+}
+
+// nativeNullable tells whether the document is an OpenAPI 3.x one, where nullable is a keyword of the schemas
+func (t *typeResolver) nativeNullable() bool {
+	if t.openAPI3 == nil {
+		v := isOpenAPI3(t.Doc)
+		t.openAPI3 = &v
+	}
+	return *t.openAPI3
+}
+
+// isOpenAPI3 returns true when the document declares an openapi version 3.x instead of swagger 2.0
+func isOpenAPI3(doc *loads.Document) bool {
+	if doc == nil || doc.Version() != "" || len(doc.Raw()) == 0 {
+		return false
+	}
+	var version struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(doc.Raw(), &version); err != nil {
+		return false
+	}
+	return strings.HasPrefix(version.OpenAPI, "3.")
+}
+
+// nullableKeyword returns the value of the OpenAPI 3 nullable keyword, which the swagger 2.0 schema
+// doesn't know of and keeps with the extra properties
+func nullableKeyword(schema *spec.Schema) *bool {
+	if nullable, ok := schema.ExtraProps["nullable"].(bool); ok {
+		return &nullable
+	}
+	return nil
+}
+
 func nullableExtension(ext spec.Extensions) *bool {
 	if ext == nil {
 		return nil
@@ -553,6 +1793,18 @@ func nullableExtension(ext spec.Extensions) *bool {
 	return boolExtension(ext, xIsNullable)
 }
 
+// isSensitive returns true when a value should never end up in logs,
+// this is the case for passwords and anything marked with x-sensitive
+func isSensitive(format string, ext spec.Extensions) bool {
+	if format == "password" {
+		return true
+	}
+	if boolPtr := boolExtension(ext, xSensitive); boolPtr != nil {
+		return *boolPtr
+	}
+	return false
+}
+
 func boolExtension(ext spec.Extensions, key string) *bool {
 	if v, ok := ext[key]; ok {
 		if bb, ok := v.(bool); ok {
@@ -562,7 +1814,127 @@ func boolExtension(ext spec.Extensions, key string) *bool {
 	return nil
 }
 
-func (t *typeResolver) ResolveSchema(schema *spec.Schema, isAnonymous, isRequired bool) (result resolvedType, err error) {
+// isWriteOnly returns true for the schemas only sent in requests, marked with x-writeOnly
+// or with the writeOnly keyword of OpenAPI 3, which swagger 2.0 keeps as an unknown property
+func isWriteOnly(schema *spec.Schema) bool {
+	if wo := boolExtension(schema.Extensions, xWriteOnly); wo != nil {
+		return *wo
+	}
+	wo, _ := schema.ExtraProps["writeOnly"].(bool)
+	return wo
+}
+
+// dateBounds returns the earliest and latest date-time allowed by x-min-date and x-max-date,
+// normalized to UTC in RFC 3339. The bounds are RFC 3339 date-times, or full-dates meaning midnight UTC.
+func dateBounds(format string, ext spec.Extensions) (min, max string, err error) {
+	bounds := make([]time.Time, 2)
+	var found bool
+	for i, key := range []string{xMinDate, xMaxDate} {
+		v, ok := ext[key]
+		if !ok {
+			continue
+		}
+		found = true
+		raw, ok := v.(string)
+		if !ok {
+			return "", "", fmt.Errorf("%s must be a date-time string, got %v", key, v)
+		}
+		if bounds[i], err = time.Parse(time.RFC3339Nano, raw); err != nil {
+			if bounds[i], err = time.Parse("2006-01-02", raw); err != nil {
+				return "", "", fmt.Errorf("%s must be an RFC 3339 date-time, got %q", key, raw)
+			}
+		}
+	}
+	if !found {
+		return "", "", nil
+	}
+	if format != "date-time" {
+		return "", "", fmt.Errorf("%s and %s only apply to the date-time format, got %q", xMinDate, xMaxDate, format)
+	}
+	if !bounds[0].IsZero() && !bounds[1].IsZero() && bounds[0].After(bounds[1]) {
+		return "", "", fmt.Errorf("%s is after %s", xMinDate, xMaxDate)
+	}
+	if !bounds[0].IsZero() {
+		min = bounds[0].UTC().Format(time.RFC3339Nano)
+	}
+	if !bounds[1].IsZero() {
+		max = bounds[1].UTC().Format(time.RFC3339Nano)
+	}
+	return min, max, nil
+}
+
+// unwrapProperty returns the name and the schema of the single property of a wrapper object
+// marked with x-go-unwrap, or an empty name when the schema isn't marked.
+func unwrapProperty(schema *spec.Schema) (string, *spec.Schema, error) {
+	unwrap := boolExtension(schema.Extensions, xGoUnwrap)
+	if unwrap == nil || !*unwrap {
+		return "", nil, nil
+	}
+	if (len(schema.Type) > 0 && !schema.Type.Contains(object)) || len(schema.Properties) != 1 {
+		return "", nil, fmt.Errorf("%s only applies to objects with a single property", xGoUnwrap)
+	}
+	if len(schema.AllOf) > 0 || schema.AdditionalProperties != nil || schema.Discriminator != "" {
+		return "", nil, fmt.Errorf("%s only applies to objects with a single property", xGoUnwrap)
+	}
+	for k, v := range schema.Properties {
+		prop := v
+		return k, &prop, nil
+	}
+	return "", nil, nil
+}
+
+// customTag returns the struct tag of a property marked with x-go-custom-tag, like validate:"required".
+// It must be a list of key:"value" pairs, which are normalized to be separated by a single space.
+func customTag(schema *spec.Schema) (string, error) {
+	v, ok := schema.Extensions[xCustomTag]
+	if !ok {
+		return "", nil
+	}
+	tag, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string, like validate:\"required\"", xCustomTag)
+	}
+	if strings.Contains(tag, "`") {
+		return "", fmt.Errorf("%s %q can't contain a backquote", xCustomTag, tag)
+	}
+	var pairs, keys []string
+	for rest := strings.TrimSpace(tag); rest != ""; rest = strings.TrimLeft(rest, " ") {
+		// the key and the quoted value are scanned the way reflect.StructTag reads them
+		i := 0
+		for i < len(rest) && rest[i] > ' ' && rest[i] != ':' && rest[i] != '"' && rest[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(rest) || rest[i] != ':' || rest[i+1] != '"' {
+			return "", fmt.Errorf("%s %q must be a list of key:\"value\" pairs", xCustomTag, tag)
+		}
+		key := rest[:i]
+		j := i + 2
+		for j < len(rest) && rest[j] != '"' {
+			if rest[j] == '\\' {
+				j++
+			}
+			j++
+		}
+		if j >= len(rest) {
+			return "", fmt.Errorf("%s %q must be a list of key:\"value\" pairs", xCustomTag, tag)
+		}
+		if _, err := strconv.Unquote(rest[i+1 : j+1]); err != nil {
+			return "", fmt.Errorf("%s %q has an invalid value for %s: %v", xCustomTag, tag, key, err)
+		}
+		if key == "json" {
+			return "", fmt.Errorf("%s %q can't set the json tag, it is rendered from the property", xCustomTag, tag)
+		}
+		if containsString(keys, key) {
+			return "", fmt.Errorf("%s %q sets the %s tag twice", xCustomTag, tag, key)
+		}
+		keys = append(keys, key)
+		pairs = append(pairs, rest[:j+1])
+		rest = rest[j+1:]
+	}
+	return strings.Join(pairs, " "), nil
+}
+
+func (t *typeResolver) ResolveSchema(schema *spec.Schema, isAnonymous, isRequired bool) (result ResolvedType, err error) {
 	if Debug {
 		// bbb, _ := json.MarshalIndent(schema, "", "  ")
 		_, file, pos, _ := runtime.Caller(1)
@@ -572,11 +1944,42 @@ func (t *typeResolver) ResolveSchema(schema *spec.Schema, isAnonymous, isRequire
 	}
 	if schema == nil {
 		result.IsInterface = true
-		result.GoType = iface
+		result.GoType = t.iface()
 		return
 	}
+	if ignored := boolExtension(schema.Extensions, xGoIgnore); ignored != nil && *ignored {
+		defer func() { result.IsIgnored = true }()
+	}
+	defer func() {
+		// a $ref has already got the documentation of its target
+		if result.Description == "" {
+			result.Description = schema.Description
+		}
+		if result.Example == "" && schema.Example != nil {
+			result.Example = fmt.Sprintf("%#v", schema.Example)
+		}
+	}()
+	defer func() {
+		result.IsReadOnly = schema.ReadOnly
+		result.IsWriteOnly = isWriteOnly(schema)
+	}()
+	if schema.Default != nil {
+		defer func() {
+			if result.IsArray || result.IsMap {
+				result.defaultValue, result.hasDefault = schema.Default, true
+			}
+		}()
+	}
+	if t.contextFormat(schema.Format) {
+		defer func() { result.ContextFormat = schema.Format }()
+	}
 
 	var returns bool
+	returns, result, err = t.resolveGoType(schema, isAnonymous, isRequired)
+	if returns {
+		return
+	}
+
 	returns, result, err = t.resolveSchemaRef(schema, isRequired)
 	if returns {
 		if !isAnonymous {
@@ -586,6 +1989,21 @@ func (t *typeResolver) ResolveSchema(schema *spec.Schema, isAnonymous, isRequire
 		return
 	}
 
+	if len(schema.Enum) > 0 {
+		defer func() {
+			if err == nil && isEnumConstType(&result) {
+				result.EnumValues = schema.Enum
+			}
+		}()
+	}
+
+	if types := nonNullTypes(schema); len(types) > 1 {
+		log.Printf("warning: %s: the schema has the types %v, it is rendered as %s", t.ModelName, types, t.iface())
+		result.IsInterface = true
+		result.GoType = t.iface()
+		return
+	}
+
 	returns, result, err = t.resolveFormat(schema, isAnonymous, isRequired)
 	if returns {
 		return
@@ -606,11 +2024,11 @@ func (t *typeResolver) ResolveSchema(schema *spec.Schema, isAnonymous, isRequire
 		case boolean:
 			result.IsPrimitive = true
 			result.IsCustomFormatter = false
-			result.IsNullable = nullableBool(schema, isRequired)
+			result.IsNullable = t.nullableBool(schema, isRequired)
 		case number, integer:
 			result.IsPrimitive = true
 			result.IsCustomFormatter = false
-			result.IsNullable = nullableNumber(schema, isRequired)
+			result.IsNullable = t.nullableNumber(schema, isRequired)
 		case file:
 		}
 		return
@@ -621,13 +2039,13 @@ func (t *typeResolver) ResolveSchema(schema *spec.Schema, isAnonymous, isRequire
 		t.inferAliasing(&result, schema, isAnonymous, isRequired)
 
 		result.IsPrimitive = true
-		result.IsNullable = nullableString(schema, isRequired)
+		result.IsNullable = t.nullableString(schema, isRequired)
 		return
 
 	case object:
 		rt, err2 := t.resolveObject(schema, isAnonymous)
 		if err2 != nil {
-			return resolvedType{}, err2
+			return ResolvedType{}, err2
 		}
 		rt.HasDiscriminator = schema.Discriminator != ""
 		return rt, nil
@@ -638,38 +2056,176 @@ func (t *typeResolver) ResolveSchema(schema *spec.Schema, isAnonymous, isRequire
 	}
 }
 
-// A resolvedType is a swagger type that has been resolved and analyzed for usage
+// A ResolvedType is a swagger type that has been resolved and analyzed for usage
 // in a template
-type resolvedType struct {
-	IsAnonymous       bool
-	IsArray           bool
-	IsMap             bool
-	IsInterface       bool
-	IsPrimitive       bool
+type ResolvedType struct {
+	// IsAnonymous is true for the inline schemas, which don't get a type name of their own
+	IsAnonymous bool
+	// IsArray is true for the slices, ElemType is the type of their items
+	IsArray bool
+	// IsMap is true for the maps with string keys, ElemType is the type of their values
+	IsMap bool
+	// IsInterface is true for the schemas without any type, rendered as interface{}
+	IsInterface bool
+	// IsPrimitive is true for the booleans, numbers and strings, formatted or not
+	IsPrimitive bool
+	// IsCustomFormatter is true for the formatted strings rendered as a type of the strfmt package
 	IsCustomFormatter bool
-	IsAliased         bool
-	IsNullable        bool
-	IsStream          bool
-	HasDiscriminator  bool
+	// IsAliased is true when GoType is a named type defined with AliasedType as underlying type
+	IsAliased bool
+	// IsNullable is true when the value is rendered as a pointer, to tell it apart from its zero value
+	IsNullable bool
+	// IsStream is true for the binary strings, rendered as an io.ReadCloser
+	IsStream bool
+	// IsIgnored is true for the schemas marked with x-go-ignore
+	IsIgnored bool
+	// HasDiscriminator is true for the polymorphic types, rendered as an interface
+	HasDiscriminator bool
+
+	// FixedSize is the size of an array rendered as [FixedSize]T instead of a slice
+	FixedSize int64
+
+	// ContextFormat is set when the value is validated by a context-aware format validator
+	ContextFormat string
 
 	// A tuple gets rendered as an anonymous struct with P{index} as property name
-	IsTuple            bool
+	IsTuple bool
+	// HasAdditionalItems is true for the tuples which allow more items than their positional ones
 	HasAdditionalItems bool
-	IsComplexObject    bool
-	IsBaseType         bool
-
-	GoType        string
-	AliasedType   string
+	// IsComplexObject is true for the objects rendered as a struct
+	IsComplexObject bool
+	// IsBaseType is true for the schemas with a discriminator, which other types extend
+	IsBaseType bool
+
+	// GoType is the go type rendered for the schema, qualified with its package when outside the models package
+	GoType string
+	// AliasedType is the underlying type of an aliased GoType
+	AliasedType string
+	// SwaggerType and SwaggerFormat are the type and the format of the schema
 	SwaggerType   string
 	SwaggerFormat string
 
-	ElemType *resolvedType
+	// ElemType is the type of the items of an array or of the values of a map
+	ElemType *ResolvedType
+
+	// KeyType is the go type of the keys of a map declared with x-go-type-key, empty for string keys
+	KeyType string
+
+	// IsChar is true for the single character strings resolved to the Char type of the models package
+	IsChar bool
+
+	// Embeds lists the go types of the $ref members of an allOf composition
+	Embeds []string
+
+	// IsExternal is true for the schemas rendered as the go type named by x-go-type,
+	// which brings its own serialization and validation
+	IsExternal bool
+
+	// IsOneOf and IsAnyOf are set on the named unions of the variants of a oneOf or anyOf composition,
+	// the resolved types of the variants are listed in Variants
+	IsOneOf  bool
+	IsAnyOf  bool
+	Variants []*ResolvedType
+
+	// HasUntypedAdditionalProps is true for the objects with properties declaring additionalProperties: true,
+	// the values of their extra keys are kept in a map of untyped values
+	HasUntypedAdditionalProps bool
+
+	// Description and Example document the schema, for a $ref they are the ones of its target
+	Description string
+	Example     string
+
+	// IsReadOnly and IsWriteOnly are set on the values only sent in responses, with readOnly,
+	// or only sent in requests, with x-writeOnly or the writeOnly keyword of OpenAPI 3
+	IsReadOnly  bool
+	IsWriteOnly bool
+
+	// zero is the go expression of the zero value of a go type declared by x-go-type with a zero hint
+	zero string
+
+	// defaultValue is the default of an array or a map, its zero value when hasDefault is set
+	defaultValue interface{}
+	hasDefault   bool
+
+	// EnumValues lists the values of the enum of a string or numeric schema, declared as go constants
+	EnumValues []interface{}
+
+	// DiscriminatorValues maps the discriminator values of the subtypes of a base type to their go type,
+	// the polymorphic values are unmarshalled into the subtype their discriminator field tells
+	DiscriminatorValues map[string]string
+}
+
+// isEnumConstType returns true when the resolved type is a string or a number the values of which
+// can be declared as go constants, the formatted strings of the strfmt package and the chars excluded
+func isEnumConstType(rt *ResolvedType) bool {
+	if !rt.IsPrimitive || rt.IsCustomFormatter || rt.IsChar || rt.IsArray || rt.IsMap {
+		return false
+	}
+	switch rt.SwaggerType {
+	case str, number, integer:
+	default:
+		return false
+	}
+	tpe := rt.GoType
+	if rt.IsAliased {
+		tpe = rt.AliasedType
+	}
+	switch tpe {
+	case "string", "float32", "float64",
+		"int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+	return false
+}
+
+// Zero returns the go expression of the zero value of the type, empty when there is none.
+//
+// A nullable custom formatter is a pointer, its zero is nil. An array or a map with a default
+// starts with that default, rendered as a composite literal.
+func (rt *ResolvedType) Zero() string {
+	if rt.IsCustomFormatter && rt.IsNullable {
+		return "nil"
+	}
+	return rt.zeroValue()
 }
 
-func (rt *resolvedType) Zero() string {
+// zeroValue returns the zero of the value of the type, regardless of its nullability
+func (rt *ResolvedType) zeroValue() string {
 	if zr, ok := zeroes[rt.GoType]; ok {
 		return zr
 	}
+	if rt.zero != "" && !rt.IsAliased {
+		return rt.zero
+	}
+	if rt.IsAliased && (rt.IsCustomFormatter || rt.zero != "") {
+		// a named type converts the zero of its underlying type
+		zr := rt.zero
+		if zr == "" {
+			zr = zeroes[rt.AliasedType]
+		}
+		switch zr {
+		case "":
+		case "nil":
+			return zr
+		default:
+			return rt.GoType + "(" + zr + ")"
+		}
+	}
+	if isTimeOfDay(rt.GoType) {
+		return rt.GoType + "{}"
+	}
+	if isChar(rt.GoType) && rt.IsPrimitive {
+		return rt.GoType + "(0)"
+	}
+	if rt.hasDefault {
+		if literal := defaultLiteral(rt, rt.defaultValue); literal != "" {
+			return literal
+		}
+	}
+	if rt.FixedSize > 0 {
+		return rt.GoType + "{}"
+	}
 	if rt.IsMap || rt.IsArray {
 		return "make(" + rt.GoType + ")"
 	}
@@ -682,7 +2238,83 @@ func (rt *resolvedType) Zero() string {
 	if rt.IsInterface {
 		return "nil"
 	}
+	if rt.IsCustomFormatter {
+		// a formatter without a known zero, like the go types with a formatter hint, is usually a struct
+		return rt.GoType + "{}"
+	}
+
+	return ""
+}
+
+// defaultLiteral renders the default of an array or a map as a go composite literal, nil for a null default.
+// It is empty when the default is no literal of the type, like objects in an array of models.
+func defaultLiteral(rt *ResolvedType, value interface{}) string {
+	if value == nil {
+		return "nil"
+	}
+	var elems []string
+	switch v := value.(type) {
+	case []interface{}:
+		if !rt.IsArray || rt.ElemType == nil || (rt.FixedSize > 0 && int64(len(v)) > rt.FixedSize) {
+			return ""
+		}
+		for _, item := range v {
+			elem := elemLiteral(rt.ElemType, item)
+			if elem == "" {
+				return ""
+			}
+			elems = append(elems, elem)
+		}
+	case map[string]interface{}:
+		if !rt.IsMap || rt.ElemType == nil || (rt.KeyType != "" && rt.KeyType != "string") {
+			return ""
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			elem := elemLiteral(rt.ElemType, v[k])
+			if elem == "" {
+				return ""
+			}
+			elems = append(elems, strconv.Quote(k)+": "+elem)
+		}
+	default:
+		return ""
+	}
+	return rt.GoType + "{" + strings.Join(elems, ", ") + "}"
+}
 
+// elemLiteral renders an item of the default of an array or a map, as an untyped constant for a primitive
+func elemLiteral(rt *ResolvedType, value interface{}) string {
+	if rt.IsArray || rt.IsMap {
+		return defaultLiteral(rt, value)
+	}
+	if value == nil {
+		if rt.IsNullable || rt.IsInterface {
+			return "nil"
+		}
+		return ""
+	}
+	if !rt.IsPrimitive || rt.IsNullable || rt.IsCustomFormatter || rt.IsStream {
+		return ""
+	}
+	switch v := value.(type) {
+	case string:
+		if rt.SwaggerType == str {
+			return strconv.Quote(v)
+		}
+	case float64:
+		if rt.SwaggerType == number || (rt.SwaggerType == integer && v == math.Trunc(v)) {
+			return asGoLiteral(v)
+		}
+	case bool:
+		if rt.SwaggerType == boolean {
+			return strconv.FormatBool(v)
+		}
+	}
 	return ""
 }
 
@@ -709,6 +2341,7 @@ var primitives = map[string]struct{}{
 }
 
 var customFormatters = map[string]struct{}{
+	timeOfDay:           struct{}{},
 	"strfmt.DateTime":   struct{}{},
 	"strfmt.Date":       struct{}{},
 	"strfmt.URI":        struct{}{},
@@ -733,3 +2366,25 @@ var customFormatters = map[string]struct{}{
 	"io.ReadCloser":     struct{}{},
 	"io.Writer":         struct{}{},
 }
+
+// parsedFormatters are the custom formatters which can't hold a malformed value once unmarshaled,
+// or which don't have a format to check
+var parsedFormatters = map[string]struct{}{
+	timeOfDay:         struct{}{},
+	"strfmt.DateTime": struct{}{},
+	"strfmt.Date":     struct{}{},
+	"strfmt.Base64":   struct{}{},
+	"strfmt.Duration": struct{}{},
+	"strfmt.Password": struct{}{},
+	"io.ReadCloser":   struct{}{},
+	"io.Writer":       struct{}{},
+}
+
+// validatesFormat returns true when a value of the go type gets its format checked by validation only
+func validatesFormat(goType string) bool {
+	if _, ok := customFormatters[goType]; !ok {
+		return false
+	}
+	_, parsed := parsedFormatters[goType]
+	return !parsed
+}